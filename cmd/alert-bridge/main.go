@@ -2,21 +2,27 @@ package main
 
 import (
 	"context"
+	"flag"
+	"fmt"
 	"log"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/app"
 )
 
 func main() {
-	configPath := os.Getenv("CONFIG_PATH")
-	if configPath == "" {
-		configPath = "config/config.yaml"
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		runMigrate(os.Args[2:])
+		return
 	}
 
-	application, err := app.New(configPath)
+	dryRun := flag.Bool("dry-run", false, "log rendered notifications instead of sending them")
+	flag.Parse()
+
+	application, err := app.New(configPath(), app.WithDryRun(*dryRun))
 	if err != nil {
 		log.Fatalf("failed to initialize application: %v", err)
 	}
@@ -28,7 +34,58 @@ func main() {
 		log.Fatalf("server error: %v", err)
 	}
 
+	// Start returns once the server has stopped; Shutdown then drains
+	// notifiers before closing the database.
 	if err := application.Shutdown(); err != nil {
 		log.Fatalf("shutdown error: %v", err)
 	}
 }
+
+// runMigrate handles `alert-bridge migrate up|down [version]|status` without
+// starting the server. down without a version reverts the latest migration.
+func runMigrate(args []string) {
+	fs := flag.NewFlagSet("migrate", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: alert-bridge migrate up|down [version]|status")
+	}
+	_ = fs.Parse(args)
+
+	action, toVersion, ok := parseMigrateArgs(fs.Args())
+	if !ok {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if err := app.Migrate(ctx, configPath(), action, toVersion, os.Stdout); err != nil {
+		log.Fatalf("migrate %s: %v", action, err)
+	}
+}
+
+// parseMigrateArgs returns the action and target version of the migrate
+// arguments, reporting false if they do not match the usage. Unknown
+// actions are left to app.Migrate to reject.
+func parseMigrateArgs(args []string) (action string, toVersion int, ok bool) {
+	switch {
+	case len(args) == 2 && args[0] == app.MigrateDown:
+		version, err := strconv.Atoi(args[1])
+		if err != nil || version < 0 {
+			return "", 0, false
+		}
+		return args[0], version, true
+	case len(args) == 1:
+		return args[0], app.PreviousVersion, true
+	default:
+		return "", 0, false
+	}
+}
+
+// configPath returns the config file path from CONFIG_PATH or the default.
+func configPath() string {
+	if path := os.Getenv("CONFIG_PATH"); path != "" {
+		return path
+	}
+	return "config/config.yaml"
+}
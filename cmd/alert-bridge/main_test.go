@@ -0,0 +1,42 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/app"
+)
+
+func TestParseMigrateArgs(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		wantAction    string
+		wantToVersion int
+		wantOK        bool
+	}{
+		{name: "up", args: []string{"up"}, wantAction: app.MigrateUp, wantToVersion: app.PreviousVersion, wantOK: true},
+		{name: "status", args: []string{"status"}, wantAction: app.MigrateStatus, wantToVersion: app.PreviousVersion, wantOK: true},
+		{name: "down to the previous version", args: []string{"down"}, wantAction: app.MigrateDown, wantToVersion: app.PreviousVersion, wantOK: true},
+		{name: "down to a version", args: []string{"down", "3"}, wantAction: app.MigrateDown, wantToVersion: 3, wantOK: true},
+		{name: "down to zero", args: []string{"down", "0"}, wantAction: app.MigrateDown, wantToVersion: 0, wantOK: true},
+		{name: "no action", args: nil},
+		{name: "negative version", args: []string{"down", "-1"}},
+		{name: "version that is not a number", args: []string{"down", "three"}},
+		{name: "version for up", args: []string{"up", "3"}},
+		{name: "too many arguments", args: []string{"down", "3", "4"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action, toVersion, ok := parseMigrateArgs(tt.args)
+			if ok != tt.wantOK {
+				t.Fatalf("expected ok %v, got %v", tt.wantOK, ok)
+			}
+			if !ok {
+				return
+			}
+			if action != tt.wantAction || toVersion != tt.wantToVersion {
+				t.Errorf("expected %s to %d, got %s to %d", tt.wantAction, tt.wantToVersion, action, toVersion)
+			}
+		})
+	}
+}
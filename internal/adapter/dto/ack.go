@@ -0,0 +1,93 @@
+package dto
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// AckRequest is the optional body of POST /api/v1/alerts/{id}/ack. The
+// acknowledging user is the authenticated caller.
+type AckRequest struct {
+	Note string `json:"note"`
+
+	// Duration acknowledges the alert for this long, e.g. "2h" (optional).
+	Duration string `json:"duration"`
+}
+
+// ParseDuration returns the ack duration, or nil if none is set.
+func (r *AckRequest) ParseDuration() (*time.Duration, error) {
+	if r.Duration == "" {
+		return nil, nil
+	}
+	d, err := time.ParseDuration(r.Duration)
+	if err != nil || d <= 0 {
+		return nil, fmt.Errorf("duration must be a positive duration like \"2h\", got %q", r.Duration)
+	}
+	return &d, nil
+}
+
+// AckResponse reports the outcome of POST /api/v1/alerts/{id}/ack.
+type AckResponse struct {
+	AlertID      string   `json:"alert_id"`
+	State        string   `json:"state"`
+	AckedBy      string   `json:"acked_by"`
+	AlreadyAcked bool     `json:"already_acked"`
+	SyncedTo     []string `json:"synced_to,omitempty"`
+	SyncFailed   []string `json:"sync_failed,omitempty"`
+	NoteFailed   []string `json:"note_failed,omitempty"`
+}
+
+// BulkAckRequest is the body of POST /api/v1/alerts/ack. Exactly one of
+// AlertIDs and Selector is set.
+type BulkAckRequest struct {
+	AlertIDs []string `json:"alert_ids"`
+
+	// Selector acknowledges every unacknowledged firing alert whose labels
+	// include all of its pairs.
+	Selector map[string]string `json:"selector"`
+
+	// UserEmail identifies who acknowledged, for the ack events and the
+	// PagerDuty sync.
+	UserEmail string `json:"user_email"`
+	UserName  string `json:"user_name"`
+	Note      string `json:"note"`
+}
+
+// Validate checks the required fields.
+func (r *BulkAckRequest) Validate() error {
+	switch {
+	case len(r.AlertIDs) == 0 && len(r.Selector) == 0:
+		return errors.New("alert_ids or selector is required")
+	case len(r.AlertIDs) > 0 && len(r.Selector) > 0:
+		return errors.New("alert_ids and selector are mutually exclusive")
+	case r.UserEmail == "":
+		return errors.New("user_email is required")
+	}
+	for _, id := range r.AlertIDs {
+		if id == "" {
+			return errors.New("alert_ids must not contain empty IDs")
+		}
+	}
+	for key := range r.Selector {
+		if key == "" {
+			return errors.New("selector must not have empty label names")
+		}
+	}
+	return nil
+}
+
+// BulkAckResult is the outcome for one alert of a bulk ack.
+type BulkAckResult struct {
+	AlertID  string   `json:"alert_id"`
+	OK       bool     `json:"ok"`
+	Error    string   `json:"error,omitempty"`
+	SyncedTo []string `json:"synced_to,omitempty"`
+}
+
+// BulkAckResponse reports each alert of a bulk ack.
+type BulkAckResponse struct {
+	Acknowledged int             `json:"acknowledged"`
+	Failed       int             `json:"failed"`
+	Results      []BulkAckResult `json:"results"`
+}
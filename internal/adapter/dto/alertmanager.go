@@ -1,6 +1,14 @@
 package dto
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
@@ -21,6 +29,108 @@ type AlertmanagerWebhook struct {
 	Alerts            []AlertmanagerAlert `json:"alerts"`
 }
 
+// IdempotencyKey derives a stable key for the payload from the group key and the
+// alerts' fingerprints, statuses and timestamps. Alertmanager retries resend the
+// exact same payload, so they map to the same key.
+func (w *AlertmanagerWebhook) IdempotencyKey() string {
+	h := sha256.New()
+	h.Write([]byte(w.GroupKey + "\x00" + w.Status))
+	for _, a := range w.Alerts {
+		h.Write([]byte("\x00" + a.Fingerprint + "\x00" + a.Status + "\x00" +
+			a.StartsAt.UTC().Format(time.RFC3339Nano) + "\x00" + a.EndsAt.UTC().Format(time.RFC3339Nano)))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Validate checks the fields alert processing relies on and reports all
+// missing or invalid ones at once as a *PayloadError.
+func (w *AlertmanagerWebhook) Validate() error {
+	var problems []string
+	if !validAlertStatus(w.Status) {
+		problems = append(problems, statusProblem("status", w.Status))
+	}
+	if len(w.Alerts) == 0 {
+		problems = append(problems, "alerts must not be empty")
+	}
+	for i, a := range w.Alerts {
+		field := fmt.Sprintf("alerts[%d]", i)
+		if !validAlertStatus(a.Status) {
+			problems = append(problems, statusProblem(field+".status", a.Status))
+		}
+		if a.Labels["alertname"] == "" {
+			problems = append(problems, field+".labels.alertname is required")
+		}
+		if a.StartsAt.IsZero() {
+			problems = append(problems, field+".startsAt is required")
+		}
+	}
+	if len(problems) > 0 {
+		return &PayloadError{Problems: problems}
+	}
+	return nil
+}
+
+func validAlertStatus(status string) bool {
+	return status == "firing" || status == "resolved"
+}
+
+func statusProblem(field, status string) string {
+	if status == "" {
+		return field + " is required"
+	}
+	return fmt.Sprintf("%s must be firing or resolved, got %q", field, status)
+}
+
+// AlertmanagerURLAnnotation is the annotation set to the alert's page in the
+// Alertmanager UI when the payload carries an externalURL.
+const AlertmanagerURLAnnotation = "alertmanager_url"
+
+// ToProcessAlertInput converts one of the payload's alerts like the
+// package-level ToProcessAlertInput, after filling in the labels and
+// annotations it shares with the group from commonLabels and
+// commonAnnotations. The alert's own values take precedence.
+func (w *AlertmanagerWebhook) ToProcessAlertInput(alert AlertmanagerAlert) ProcessAlertInput {
+	alert.Labels = mergeMissing(alert.Labels, w.CommonLabels)
+	alert.Annotations = mergeMissing(alert.Annotations, w.CommonAnnotations)
+	if alertmanagerURL := w.alertURL(alert); alertmanagerURL != "" && alert.Annotations[AlertmanagerURLAnnotation] == "" {
+		alert.Annotations[AlertmanagerURLAnnotation] = alertmanagerURL
+	}
+	return ToProcessAlertInput(alert)
+}
+
+// alertURL links to the alert's notification group in the Alertmanager UI:
+// the alerts of the receiver matching the group labels and alertname.
+// Returns "" when the payload has no externalURL.
+func (w *AlertmanagerWebhook) alertURL(alert AlertmanagerAlert) string {
+	if w.ExternalURL == "" {
+		return ""
+	}
+	matchers := make(map[string]string, len(w.GroupLabels)+1)
+	maps.Copy(matchers, w.GroupLabels)
+	if name := alert.Labels["alertname"]; name != "" {
+		matchers["alertname"] = name
+	}
+	filter := make([]string, 0, len(matchers))
+	for _, name := range slices.Sorted(maps.Keys(matchers)) {
+		filter = append(filter, fmt.Sprintf("%s=%q", name, matchers[name]))
+	}
+
+	query := url.Values{}
+	query.Set("filter", "{"+strings.Join(filter, ",")+"}")
+	if w.Receiver != "" {
+		query.Set("receiver", w.Receiver)
+	}
+	return strings.TrimSuffix(w.ExternalURL, "/") + "/#/alerts?" + query.Encode()
+}
+
+// mergeMissing returns a copy of values with the entries of defaults it lacks.
+func mergeMissing(values, defaults map[string]string) map[string]string {
+	merged := make(map[string]string, len(values)+len(defaults))
+	maps.Copy(merged, defaults)
+	maps.Copy(merged, values)
+	return merged
+}
+
 // AlertmanagerAlert represents a single alert in the Alertmanager webhook payload.
 type AlertmanagerAlert struct {
 	Status       string            `json:"status"` // "firing" or "resolved"
@@ -35,16 +145,19 @@ type AlertmanagerAlert struct {
 // ProcessAlertInput represents the input for processing an alert.
 type ProcessAlertInput struct {
 	Fingerprint string
-	Name        string
-	Instance    string
-	Target      string
-	Summary     string
-	Description string
-	Severity    entity.AlertSeverity
-	Status      string // "firing" or "resolved"
-	Labels      map[string]string
-	Annotations map[string]string
-	FiredAt     time.Time
+	// LegacyFingerprint is the fingerprint earlier releases derived for the
+	// alert, if any; a firing alert stored under it is matched too.
+	LegacyFingerprint string
+	Name              string
+	Instance          string
+	Target            string
+	Summary           string
+	Description       string
+	Severity          entity.AlertSeverity
+	Status            string // "firing" or "resolved"
+	Labels            map[string]string
+	Annotations       map[string]string
+	FiredAt           time.Time
 }
 
 // ToProcessAlertInput converts an AlertmanagerAlert to ProcessAlertInput.
@@ -91,12 +204,55 @@ type ProcessAlertOutput struct {
 	AlertID             string
 	IsNew               bool
 	IsSilenced          bool
+	IsHeld              bool // Notifications held back for quiet hours
+	IsDigested          bool // Only reported in digests
+	IsSuppressed        bool // Suppressed by a firing parent alert
+	IsThrottled         bool // Over the burst limit, held back from chat and posted later
 	NotificationsSent   []string
 	NotificationsFailed []NotificationError
 }
 
+// NotificationErr joins the errors of the failed notifications, each prefixed
+// with its notifier name. Returns nil if every notification succeeded.
+func (o *ProcessAlertOutput) NotificationErr() error {
+	errs := make([]error, 0, len(o.NotificationsFailed))
+	for _, failed := range o.NotificationsFailed {
+		errs = append(errs, fmt.Errorf("%s: %w", failed.NotifierName, failed.Error))
+	}
+	return errors.Join(errs...)
+}
+
 // NotificationError represents a failed notification attempt.
 type NotificationError struct {
 	NotifierName string
 	Error        error
 }
+
+// RenotifyAlertOutput reports which notifiers re-sent an alert's notification.
+type RenotifyAlertOutput struct {
+	AlertID   string            `json:"alert_id"`
+	Succeeded []string          `json:"succeeded"`
+	Failed    map[string]string `json:"failed,omitempty"`
+}
+
+// ToRenotifyAlertOutput converts a process output into the renotify response.
+func ToRenotifyAlertOutput(output *ProcessAlertOutput) RenotifyAlertOutput {
+	result := RenotifyAlertOutput{
+		AlertID:   output.AlertID,
+		Succeeded: make([]string, 0, len(output.NotificationsSent)),
+	}
+	result.Succeeded = append(result.Succeeded, output.NotificationsSent...)
+	if len(output.NotificationsFailed) > 0 {
+		result.Failed = make(map[string]string, len(output.NotificationsFailed))
+		for _, failed := range output.NotificationsFailed {
+			result.Failed[failed.NotifierName] = failed.Error.Error()
+		}
+	}
+	return result
+}
+
+// PreviewAlertOutput holds the payload each notifier would send for an alert.
+type PreviewAlertOutput struct {
+	Previews map[string]any    `json:"previews"`
+	Errors   map[string]string `json:"errors,omitempty"`
+}
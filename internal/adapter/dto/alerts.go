@@ -0,0 +1,92 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+)
+
+// AlertListOutput is returned by GET /api/v1/alerts.
+type AlertListOutput struct {
+	Alerts []AlertSummary `json:"alerts"`
+	Count  int            `json:"count"`
+
+	// ETag identifies this result set; it changes whenever an alert in the
+	// list is added, removed or updated.
+	ETag string `json:"-"`
+}
+
+// AlertSummary is one firing alert in an AlertListOutput.
+type AlertSummary struct {
+	ID          string            `json:"id"`
+	Fingerprint string            `json:"fingerprint"`
+	Name        string            `json:"name"`
+	Instance    string            `json:"instance,omitempty"`
+	Summary     string            `json:"summary,omitempty"`
+	Severity    string            `json:"severity"`
+	State       string            `json:"state"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	TenantID    string            `json:"tenant_id,omitempty"`
+	FiredAt     time.Time         `json:"fired_at"`
+	AckedBy     string            `json:"acked_by,omitempty"`
+	AckedAt     *time.Time        `json:"acked_at,omitempty"`
+	AssignedTo  string            `json:"assigned_to,omitempty"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+// NewAlertSummary maps an alert to its API representation.
+func NewAlertSummary(alert *entity.Alert) AlertSummary {
+	return AlertSummary{
+		ID:          alert.ID,
+		Fingerprint: alert.Fingerprint,
+		Name:        alert.Name,
+		Instance:    alert.Instance,
+		Summary:     alert.Summary,
+		Severity:    string(alert.Severity),
+		State:       string(alert.State),
+		Labels:      alert.Labels,
+		TenantID:    alert.TenantID,
+		FiredAt:     alert.FiredAt,
+		AckedBy:     alert.AckedBy,
+		AckedAt:     alert.AckedAt,
+		AssignedTo:  alert.AssignedTo,
+		UpdatedAt:   alert.UpdatedAt,
+	}
+}
+
+// SilencedAlertsOutput is returned by GET /api/v1/alerts/silenced.
+type SilencedAlertsOutput struct {
+	Alerts []SilencedAlert `json:"alerts"`
+	Count  int             `json:"count"`
+}
+
+// SilencedAlert is a firing alert together with the active silences matching it.
+type SilencedAlert struct {
+	AlertSummary
+	Silences []SilenceSummary `json:"silences"`
+}
+
+// SilenceSummary is one silence in a SilencedAlert, or the silence
+// returned by POST /api/v1/silences/{id}/expire.
+type SilenceSummary struct {
+	ID             string    `json:"id"`
+	CreatedBy      string    `json:"created_by"`
+	CreatedByEmail string    `json:"created_by_email,omitempty"`
+	Reason         string    `json:"reason,omitempty"`
+	Source         string    `json:"source"`
+	StartAt        time.Time `json:"start_at"`
+	EndAt          time.Time `json:"end_at"`
+}
+
+// NewSilenceSummary maps a silence to its API representation.
+func NewSilenceSummary(silence *entity.SilenceMark) SilenceSummary {
+	return SilenceSummary{
+		ID:             silence.ID,
+		CreatedBy:      silence.CreatedBy,
+		CreatedByEmail: silence.CreatedByEmail,
+		Reason:         silence.Reason,
+		Source:         string(silence.Source),
+		StartAt:        silence.StartAt,
+		EndAt:          silence.EndAt,
+	}
+}
@@ -0,0 +1,32 @@
+package dto
+
+import (
+	"errors"
+	"time"
+)
+
+// AssignRequest is the body of POST /api/v1/alerts/{id}/assign.
+type AssignRequest struct {
+	// Assignee identifies the responder who owns the alert, usually by
+	// email. An empty assignee unassigns the alert.
+	Assignee string `json:"assignee"`
+
+	// AssignedBy identifies who made the assignment.
+	AssignedBy string `json:"assigned_by"`
+}
+
+// Validate checks the required fields.
+func (r *AssignRequest) Validate() error {
+	if r.AssignedBy == "" {
+		return errors.New("assigned_by is required")
+	}
+	return nil
+}
+
+// AssignResponse reports the outcome of POST /api/v1/alerts/{id}/assign.
+type AssignResponse struct {
+	AlertID    string     `json:"alert_id"`
+	AssignedTo string     `json:"assigned_to"`
+	AssignedAt *time.Time `json:"assigned_at,omitempty"`
+	Changed    bool       `json:"changed"`
+}
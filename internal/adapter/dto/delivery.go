@@ -0,0 +1,24 @@
+package dto
+
+import "time"
+
+// NotificationDelivery is one entry of the array returned by
+// GET /api/v1/alerts/{id}/deliveries, oldest first.
+type NotificationDelivery struct {
+	// Notifier is the name of the notifier, e.g. slack or pagerduty.
+	Notifier string `json:"notifier"`
+
+	// Action is notify for a new notification and update for a change to it.
+	Action string `json:"action"`
+
+	// Status is sent or failed.
+	Status string `json:"status"`
+
+	// ExternalReference is the notifier's message ID of a sent notification.
+	ExternalReference string `json:"external_reference,omitempty"`
+
+	// Error is the notifier error of a failed notification.
+	Error string `json:"error,omitempty"`
+
+	At time.Time `json:"at"`
+}
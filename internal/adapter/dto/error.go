@@ -0,0 +1,37 @@
+package dto
+
+import "strings"
+
+// ErrorResponse is the JSON envelope for rejected webhook requests.
+type ErrorResponse struct {
+	Error ErrorDetail `json:"error"`
+}
+
+// ErrorDetail describes why a request was rejected.
+type ErrorDetail struct {
+	// Code is a stable machine-readable reason, e.g. "payload_too_large".
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	// Details lists the individual problems, e.g. each invalid field.
+	Details []string `json:"details,omitempty"`
+}
+
+// Error codes used in ErrorResponse.
+const (
+	ErrorCodePayloadTooLarge = "payload_too_large"
+	ErrorCodeInvalidPayload  = "invalid_payload"
+)
+
+// NewErrorResponse creates an error envelope.
+func NewErrorResponse(code, message string) ErrorResponse {
+	return ErrorResponse{Error: ErrorDetail{Code: code, Message: message}}
+}
+
+// PayloadError reports every problem found while validating a payload.
+type PayloadError struct {
+	Problems []string
+}
+
+func (e *PayloadError) Error() string {
+	return "invalid payload: " + strings.Join(e.Problems, "; ")
+}
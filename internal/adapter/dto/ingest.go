@@ -0,0 +1,100 @@
+package dto
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+)
+
+// IngestAlertRequest is the body of POST /api/v1/alerts, for systems that
+// cannot send Alertmanager webhooks.
+type IngestAlertRequest struct {
+	Name     string `json:"name"`
+	Severity string `json:"severity"` // Severity label value, e.g. critical; empty uses the configured default
+	Instance string `json:"instance"`
+	Summary  string `json:"summary"`
+
+	// Labels are matched by routes, silences and correlation like
+	// Alertmanager labels. name, instance and severity are added as the
+	// alertname, instance and severity labels.
+	Labels map[string]string `json:"labels"`
+
+	// Fingerprint identifies repeated deliveries of the same alert; empty
+	// derives one from name, instance and labels with
+	// entity.ComputeFingerprint, as Alertmanager would.
+	Fingerprint string `json:"fingerprint"`
+
+	// Status is "firing" (the default) or "resolved".
+	Status string `json:"status"`
+}
+
+// IngestAlertResponse is returned for an accepted ingested alert.
+type IngestAlertResponse struct {
+	AlertID  string `json:"alert_id"`
+	IsNew    bool   `json:"is_new"`
+	Silenced bool   `json:"silenced"`
+}
+
+// Validate checks the required fields.
+func (r *IngestAlertRequest) Validate() error {
+	if r.Name == "" {
+		return errors.New("name is required")
+	}
+	switch r.Status {
+	case "", "firing", "resolved":
+	default:
+		return fmt.Errorf("status must be firing or resolved, got %q", r.Status)
+	}
+	for key := range r.Labels {
+		if key == "" {
+			return errors.New("labels must not have empty names")
+		}
+	}
+	return nil
+}
+
+// ToProcessAlertInput converts a validated request the same way an
+// Alertmanager alert is converted, so both take the same processing path.
+func (r *IngestAlertRequest) ToProcessAlertInput(defaultSeverity string, now time.Time) ProcessAlertInput {
+	labels := make(map[string]string, len(r.Labels)+3)
+	maps.Copy(labels, r.Labels)
+	labels["alertname"] = r.Name
+	if r.Instance != "" {
+		labels["instance"] = r.Instance
+	}
+	severity := r.Severity
+	if severity == "" {
+		severity = defaultSeverity
+	}
+	labels["severity"] = severity
+
+	annotations := make(map[string]string)
+	if r.Summary != "" {
+		annotations["summary"] = r.Summary
+	}
+
+	status := r.Status
+	if status == "" {
+		status = "firing"
+	}
+
+	fingerprint := r.Fingerprint
+	var legacyFingerprint string
+	if fingerprint == "" {
+		fingerprint = entity.ComputeFingerprint(labels)
+		legacyFingerprint = entity.ComputeLegacyFingerprint(labels)
+	}
+
+	input := ToProcessAlertInput(AlertmanagerAlert{
+		Status:      status,
+		Labels:      labels,
+		Annotations: annotations,
+		StartsAt:    now,
+		Fingerprint: fingerprint,
+	})
+	input.LegacyFingerprint = legacyFingerprint
+	return input
+}
@@ -47,6 +47,11 @@ type PagerDutyWebhookEventData struct {
 	Priority           *PagerDutyPriorityRef      `json:"priority,omitempty"`
 	Urgency            string                     `json:"urgency"`
 	ResolveReason      string                     `json:"resolve_reason,omitempty"`
+
+	// Incident and Content are set for incident.annotated events, whose data
+	// is the added note rather than the incident.
+	Incident *PagerDutyIncidentRef `json:"incident,omitempty"`
+	Content  string                `json:"content,omitempty"`
 }
 
 // PagerDutyAgent represents the agent that triggered the event.
@@ -72,6 +77,15 @@ type PagerDutyServiceRef struct {
 	Summary string `json:"summary"`
 }
 
+// PagerDutyIncidentRef represents an incident reference.
+type PagerDutyIncidentRef struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Self    string `json:"self"`
+	HTMLURL string `json:"html_url"`
+	Summary string `json:"summary"`
+}
+
 // PagerDutyUserRef represents a user reference.
 type PagerDutyUserRef struct {
 	ID      string `json:"id"`
@@ -101,12 +115,14 @@ type PagerDutyPriorityRef struct {
 type HandlePagerDutyWebhookInput struct {
 	EventType     string
 	IncidentID    string
+	IncidentURL   string // Web URL of the incident, if the event carries it
 	IncidentKey   string // Maps to our alert fingerprint/ID
 	UserEmail     string
 	UserName      string
 	UserID        string
 	Status        string
 	ResolveReason string
+	Note          string // Note added by an incident.annotated event
 }
 
 // HandlePagerDutyWebhookOutput represents the result of handling a PagerDuty webhook.
@@ -119,10 +135,12 @@ type HandlePagerDutyWebhookOutput struct {
 // IsSupportedEventType checks if the event type should be processed.
 func IsSupportedEventType(eventType string) bool {
 	supportedTypes := map[string]bool{
+		"incident.triggered":      true,
 		"incident.acknowledged":   true,
 		"incident.resolved":       true,
 		"incident.unacknowledged": true,
 		"incident.reassigned":     true,
+		"incident.annotated":      true,
 	}
 	return supportedTypes[eventType]
 }
@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"errors"
+)
+
+// SilencePreviewRequest is the body of POST /api/v1/silences/preview: the
+// matchers of a proposed silence. At least one of them is set.
+type SilencePreviewRequest struct {
+	AlertID     string            `json:"alert_id"`
+	Instance    string            `json:"instance"`
+	Fingerprint string            `json:"fingerprint"`
+	Labels      map[string]string `json:"labels"`
+}
+
+// Validate checks that the proposed silence matches something.
+func (r *SilencePreviewRequest) Validate() error {
+	if r.AlertID == "" && r.Instance == "" && r.Fingerprint == "" && len(r.Labels) == 0 {
+		return errors.New("alert_id, instance, fingerprint or labels is required")
+	}
+	for key := range r.Labels {
+		if key == "" {
+			return errors.New("labels must not have empty label names")
+		}
+	}
+	return nil
+}
+
+// SilencePreviewResponse reports the firing alerts a proposed silence would
+// match: their number and the most recently fired of them.
+type SilencePreviewResponse struct {
+	Count     int            `json:"count"`
+	Sample    []AlertSummary `json:"sample"`
+	Truncated bool           `json:"truncated"` // the sample omits some matched alerts
+}
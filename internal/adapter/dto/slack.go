@@ -2,6 +2,8 @@ package dto
 
 import (
 	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
 )
 
 // SlackInteractionInput represents a Slack interactive component action.
@@ -30,13 +32,21 @@ type SlackInteractionInput struct {
 	// MessageTS is the timestamp of the message.
 	MessageTS string
 
-	// Value is the action value (e.g., duration for silence).
+	// ThreadTS is the timestamp of the thread's parent when the message is a reply.
+	ThreadTS string
+
+	// Value is the action value (e.g., duration for silence, silence ID for unsilence).
 	Value string
 
 	// TriggerID is used for opening modals.
 	TriggerID string
 }
 
+// MessageRef returns the reference of the message the user interacted with.
+func (i SlackInteractionInput) MessageRef() entity.MessageRef {
+	return entity.MessageRef{Channel: i.ChannelID, Timestamp: i.MessageTS, ThreadTimestamp: i.ThreadTS}
+}
+
 // SlackInteractionOutput represents the result of handling a Slack interaction.
 type SlackInteractionOutput struct {
 	// Success indicates if the interaction was handled successfully.
@@ -45,9 +55,32 @@ type SlackInteractionOutput struct {
 	// Message is an optional message to display.
 	Message string
 
+	// Reply is an optional message shown only to the user who interacted.
+	Reply string
+
 	// SilenceID is set if a silence was created.
 	SilenceID string
 
 	// SilenceEndAt is when the silence expires.
 	SilenceEndAt *time.Time
 }
+
+// SlackReactionInput represents an emoji reaction added to a Slack message.
+type SlackReactionInput struct {
+	// Reaction is the emoji name without colons (e.g., "white_check_mark").
+	Reaction string
+
+	// UserID is the Slack user ID who added the reaction.
+	UserID string
+
+	// ChannelID is the channel containing the reacted message.
+	ChannelID string
+
+	// MessageTS is the timestamp of the reacted message.
+	MessageTS string
+}
+
+// MessageRef returns the reference of the reacted message.
+func (i SlackReactionInput) MessageRef() entity.MessageRef {
+	return entity.MessageRef{Channel: i.ChannelID, Timestamp: i.MessageTS}
+}
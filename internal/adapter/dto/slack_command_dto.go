@@ -1,6 +1,8 @@
 package dto
 
 import (
+	"errors"
+	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
@@ -14,6 +16,7 @@ const (
 	SilenceActionCreate    SilenceAction = "create"
 	SilenceActionList      SilenceAction = "list"
 	SilenceActionDelete    SilenceAction = "delete"
+	SilenceActionExpire    SilenceAction = "expire"     // Ends a silence early, keeping it
 	SilenceActionOpenModal SilenceAction = "open_modal" // Opens the create silence modal
 	SilenceActionFromModal SilenceAction = "from_modal" // Created from modal submission
 )
@@ -23,8 +26,9 @@ type SilenceRequest struct {
 	Action    SilenceAction
 	Duration  time.Duration
 	Reason    string
-	SilenceID string            // For delete action
+	SilenceID string            // For delete and expire actions
 	Matchers  map[string]string // Label matchers (key=value pairs)
+	Instance  string            // Silence every alert from this instance
 	UserID    string
 	UserName  string
 	TriggerID string // For opening modals
@@ -181,6 +185,37 @@ func (d *SlackCommandDTO) ParseSilenceRequest() *SilenceRequest {
 	return req
 }
 
+// ParseUnsilenceRequest parses the command text for /unsilence command.
+// Usage: /unsilence <id>
+func (d *SlackCommandDTO) ParseUnsilenceRequest() *SilenceRequest {
+	req := &SilenceRequest{
+		UserID:   d.UserID,
+		UserName: d.UserName,
+		Action:   SilenceActionExpire,
+	}
+	if parts := strings.Fields(d.Text); len(parts) > 0 {
+		req.SilenceID = parts[0]
+	}
+	return req
+}
+
+// ParseLabelSelector parses command text of space-separated key=value
+// pairs, as used by /ack service=api env=prod.
+func (d *SlackCommandDTO) ParseLabelSelector() (map[string]string, error) {
+	selector := make(map[string]string)
+	for _, part := range strings.Fields(d.Text) {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("expected key=value, got %q", part)
+		}
+		selector[key] = strings.Trim(value, `"'`)
+	}
+	if len(selector) == 0 {
+		return nil, errors.New("at least one key=value label is required")
+	}
+	return selector, nil
+}
+
 // parseDuration parses duration strings like "1h", "30m", "7d", "1w"
 func parseDuration(s string) time.Duration {
 	matches := periodRegex.FindStringSubmatch(strings.ToLower(s))
@@ -0,0 +1,32 @@
+package dto
+
+import "time"
+
+// AlertStatsOutput is the aggregate view returned by GET /api/v1/stats.
+type AlertStatsOutput struct {
+	// TotalAlerts counts every stored alert, resolved ones included.
+	TotalAlerts int `json:"total_alerts"`
+
+	// ByState maps each alert state to its count.
+	ByState map[string]int `json:"by_state"`
+
+	// BySeverity maps each severity to its count across all states.
+	BySeverity map[string]int `json:"by_severity"`
+
+	// ByStateSeverity maps state, then severity, to a count.
+	ByStateSeverity map[string]map[string]int `json:"by_state_severity"`
+
+	// ActiveSilences is the number of silences currently in effect.
+	ActiveSilences int `json:"active_silences"`
+
+	// OldestUnacked describes the longest-firing unacknowledged alert; nil if none.
+	OldestUnacked *OldestUnackedAlert `json:"oldest_unacked,omitempty"`
+
+	GeneratedAt time.Time `json:"generated_at"`
+}
+
+// OldestUnackedAlert reports how long the oldest unacknowledged alert has been firing.
+type OldestUnackedAlert struct {
+	FiredAt    time.Time `json:"fired_at"`
+	AgeSeconds int64     `json:"age_seconds"`
+}
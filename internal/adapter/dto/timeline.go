@@ -0,0 +1,36 @@
+package dto
+
+import "time"
+
+// Timeline event types.
+const (
+	TimelineFired        = "fired"
+	TimelineAcknowledged = "acknowledged"
+	TimelineSilenced     = "silenced"
+	TimelineResolved     = "resolved"
+	TimelineAssigned     = "assigned"
+	TimelineUnassigned   = "unassigned"
+)
+
+// TimelineEvent is one entry of the array returned by
+// GET /api/v1/alerts/{id}/timeline, oldest first.
+type TimelineEvent struct {
+	// Type is one of the Timeline* constants.
+	Type string `json:"type"`
+
+	At time.Time `json:"at"`
+
+	// Actor identifies the user or system behind the event, if known.
+	Actor string `json:"actor,omitempty"`
+
+	// Source is where the event originated, e.g. slack or pagerduty.
+	Source string `json:"source,omitempty"`
+
+	Note string `json:"note,omitempty"`
+
+	// Assignee is who an assigned event assigned the alert to.
+	Assignee string `json:"assignee,omitempty"`
+
+	// DurationSeconds is the silence length of a silenced event.
+	DurationSeconds int64 `json:"duration_seconds,omitempty"`
+}
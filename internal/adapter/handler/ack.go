@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/auth"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/ack"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
+)
+
+// AckHandler acknowledges an alert on behalf of the authenticated caller.
+type AckHandler struct {
+	acknowledge *ack.AcknowledgeUseCase
+	strictJSON  bool
+	logger      alert.Logger
+}
+
+// NewAckHandler creates a new ack handler.
+func NewAckHandler(acknowledge *ack.AcknowledgeUseCase, logger alert.Logger) *AckHandler {
+	return &AckHandler{
+		acknowledge: acknowledge,
+		logger:      logger,
+	}
+}
+
+// SetStrictJSON makes the handler reject payloads with unknown fields.
+func (h *AckHandler) SetStrictJSON(strict bool) {
+	h.strictJSON = strict
+}
+
+// ServeHTTP handles POST /api/v1/alerts/{id}/ack. The ack is attributed to
+// the user the auth middleware stored in the context; requests without one
+// are rejected with 401. The body, with an optional note and duration, may
+// be empty.
+func (h *AckHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	user, ok := auth.UserFromContext(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var request dto.AckRequest
+	if err := decodeJSON(r.Body, &request, h.strictJSON); err != nil && !errors.Is(err, io.EOF) {
+		requestLogger(r.Context(), h.logger).Error("failed to decode ack request", "error", err)
+		writeDecodeError(w, err)
+		return
+	}
+	duration, err := request.ParseDuration()
+	if err != nil {
+		writeValidationError(w, err)
+		return
+	}
+
+	alertID := r.PathValue("id")
+	output, err := h.acknowledge.Execute(r.Context(), ack.SyncAckInput{
+		AlertID:   alertID,
+		Source:    entity.AckSourceAPI,
+		UserID:    user.ID,
+		UserEmail: user.Email,
+		UserName:  user.Name,
+		Note:      request.Note,
+		Duration:  duration,
+	})
+	if errors.Is(err, entity.ErrAlertNotFound) {
+		http.Error(w, "alert not found", http.StatusNotFound)
+		return
+	}
+	if errors.Is(err, entity.ErrAlertAlreadyResolved) {
+		http.Error(w, "alert already resolved", http.StatusConflict)
+		return
+	}
+	if err != nil {
+		requestLogger(r.Context(), h.logger).Error("failed to acknowledge alert", "alertID", alertID, "error", err)
+		http.Error(w, "acknowledgment failed", http.StatusInternalServerError)
+		return
+	}
+
+	response := dto.AckResponse{
+		AlertID:      output.Alert.ID,
+		State:        string(output.Alert.State),
+		AckedBy:      output.Alert.AckedBy,
+		AlreadyAcked: output.AlreadyAcked,
+		SyncedTo:     output.SyncedTo,
+	}
+	for _, syncErr := range output.SyncErrors {
+		response.SyncFailed = append(response.SyncFailed, syncErr.System)
+	}
+	for _, noteErr := range output.NoteErrors {
+		response.NoteFailed = append(response.NoteFailed, noteErr.System)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
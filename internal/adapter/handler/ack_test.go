@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/auth"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/ack"
+)
+
+// recordingUpdater records the messages it was asked to update.
+type recordingUpdater struct {
+	updated []string
+}
+
+func (u *recordingUpdater) UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error {
+	u.updated = append(u.updated, messageID)
+	return nil
+}
+
+func TestAckHandler(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	ackEventRepo := memory.NewAckEventRepository()
+	syncAck := ack.NewSyncAckUseCase(alertRepo, ackEventRepo, memory.NewTransactionManager(), nil, nopLogger{}, nil)
+	acknowledge := ack.NewAcknowledgeUseCase(alertRepo, syncAck, nopLogger{})
+	slack := &recordingUpdater{}
+	acknowledge.SetSlackUpdater(slack)
+	h := NewAckHandler(acknowledge, nopLogger{})
+
+	stored := entity.NewAlert("fp-1", "DiskFull", "db-1", "", "Disk 95% full", entity.SeverityCritical)
+	stored.SetExternalReference("slack", "C1:1700000000.000100")
+	if err := alertRepo.Save(ctx, stored); err != nil {
+		t.Fatalf("failed to save alert: %v", err)
+	}
+
+	post := func(alertID, body string, user *auth.User) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/alerts/"+alertID+"/ack", strings.NewReader(body))
+		req.SetPathValue("id", alertID)
+		if user != nil {
+			req = req.WithContext(auth.NewContextWithUser(req.Context(), *user))
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		return w
+	}
+	jane := &auth.User{ID: "u-1", Email: "jane@example.com", Name: "Jane"}
+
+	if w := post(stored.ID, "", nil); w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status 401 without a user, got %d", w.Code)
+	}
+	if w := post("missing", "", jane); w.Code != http.StatusNotFound {
+		t.Fatalf("expected status 404 for an unknown alert, got %d", w.Code)
+	}
+	resolved := entity.NewAlert("fp-2", "DiskFull", "db-2", "", "Disk 95% full", entity.SeverityCritical)
+	if err := resolved.Resolve("alertmanager", time.Now().UTC()); err != nil {
+		t.Fatalf("failed to resolve alert: %v", err)
+	}
+	if err := alertRepo.Save(ctx, resolved); err != nil {
+		t.Fatalf("failed to save alert: %v", err)
+	}
+	if w := post(resolved.ID, "", jane); w.Code != http.StatusConflict {
+		t.Fatalf("expected status 409 for a resolved alert, got %d", w.Code)
+	}
+	if w := post(stored.ID, `{"duration": "soon"}`, jane); w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400 for an invalid duration, got %d", w.Code)
+	}
+
+	w := post(stored.ID, `{"note": "looking into it", "duration": "2h"}`, jane)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	var resp dto.AckResponse
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.State != string(entity.StateAcked) || resp.AckedBy != "jane@example.com" || resp.AlreadyAcked {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+	if len(slack.updated) != 1 || len(resp.SyncedTo) != 1 || resp.SyncedTo[0] != "slack" {
+		t.Errorf("expected the Slack message updated, got updates %v and response %+v", slack.updated, resp)
+	}
+
+	// The ack is attributed to the authenticated user
+	event, err := ackEventRepo.FindLatestByAlertID(ctx, stored.ID)
+	if err != nil || event == nil {
+		t.Fatalf("expected an ack event, got %v (%v)", event, err)
+	}
+	if event.Source != entity.AckSourceAPI || event.UserID != "u-1" || event.UserEmail != "jane@example.com" ||
+		event.UserName != "Jane" || event.Note != "looking into it" {
+		t.Errorf("unexpected ack event: %+v", event)
+	}
+
+	// Repeating the ack changes nothing
+	w = post(stored.ID, "", jane)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", w.Code)
+	}
+	resp = dto.AckResponse{}
+	json.NewDecoder(w.Body).Decode(&resp)
+	if !resp.AlreadyAcked || len(slack.updated) != 1 {
+		t.Errorf("expected a repeated ack to be a no-op, got %+v", resp)
+	}
+}
@@ -1,17 +1,33 @@
 package handler
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
 )
 
+// IdempotencyKeyHeader lets senders supply their own idempotency key.
+const IdempotencyKeyHeader = "X-Idempotency-Key"
+
+// idempotencyClaimLease bounds how long a payload that is still being
+// processed, or whose processing died with the process, blocks redeliveries.
+// It exceeds the default request timeout.
+const idempotencyClaimLease = time.Minute
+
 // AlertmanagerHandler handles Alertmanager webhook requests.
 type AlertmanagerHandler struct {
-	processAlert *alert.ProcessAlertUseCase
-	logger       alert.Logger
+	processAlert   *alert.ProcessAlertUseCase
+	idempotency    repository.IdempotencyStore
+	idempotencyTTL time.Duration
+	strictJSON     bool
+	logger         alert.Logger
 }
 
 // NewAlertmanagerHandler creates a new handler.
@@ -22,6 +38,17 @@ func NewAlertmanagerHandler(processAlert *alert.ProcessAlertUseCase, logger aler
 	}
 }
 
+// SetIdempotencyStore enables skipping of redelivered payloads seen within ttl.
+func (h *AlertmanagerHandler) SetIdempotencyStore(store repository.IdempotencyStore, ttl time.Duration) {
+	h.idempotency = store
+	h.idempotencyTTL = ttl
+}
+
+// SetStrictJSON makes the handler reject payloads with unknown fields.
+func (h *AlertmanagerHandler) SetStrictJSON(strict bool) {
+	h.strictJSON = strict
+}
+
 // ServeHTTP handles POST /webhook/alertmanager
 func (h *AlertmanagerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -30,41 +57,71 @@ func (h *AlertmanagerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	}
 
 	var payload dto.AlertmanagerWebhook
-	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
-		h.logger.Error("failed to decode alertmanager payload",
+	if err := decodeJSON(r.Body, &payload, h.strictJSON); err != nil {
+		requestLogger(r.Context(), h.logger).Error("failed to decode alertmanager payload",
 			"error", err,
 		)
-		http.Error(w, "invalid payload", http.StatusBadRequest)
+		writeDecodeError(w, err)
+		return
+	}
+	if err := payload.Validate(); err != nil {
+		requestLogger(r.Context(), h.logger).Warn("rejected invalid alertmanager payload",
+			"error", err,
+		)
+		writeValidationError(w, err)
 		return
 	}
 
 	ctx := r.Context()
-	var processed, failed int
 
-	// Process each alert in the payload
-	for _, alertData := range payload.Alerts {
-		input := dto.ToProcessAlertInput(alertData)
+	// claimedKey is the idempotency key claimed for this delivery, if any
+	var claimedKey string
+	if h.idempotency != nil {
+		key := payload.IdempotencyKey()
+		if header := r.Header.Get(IdempotencyKeyHeader); header != "" {
+			sum := sha256.Sum256([]byte(header))
+			key = hex.EncodeToString(sum[:])
+		}
+		key = "alertmanager:" + key
 
-		output, err := h.processAlert.Execute(ctx, input)
+		// Claim the key only for as long as processing may take; it is
+		// recorded for the full TTL once every alert was processed
+		first, err := h.idempotency.MarkProcessed(ctx, key, min(idempotencyClaimLease, h.idempotencyTTL))
 		if err != nil {
-			h.logger.Error("failed to process alert",
-				"fingerprint", alertData.Fingerprint,
-				"status", alertData.Status,
+			// Fail open: processing is deduplicated by fingerprint anyway
+			requestLogger(r.Context(), h.logger).Warn("idempotency check failed, processing payload",
+				"groupKey", payload.GroupKey,
 				"error", err,
 			)
+		} else if !first {
+			requestLogger(r.Context(), h.logger).Info("duplicate alertmanager payload, skipping",
+				"groupKey", payload.GroupKey,
+				"alerts", len(payload.Alerts),
+			)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(map[string]any{
+				"status": "duplicate, skipped",
+			})
+			return
+		} else {
+			claimedKey = key
+		}
+	}
+
+	var processed, failed int
+
+	// Process each alert in the payload
+	for _, alertData := range payload.Alerts {
+		if _, err := processAlert(ctx, h.processAlert, h.logger, payload.ToProcessAlertInput(alertData)); err != nil {
 			failed++
 			continue
 		}
-
 		processed++
-		h.logger.Info("alert processed",
-			"alertID", output.AlertID,
-			"fingerprint", alertData.Fingerprint,
-			"status", alertData.Status,
-			"isNew", output.IsNew,
-			"isSilenced", output.IsSilenced,
-			"notificationsSent", output.NotificationsSent,
-		)
+	}
+
+	if claimedKey != "" {
+		h.settleIdempotencyKey(ctx, claimedKey, failed == 0 && ctx.Err() == nil)
 	}
 
 	// Return success response
@@ -76,3 +133,55 @@ func (h *AlertmanagerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		"failed":    failed,
 	})
 }
+
+// settleIdempotencyKey records a claimed key for the full TTL once its
+// payload was completely processed. Otherwise, e.g. when an alert failed or
+// the request timed out, the key is released so a retry is processed.
+func (h *AlertmanagerHandler) settleIdempotencyKey(ctx context.Context, key string, completed bool) {
+	// The request context may be done, but the outcome must still be stored
+	ctx = context.WithoutCancel(ctx)
+
+	err := h.idempotency.Release(ctx, key)
+	if err == nil && completed {
+		_, err = h.idempotency.MarkProcessed(ctx, key, h.idempotencyTTL)
+	}
+	if err != nil {
+		requestLogger(ctx, h.logger).Warn("failed to settle idempotency key",
+			"key", key,
+			"completed", completed,
+			"error", err,
+		)
+	}
+}
+
+// processAlert runs one alert through the use case and logs the outcome.
+// Every alert source goes through it so they are processed identically.
+func processAlert(ctx context.Context, uc *alert.ProcessAlertUseCase, logger alert.Logger, input dto.ProcessAlertInput) (*dto.ProcessAlertOutput, error) {
+	logger = requestLogger(ctx, logger)
+	output, err := uc.Execute(ctx, input)
+	if err != nil {
+		logger.Error("failed to process alert",
+			"fingerprint", input.Fingerprint,
+			"status", input.Status,
+			"error", err,
+		)
+		return nil, err
+	}
+
+	logger.Info("alert processed",
+		"alertID", output.AlertID,
+		"fingerprint", input.Fingerprint,
+		"status", input.Status,
+		"isNew", output.IsNew,
+		"isSilenced", output.IsSilenced,
+		"notificationsSent", output.NotificationsSent,
+	)
+	// The alert is stored and the other notifiers were still called
+	if err := output.NotificationErr(); err != nil {
+		logger.Warn("alert processed with failed notifications",
+			"alertID", output.AlertID,
+			"error", err,
+		)
+	}
+	return output, nil
+}
@@ -0,0 +1,348 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
+)
+
+func newTestAlertmanagerHandler() *AlertmanagerHandler {
+	processAlert := alert.NewProcessAlertUseCase(
+		memory.NewAlertRepository(),
+		memory.NewSilenceRepository(),
+		nil,
+		nopLogger{},
+		nil,
+	)
+	return NewAlertmanagerHandler(processAlert, nopLogger{})
+}
+
+func TestAlertmanagerHandler_RejectedPayloads(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		strict     bool
+		maxBytes   int64
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "malformed JSON", body: `{"alerts": [`, wantStatus: http.StatusBadRequest, wantCode: dto.ErrorCodeInvalidPayload},
+		{name: "trailing data", body: `{"alerts": []} {}`, wantStatus: http.StatusBadRequest, wantCode: dto.ErrorCodeInvalidPayload},
+		{name: "unknown field in strict mode", body: `{"alerts": [], "extra": 1}`, strict: true, wantStatus: http.StatusBadRequest, wantCode: dto.ErrorCodeInvalidPayload},
+		{name: "unknown field is accepted by default", body: `{"status": "firing", "alerts": [{"status": "firing", "labels": {"alertname": "HighCPU"}, "startsAt": "2025-01-02T03:04:05Z"}], "extra": 1}`, wantStatus: http.StatusOK},
+		{name: "oversized body", body: `{"alerts": []}`, maxBytes: 4, wantStatus: http.StatusRequestEntityTooLarge, wantCode: dto.ErrorCodePayloadTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newTestAlertmanagerHandler()
+			h.SetStrictJSON(tt.strict)
+
+			req := httptest.NewRequest(http.MethodPost, "/webhook/alertmanager", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+			if tt.maxBytes > 0 {
+				req.Body = http.MaxBytesReader(w, req.Body, tt.maxBytes)
+			}
+			h.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if tt.wantCode == "" {
+				return
+			}
+
+			var resp dto.ErrorResponse
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode error response: %v", err)
+			}
+			if resp.Error.Code != tt.wantCode {
+				t.Errorf("expected error code %q, got %q", tt.wantCode, resp.Error.Code)
+			}
+		})
+	}
+}
+
+func TestAlertmanagerHandler_InvalidPayloads(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		wantDetails []string
+	}{
+		{
+			name:        "empty alerts",
+			body:        `{"status": "firing", "alerts": []}`,
+			wantDetails: []string{"alerts must not be empty"},
+		},
+		{
+			name:        "missing alerts and status",
+			body:        `{}`,
+			wantDetails: []string{"status is required", "alerts must not be empty"},
+		},
+		{
+			name: "missing required alert fields",
+			body: `{"status": "firing", "alerts": [
+				{"status": "firing", "labels": {"alertname": "HighCPU"}, "startsAt": "2025-01-02T03:04:05Z"},
+				{"status": "firing", "labels": {"severity": "critical"}}]}`,
+			wantDetails: []string{"alerts[1].labels.alertname is required", "alerts[1].startsAt is required"},
+		},
+		{
+			name: "unknown status",
+			body: `{"status": "pending", "alerts": [
+				{"status": "", "labels": {"alertname": "HighCPU"}, "startsAt": "2025-01-02T03:04:05Z"}]}`,
+			wantDetails: []string{`status must be firing or resolved, got "pending"`, "alerts[0].status is required"},
+		},
+		{
+			name:        "wrong label type",
+			body:        `{"status": "firing", "alerts": [{"status": "firing", "labels": {"alertname": 1}}]}`,
+			wantDetails: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newTestAlertmanagerHandler()
+
+			req := httptest.NewRequest(http.MethodPost, "/webhook/alertmanager", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Fatalf("expected status 400, got %d: %s", w.Code, w.Body.String())
+			}
+			var resp dto.ErrorResponse
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode error response: %v", err)
+			}
+			if resp.Error.Code != dto.ErrorCodeInvalidPayload {
+				t.Errorf("expected error code %q, got %q", dto.ErrorCodeInvalidPayload, resp.Error.Code)
+			}
+			if !slices.Equal(resp.Error.Details, tt.wantDetails) {
+				t.Errorf("expected details %q, got %q", tt.wantDetails, resp.Error.Details)
+			}
+		})
+	}
+}
+
+func TestAlertmanagerHandler_GroupedFields(t *testing.T) {
+	alertRepo := memory.NewAlertRepository()
+	processAlert := alert.NewProcessAlertUseCase(alertRepo, memory.NewSilenceRepository(), nil, nopLogger{}, nil)
+	h := NewAlertmanagerHandler(processAlert, nopLogger{})
+
+	// A version 4 payload as Alertmanager sends it for a group of two alerts
+	body := `{
+		"version": "4",
+		"groupKey": "{}:{alertname=\"HighCPU\", cluster=\"prod\"}",
+		"truncatedAlerts": 0,
+		"status": "firing",
+		"receiver": "alert-bridge",
+		"groupLabels": {"alertname": "HighCPU", "cluster": "prod"},
+		"commonLabels": {"alertname": "HighCPU", "cluster": "prod", "severity": "critical"},
+		"commonAnnotations": {"summary": "CPU usage above 90%", "dashboard": "https://grafana.example.com/d/cpu"},
+		"externalURL": "https://alertmanager.example.com/",
+		"alerts": [
+			{
+				"status": "firing",
+				"labels": {"alertname": "HighCPU", "cluster": "prod", "severity": "critical", "instance": "host-1"},
+				"annotations": {"summary": "CPU usage above 95% on host-1"},
+				"startsAt": "2025-01-02T03:04:05Z",
+				"endsAt": "0001-01-01T00:00:00Z",
+				"generatorURL": "https://prometheus.example.com/graph?g0.expr=cpu",
+				"fingerprint": "fp-1"
+			},
+			{
+				"status": "firing",
+				"labels": {"alertname": "HighCPU", "instance": "host-2"},
+				"startsAt": "2025-01-02T03:04:05Z",
+				"fingerprint": "fp-2"
+			}
+		]
+	}`
+	req := httptest.NewRequest(http.MethodPost, "/webhook/alertmanager", strings.NewReader(body))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	find := func(fingerprint string) *entity.Alert {
+		t.Helper()
+		alerts, err := alertRepo.FindByFingerprint(context.Background(), fingerprint)
+		if err != nil || len(alerts) != 1 {
+			t.Fatalf("expected one stored alert for %s, got %v, %v", fingerprint, alerts, err)
+		}
+		return alerts[0]
+	}
+
+	// The alert's own summary wins over the common one
+	first := find("fp-1")
+	if got := first.GetAnnotation("summary"); got != "CPU usage above 95% on host-1" {
+		t.Errorf("expected the alert's own summary, got %q", got)
+	}
+	if got := first.GetAnnotation("dashboard"); got != "https://grafana.example.com/d/cpu" {
+		t.Errorf("expected the common dashboard annotation, got %q", got)
+	}
+
+	// Missing labels and annotations are filled in from the common ones
+	second := find("fp-2")
+	if second.Severity != entity.SeverityCritical || second.GetLabel("cluster") != "prod" {
+		t.Errorf("expected common labels to be applied, got severity %s and labels %v", second.Severity, second.Labels)
+	}
+	if second.Summary != "CPU usage above 90%" {
+		t.Errorf("expected the common summary, got %q", second.Summary)
+	}
+
+	want := "https://alertmanager.example.com/#/alerts?filter=%7Balertname%3D%22HighCPU%22%2Ccluster%3D%22prod%22%7D&receiver=alert-bridge"
+	if got := second.GetAnnotation(dto.AlertmanagerURLAnnotation); got != want {
+		t.Errorf("expected Alertmanager link %q, got %q", want, got)
+	}
+}
+
+// stateNotifier records the alert state of every notification update.
+type stateNotifier struct {
+	name    string
+	updates []entity.AlertState
+}
+
+func (n *stateNotifier) Notify(ctx context.Context, alert *entity.Alert) (string, error) {
+	return n.name + "-msg", nil
+}
+
+func (n *stateNotifier) UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error {
+	n.updates = append(n.updates, alert.State)
+	return nil
+}
+
+func (n *stateNotifier) Name() string { return n.name }
+
+func TestAlertmanagerHandler_ResolvedAlert(t *testing.T) {
+	alertRepo := memory.NewAlertRepository()
+	slack := &stateNotifier{name: "slack"}
+	pagerDuty := &stateNotifier{name: "pagerduty"}
+	processAlert := alert.NewProcessAlertUseCase(alertRepo, memory.NewSilenceRepository(),
+		[]alert.Notifier{slack, pagerDuty}, nopLogger{}, nil)
+	h := NewAlertmanagerHandler(processAlert, nopLogger{})
+
+	post := func(status string) {
+		t.Helper()
+		body := `{"status": "` + status + `", "alerts": [{"status": "` + status + `", "fingerprint": "fp-1",
+			"labels": {"alertname": "HighCPU", "severity": "critical", "instance": "host-1"},
+			"startsAt": "2025-01-02T03:04:05Z"}]}`
+		req := httptest.NewRequest(http.MethodPost, "/webhook/alertmanager", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s: expected status 200, got %d: %s", status, w.Code, w.Body.String())
+		}
+	}
+
+	post("firing")
+	post("resolved")
+
+	alerts, err := alertRepo.FindByFingerprint(context.Background(), "fp-1")
+	if err != nil || len(alerts) != 1 {
+		t.Fatalf("expected one stored alert, got %v, %v", alerts, err)
+	}
+	if alerts[0].State != entity.StateResolved || alerts[0].ResolvedAt == nil {
+		t.Errorf("expected the alert to be resolved, got state %s", alerts[0].State)
+	}
+
+	// Slack re-renders the resolved layout and PagerDuty resolves the incident
+	for _, n := range []*stateNotifier{slack, pagerDuty} {
+		if len(n.updates) != 1 || n.updates[0] != entity.StateResolved {
+			t.Errorf("%s: expected one resolved update, got %v", n.name, n.updates)
+		}
+	}
+}
+
+// failOnceRepository fails the first alert lookup by fingerprint.
+type failOnceRepository struct {
+	repository.AlertRepository
+	failed bool
+}
+
+func (r *failOnceRepository) FindByFingerprint(ctx context.Context, fingerprint string) ([]*entity.Alert, error) {
+	if !r.failed {
+		r.failed = true
+		return nil, errors.New("database unavailable")
+	}
+	return r.AlertRepository.FindByFingerprint(ctx, fingerprint)
+}
+
+func TestAlertmanagerHandler_RetryAfterFailedDelivery(t *testing.T) {
+	alertRepo := &failOnceRepository{AlertRepository: memory.NewAlertRepository()}
+	processAlert := alert.NewProcessAlertUseCase(alertRepo, memory.NewSilenceRepository(), nil, nopLogger{}, nil)
+	h := NewAlertmanagerHandler(processAlert, nopLogger{})
+	h.SetIdempotencyStore(memory.NewIdempotencyStore(0), 5*time.Minute)
+
+	body := `{"status": "firing", "groupKey": "{}:{alertname=\"HighCPU\"}", "alerts": [{"status": "firing",
+		"fingerprint": "fp-1", "labels": {"alertname": "HighCPU", "severity": "critical"},
+		"startsAt": "2025-01-02T03:04:05Z"}]}`
+	post := func() map[string]any {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPost, "/webhook/alertmanager", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+		}
+		var resp map[string]any
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		return resp
+	}
+
+	if resp := post(); resp["failed"] != float64(1) {
+		t.Fatalf("expected the first delivery to fail, got %v", resp)
+	}
+
+	// The retry is processed instead of being skipped as a duplicate
+	if resp := post(); resp["status"] != "ok" || resp["processed"] != float64(1) {
+		t.Fatalf("expected the retry to be processed, got %v", resp)
+	}
+	alerts, err := alertRepo.FindByFingerprint(context.Background(), "fp-1")
+	if err != nil || len(alerts) != 1 {
+		t.Fatalf("expected one stored alert, got %v, %v", alerts, err)
+	}
+
+	// A completely processed payload is then skipped
+	if resp := post(); resp["status"] != "duplicate, skipped" {
+		t.Errorf("expected the redelivery to be skipped, got %v", resp)
+	}
+}
+
+func TestAlertmanagerHandler_RetryAfterTimedOutDelivery(t *testing.T) {
+	h := newTestAlertmanagerHandler()
+	store := memory.NewIdempotencyStore(0)
+	h.SetIdempotencyStore(store, 5*time.Minute)
+
+	body := `{"status": "firing", "groupKey": "{}:{alertname=\"HighCPU\"}", "alerts": [{"status": "firing",
+		"fingerprint": "fp-1", "labels": {"alertname": "HighCPU", "severity": "critical"},
+		"startsAt": "2025-01-02T03:04:05Z"}]}`
+
+	// The request timed out while it was processed
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req := httptest.NewRequest(http.MethodPost, "/webhook/alertmanager", strings.NewReader(body)).WithContext(ctx)
+	h.ServeHTTP(httptest.NewRecorder(), req)
+
+	req = httptest.NewRequest(http.MethodPost, "/webhook/alertmanager", strings.NewReader(body))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if strings.Contains(w.Body.String(), "duplicate") {
+		t.Errorf("expected the retry to be processed, got %s", w.Body.String())
+	}
+}
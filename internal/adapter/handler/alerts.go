@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
+)
+
+// ListAlertsHandler serves the firing alerts to dashboards and polling
+// clients. Clients sending the last ETag in If-None-Match get 304 Not
+// Modified while nothing changed.
+type ListAlertsHandler struct {
+	listAlerts *alert.ListAlertsUseCase
+	logger     alert.Logger
+}
+
+// NewListAlertsHandler creates a new alert list handler.
+func NewListAlertsHandler(listAlerts *alert.ListAlertsUseCase, logger alert.Logger) *ListAlertsHandler {
+	return &ListAlertsHandler{
+		listAlerts: listAlerts,
+		logger:     logger,
+	}
+}
+
+// ServeHTTP handles GET /api/v1/alerts. The optional severity query
+// parameter filters the list, each label=name=value parameter keeps only
+// alerts carrying that label, and sort=priority orders it by urgency.
+func (h *ListAlertsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	sortBy := r.URL.Query().Get("sort")
+	if sortBy != "" && sortBy != alert.SortByFiredAt && sortBy != alert.SortByPriority {
+		http.Error(w, `sort must be "fired_at" or "priority"`, http.StatusBadRequest)
+		return
+	}
+
+	labels, err := parseLabelMatchers(r.URL.Query()["label"])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.listAlerts.Execute(r.Context(), r.URL.Query().Get("severity"), sortBy, labels)
+	if err != nil {
+		requestLogger(r.Context(), h.logger).Error("failed to list alerts", "error", err)
+		http.Error(w, "alerts unavailable", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("ETag", output.ETag)
+	w.Header().Set("Cache-Control", "no-cache")
+	if etagMatches(r.Header.Get("If-None-Match"), output.ETag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// SilencedAlertsHandler serves the firing alerts suppressed by active
+// silences, with the silences and who created them.
+type SilencedAlertsHandler struct {
+	findSilenced *alert.FindSilencedAlertsUseCase
+	logger       alert.Logger
+}
+
+// NewSilencedAlertsHandler creates a new silenced alerts handler.
+func NewSilencedAlertsHandler(findSilenced *alert.FindSilencedAlertsUseCase, logger alert.Logger) *SilencedAlertsHandler {
+	return &SilencedAlertsHandler{
+		findSilenced: findSilenced,
+		logger:       logger,
+	}
+}
+
+// ServeHTTP handles GET /api/v1/alerts/silenced.
+func (h *SilencedAlertsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	output, err := h.findSilenced.Execute(r.Context())
+	if err != nil {
+		requestLogger(r.Context(), h.logger).Error("failed to find silenced alerts", "error", err)
+		http.Error(w, "silenced alerts unavailable", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// parseLabelMatchers parses label query parameters of the form name=value.
+// A label given twice with different values is rejected.
+func parseLabelMatchers(params []string) (map[string]string, error) {
+	if len(params) == 0 {
+		return nil, nil
+	}
+	labels := make(map[string]string, len(params))
+	for _, param := range params {
+		name, value, ok := strings.Cut(param, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("label must be name=value, got %q", param)
+		}
+		if previous, seen := labels[name]; seen && previous != value {
+			return nil, fmt.Errorf("label %q is given with different values", name)
+		}
+		labels[name] = value
+	}
+	return labels, nil
+}
+
+// etagMatches reports whether an If-None-Match header matches etag, using
+// the weak comparison RFC 9110 requires for If-None-Match.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,212 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
+)
+
+func TestListAlertsHandler_ETag(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	h := NewListAlertsHandler(alert.NewListAlertsUseCase(alertRepo), nopLogger{})
+
+	get := func(ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/alerts", nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		return w
+	}
+
+	stored := entity.NewAlert("fp-1", "DiskFull", "db-1", "", "Disk 95% full", entity.SeverityCritical)
+	if err := alertRepo.Save(ctx, stored); err != nil {
+		t.Fatalf("failed to save alert: %v", err)
+	}
+
+	w := get("")
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	etag := w.Header().Get("ETag")
+	if etag == "" {
+		t.Fatal("expected an ETag header")
+	}
+	var resp dto.AlertListOutput
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Count != 1 || len(resp.Alerts) != 1 || resp.Alerts[0].ID != stored.ID {
+		t.Fatalf("expected the stored alert, got %+v", resp)
+	}
+
+	// Nothing changed: 304 without a body
+	w = get(etag)
+	if w.Code != http.StatusNotModified {
+		t.Fatalf("expected status 304, got %d", w.Code)
+	}
+	if w.Body.Len() != 0 {
+		t.Errorf("expected no body, got %q", w.Body.String())
+	}
+
+	// Acknowledging the alert changes the ETag
+	current, err := alertRepo.FindByID(ctx, stored.ID)
+	if err != nil {
+		t.Fatalf("failed to load alert: %v", err)
+	}
+	if err := current.Acknowledge("jane@example.com", time.Now().Add(time.Second)); err != nil {
+		t.Fatalf("failed to acknowledge alert: %v", err)
+	}
+	if err := alertRepo.Update(ctx, current); err != nil {
+		t.Fatalf("failed to update alert: %v", err)
+	}
+
+	w = get(etag)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200 after an update, got %d", w.Code)
+	}
+	if w.Header().Get("ETag") == etag {
+		t.Errorf("expected a new ETag after an update, got %s", etag)
+	}
+
+	// Updates within the same second change it too
+	etag = w.Header().Get("ETag")
+	current, _ = alertRepo.FindByID(ctx, stored.ID)
+	updatedAt := current.UpdatedAt
+	current.AddAnnotation("note", "rebooting")
+	current.UpdatedAt = updatedAt
+	if err := alertRepo.Update(ctx, current); err != nil {
+		t.Fatalf("failed to update alert: %v", err)
+	}
+	if w = get(etag); w.Code != http.StatusOK {
+		t.Errorf("expected status 200 after a same-second update, got %d", w.Code)
+	}
+}
+
+func TestListAlertsHandler_SortByPriority(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	h := NewListAlertsHandler(alert.NewListAlertsUseCase(alertRepo), nopLogger{})
+
+	now := time.Now()
+	warning := entity.NewAlert("fp-1", "HighLatency", "api-1", "", "", entity.SeverityWarning)
+	warning.FiredAt = now.Add(-2 * time.Hour)
+	critical := entity.NewAlert("fp-2", "DiskFull", "db-1", "", "", entity.SeverityCritical)
+	critical.FiredAt = now.Add(-time.Hour)
+	info := entity.NewAlert("fp-3", "Deploy", "api-1", "", "", entity.SeverityInfo)
+	info.FiredAt = now
+	for _, stored := range []*entity.Alert{warning, critical, info} {
+		if err := alertRepo.Save(ctx, stored); err != nil {
+			t.Fatalf("failed to save alert: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		target  string
+		wantIDs []string
+	}{
+		{name: "default", target: "/api/v1/alerts", wantIDs: []string{info.ID, critical.ID, warning.ID}},
+		{name: "fired_at", target: "/api/v1/alerts?sort=fired_at", wantIDs: []string{info.ID, critical.ID, warning.ID}},
+		{name: "priority", target: "/api/v1/alerts?sort=priority", wantIDs: []string{critical.ID, warning.ID, info.ID}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.target, nil))
+			if w.Code != http.StatusOK {
+				t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+			}
+
+			var resp dto.AlertListOutput
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			var ids []string
+			for _, summary := range resp.Alerts {
+				ids = append(ids, summary.ID)
+			}
+			if strings.Join(ids, ",") != strings.Join(tt.wantIDs, ",") {
+				t.Errorf("expected order %v, got %v", tt.wantIDs, ids)
+			}
+		})
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/alerts?sort=name", nil))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status 400 for an unknown sort, got %d", w.Code)
+	}
+}
+
+func TestListAlertsHandler_LabelFilter(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	h := NewListAlertsHandler(alert.NewListAlertsUseCase(alertRepo), nopLogger{})
+
+	now := time.Now()
+	prodAPI := entity.NewAlert("fp-1", "HighLatency", "api-1", "", "", entity.SeverityWarning)
+	prodAPI.Labels = map[string]string{"env": "prod", "service": "api"}
+	prodAPI.FiredAt = now.Add(-time.Hour)
+	prodDB := entity.NewAlert("fp-2", "DiskFull", "db-1", "", "", entity.SeverityCritical)
+	prodDB.Labels = map[string]string{"env": "prod", "service": "db"}
+	prodDB.FiredAt = now
+	stagingAPI := entity.NewAlert("fp-3", "HighLatency", "api-2", "", "", entity.SeverityWarning)
+	stagingAPI.Labels = map[string]string{"env": "staging", "service": "api"}
+	for _, stored := range []*entity.Alert{prodAPI, prodDB, stagingAPI} {
+		if err := alertRepo.Save(ctx, stored); err != nil {
+			t.Fatalf("failed to save alert: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		target   string
+		wantCode int
+		wantIDs  []string
+	}{
+		{name: "one label", target: "/api/v1/alerts?label=env=prod", wantCode: http.StatusOK, wantIDs: []string{prodDB.ID, prodAPI.ID}},
+		{name: "several labels", target: "/api/v1/alerts?label=env=prod&label=service=api", wantCode: http.StatusOK, wantIDs: []string{prodAPI.ID}},
+		{name: "with severity", target: "/api/v1/alerts?label=env=prod&severity=critical", wantCode: http.StatusOK, wantIDs: []string{prodDB.ID}},
+		{name: "by priority", target: "/api/v1/alerts?label=service=api&sort=priority", wantCode: http.StatusOK, wantIDs: []string{prodAPI.ID, stagingAPI.ID}},
+		{name: "malformed", target: "/api/v1/alerts?label=env", wantCode: http.StatusBadRequest},
+		{name: "conflicting", target: "/api/v1/alerts?label=env=prod&label=env=staging", wantCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.target, nil))
+			if w.Code != tt.wantCode {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantCode, w.Code, w.Body.String())
+			}
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+
+			var resp dto.AlertListOutput
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			var ids []string
+			for _, summary := range resp.Alerts {
+				ids = append(ids, summary.ID)
+			}
+			if strings.Join(ids, ",") != strings.Join(tt.wantIDs, ",") {
+				t.Errorf("expected %v, got %v", tt.wantIDs, ids)
+			}
+		})
+	}
+}
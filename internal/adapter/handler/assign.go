@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
+)
+
+// AssignHandler assigns alerts to responders.
+type AssignHandler struct {
+	assign     *alert.AssignUseCase
+	strictJSON bool
+	logger     alert.Logger
+}
+
+// NewAssignHandler creates a new assign handler.
+func NewAssignHandler(assign *alert.AssignUseCase, logger alert.Logger) *AssignHandler {
+	return &AssignHandler{
+		assign: assign,
+		logger: logger,
+	}
+}
+
+// SetStrictJSON makes the handler reject payloads with unknown fields.
+func (h *AssignHandler) SetStrictJSON(strict bool) {
+	h.strictJSON = strict
+}
+
+// ServeHTTP handles POST /api/v1/alerts/{id}/assign. An empty assignee
+// unassigns the alert; resolved alerts can only be unassigned and respond
+// 409 otherwise.
+func (h *AssignHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var request dto.AssignRequest
+	if err := decodeJSON(r.Body, &request, h.strictJSON); err != nil {
+		requestLogger(r.Context(), h.logger).Error("failed to decode assign request", "error", err)
+		writeDecodeError(w, err)
+		return
+	}
+	if err := request.Validate(); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+
+	alertID := r.PathValue("id")
+	output, err := h.assign.Execute(r.Context(), alert.AssignInput{
+		AlertID:  alertID,
+		Assignee: request.Assignee,
+		By:       request.AssignedBy,
+	})
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		http.Error(w, "alert not found", http.StatusNotFound)
+		return
+	case errors.Is(err, entity.ErrAlertAlreadyResolved):
+		http.Error(w, "alert already resolved", http.StatusConflict)
+		return
+	case err != nil:
+		requestLogger(r.Context(), h.logger).Error("failed to assign alert", "alertID", alertID, "error", err)
+		http.Error(w, "assignment failed", http.StatusInternalServerError)
+		return
+	}
+
+	response := dto.AssignResponse{
+		AlertID:    output.Alert.ID,
+		AssignedTo: output.Alert.AssignedTo,
+		Changed:    output.Changed,
+	}
+	if n := len(output.Alert.Assignments); n > 0 && output.Alert.AssignedTo != "" {
+		response.AssignedAt = &output.Alert.Assignments[n-1].At
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
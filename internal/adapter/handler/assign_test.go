@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
+)
+
+func TestAssignHandler(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	h := NewAssignHandler(alert.NewAssignUseCase(alertRepo, nopLogger{}), nopLogger{})
+
+	stored := entity.NewAlert("fp-1", "DiskFull", "db-1", "", "Disk 95% full", entity.SeverityCritical)
+	if err := alertRepo.Save(ctx, stored); err != nil {
+		t.Fatalf("failed to save alert: %v", err)
+	}
+
+	post := func(alertID, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/alerts/"+alertID+"/assign", strings.NewReader(body))
+		req.SetPathValue("id", alertID)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		return w
+	}
+
+	if w := post(stored.ID, `{"assignee": "jane@example.com"}`); w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400 without assigned_by, got %d", w.Code)
+	}
+	if w := post("missing", `{"assignee": "jane@example.com", "assigned_by": "lead"}`); w.Code != http.StatusNotFound {
+		t.Fatalf("expected status 404 for an unknown alert, got %d", w.Code)
+	}
+
+	w := post(stored.ID, `{"assignee": "jane@example.com", "assigned_by": "lead"}`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	var resp dto.AssignResponse
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.AssignedTo != "jane@example.com" || !resp.Changed || resp.AssignedAt == nil {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+
+	// Resolved alerts cannot be reassigned, only unassigned
+	found, _ := alertRepo.FindByID(ctx, stored.ID)
+	if err := found.Resolve("alertmanager", time.Now()); err != nil {
+		t.Fatalf("failed to resolve alert: %v", err)
+	}
+	if err := alertRepo.Update(ctx, found); err != nil {
+		t.Fatalf("failed to update alert: %v", err)
+	}
+	if w := post(stored.ID, `{"assignee": "sam@example.com", "assigned_by": "lead"}`); w.Code != http.StatusConflict {
+		t.Fatalf("expected status 409 for a resolved alert, got %d", w.Code)
+	}
+	w = post(stored.ID, `{"assignee": "", "assigned_by": "jane@example.com"}`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200 when unassigning, got %d", w.Code)
+	}
+	resp = dto.AssignResponse{}
+	json.NewDecoder(w.Body).Decode(&resp)
+	if resp.AssignedTo != "" || resp.AssignedAt != nil || !resp.Changed {
+		t.Errorf("expected the alert unassigned, got %+v", resp)
+	}
+}
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/ack"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
+)
+
+// BulkAckHandler acknowledges several alerts in one request.
+type BulkAckHandler struct {
+	syncAck    *ack.SyncAckUseCase
+	strictJSON bool
+	logger     alert.Logger
+}
+
+// NewBulkAckHandler creates a new bulk ack handler.
+func NewBulkAckHandler(syncAck *ack.SyncAckUseCase, logger alert.Logger) *BulkAckHandler {
+	return &BulkAckHandler{
+		syncAck: syncAck,
+		logger:  logger,
+	}
+}
+
+// SetStrictJSON makes the handler reject payloads with unknown fields.
+func (h *BulkAckHandler) SetStrictJSON(strict bool) {
+	h.strictJSON = strict
+}
+
+// ServeHTTP handles POST /api/v1/alerts/ack.
+// Responds 200 with a result per alert, even when some of them failed.
+func (h *BulkAckHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var request dto.BulkAckRequest
+	if err := decodeJSON(r.Body, &request, h.strictJSON); err != nil {
+		requestLogger(r.Context(), h.logger).Error("failed to decode bulk ack request", "error", err)
+		writeDecodeError(w, err)
+		return
+	}
+	if err := request.Validate(); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+
+	results, err := h.syncAck.ExecuteBulk(r.Context(), ack.BulkAckInput{
+		AlertIDs:  request.AlertIDs,
+		Selector:  request.Selector,
+		Source:    entity.AckSourceAPI,
+		UserEmail: request.UserEmail,
+		UserName:  request.UserName,
+		Note:      request.Note,
+	})
+	if err != nil {
+		requestLogger(r.Context(), h.logger).Error("failed to select alerts for bulk ack", "error", err)
+		http.Error(w, "bulk ack failed", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(toBulkAckResponse(results))
+}
+
+// toBulkAckResponse reports each result without exposing internal errors.
+func toBulkAckResponse(results []ack.BulkAckResult) dto.BulkAckResponse {
+	response := dto.BulkAckResponse{Results: make([]dto.BulkAckResult, 0, len(results))}
+	for _, result := range results {
+		item := dto.BulkAckResult{AlertID: result.AlertID}
+		switch {
+		case result.Err == nil:
+			item.OK = true
+			item.SyncedTo = result.Output.SyncedTo
+			response.Acknowledged++
+		case errors.Is(result.Err, entity.ErrAlertNotFound):
+			item.Error = "alert not found"
+			response.Failed++
+		default:
+			item.Error = "acknowledgment failed"
+			response.Failed++
+		}
+		response.Results = append(response.Results, item)
+	}
+	return response
+}
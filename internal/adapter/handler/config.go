@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/logger"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/config"
+)
+
+// ConfigHandler serves the effective configuration, after defaults and
+// environment overrides, with credentials redacted.
+type ConfigHandler struct {
+	configManager *config.ConfigManager
+	logger        logger.Logger
+}
+
+// NewConfigHandler creates a new effective configuration handler.
+func NewConfigHandler(cm *config.ConfigManager, logger logger.Logger) *ConfigHandler {
+	return &ConfigHandler{
+		configManager: cm,
+		logger:        logger,
+	}
+}
+
+// ServeHTTP handles GET /api/v1/admin/config requests.
+func (h *ConfigHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	document, err := configDocument(h.configManager.Get().Redacted())
+	if err != nil {
+		h.logger.Error("failed to render effective config", "error", err)
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(document)
+}
+
+// configDocument converts the configuration to a generic document keyed by
+// the same names as the config file, with durations as strings like "5m0s".
+func configDocument(cfg *config.Config) (map[string]any, error) {
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+	var document map[string]any
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	return document, nil
+}
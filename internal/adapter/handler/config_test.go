@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/config"
+)
+
+func TestConfigHandler_RedactsSecrets(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Server.Port = 8080
+	cfg.Server.AdminToken = "admin-s3cr3t"
+	cfg.Slack.BotToken = "xoxb-s3cr3t"
+	cfg.Slack.ChannelID = "C123"
+	cfg.PagerDuty.RoutingKey = "routing-s3cr3t"
+	cfg.PagerDuty.RoutingKeys = map[string]string{"critical": "critical-s3cr3t"}
+	cfg.Storage.MySQL.Primary.Password = "db-s3cr3t"
+
+	h := NewConfigHandler(config.NewConfigManager(cfg, nil, "", nil), nopLogger{})
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/config", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	body := w.Body.String()
+	if strings.Contains(body, "s3cr3t") {
+		t.Errorf("expected secrets to be redacted, got %s", body)
+	}
+
+	var resp struct {
+		Server struct {
+			Port       int    `json:"port"`
+			AdminToken string `json:"admin_token"`
+		} `json:"server"`
+		Slack struct {
+			BotToken      string `json:"bot_token"`
+			SigningSecret string `json:"signing_secret"`
+			ChannelID     string `json:"channel_id"`
+		} `json:"slack"`
+		PagerDuty struct {
+			RoutingKeys map[string]string `json:"routing_keys"`
+		} `json:"pagerduty"`
+	}
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Server.AdminToken != "***" || resp.Slack.BotToken != "***" {
+		t.Errorf("expected credentials shown as ***, got %+v", resp)
+	}
+	if resp.PagerDuty.RoutingKeys["critical"] != "***" {
+		t.Errorf("expected map credentials shown as ***, got %+v", resp.PagerDuty.RoutingKeys)
+	}
+	if resp.Slack.SigningSecret != "" {
+		t.Errorf("expected unset credential to stay empty, got %q", resp.Slack.SigningSecret)
+	}
+	if resp.Server.Port != 8080 || resp.Slack.ChannelID != "C123" {
+		t.Errorf("expected other settings unchanged, got %+v", resp)
+	}
+	if cfg.Slack.BotToken != "xoxb-s3cr3t" || cfg.PagerDuty.RoutingKeys["critical"] != "critical-s3cr3t" {
+		t.Errorf("expected running config to keep its credentials, got %q and %v", cfg.Slack.BotToken, cfg.PagerDuty.RoutingKeys)
+	}
+}
@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+)
+
+// decodeJSON decodes exactly one JSON value from r into v. With strict set,
+// fields that v does not declare are rejected.
+func decodeJSON(r io.Reader, v any, strict bool) error {
+	dec := json.NewDecoder(r)
+	if strict {
+		dec.DisallowUnknownFields()
+	}
+	if err := dec.Decode(v); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) && typeErr.Field != "" {
+			return fmt.Errorf("%s must be %s, got %s", typeErr.Field, typeErr.Type, typeErr.Value)
+		}
+		return err
+	}
+	if err := dec.Decode(&struct{}{}); !errors.Is(err, io.EOF) {
+		return errors.New("unexpected data after JSON payload")
+	}
+	return nil
+}
+
+// writeDecodeError responds to a payload decodeJSON rejected: 413 when the
+// body exceeded the size limit, 400 otherwise.
+func writeDecodeError(w http.ResponseWriter, err error) {
+	status := http.StatusBadRequest
+	response := dto.NewErrorResponse(dto.ErrorCodeInvalidPayload, err.Error())
+
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		status = http.StatusRequestEntityTooLarge
+		response = dto.NewErrorResponse(dto.ErrorCodePayloadTooLarge, err.Error())
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(response)
+}
+
+// writeValidationError responds 400 to a decoded payload that failed
+// validation, listing each problem of a *dto.PayloadError in the details.
+func writeValidationError(w http.ResponseWriter, err error) {
+	response := dto.NewErrorResponse(dto.ErrorCodeInvalidPayload, err.Error())
+	var payloadErr *dto.PayloadError
+	if errors.As(err, &payloadErr) {
+		response.Error.Details = payloadErr.Problems
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	json.NewEncoder(w).Encode(response)
+}
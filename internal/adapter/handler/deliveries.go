@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
+)
+
+// DeliveriesHandler serves the notification attempts made for an alert.
+type DeliveriesHandler struct {
+	deliveries *alert.DeliveriesUseCase
+	logger     alert.Logger
+}
+
+// NewDeliveriesHandler creates a new deliveries handler.
+func NewDeliveriesHandler(deliveries *alert.DeliveriesUseCase, logger alert.Logger) *DeliveriesHandler {
+	return &DeliveriesHandler{
+		deliveries: deliveries,
+		logger:     logger,
+	}
+}
+
+// ServeHTTP handles GET /api/v1/alerts/{id}/deliveries.
+func (h *DeliveriesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	alertID := r.PathValue("id")
+	deliveries, err := h.deliveries.Execute(r.Context(), alertID)
+	if errors.Is(err, repository.ErrNotFound) {
+		http.Error(w, "alert not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		requestLogger(r.Context(), h.logger).Error("failed to list notification deliveries", "alertID", alertID, "error", err)
+		http.Error(w, "deliveries unavailable", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(deliveries)
+}
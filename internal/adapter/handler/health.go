@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"sync"
 	"time"
@@ -84,6 +85,12 @@ func (h *HealthHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(response)
 }
 
+// ServeHealthy handles GET /-/healthy, the Prometheus-style liveness probe.
+// It answers with a one-line plain-text body instead of the JSON status.
+func (h *HealthHandler) ServeHealthy(w http.ResponseWriter, r *http.Request) {
+	writeProbe(w, r, http.StatusOK, "alert-bridge is Healthy.")
+}
+
 
 // ReadinessChecker provides a way to check if a dependency is ready.
 // Implementations should return nil if ready, or an error describing the issue.
@@ -119,10 +126,42 @@ func (h *ReadyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	checks, allReady := h.check(r.Context())
+
+	response := map[string]any{
+		"ready":     allReady,
+		"timestamp": time.Now().UTC().Format(time.RFC3339),
+		"checks":    checks,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if allReady {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
+
+// ServeReady handles GET /-/ready, the Prometheus-style readiness probe.
+// It runs the same dependency checks as /ready and answers 200 or 503 with
+// a one-line plain-text body.
+func (h *ReadyHandler) ServeReady(w http.ResponseWriter, r *http.Request) {
+	if _, allReady := h.check(r.Context()); !allReady {
+		writeProbe(w, r, http.StatusServiceUnavailable, "alert-bridge is not ready.")
+		return
+	}
+	writeProbe(w, r, http.StatusOK, "alert-bridge is Ready.")
+}
+
+// check pings every registered dependency and returns the result of each,
+// and whether all of them are ready.
+func (h *ReadyHandler) check(ctx context.Context) (map[string]any, bool) {
 	h.mu.RLock()
 	defer h.mu.RUnlock()
 
-	ctx := r.Context()
 	checks := make(map[string]any)
 	allReady := true
 
@@ -140,19 +179,20 @@ func (h *ReadyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	response := map[string]any{
-		"ready":     allReady,
-		"timestamp": time.Now().UTC().Format(time.RFC3339),
-		"checks":    checks,
-	}
-
-	w.Header().Set("Content-Type", "application/json")
+	return checks, allReady
+}
 
-	if allReady {
-		w.WriteHeader(http.StatusOK)
-	} else {
-		w.WriteHeader(http.StatusServiceUnavailable)
+// writeProbe answers a Prometheus-style probe with status and a plain-text
+// line. Probes accept GET and HEAD, which gets no body.
+func writeProbe(w http.ResponseWriter, r *http.Request, status int, text string) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
 	}
 
-	json.NewEncoder(w).Encode(response)
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(status)
+	if r.Method == http.MethodGet {
+		fmt.Fprintln(w, text)
+	}
 }
@@ -189,3 +189,42 @@ func TestReadyHandler_MethodNotAllowed(t *testing.T) {
 		t.Errorf("expected status 405, got %d", w.Code)
 	}
 }
+
+func TestPrometheusProbes(t *testing.T) {
+	health := NewHealthHandler()
+	ready := NewReadyHandler()
+	database := &mockChecker{}
+	ready.AddChecker("database", database)
+
+	probe := func(serve http.HandlerFunc, method, path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		serve(w, httptest.NewRequest(method, path, nil))
+		return w
+	}
+
+	w := probe(health.ServeHealthy, http.MethodGet, "/-/healthy")
+	if w.Code != http.StatusOK || w.Body.String() != "alert-bridge is Healthy.\n" {
+		t.Errorf("unexpected liveness response: %d %q", w.Code, w.Body.String())
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "text/plain; charset=utf-8" {
+		t.Errorf("expected a plain-text body, got %s", ct)
+	}
+
+	w = probe(ready.ServeReady, http.MethodGet, "/-/ready")
+	if w.Code != http.StatusOK || w.Body.String() != "alert-bridge is Ready.\n" {
+		t.Errorf("unexpected readiness response: %d %q", w.Code, w.Body.String())
+	}
+	if w = probe(ready.ServeReady, http.MethodHead, "/-/ready"); w.Code != http.StatusOK || w.Body.Len() != 0 {
+		t.Errorf("expected HEAD to answer 200 without a body, got %d %q", w.Code, w.Body.String())
+	}
+
+	database.err = errors.New("connection refused")
+	w = probe(ready.ServeReady, http.MethodGet, "/-/ready")
+	if w.Code != http.StatusServiceUnavailable || w.Body.String() != "alert-bridge is not ready.\n" {
+		t.Errorf("unexpected readiness response with a failing database: %d %q", w.Code, w.Body.String())
+	}
+
+	if w = probe(health.ServeHealthy, http.MethodPost, "/-/healthy"); w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected status 405, got %d", w.Code)
+	}
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
+)
+
+// IngestHandler accepts alerts in a simple JSON format from systems that
+// cannot send Alertmanager webhooks.
+type IngestHandler struct {
+	processAlert    *alert.ProcessAlertUseCase
+	defaultSeverity string
+	strictJSON      bool
+	logger          alert.Logger
+}
+
+// NewIngestHandler creates a new ingest handler. Alerts without a severity
+// get "warning" unless SetDefaultSeverity says otherwise.
+func NewIngestHandler(processAlert *alert.ProcessAlertUseCase, logger alert.Logger) *IngestHandler {
+	return &IngestHandler{
+		processAlert:    processAlert,
+		defaultSeverity: string(entity.SeverityWarning),
+		logger:          logger,
+	}
+}
+
+// SetDefaultSeverity sets the severity of alerts that do not specify one.
+func (h *IngestHandler) SetDefaultSeverity(severity string) {
+	if severity != "" {
+		h.defaultSeverity = severity
+	}
+}
+
+// SetStrictJSON makes the handler reject payloads with unknown fields.
+func (h *IngestHandler) SetStrictJSON(strict bool) {
+	h.strictJSON = strict
+}
+
+// ServeHTTP handles POST /api/v1/alerts.
+func (h *IngestHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var request dto.IngestAlertRequest
+	if err := decodeJSON(r.Body, &request, h.strictJSON); err != nil {
+		requestLogger(r.Context(), h.logger).Error("failed to decode ingested alert", "error", err)
+		writeDecodeError(w, err)
+		return
+	}
+	if err := request.Validate(); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+
+	input := request.ToProcessAlertInput(h.defaultSeverity, time.Now().UTC())
+	output, err := processAlert(r.Context(), h.processAlert, h.logger, input)
+	if errors.Is(err, entity.ErrTooManyLabels) {
+		writeValidationError(w, err)
+		return
+	}
+	if err != nil {
+		http.Error(w, "processing alert failed", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(dto.IngestAlertResponse{
+		AlertID:  output.AlertID,
+		IsNew:    output.IsNew,
+		Silenced: output.IsSilenced,
+	})
+}
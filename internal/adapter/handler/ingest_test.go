@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
+)
+
+func TestIngestHandler(t *testing.T) {
+	alertRepo := memory.NewAlertRepository()
+	processAlert := alert.NewProcessAlertUseCase(alertRepo, memory.NewSilenceRepository(), nil, nopLogger{}, nil)
+	h := NewIngestHandler(processAlert, nopLogger{})
+	h.SetDefaultSeverity("critical")
+
+	post := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/alerts", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		return w
+	}
+
+	w := post(`{"name": "DiskFull", "instance": "db-1", "summary": "Disk 95% full", "labels": {"team": "storage"}}`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	var resp dto.IngestAlertResponse
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.AlertID == "" || !resp.IsNew {
+		t.Fatalf("expected a new alert ID, got %+v", resp)
+	}
+
+	stored, err := alertRepo.FindByID(context.Background(), resp.AlertID)
+	if err != nil || stored == nil {
+		t.Fatalf("expected the alert to be stored, got %v, %v", stored, err)
+	}
+	if stored.Name != "DiskFull" || stored.Instance != "db-1" || stored.Summary != "Disk 95% full" {
+		t.Errorf("unexpected alert fields: %+v", stored)
+	}
+	if stored.Severity != entity.SeverityCritical {
+		t.Errorf("expected the default severity critical, got %s", stored.Severity)
+	}
+	if stored.Labels["team"] != "storage" || stored.Labels["alertname"] != "DiskFull" {
+		t.Errorf("unexpected labels: %v", stored.Labels)
+	}
+
+	// The derived fingerprint deduplicates repeated deliveries
+	w = post(`{"name": "DiskFull", "instance": "db-1", "labels": {"team": "storage"}}`)
+	var again dto.IngestAlertResponse
+	if err := json.NewDecoder(w.Body).Decode(&again); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if again.AlertID != resp.AlertID || again.IsNew {
+		t.Errorf("expected the existing alert %s, got %+v", resp.AlertID, again)
+	}
+}
+
+func TestIngestHandler_MatchesLegacyFingerprints(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	processAlert := alert.NewProcessAlertUseCase(alertRepo, memory.NewSilenceRepository(), nil, nopLogger{}, nil)
+	h := NewIngestHandler(processAlert, nopLogger{})
+	h.SetDefaultSeverity("critical")
+
+	// An alert ingested before fingerprints followed Alertmanager's algorithm
+	labels := map[string]string{"alertname": "DiskFull", "instance": "db-1", "severity": "critical"}
+	legacy := entity.NewAlert(entity.ComputeLegacyFingerprint(labels), "DiskFull", "db-1", "", "", entity.SeverityCritical)
+	if err := alertRepo.Save(ctx, legacy); err != nil {
+		t.Fatalf("failed to save alert: %v", err)
+	}
+
+	post := func(body string) dto.IngestAlertResponse {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/alerts", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		var resp dto.IngestAlertResponse
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		return resp
+	}
+
+	if resp := post(`{"name": "DiskFull", "instance": "db-1"}`); resp.AlertID != legacy.ID || resp.IsNew {
+		t.Errorf("expected the legacy alert %s to be deduplicated, got %+v", legacy.ID, resp)
+	}
+	post(`{"name": "DiskFull", "instance": "db-1", "status": "resolved"}`)
+	if stored, _ := alertRepo.FindByID(ctx, legacy.ID); stored == nil || stored.IsFiring() {
+		t.Fatalf("expected the legacy alert to resolve, got %+v", stored)
+	}
+
+	// Once resolved, the alert fires again under the new fingerprint
+	resp := post(`{"name": "DiskFull", "instance": "db-1"}`)
+	stored, _ := alertRepo.FindByID(ctx, resp.AlertID)
+	if !resp.IsNew || stored == nil || stored.Fingerprint != entity.ComputeFingerprint(labels) {
+		t.Errorf("expected a new alert under the new fingerprint, got %+v", stored)
+	}
+}
+
+func TestIngestHandler_RejectsInvalidAlerts(t *testing.T) {
+	processAlert := alert.NewProcessAlertUseCase(memory.NewAlertRepository(), memory.NewSilenceRepository(), nil, nopLogger{}, nil)
+	processAlert.SetLabelLimits(3, 0)
+	h := NewIngestHandler(processAlert, nopLogger{})
+
+	for _, body := range []string{
+		`{"severity": "critical"}`,
+		`{"name": "DiskFull", "status": "pending"}`,
+		`{"name": "DiskFull", "labels": {"": "x"}}`,
+		`{"name": "DiskFull", "labels": {"a": "1", "b": "2", "c": "3"}}`,
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/alerts", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected status 400, got %d", body, w.Code)
+		}
+	}
+}
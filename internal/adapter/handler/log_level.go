@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/logger"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/config"
+)
+
+// LogLevelHandler changes the application log level at runtime.
+type LogLevelHandler struct {
+	level  *slog.LevelVar
+	logger logger.Logger
+}
+
+// NewLogLevelHandler creates a new log level handler backed by the shared LevelVar.
+func NewLogLevelHandler(level *slog.LevelVar, logger logger.Logger) *LogLevelHandler {
+	return &LogLevelHandler{
+		level:  level,
+		logger: logger,
+	}
+}
+
+// logLevelRequest is the body of PUT /api/v1/admin/log-level.
+type logLevelRequest struct {
+	Level string `json:"level"`
+}
+
+// ServeHTTP handles GET and PUT /api/v1/admin/log-level requests.
+func (h *LogLevelHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		writeLogLevel(w, map[string]string{"level": levelName(h.level.Level())})
+	case http.MethodPut:
+		h.update(w, r)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (h *LogLevelHandler) update(w http.ResponseWriter, r *http.Request) {
+	var req logLevelRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	level := strings.ToLower(strings.TrimSpace(req.Level))
+	if err := config.ValidateLogLevel(level); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var newLevel slog.Level
+	if err := newLevel.UnmarshalText([]byte(level)); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	previous := levelName(h.level.Level())
+	h.level.Set(newLevel)
+
+	h.logger.Info("log level changed",
+		"previous", previous,
+		"level", level,
+		"remote_addr", r.RemoteAddr,
+	)
+
+	writeLogLevel(w, map[string]string{
+		"previous": previous,
+		"level":    level,
+	})
+}
+
+// levelName returns the config-style (lowercase) name of a slog level.
+func levelName(level slog.Level) string {
+	return strings.ToLower(level.String())
+}
+
+func writeLogLevel(w http.ResponseWriter, body map[string]string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...any) {}
+func (nopLogger) Info(string, ...any)  {}
+func (nopLogger) Warn(string, ...any)  {}
+func (nopLogger) Error(string, ...any) {}
+
+func TestLogLevelHandler_Update(t *testing.T) {
+	level := new(slog.LevelVar)
+	h := NewLogLevelHandler(level, nopLogger{})
+
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/admin/log-level", strings.NewReader(`{"level":"debug"}`))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if level.Level() != slog.LevelDebug {
+		t.Errorf("expected level debug, got %s", level.Level())
+	}
+
+	var resp map[string]string
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp["previous"] != "info" || resp["level"] != "debug" {
+		t.Errorf("unexpected response: %v", resp)
+	}
+}
+
+func TestLogLevelHandler_InvalidLevel(t *testing.T) {
+	level := new(slog.LevelVar)
+	h := NewLogLevelHandler(level, nopLogger{})
+
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/admin/log-level", strings.NewReader(`{"level":"verbose"}`))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status 400, got %d", w.Code)
+	}
+	if level.Level() != slog.LevelInfo {
+		t.Errorf("expected level to remain info, got %s", level.Level())
+	}
+}
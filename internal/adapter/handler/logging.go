@@ -0,0 +1,13 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/logger"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
+)
+
+// requestLogger returns l tagged with the request ID carried by ctx, if any.
+func requestLogger(ctx context.Context, l alert.Logger) alert.Logger {
+	return logger.WithContext(ctx, l)
+}
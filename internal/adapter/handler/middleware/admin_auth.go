@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+// AdminAuth creates middleware that requires a static bearer token for admin endpoints.
+// Unlike the webhook middlewares, an empty token rejects every request so that
+// admin endpoints are never exposed unauthenticated by accident.
+//
+// Expected header format: Authorization: Bearer <token>
+func AdminAuth(token string, logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			provided, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if token == "" || !ok || subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+				logger.Warn("unauthorized admin request",
+					"remote_addr", r.RemoteAddr,
+					"path", r.URL.Path,
+				)
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
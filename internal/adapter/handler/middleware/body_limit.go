@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+)
+
+// MaxBodyBytes rejects requests whose body exceeds limit bytes with 413.
+// The body is read up front so signature checks and handlers further down
+// never see a truncated payload.
+func MaxBodyBytes(limit int64, logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Body == nil || r.Body == http.NoBody {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if r.ContentLength > limit {
+				rejectOversized(w, r, limit, logger)
+				return
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, limit))
+			if err != nil {
+				var maxBytesErr *http.MaxBytesError
+				if errors.As(err, &maxBytesErr) {
+					rejectOversized(w, r, limit, logger)
+					return
+				}
+				logger.Error("failed to read request body",
+					"error", err,
+					"path", r.URL.Path,
+				)
+				http.Error(w, "failed to read body", http.StatusBadRequest)
+				return
+			}
+			r.Body.Close()
+
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func rejectOversized(w http.ResponseWriter, r *http.Request, limit int64, logger *slog.Logger) {
+	logger.Warn("request body too large",
+		"path", r.URL.Path,
+		"content_length", r.ContentLength,
+		"limit", limit,
+		"remote_addr", r.RemoteAddr,
+	)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Connection", "close")
+	w.WriteHeader(http.StatusRequestEntityTooLarge)
+	json.NewEncoder(w).Encode(dto.NewErrorResponse(
+		dto.ErrorCodePayloadTooLarge,
+		fmt.Sprintf("request body exceeds %d bytes", limit),
+	))
+}
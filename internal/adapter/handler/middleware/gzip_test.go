@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGzip(t *testing.T) {
+	const body = `{"alerts": [], "count": 0}`
+	h := Gzip(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("unchanged") != "" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, body)
+	}))
+
+	tests := []struct {
+		name           string
+		target         string
+		acceptEncoding string
+		wantGzip       bool
+	}{
+		{name: "gzip accepted", target: "/", acceptEncoding: "br, gzip", wantGzip: true},
+		{name: "gzip with weight", target: "/", acceptEncoding: "gzip;q=0.5", wantGzip: true},
+		{name: "gzip refused", target: "/", acceptEncoding: "gzip;q=0"},
+		{name: "no accept-encoding", target: "/"},
+		{name: "not modified", target: "/?unchanged=1", acceptEncoding: "gzip"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.acceptEncoding != "" {
+				req.Header.Set("Accept-Encoding", tt.acceptEncoding)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			if w.Header().Get("Vary") != "Accept-Encoding" {
+				t.Errorf("expected Vary: Accept-Encoding, got %q", w.Header().Get("Vary"))
+			}
+			if gotGzip := w.Header().Get("Content-Encoding") == "gzip"; gotGzip != tt.wantGzip {
+				t.Fatalf("expected gzip %v, got Content-Encoding %q", tt.wantGzip, w.Header().Get("Content-Encoding"))
+			}
+			if w.Code == http.StatusNotModified {
+				if w.Body.Len() != 0 {
+					t.Errorf("expected no body for 304, got %q", w.Body.String())
+				}
+				return
+			}
+
+			var reader io.Reader = w.Body
+			if tt.wantGzip {
+				gz, err := gzip.NewReader(w.Body)
+				if err != nil {
+					t.Fatalf("invalid gzip body: %v", err)
+				}
+				reader = gz
+			}
+			got, err := io.ReadAll(reader)
+			if err != nil {
+				t.Fatalf("failed to read body: %v", err)
+			}
+			if string(got) != body {
+				t.Errorf("expected body %q, got %q", body, got)
+			}
+		})
+	}
+}
@@ -8,36 +8,50 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/logger"
 )
 
-// contextKey is a custom type for context keys.
-type contextKey string
+// RequestIDHeader carries the request ID in requests and responses.
+const RequestIDHeader = "X-Request-ID"
 
-const (
-	// RequestIDKey is the context key for request ID.
-	RequestIDKey contextKey = "request_id"
-)
+// maxRequestIDLength is the longest client-supplied request ID accepted.
+const maxRequestIDLength = 128
 
-// RequestID adds a unique request ID to each request.
+// RequestID takes the request ID from the X-Request-ID header, or generates
+// one if the header is missing or invalid, stores it in the request context
+// and echoes it in the response. Loggers derived with logger.WithContext add
+// it to every line logged for the request.
 func RequestID(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		requestID := r.Header.Get("X-Request-ID")
-		if requestID == "" {
+		requestID := r.Header.Get(RequestIDHeader)
+		if !validRequestID(requestID) {
 			requestID = uuid.New().String()
 		}
 
-		ctx := context.WithValue(r.Context(), RequestIDKey, requestID)
-		w.Header().Set("X-Request-ID", requestID)
+		ctx := logger.NewContextWithRequestID(r.Context(), requestID)
+		w.Header().Set(RequestIDHeader, requestID)
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
+// validRequestID reports whether a client-supplied request ID is short and
+// printable ASCII, so it cannot forge log lines.
+func validRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(requestID); i++ {
+		if requestID[i] < 0x21 || requestID[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
 // GetRequestID retrieves the request ID from context.
 func GetRequestID(ctx context.Context) string {
-	if id, ok := ctx.Value(RequestIDKey).(string); ok {
-		return id
-	}
-	return ""
+	return logger.RequestIDFromContext(ctx)
 }
 
 // responseWriter wraps http.ResponseWriter to capture status code.
@@ -81,33 +81,33 @@ func PagerDutyAuth(secretGetter WebhookSecretGetter, logger *slog.Logger) func(h
 }
 
 // verifyPagerDutySignature verifies the PagerDuty webhook signature.
-// PagerDuty sends multiple signatures with different versions.
-// Returns true if at least one signature matches.
+// PagerDuty sends one signature per active secret, comma-separated in a single
+// header (e.g. while a secret is rotated), and may repeat the header.
+// Returns true if at least one v1 signature matches.
 func verifyPagerDutySignature(body []byte, signatures []string, webhookSecret string) bool {
-	for _, sig := range signatures {
-		// Parse version and signature
-		// Format: "v1=<signature>"
-		parts := strings.SplitN(sig, "=", 2)
-		if len(parts) != 2 {
-			continue
-		}
-
-		version := parts[0]
-		signature := parts[1]
-
-		// Only support v1 signatures
-		if version != "v1" {
-			continue
-		}
+	// Compute expected signature
+	mac := hmac.New(sha256.New, []byte(webhookSecret))
+	mac.Write(body)
+	expectedSig := hex.EncodeToString(mac.Sum(nil))
+
+	for _, header := range signatures {
+		for _, sig := range strings.Split(header, ",") {
+			// Parse version and signature
+			// Format: "v1=<signature>"
+			version, signature, ok := strings.Cut(strings.TrimSpace(sig), "=")
+			if !ok {
+				continue
+			}
 
-		// Compute expected signature
-		mac := hmac.New(sha256.New, []byte(webhookSecret))
-		mac.Write(body)
-		expectedSig := hex.EncodeToString(mac.Sum(nil))
+			// Only support v1 signatures
+			if version != "v1" {
+				continue
+			}
 
-		// Constant-time comparison to prevent timing attacks
-		if hmac.Equal([]byte(signature), []byte(expectedSig)) {
-			return true
+			// Constant-time comparison to prevent timing attacks
+			if hmac.Equal([]byte(signature), []byte(expectedSig)) {
+				return true
+			}
 		}
 	}
 
@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// Known vector: HMAC-SHA256 of pdTestBody keyed with pdTestSecret.
+const (
+	pdTestSecret    = "whsec_test"
+	pdTestBody      = `{"event":{"id":"01DEN3SFGQ6YJQ5Y2QTLQ8RDTF","event_type":"incident.acknowledged"}}`
+	pdTestSignature = "v1=7bf9bf23661b18c06f6a22fe74ff811de7af0a466ab182384ae0df6fe14a2f0f"
+)
+
+func TestPagerDutyAuth(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		signatures []string
+		wantStatus int
+	}{
+		{name: "valid signature", body: pdTestBody, signatures: []string{pdTestSignature}, wantStatus: http.StatusOK},
+		{name: "one of several comma-separated signatures", body: pdTestBody, signatures: []string{"v1=deadbeef, " + pdTestSignature}, wantStatus: http.StatusOK},
+		{name: "one of several headers", body: pdTestBody, signatures: []string{"v1=deadbeef", pdTestSignature}, wantStatus: http.StatusOK},
+		{name: "tampered body", body: strings.Replace(pdTestBody, "acknowledged", "resolved", 1), signatures: []string{pdTestSignature}, wantStatus: http.StatusUnauthorized},
+		{name: "unsupported version", body: pdTestBody, signatures: []string{strings.Replace(pdTestSignature, "v1=", "v2=", 1)}, wantStatus: http.StatusUnauthorized},
+		{name: "missing signature", body: pdTestBody, wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var called bool
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { called = true })
+			secret := func() string { return pdTestSecret }
+			h := PagerDutyAuth(secret, slog.New(slog.DiscardHandler))(next)
+
+			req := httptest.NewRequest(http.MethodPost, "/webhook/pagerduty", strings.NewReader(tt.body))
+			for _, signature := range tt.signatures {
+				req.Header.Add("X-PagerDuty-Signature", signature)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if called != (tt.wantStatus == http.StatusOK) {
+				t.Errorf("expected handler called=%v, got %v", tt.wantStatus == http.StatusOK, called)
+			}
+		})
+	}
+}
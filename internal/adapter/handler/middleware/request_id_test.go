@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRequestID(t *testing.T) {
+	var seen string
+	h := RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = GetRequestID(r.Context())
+	}))
+
+	tests := []struct {
+		name     string
+		incoming string
+		wantSame bool
+	}{
+		{name: "valid header is kept", incoming: "abc-123", wantSame: true},
+		{name: "missing header"},
+		{name: "control characters", incoming: "abc\x01def"},
+		{name: "spaces", incoming: "abc def"},
+		{name: "too long", incoming: strings.Repeat("a", maxRequestIDLength+1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seen = ""
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.incoming != "" {
+				req.Header.Set(RequestIDHeader, tt.incoming)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			echoed := rec.Header().Get(RequestIDHeader)
+			if echoed == "" {
+				t.Fatal("expected response request ID header")
+			}
+			if seen != echoed {
+				t.Errorf("context ID = %q, header = %q", seen, echoed)
+			}
+			if tt.wantSame && echoed != tt.incoming {
+				t.Errorf("request ID = %q, want %q", echoed, tt.incoming)
+			}
+			if !tt.wantSame && echoed == tt.incoming {
+				t.Errorf("expected generated request ID, got %q", echoed)
+			}
+		})
+	}
+}
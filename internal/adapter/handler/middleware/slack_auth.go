@@ -13,10 +13,21 @@ import (
 	"time"
 )
 
+// DefaultSlackRequestMaxAge is the replay window Slack recommends.
+const DefaultSlackRequestMaxAge = 5 * time.Minute
+
 // SlackAuth creates middleware for Slack webhook signature verification.
 // Implements the Slack signature verification protocol:
 // https://api.slack.com/authentication/verifying-requests-from-slack
-func SlackAuth(signingSecret string, logger *slog.Logger) func(http.Handler) http.Handler {
+//
+// Requests whose X-Slack-Request-Timestamp is more than maxAge away from now
+// are rejected as replays before the signature is checked. A zero maxAge
+// uses DefaultSlackRequestMaxAge.
+func SlackAuth(signingSecret string, maxAge time.Duration, logger *slog.Logger) func(http.Handler) http.Handler {
+	if maxAge <= 0 {
+		maxAge = DefaultSlackRequestMaxAge
+	}
+
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			// Skip auth for GET requests (e.g., listing available commands)
@@ -33,6 +44,13 @@ func SlackAuth(signingSecret string, logger *slog.Logger) func(http.Handler) htt
 				return
 			}
 
+			// Reject stale requests without reading the body
+			if err := verifySlackTimestamp(r.Header, time.Now(), maxAge); err != nil {
+				logger.Warn("rejected slack request", "error", err, "remote_addr", r.RemoteAddr)
+				http.Error(w, "invalid signature", http.StatusUnauthorized)
+				return
+			}
+
 			// Read body for signature verification
 			body, err := io.ReadAll(r.Body)
 			if err != nil {
@@ -57,24 +75,33 @@ func SlackAuth(signingSecret string, logger *slog.Logger) func(http.Handler) htt
 	}
 }
 
-// verifySlackSignature verifies the Slack request signature.
-func verifySlackSignature(header http.Header, body []byte, signingSecret string) error {
+// verifySlackTimestamp checks that the request timestamp is within maxAge of now.
+func verifySlackTimestamp(header http.Header, now time.Time, maxAge time.Duration) error {
 	timestamp := header.Get("X-Slack-Request-Timestamp")
-	signature := header.Get("X-Slack-Signature")
-
-	if timestamp == "" || signature == "" {
-		return fmt.Errorf("missing timestamp or signature headers")
+	if timestamp == "" {
+		return fmt.Errorf("missing timestamp header")
 	}
 
-	// Check timestamp is recent (within 5 minutes)
 	ts, err := strconv.ParseInt(timestamp, 10, 64)
 	if err != nil {
 		return fmt.Errorf("invalid timestamp: %w", err)
 	}
 
-	now := time.Now().Unix()
-	if abs(now-ts) > 60*5 {
-		return fmt.Errorf("timestamp too old (request age: %d seconds)", abs(now-ts))
+	age := abs(now.Unix() - ts)
+	if age > int64(maxAge/time.Second) {
+		return fmt.Errorf("timestamp too old (request age: %d seconds)", age)
+	}
+	return nil
+}
+
+// verifySlackSignature verifies the Slack request signature.
+// The timestamp must already have been checked with verifySlackTimestamp.
+func verifySlackSignature(header http.Header, body []byte, signingSecret string) error {
+	timestamp := header.Get("X-Slack-Request-Timestamp")
+	signature := header.Get("X-Slack-Signature")
+
+	if timestamp == "" || signature == "" {
+		return fmt.Errorf("missing timestamp or signature headers")
 	}
 
 	// Compute expected signature
@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+)
+
+// TenantHeader names the request header selecting the tenant of an API request.
+const TenantHeader = "X-Tenant-ID"
+
+// maxTenantIDLength matches the width of the tenant_id columns.
+const maxTenantIDLength = 255
+
+// TenantScope scopes the request context to the tenant named by the
+// X-Tenant-ID header, so listings only return that tenant's alerts and
+// ingested alerts without a tenant label are assigned to it. Requests
+// without the header see every tenant.
+func TenantScope(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Responses differ per tenant
+		w.Header().Add("Vary", TenantHeader)
+
+		tenantID := strings.TrimSpace(r.Header.Get(TenantHeader))
+		if tenantID == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if len(tenantID) > maxTenantIDLength {
+			http.Error(w, "tenant ID too long", http.StatusBadRequest)
+			return
+		}
+
+		ctx := repository.NewContextWithTenant(r.Context(), tenantID)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
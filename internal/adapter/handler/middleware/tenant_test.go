@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+)
+
+func TestTenantScope(t *testing.T) {
+	var (
+		gotTenant string
+		gotScoped bool
+	)
+	h := TenantScope(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotTenant, gotScoped = repository.TenantFromContext(r.Context())
+	}))
+
+	tests := []struct {
+		name       string
+		header     string
+		wantStatus int
+		wantTenant string
+		wantScoped bool
+	}{
+		{name: "no header", wantStatus: http.StatusOK},
+		{name: "tenant", header: " team-a ", wantStatus: http.StatusOK, wantTenant: "team-a", wantScoped: true},
+		{name: "too long", header: strings.Repeat("a", 256), wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotTenant, gotScoped = "", false
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set(TenantHeader, tt.header)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if gotTenant != tt.wantTenant || gotScoped != tt.wantScoped {
+				t.Errorf("tenant = %q (scoped %v), want %q (scoped %v)", gotTenant, gotScoped, tt.wantTenant, tt.wantScoped)
+			}
+		})
+	}
+}
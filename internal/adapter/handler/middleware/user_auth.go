@@ -0,0 +1,156 @@
+package middleware
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/auth"
+)
+
+// jwtLeeway tolerates clock skew between the token issuer and the bridge
+// when checking the exp and nbf claims.
+const jwtLeeway = 30 * time.Second
+
+// JWTConfig describes the tokens UserAuth accepts.
+type JWTConfig struct {
+	Secret   string // HMAC key of HS256-signed tokens
+	Issuer   string // Required "iss" claim (optional)
+	Audience string // Required "aud" claim entry (optional)
+}
+
+// jwtHeader is the JOSE header of a token.
+type jwtHeader struct {
+	Alg string `json:"alg"`
+}
+
+// jwtClaims are the claims UserAuth reads.
+type jwtClaims struct {
+	Subject           string          `json:"sub"`
+	Email             string          `json:"email"`
+	Name              string          `json:"name"`
+	PreferredUsername string          `json:"preferred_username"`
+	Issuer            string          `json:"iss"`
+	Audience          json.RawMessage `json:"aud"` // A string or an array of strings
+	ExpiresAt         float64         `json:"exp"`
+	NotBefore         float64         `json:"nbf"`
+}
+
+// UserAuth creates middleware that authenticates the caller with an HS256
+// JWT bearer token and stores who they are in the request context, so their
+// actions are attributed to them. Tokens must be unexpired and carry an
+// email claim; the name claim, or else preferred_username, names the user.
+// Requests without a valid token are rejected with 401, and an empty
+// secret rejects every request.
+//
+// Expected header format: Authorization: Bearer <jwt>
+func UserAuth(cfg JWTConfig, logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || cfg.Secret == "" {
+				logger.Warn("unauthenticated API request",
+					"remote_addr", r.RemoteAddr,
+					"path", r.URL.Path,
+				)
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+
+			user, err := verifyJWT(token, cfg, time.Now())
+			if err != nil {
+				logger.Warn("rejected API token",
+					"remote_addr", r.RemoteAddr,
+					"path", r.URL.Path,
+					"error", err,
+				)
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(auth.NewContextWithUser(r.Context(), user)))
+		})
+	}
+}
+
+// verifyJWT checks the token's signature and claims and returns its user.
+func verifyJWT(token string, cfg JWTConfig, now time.Time) (auth.User, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return auth.User{}, errors.New("malformed token")
+	}
+
+	var header jwtHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return auth.User{}, fmt.Errorf("decoding header: %w", err)
+	}
+	if header.Alg != "HS256" {
+		return auth.User{}, fmt.Errorf("unsupported algorithm %q", header.Alg)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return auth.User{}, fmt.Errorf("decoding signature: %w", err)
+	}
+	mac := hmac.New(sha256.New, []byte(cfg.Secret))
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return auth.User{}, errors.New("invalid signature")
+	}
+
+	var claims jwtClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return auth.User{}, fmt.Errorf("decoding claims: %w", err)
+	}
+	switch {
+	case claims.ExpiresAt == 0:
+		return auth.User{}, errors.New("token has no expiry")
+	case now.Add(-jwtLeeway).After(time.Unix(int64(claims.ExpiresAt), 0)):
+		return auth.User{}, errors.New("token expired")
+	case claims.NotBefore != 0 && now.Add(jwtLeeway).Before(time.Unix(int64(claims.NotBefore), 0)):
+		return auth.User{}, errors.New("token not yet valid")
+	case cfg.Issuer != "" && claims.Issuer != cfg.Issuer:
+		return auth.User{}, fmt.Errorf("unexpected issuer %q", claims.Issuer)
+	case cfg.Audience != "" && !hasAudience(claims.Audience, cfg.Audience):
+		return auth.User{}, errors.New("token not issued for this audience")
+	case claims.Email == "":
+		return auth.User{}, errors.New("token has no email claim")
+	}
+
+	name := claims.Name
+	if name == "" {
+		name = claims.PreferredUsername
+	}
+	return auth.User{ID: claims.Subject, Email: claims.Email, Name: name}, nil
+}
+
+// decodeSegment decodes a base64url-encoded JSON token segment into v.
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// hasAudience reports whether the aud claim, a string or an array of
+// strings, contains audience.
+func hasAudience(claim json.RawMessage, audience string) bool {
+	var single string
+	if err := json.Unmarshal(claim, &single); err == nil {
+		return single == audience
+	}
+	var multiple []string
+	if err := json.Unmarshal(claim, &multiple); err == nil {
+		return slices.Contains(multiple, audience)
+	}
+	return false
+}
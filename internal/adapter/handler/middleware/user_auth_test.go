@@ -0,0 +1,99 @@
+package middleware
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/auth"
+)
+
+const jwtTestSecret = "0123456789abcdef0123456789abcdef"
+
+// signJWT returns a token with the given header algorithm and claims.
+func signJWT(t *testing.T, alg, secret string, claims map[string]any) string {
+	t.Helper()
+	encode := func(v any) string {
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("failed to marshal token segment: %v", err)
+		}
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+	unsigned := encode(map[string]string{"alg": alg, "typ": "JWT"}) + "." + encode(claims)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestUserAuth(t *testing.T) {
+	exp := time.Now().Add(time.Hour).Unix()
+	valid := map[string]any{"sub": "u-1", "email": "jane@example.com", "name": "Jane", "iss": "sso", "aud": []string{"other", "alert-bridge"}, "exp": exp}
+	with := func(key string, value any) map[string]any {
+		claims := make(map[string]any, len(valid))
+		for k, v := range valid {
+			claims[k] = v
+		}
+		if value == nil {
+			delete(claims, key)
+		} else {
+			claims[key] = value
+		}
+		return claims
+	}
+
+	tests := []struct {
+		name   string
+		header string
+		want   int
+	}{
+		{name: "valid token", header: "Bearer " + signJWT(t, "HS256", jwtTestSecret, valid), want: http.StatusOK},
+		{name: "single audience", header: "Bearer " + signJWT(t, "HS256", jwtTestSecret, with("aud", "alert-bridge")), want: http.StatusOK},
+		{name: "missing header", want: http.StatusUnauthorized},
+		{name: "not a bearer token", header: "Basic amFuZTpwdw==", want: http.StatusUnauthorized},
+		{name: "wrong secret", header: "Bearer " + signJWT(t, "HS256", "another-secret-another-secret-00", valid), want: http.StatusUnauthorized},
+		{name: "unsigned algorithm", header: "Bearer " + signJWT(t, "none", jwtTestSecret, valid), want: http.StatusUnauthorized},
+		{name: "expired", header: "Bearer " + signJWT(t, "HS256", jwtTestSecret, with("exp", time.Now().Add(-time.Hour).Unix())), want: http.StatusUnauthorized},
+		{name: "no expiry", header: "Bearer " + signJWT(t, "HS256", jwtTestSecret, with("exp", nil)), want: http.StatusUnauthorized},
+		{name: "not yet valid", header: "Bearer " + signJWT(t, "HS256", jwtTestSecret, with("nbf", time.Now().Add(time.Hour).Unix())), want: http.StatusUnauthorized},
+		{name: "wrong issuer", header: "Bearer " + signJWT(t, "HS256", jwtTestSecret, with("iss", "elsewhere")), want: http.StatusUnauthorized},
+		{name: "wrong audience", header: "Bearer " + signJWT(t, "HS256", jwtTestSecret, with("aud", "other")), want: http.StatusUnauthorized},
+		{name: "no email", header: "Bearer " + signJWT(t, "HS256", jwtTestSecret, with("email", nil)), want: http.StatusUnauthorized},
+		{name: "malformed", header: "Bearer not.a-token", want: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var user auth.User
+			var called bool
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				user, called = auth.UserFromContext(r.Context())
+			})
+			cfg := JWTConfig{Secret: jwtTestSecret, Issuer: "sso", Audience: "alert-bridge"}
+			h := UserAuth(cfg, slog.New(slog.DiscardHandler))(next)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/alerts/a-1/ack", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			if w.Code != tt.want {
+				t.Fatalf("expected status %d, got %d", tt.want, w.Code)
+			}
+			if called != (tt.want == http.StatusOK) {
+				t.Fatalf("expected handler called=%v, got %v", tt.want == http.StatusOK, called)
+			}
+			if called && (user != auth.User{ID: "u-1", Email: "jane@example.com", Name: "Jane"}) {
+				t.Errorf("unexpected user in context: %+v", user)
+			}
+		})
+	}
+}
@@ -2,7 +2,6 @@ package handler
 
 import (
 	"encoding/json"
-	"io"
 	"net/http"
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
@@ -14,6 +13,7 @@ import (
 // NOTE: Signature verification is handled by middleware.PagerDutyAuth middleware.
 type PagerDutyWebhookHandler struct {
 	handleWebhook *pdUseCase.HandleWebhookUseCase
+	strictJSON    bool
 	logger        alert.Logger
 }
 
@@ -28,6 +28,11 @@ func NewPagerDutyWebhookHandler(
 	}
 }
 
+// SetStrictJSON makes the handler reject payloads with unknown fields.
+func (h *PagerDutyWebhookHandler) SetStrictJSON(strict bool) {
+	h.strictJSON = strict
+}
+
 // ServeHTTP handles POST /webhook/pagerduty
 func (h *PagerDutyWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -35,19 +40,11 @@ func (h *PagerDutyWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	// Read body
-	body, err := io.ReadAll(r.Body)
-	if err != nil {
-		h.logger.Error("failed to read request body", "error", err)
-		http.Error(w, "failed to read body", http.StatusBadRequest)
-		return
-	}
-
 	// Parse webhook payload
 	var payload dto.PagerDutyWebhookV3
-	if err := json.Unmarshal(body, &payload); err != nil {
-		h.logger.Error("failed to parse PagerDuty webhook payload", "error", err)
-		http.Error(w, "invalid payload", http.StatusBadRequest)
+	if err := decodeJSON(r.Body, &payload, h.strictJSON); err != nil {
+		requestLogger(r.Context(), h.logger).Error("failed to parse PagerDuty webhook payload", "error", err)
+		writeDecodeError(w, err)
 		return
 	}
 
@@ -60,7 +57,7 @@ func (h *PagerDutyWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 
 		// Skip unsupported event types
 		if !dto.IsSupportedEventType(event.EventType) {
-			h.logger.Debug("skipping unsupported event type",
+			requestLogger(r.Context(), h.logger).Debug("skipping unsupported event type",
 				"eventType", event.EventType,
 			)
 			skipped++
@@ -71,11 +68,22 @@ func (h *PagerDutyWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 		input := dto.HandlePagerDutyWebhookInput{
 			EventType:     event.EventType,
 			IncidentID:    event.Data.ID,
+			IncidentURL:   event.Data.HTMLURL,
 			IncidentKey:   event.Data.IncidentKey,
 			Status:        event.Data.Status,
 			ResolveReason: event.Data.ResolveReason,
 		}
 
+		// Annotation events carry the note, referencing the incident
+		if event.EventType == "incident.annotated" {
+			input.Note = event.Data.Content
+			input.IncidentID, input.IncidentURL = "", ""
+			if event.Data.Incident != nil {
+				input.IncidentID = event.Data.Incident.ID
+				input.IncidentURL = event.Data.Incident.HTMLURL
+			}
+		}
+
 		// Extract user info from agent or last status change
 		if event.Agent != nil {
 			input.UserID = event.Agent.ID
@@ -98,7 +106,7 @@ func (h *PagerDutyWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 		// Execute use case
 		output, err := h.handleWebhook.Execute(ctx, input)
 		if err != nil {
-			h.logger.Error("failed to handle PagerDuty webhook",
+			requestLogger(r.Context(), h.logger).Error("failed to handle PagerDuty webhook",
 				"eventType", event.EventType,
 				"incidentID", event.Data.ID,
 				"error", err,
@@ -108,7 +116,7 @@ func (h *PagerDutyWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 
 		if output.Processed {
 			processed++
-			h.logger.Info("PagerDuty webhook processed",
+			requestLogger(r.Context(), h.logger).Info("PagerDuty webhook processed",
 				"eventType", event.EventType,
 				"incidentID", event.Data.ID,
 				"alertID", output.AlertID,
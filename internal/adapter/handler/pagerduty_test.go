@@ -0,0 +1,262 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/ack"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
+	pdUseCase "github.com/qj0r9j0vc2/alert-bridge/internal/usecase/pagerduty"
+)
+
+// recordingSlack records the Slack message updates and thread replies.
+type recordingSlack struct {
+	updated []string
+	replies []string
+}
+
+func (s *recordingSlack) UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error {
+	s.updated = append(s.updated, messageID)
+	return nil
+}
+
+func (s *recordingSlack) PostThreadReply(ctx context.Context, messageID, text string) error {
+	s.replies = append(s.replies, text)
+	return nil
+}
+
+const annotatedEvent = `{
+  "messages": [{
+    "id": "msg-1",
+    "event": {
+      "id": "evt-1",
+      "event_type": "incident.annotated",
+      "resource_type": "incident",
+      "occurred_at": "2024-05-01T10:00:00Z",
+      "agent": {"id": "PUSER1", "type": "user_reference", "name": "Jane Doe", "email": "jane@example.com"},
+      "data": {
+        "incident": {"id": "PINC1", "type": "incident_reference", "html_url": "https://example.pagerduty.com/incidents/PINC1"},
+        "id": "PNOTE1",
+        "content": "Restarted the <primary> replica",
+        "type": "incident_note"
+      }
+    }
+  }]
+}`
+
+func TestPagerDutyWebhookHandler_Annotated(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	ackEventRepo := memory.NewAckEventRepository()
+
+	a := entity.NewAlert("fp-1", "DiskFull", "db-1", "", "", entity.SeverityCritical)
+	a.SetExternalReference("slack", "C123:1700000000.000100")
+	a.SetExternalReference(entity.PagerDutyIncidentReference, "PINC1")
+	if err := alertRepo.Save(ctx, a); err != nil {
+		t.Fatalf("failed to save alert: %v", err)
+	}
+
+	slackClient := &recordingSlack{}
+	syncAck := ack.NewSyncAckUseCase(alertRepo, ackEventRepo, memory.NewTransactionManager(), nil, nopLogger{}, nil)
+	uc := pdUseCase.NewHandleWebhookUseCase(alertRepo, syncAck, slackClient, nopLogger{})
+	uc.SetThreadReplier(slackClient)
+	h := NewPagerDutyWebhookHandler(uc, nopLogger{})
+
+	req := httptest.NewRequest(http.MethodPost, "/webhook/pagerduty", strings.NewReader(annotatedEvent))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("expected status 202, got %d: %s", w.Code, w.Body.String())
+	}
+	if !strings.Contains(w.Body.String(), `"processed":1`) {
+		t.Errorf("expected the event to be processed, got %s", w.Body.String())
+	}
+
+	events, err := ackEventRepo.FindByAlertID(ctx, a.ID)
+	if err != nil || len(events) != 1 {
+		t.Fatalf("expected one ack event, got %v, %v", events, err)
+	}
+	if events[0].Source != entity.AckSourcePagerDuty || events[0].Note != "Restarted the <primary> replica" {
+		t.Errorf("unexpected ack event: %+v", events[0])
+	}
+
+	stored, _ := alertRepo.FindByID(ctx, a.ID)
+	if !stored.IsAcked() || stored.AckedBy != "jane@example.com" {
+		t.Errorf("expected the alert acked by jane@example.com, got state %s by %q", stored.State, stored.AckedBy)
+	}
+
+	if len(slackClient.updated) != 1 {
+		t.Errorf("expected the Slack message to be updated once, got %v", slackClient.updated)
+	}
+	if len(slackClient.replies) != 1 {
+		t.Fatalf("expected one thread reply, got %v", slackClient.replies)
+	}
+	if reply := slackClient.replies[0]; !strings.Contains(reply, "jane@example.com") || !strings.Contains(reply, ">Restarted the &lt;primary&gt; replica") {
+		t.Errorf("unexpected thread reply: %q", reply)
+	}
+}
+
+const triggeredEvent = `{
+  "messages": [{
+    "id": "msg-2",
+    "event": {
+      "id": "evt-2",
+      "event_type": "incident.triggered",
+      "resource_type": "incident",
+      "occurred_at": "2024-05-01T10:00:00Z",
+      "data": {
+        "id": "PINC2",
+        "type": "incident",
+        "html_url": "https://example.pagerduty.com/incidents/PINC2",
+        "incident_key": "fp-2",
+        "status": "triggered"
+      }
+    }
+  }]
+}`
+
+func TestPagerDutyWebhookHandler_TriggeredLinksIncident(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+
+	a := entity.NewAlert("fp-2", "DiskFull", "db-1", "", "", entity.SeverityCritical)
+	a.SetExternalReference("slack", "C123:1700000000.000100")
+	a.SetExternalReference("pagerduty", "fp-2")
+	if err := alertRepo.Save(ctx, a); err != nil {
+		t.Fatalf("failed to save alert: %v", err)
+	}
+
+	slackClient := &recordingSlack{}
+	syncAck := ack.NewSyncAckUseCase(alertRepo, memory.NewAckEventRepository(), memory.NewTransactionManager(), nil, nopLogger{}, nil)
+	h := NewPagerDutyWebhookHandler(pdUseCase.NewHandleWebhookUseCase(alertRepo, syncAck, slackClient, nopLogger{}), nopLogger{})
+
+	for range 2 {
+		req := httptest.NewRequest(http.MethodPost, "/webhook/pagerduty", strings.NewReader(triggeredEvent))
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		if w.Code != http.StatusAccepted {
+			t.Fatalf("expected status 202, got %d: %s", w.Code, w.Body.String())
+		}
+	}
+
+	stored, _ := alertRepo.FindByID(ctx, a.ID)
+	if got := stored.GetExternalReference(entity.PagerDutyURLReference); got != "https://example.pagerduty.com/incidents/PINC2" {
+		t.Errorf("expected the incident URL recorded, got %q", got)
+	}
+	if got := stored.GetExternalReference(entity.PagerDutyIncidentReference); got != "PINC2" {
+		t.Errorf("expected the incident ID recorded, got %q", got)
+	}
+	// Only the first delivery changes the link
+	if len(slackClient.updated) != 1 {
+		t.Errorf("expected the Slack message to be updated once, got %v", slackClient.updated)
+	}
+}
+
+func TestPagerDutyWebhookHandler_IgnoresSupersededIncident(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+
+	// The alert's ack expired and its incident fp-2 was replaced by a new one
+	a := entity.NewAlert("fp-2", "DiskFull", "db-1", "", "", entity.SeverityCritical)
+	a.SetExternalReference("pagerduty", entity.ReopenedDedupKey("fp-2", time.Now()))
+	a.SetExternalReference(entity.PagerDutyIncidentReference, "PINC2")
+	if err := alertRepo.Save(ctx, a); err != nil {
+		t.Fatalf("failed to save alert: %v", err)
+	}
+
+	syncAck := ack.NewSyncAckUseCase(alertRepo, memory.NewAckEventRepository(), memory.NewTransactionManager(), nil, nopLogger{}, nil)
+	h := NewPagerDutyWebhookHandler(pdUseCase.NewHandleWebhookUseCase(alertRepo, syncAck, &recordingSlack{}, nopLogger{}), nopLogger{})
+
+	resolvedEvent := strings.NewReplacer("incident.triggered", "incident.resolved", `"status": "triggered"`, `"status": "resolved"`).Replace(triggeredEvent)
+	req := httptest.NewRequest(http.MethodPost, "/webhook/pagerduty", strings.NewReader(resolvedEvent))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("expected status 202, got %d: %s", w.Code, w.Body.String())
+	}
+
+	stored, _ := alertRepo.FindByID(ctx, a.ID)
+	if !stored.IsActive() {
+		t.Errorf("expected the alert to stay active, got %s", stored.State)
+	}
+}
+
+// countingNotifier counts the new messages it posts.
+type countingNotifier struct {
+	posted int
+}
+
+func (n *countingNotifier) Notify(ctx context.Context, alert *entity.Alert) (string, error) {
+	n.posted++
+	return "incident", nil
+}
+
+func (n *countingNotifier) UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error {
+	return nil
+}
+
+func (n *countingNotifier) Name() string { return "pagerduty" }
+
+func TestPagerDutyWebhookHandler_ResolvedReleasesDependents(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	notifier := &countingNotifier{}
+
+	processAlert := alert.NewProcessAlertUseCase(alertRepo, memory.NewSilenceRepository(), []alert.Notifier{notifier}, nopLogger{}, nil)
+	processAlert.SetDependencySuppressor(alert.NewDependencySuppressor(alertRepo, []alert.Dependency{{
+		Parent: map[string]string{"alertname": "NodeDown"},
+		Equal:  []string{"instance"},
+	}}))
+	process := func(fingerprint, name string) string {
+		output, err := processAlert.Execute(ctx, dto.ProcessAlertInput{
+			Fingerprint: fingerprint,
+			Name:        name,
+			Instance:    "node-1",
+			Severity:    entity.SeverityCritical,
+			Status:      "firing",
+			Labels:      map[string]string{"alertname": name, "instance": "node-1"},
+		})
+		if err != nil {
+			t.Fatalf("failed to process alert: %v", err)
+		}
+		return output.AlertID
+	}
+	parentID := process("fp-2", "NodeDown")
+	childID := process("fp-api", "APIDown")
+	if notifier.posted != 1 {
+		t.Fatalf("expected only the parent to page, got %d pages", notifier.posted)
+	}
+
+	syncAck := ack.NewSyncAckUseCase(alertRepo, memory.NewAckEventRepository(), memory.NewTransactionManager(), nil, nopLogger{}, nil)
+	uc := pdUseCase.NewHandleWebhookUseCase(alertRepo, syncAck, &recordingSlack{}, nopLogger{})
+	uc.SetDependentReleaser(processAlert)
+	h := NewPagerDutyWebhookHandler(uc, nopLogger{})
+
+	resolvedEvent := strings.NewReplacer("incident.triggered", "incident.resolved", `"status": "triggered"`, `"status": "resolved"`).Replace(triggeredEvent)
+	req := httptest.NewRequest(http.MethodPost, "/webhook/pagerduty", strings.NewReader(resolvedEvent))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("expected status 202, got %d: %s", w.Code, w.Body.String())
+	}
+
+	parent, _ := alertRepo.FindByID(ctx, parentID)
+	if !parent.IsResolved() {
+		t.Fatalf("expected the parent to be resolved, got %s", parent.State)
+	}
+	child, _ := alertRepo.FindByID(ctx, childID)
+	if child.HasExternalReference(entity.SuppressedByReference) {
+		t.Errorf("expected the child to be released from its resolved parent")
+	}
+	if notifier.posted != 2 {
+		t.Errorf("expected the released child to page, got %d pages", notifier.posted)
+	}
+}
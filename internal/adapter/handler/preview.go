@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
+)
+
+// PreviewHandler renders the notifications for a sample alert without sending them.
+type PreviewHandler struct {
+	previewAlert *alert.PreviewAlertUseCase
+	logger       alert.Logger
+}
+
+// NewPreviewHandler creates a new preview handler.
+func NewPreviewHandler(previewAlert *alert.PreviewAlertUseCase, logger alert.Logger) *PreviewHandler {
+	return &PreviewHandler{
+		previewAlert: previewAlert,
+		logger:       logger,
+	}
+}
+
+// ServeHTTP handles POST /api/v1/preview with a single Alertmanager alert as the body.
+func (h *PreviewHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var sample dto.AlertmanagerAlert
+	if err := json.NewDecoder(r.Body).Decode(&sample); err != nil {
+		http.Error(w, "invalid alert payload", http.StatusBadRequest)
+		return
+	}
+
+	// Fill in fields a hand-written sample is likely to omit
+	if sample.Status == "" {
+		sample.Status = "firing"
+	}
+	if sample.Fingerprint == "" {
+		sample.Fingerprint = "preview"
+	}
+	if sample.StartsAt.IsZero() {
+		sample.StartsAt = time.Now().UTC()
+	}
+
+	output, err := h.previewAlert.Execute(r.Context(), dto.ToProcessAlertInput(sample))
+	if err != nil {
+		requestLogger(r.Context(), h.logger).Error("failed to preview alert", "error", err)
+		http.Error(w, "preview failed", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
@@ -30,9 +30,9 @@ func (h *ReloadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	if err := h.configManager.TryReload(); err != nil {
 		if err == config.ErrRequiresRestart {
-			// Static config change - log warning but return 200
+			// Static config change ignored, reloadable keys applied - return 200
 			w.WriteHeader(http.StatusOK)
-			w.Write([]byte("Configuration change requires restart\n"))
+			w.Write([]byte("Reloadable settings applied; other changes require restart\n"))
 			return
 		}
 
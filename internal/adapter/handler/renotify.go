@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
+)
+
+// RenotifyHandler re-sends the notifications for an existing alert.
+type RenotifyHandler struct {
+	processAlert *alert.ProcessAlertUseCase
+	logger       alert.Logger
+}
+
+// NewRenotifyHandler creates a new renotify handler.
+func NewRenotifyHandler(processAlert *alert.ProcessAlertUseCase, logger alert.Logger) *RenotifyHandler {
+	return &RenotifyHandler{
+		processAlert: processAlert,
+		logger:       logger,
+	}
+}
+
+// ServeHTTP handles POST /api/v1/alerts/{id}/notify.
+// ?force=true posts new messages instead of updating existing ones.
+func (h *RenotifyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var force bool
+	if value := r.URL.Query().Get("force"); value != "" {
+		var err error
+		if force, err = strconv.ParseBool(value); err != nil {
+			http.Error(w, "invalid force parameter", http.StatusBadRequest)
+			return
+		}
+	}
+
+	alertID := r.PathValue("id")
+	output, err := h.processAlert.Renotify(r.Context(), alertID, force)
+	if errors.Is(err, repository.ErrNotFound) {
+		http.Error(w, "alert not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		requestLogger(r.Context(), h.logger).Error("failed to renotify alert", "alertID", alertID, "error", err)
+		http.Error(w, "renotify failed", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(dto.ToRenotifyAlertOutput(output))
+}
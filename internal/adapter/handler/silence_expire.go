@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/silence"
+)
+
+// SilenceExpireHandler ends a silence early without deleting it.
+type SilenceExpireHandler struct {
+	expire *silence.ExpireSilenceUseCase
+	logger alert.Logger
+}
+
+// NewSilenceExpireHandler creates a new silence expire handler.
+func NewSilenceExpireHandler(expire *silence.ExpireSilenceUseCase, logger alert.Logger) *SilenceExpireHandler {
+	return &SilenceExpireHandler{
+		expire: expire,
+		logger: logger,
+	}
+}
+
+// ServeHTTP handles POST /api/v1/silences/{id}/expire.
+func (h *SilenceExpireHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	silenceID := r.PathValue("id")
+	expired, err := h.expire.Execute(r.Context(), silenceID, "api", entity.AckSourceAPI)
+	if errors.Is(err, repository.ErrNotFound) {
+		http.Error(w, "silence not found", http.StatusNotFound)
+		return
+	}
+	if errors.Is(err, entity.ErrSilenceExpired) {
+		http.Error(w, "silence already expired", http.StatusConflict)
+		return
+	}
+	if errors.Is(err, entity.ErrSilenceImported) {
+		http.Error(w, entity.ErrSilenceImported.Error(), http.StatusConflict)
+		return
+	}
+	if err != nil {
+		requestLogger(r.Context(), h.logger).Error("failed to expire silence", "silenceID", silenceID, "error", err)
+		http.Error(w, "silence expire failed", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(dto.NewSilenceSummary(expired))
+}
@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/silence"
+	slackUseCase "github.com/qj0r9j0vc2/alert-bridge/internal/usecase/slack"
+)
+
+// saveSilence stores an hour-long silence created from source.
+func saveSilence(t *testing.T, silenceRepo *memory.SilenceRepository, source entity.AckSource) *entity.SilenceMark {
+	t.Helper()
+	mark, err := entity.NewSilenceMark(time.Hour, "jane", "jane@example.com", source)
+	if err != nil {
+		t.Fatalf("failed to create silence: %v", err)
+	}
+	mark.Reason = "database maintenance"
+	if err := silenceRepo.Save(context.Background(), mark); err != nil {
+		t.Fatalf("failed to save silence: %v", err)
+	}
+	return mark
+}
+
+func TestSilenceExpireHandler(t *testing.T) {
+	silenceRepo := memory.NewSilenceRepository()
+	h := NewSilenceExpireHandler(silence.NewExpireSilenceUseCase(silenceRepo), nopLogger{})
+
+	post := func(silenceID string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/silences/"+silenceID+"/expire", nil)
+		req.SetPathValue("id", silenceID)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		return w
+	}
+
+	mark := saveSilence(t, silenceRepo, entity.AckSourceSlack)
+	w := post(mark.ID)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	var summary dto.SilenceSummary
+	if err := json.NewDecoder(w.Body).Decode(&summary); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if summary.ID != mark.ID || summary.EndAt.After(time.Now()) {
+		t.Errorf("expected the silence to end now, got %+v", summary)
+	}
+
+	// The silence is kept, ended
+	stored, _ := silenceRepo.FindByID(context.Background(), mark.ID)
+	if stored == nil || !stored.IsExpired() {
+		t.Fatalf("expected the silence to be kept and expired, got %+v", stored)
+	}
+
+	if w := post(mark.ID); w.Code != http.StatusConflict {
+		t.Errorf("expected status 409 for an expired silence, got %d", w.Code)
+	}
+	if w := post("missing"); w.Code != http.StatusNotFound {
+		t.Errorf("expected status 404 for an unknown silence, got %d", w.Code)
+	}
+
+	// Imported silences would come back on the next sync
+	imported := saveSilence(t, silenceRepo, entity.AckSourceAlertmanager)
+	w = post(imported.ID)
+	if w.Code != http.StatusConflict || !strings.Contains(w.Body.String(), "Alertmanager") {
+		t.Errorf("expected status 409 for an imported silence, got %d: %s", w.Code, w.Body.String())
+	}
+	if stored, _ := silenceRepo.FindByID(context.Background(), imported.ID); stored.IsExpired() {
+		t.Error("expected the imported silence to stay active")
+	}
+}
+
+func TestSlackCommandsHandler_Unsilence(t *testing.T) {
+	silenceRepo := memory.NewSilenceRepository()
+	manageSilence := slackUseCase.NewManageSilenceUseCase(silenceRepo, memory.NewAlertRepository(), nil)
+	h := NewSlackCommandsHandler(nil, nil, manageSilence, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	var responses []string
+	responseServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var response struct {
+			Text string `json:"text"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&response); err != nil {
+			t.Errorf("failed to decode response: %v", err)
+		}
+		responses = append(responses, response.Text)
+	}))
+	defer responseServer.Close()
+
+	unsilence := func(text string) string {
+		t.Helper()
+		responses = nil
+		h.handleUnsilence(context.Background(), &dto.SlackCommandDTO{
+			Command:     "/unsilence",
+			Text:        text,
+			UserID:      "U1",
+			UserName:    "jane",
+			ResponseURL: responseServer.URL,
+		}, time.Now())
+		if len(responses) != 1 {
+			t.Fatalf("expected one response, got %d", len(responses))
+		}
+		return responses[0]
+	}
+
+	if text := unsilence(""); !strings.Contains(text, "Usage: /unsilence <id>") {
+		t.Errorf("expected the usage without an ID, got %q", text)
+	}
+
+	mark := saveSilence(t, silenceRepo, entity.AckSourceSlack)
+	if text := unsilence(mark.ID); !strings.Contains(text, "Expired silence "+mark.ID) {
+		t.Errorf("expected the silence to be expired, got %q", text)
+	}
+	if stored, _ := silenceRepo.FindByID(context.Background(), mark.ID); !stored.IsExpired() {
+		t.Error("expected the silence to be expired")
+	}
+
+	if text := unsilence(mark.ID); !strings.Contains(text, "Failed to expire silence") {
+		t.Errorf("expected a failure for an expired silence, got %q", text)
+	}
+
+	imported := saveSilence(t, silenceRepo, entity.AckSourceAlertmanager)
+	if text := unsilence(imported.ID); !strings.Contains(text, "expire it there") {
+		t.Errorf("expected imported silences to be refused, got %q", text)
+	}
+}
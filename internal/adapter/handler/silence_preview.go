@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/silence"
+)
+
+// SilencePreviewHandler reports which firing alerts a proposed silence
+// would match, without creating it.
+type SilencePreviewHandler struct {
+	preview    *silence.PreviewSilenceUseCase
+	strictJSON bool
+	logger     alert.Logger
+}
+
+// NewSilencePreviewHandler creates a new silence preview handler.
+func NewSilencePreviewHandler(preview *silence.PreviewSilenceUseCase, logger alert.Logger) *SilencePreviewHandler {
+	return &SilencePreviewHandler{
+		preview: preview,
+		logger:  logger,
+	}
+}
+
+// SetStrictJSON makes the handler reject payloads with unknown fields.
+func (h *SilencePreviewHandler) SetStrictJSON(strict bool) {
+	h.strictJSON = strict
+}
+
+// ServeHTTP handles POST /api/v1/silences/preview.
+func (h *SilencePreviewHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var request dto.SilencePreviewRequest
+	if err := decodeJSON(r.Body, &request, h.strictJSON); err != nil {
+		requestLogger(r.Context(), h.logger).Error("failed to decode silence preview request", "error", err)
+		writeDecodeError(w, err)
+		return
+	}
+	if err := request.Validate(); err != nil {
+		writeValidationError(w, err)
+		return
+	}
+
+	response, err := h.preview.Execute(r.Context(), request)
+	if err != nil {
+		requestLogger(r.Context(), h.logger).Error("failed to preview silence", "error", err)
+		http.Error(w, "silence preview failed", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
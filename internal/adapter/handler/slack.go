@@ -3,23 +3,37 @@ package handler
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"io"
 	"net/http"
-	"strings"
+	"sync"
+	"time"
 
 	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/slackevents"
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/logger"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
 	slackUseCase "github.com/qj0r9j0vc2/alert-bridge/internal/usecase/slack"
 )
 
+// interactionTimeout bounds the background work of a block action.
+const interactionTimeout = 30 * time.Second
+
+// interactionFailedText is posted to the user when a block action fails.
+const interactionFailedText = ":warning: Your action could not be completed. Please try again."
+
 // SlackInteractionHandler handles Slack interactive component callbacks.
 // NOTE: Signature verification is handled by middleware.SlackAuth middleware.
 type SlackInteractionHandler struct {
 	handleInteraction *slackUseCase.HandleInteractionUseCase
 	logger            alert.Logger
+
+	// appHome, when set, is republished for users acting from their Home tab
+	appHome *slackUseCase.AppHomeUseCase
+
+	// pending tracks block actions still running after their response
+	pending sync.WaitGroup
 }
 
 // NewSlackInteractionHandler creates a new Slack interaction handler.
@@ -33,6 +47,12 @@ func NewSlackInteractionHandler(
 	}
 }
 
+// SetAppHome republishes the App Home tab of users who click its buttons,
+// so it shows the outcome without waiting for the next refresh.
+func (h *SlackInteractionHandler) SetAppHome(appHome *slackUseCase.AppHomeUseCase) {
+	h.appHome = appHome
+}
+
 // ServeHTTP handles POST /webhook/slack/interaction
 func (h *SlackInteractionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -42,8 +62,8 @@ func (h *SlackInteractionHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 
 	// Parse the payload
 	if err := r.ParseForm(); err != nil {
-		h.logger.Error("failed to parse form", "error", err)
-		http.Error(w, "invalid form data", http.StatusBadRequest)
+		requestLogger(r.Context(), h.logger).Error("failed to parse form", "error", err)
+		writeDecodeError(w, err)
 		return
 	}
 
@@ -55,8 +75,8 @@ func (h *SlackInteractionHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 
 	var payload slack.InteractionCallback
 	if err := json.Unmarshal([]byte(payloadStr), &payload); err != nil {
-		h.logger.Error("failed to parse interaction payload", "error", err)
-		http.Error(w, "invalid payload", http.StatusBadRequest)
+		requestLogger(r.Context(), h.logger).Error("failed to parse interaction payload", "error", err)
+		writeDecodeError(w, err)
 		return
 	}
 
@@ -65,30 +85,62 @@ func (h *SlackInteractionHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	// Route based on interaction type
 	switch payload.Type {
 	case slack.InteractionTypeViewSubmission:
+		// Validation errors must be returned in the response, so modal
+		// submissions are handled before responding
 		h.handleViewSubmission(ctx, w, &payload)
 		return
 	case slack.InteractionTypeBlockActions:
-		h.handleBlockActions(ctx, &payload)
+		// Slack expects a response within 3 seconds. Acks and silences update
+		// the database, PagerDuty and the message, so they run in the
+		// background with their own context; the request context is
+		// cancelled once the response is sent.
+		asyncCtx, cancel := context.WithTimeout(
+			logger.NewContextWithRequestID(context.Background(), logger.RequestIDFromContext(ctx)),
+			interactionTimeout,
+		)
+		h.pending.Add(1)
+		go func() {
+			defer h.pending.Done()
+			defer cancel()
+			h.handleBlockActions(asyncCtx, &payload)
+		}()
 	default:
-		h.logger.Warn("unhandled interaction type", "type", payload.Type)
+		requestLogger(r.Context(), h.logger).Warn("unhandled interaction type", "type", payload.Type)
 	}
 
 	// Acknowledge the interaction immediately
 	w.WriteHeader(http.StatusOK)
 }
 
+// Close waits for block actions still running in the background, until ctx
+// is done.
+func (h *SlackInteractionHandler) Close(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		h.pending.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // handleViewSubmission handles modal form submissions.
 func (h *SlackInteractionHandler) handleViewSubmission(ctx context.Context, w http.ResponseWriter, payload *slack.InteractionCallback) {
 	callbackID := payload.View.CallbackID
 
-	h.logger.Info("handling view submission",
+	requestLogger(ctx, h.logger).Info("handling view submission",
 		"callbackID", callbackID,
 		"userID", payload.User.ID,
 	)
 
 	output, err := h.handleInteraction.HandleModalSubmission(ctx, payload)
 	if err != nil {
-		h.logger.Error("failed to handle modal submission",
+		requestLogger(ctx, h.logger).Error("failed to handle modal submission",
 			"callbackID", callbackID,
 			"userID", payload.User.ID,
 			"error", err,
@@ -107,7 +159,7 @@ func (h *SlackInteractionHandler) handleViewSubmission(ctx context.Context, w ht
 		return
 	}
 
-	h.logger.Info("modal submission handled",
+	requestLogger(ctx, h.logger).Info("modal submission handled",
 		"callbackID", callbackID,
 		"userID", payload.User.ID,
 		"success", output.Success,
@@ -118,7 +170,9 @@ func (h *SlackInteractionHandler) handleViewSubmission(ctx context.Context, w ht
 	w.WriteHeader(http.StatusOK)
 }
 
-// handleBlockActions handles button clicks and other block actions.
+// handleBlockActions handles button clicks and other block actions. The
+// message is updated by the use case; replies and failed actions are
+// reported to the user through the response URL.
 func (h *SlackInteractionHandler) handleBlockActions(ctx context.Context, payload *slack.InteractionCallback) {
 	for _, action := range payload.ActionCallback.BlockActions {
 		input := dto.SlackInteractionInput{
@@ -129,6 +183,8 @@ func (h *SlackInteractionHandler) handleBlockActions(ctx context.Context, payloa
 			ResponseURL: payload.ResponseURL,
 			ChannelID:   payload.Channel.ID,
 			MessageTS:   payload.Message.Timestamp,
+			ThreadTS:    payload.Message.ThreadTimestamp,
+			Value:       action.Value,
 			TriggerID:   payload.TriggerID,
 		}
 
@@ -136,40 +192,75 @@ func (h *SlackInteractionHandler) handleBlockActions(ctx context.Context, payloa
 		if action.SelectedOption.Value != "" {
 			input.Value = action.SelectedOption.Value
 		}
+		// The user select of the assign action picks a user ID
+		if action.SelectedUser != "" {
+			input.Value = action.SelectedUser
+		}
 
 		output, err := h.handleInteraction.Execute(ctx, input)
 		if err != nil {
-			h.logger.Error("failed to handle interaction",
+			requestLogger(ctx, h.logger).Error("failed to handle interaction",
 				"actionID", action.ActionID,
 				"userID", payload.User.ID,
 				"error", err,
 			)
+			h.replyError(ctx, payload.ResponseURL)
 			// Continue processing other actions
 			continue
 		}
 
-		h.logger.Info("interaction handled",
+		requestLogger(ctx, h.logger).Info("interaction handled",
 			"actionID", action.ActionID,
 			"userID", payload.User.ID,
 			"success", output.Success,
 			"message", output.Message,
 		)
+		if output.Reply != "" && payload.ResponseURL != "" {
+			if err := postToResponseURL(ctx, payload.ResponseURL, dto.NewEphemeralResponse(output.Reply)); err != nil {
+				requestLogger(ctx, h.logger).Warn("failed to post interaction reply", "error", err)
+			}
+		}
+	}
+
+	if h.appHome != nil && payload.View.Type == slack.VTHomeTab {
+		if err := h.appHome.Open(ctx, payload.User.ID); err != nil {
+			requestLogger(ctx, h.logger).Warn("failed to republish home view", "userID", payload.User.ID, "error", err)
+		}
 	}
 }
 
-// SlackEventsHandler handles Slack Events API requests (URL verification, etc.).
+// replyError tells the user who triggered an interaction that it failed.
+func (h *SlackInteractionHandler) replyError(ctx context.Context, responseURL string) {
+	if responseURL == "" {
+		return
+	}
+	if err := postToResponseURL(ctx, responseURL, dto.NewEphemeralResponse(interactionFailedText)); err != nil {
+		requestLogger(ctx, h.logger).Warn("failed to post interaction error reply", "error", err)
+	}
+}
+
+// SlackEventsHandler handles Slack Events API requests (URL verification, reactions, App Home).
 // NOTE: Signature verification is handled by middleware.SlackAuth middleware.
 type SlackEventsHandler struct {
-	logger alert.Logger
+	handleReaction *slackUseCase.HandleReactionUseCase
+	appHome        *slackUseCase.AppHomeUseCase
+	logger         alert.Logger
 }
 
 // NewSlackEventsHandler creates a new Slack events handler.
-func NewSlackEventsHandler(logger alert.Logger) *SlackEventsHandler {
+// handleReaction may be nil when reaction-based acknowledgment is disabled.
+func NewSlackEventsHandler(handleReaction *slackUseCase.HandleReactionUseCase, logger alert.Logger) *SlackEventsHandler {
 	return &SlackEventsHandler{
-		logger: logger,
+		handleReaction: handleReaction,
+		logger:         logger,
 	}
 }
 
+// SetAppHome publishes the App Home tab listing active alerts when a user opens it.
+func (h *SlackEventsHandler) SetAppHome(appHome *slackUseCase.AppHomeUseCase) {
+	h.appHome = appHome
+}
+
 // ServeHTTP handles POST /webhook/slack/events
 func (h *SlackEventsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -179,7 +270,7 @@ func (h *SlackEventsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
-		http.Error(w, "failed to read body", http.StatusBadRequest)
+		writeDecodeError(w, err)
 		return
 	}
 
@@ -190,7 +281,7 @@ func (h *SlackEventsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := json.Unmarshal(body, &event); err != nil {
-		http.Error(w, "invalid payload", http.StatusBadRequest)
+		writeDecodeError(w, err)
 		return
 	}
 
@@ -201,15 +292,62 @@ func (h *SlackEventsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if event.Type == slackevents.CallbackEvent {
+		// Request authenticity is checked by the signing secret middleware
+		eventsAPI, err := slackevents.ParseEvent(json.RawMessage(body), slackevents.OptionNoVerifyToken())
+		if err != nil {
+			requestLogger(r.Context(), h.logger).Warn("failed to parse event callback", "error", err)
+		} else {
+			h.HandleInnerEvent(r.Context(), eventsAPI.InnerEvent)
+		}
+	}
+
 	// For other events, acknowledge
 	w.WriteHeader(http.StatusOK)
 }
 
-// parseMessageID parses a message ID from "channel:timestamp" format.
-func parseMessageID(messageID string) (channelID, timestamp string, err error) {
-	parts := strings.SplitN(messageID, ":", 2)
-	if len(parts) != 2 {
-		return "", "", fmt.Errorf("invalid message ID format: %s", messageID)
+// HandleInnerEvent routes an Events API inner event to the matching use case.
+// It is shared by the HTTP endpoint and Socket Mode.
+func (h *SlackEventsHandler) HandleInnerEvent(ctx context.Context, inner slackevents.EventsAPIInnerEvent) {
+	switch ev := inner.Data.(type) {
+	case *slackevents.ReactionAddedEvent:
+		if h.handleReaction == nil || ev.Item.Type != "message" {
+			return
+		}
+
+		output, err := h.handleReaction.Execute(ctx, dto.SlackReactionInput{
+			Reaction:  ev.Reaction,
+			UserID:    ev.User,
+			ChannelID: ev.Item.Channel,
+			MessageTS: ev.Item.Timestamp,
+		})
+		if err != nil {
+			requestLogger(ctx, h.logger).Error("failed to handle reaction",
+				"reaction", ev.Reaction,
+				"userID", ev.User,
+				"error", err,
+			)
+			return
+		}
+
+		requestLogger(ctx, h.logger).Debug("reaction handled",
+			"reaction", ev.Reaction,
+			"userID", ev.User,
+			"success", output.Success,
+			"message", output.Message,
+		)
+	case *slackevents.AppHomeOpenedEvent:
+		if h.appHome == nil || ev.Tab != "home" {
+			return
+		}
+
+		if err := h.appHome.Open(ctx, ev.User); err != nil {
+			requestLogger(ctx, h.logger).Error("failed to publish home view",
+				"userID", ev.User,
+				"error", err,
+			)
+		}
+	default:
+		requestLogger(ctx, h.logger).Debug("unhandled event type", "type", inner.Type)
 	}
-	return parts[0], parts[1], nil
 }
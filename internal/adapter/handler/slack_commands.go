@@ -13,6 +13,7 @@ import (
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/presenter"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
 	slackUseCase "github.com/qj0r9j0vc2/alert-bridge/internal/usecase/slack"
 )
 
@@ -52,6 +53,22 @@ var slackCommands = []SlackCommandInfo{
 		ShouldEscape:     false,
 		AutocompleteHint: "create 1h, list, delete <id>",
 	},
+	{
+		Command:          "/unsilence",
+		Description:      "End a silence early, keeping its history",
+		UsageHint:        "<id>",
+		RequestURL:       "/webhook/slack/commands",
+		ShouldEscape:     false,
+		AutocompleteHint: "ID of the silence to expire",
+	},
+	{
+		Command:          "/ack",
+		Description:      "Acknowledge all firing alerts matching labels",
+		UsageHint:        "key=value [key=value ...]",
+		RequestURL:       "/webhook/slack/commands",
+		ShouldEscape:     false,
+		AutocompleteHint: "service=api env=prod",
+	},
 }
 
 // SlackCommandsHandler handles Slack slash command webhooks (HTTP Mode).
@@ -59,6 +76,8 @@ type SlackCommandsHandler struct {
 	queryAlertStatus *slackUseCase.QueryAlertStatusUseCase
 	summarizeAlerts  *slackUseCase.SummarizeAlertsUseCase
 	manageSilence    *slackUseCase.ManageSilenceUseCase
+	ackBySelector    *slackUseCase.AckBySelectorUseCase
+	channelTenants   map[string]string
 	formatter        *presenter.SlackAlertFormatter
 	logger           *slog.Logger
 }
@@ -79,6 +98,18 @@ func NewSlackCommandsHandler(
 	}
 }
 
+// SetAckBySelector enables the /ack command.
+func (h *SlackCommandsHandler) SetAckBySelector(ackBySelector *slackUseCase.AckBySelectorUseCase) {
+	h.ackBySelector = ackBySelector
+}
+
+// SetChannelTenants scopes commands run in the given channels to the mapped
+// tenant, so they only see and change that tenant's alerts and silences.
+// Commands in other channels see every tenant.
+func (h *SlackCommandsHandler) SetChannelTenants(channelTenants map[string]string) {
+	h.channelTenants = channelTenants
+}
+
 // ServeHTTP implements http.Handler interface.
 func (h *SlackCommandsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
@@ -164,6 +195,10 @@ func (h *SlackCommandsHandler) HandleSlashCommand(w http.ResponseWriter, r *http
 
 // processCommand processes the command and sends delayed response via response_url.
 func (h *SlackCommandsHandler) processCommand(ctx context.Context, cmd *dto.SlackCommandDTO, startTime time.Time) {
+	if tenantID, ok := h.channelTenants[cmd.ChannelID]; ok {
+		ctx = repository.NewContextWithTenant(ctx, tenantID)
+	}
+
 	// Route based on command
 	switch cmd.Command {
 	case "/alert-status":
@@ -172,6 +207,10 @@ func (h *SlackCommandsHandler) processCommand(ctx context.Context, cmd *dto.Slac
 		h.handleSummary(ctx, cmd, startTime)
 	case "/silence":
 		h.handleSilence(ctx, cmd, startTime)
+	case "/unsilence":
+		h.handleUnsilence(ctx, cmd, startTime)
+	case "/ack":
+		h.handleAck(ctx, cmd, startTime)
 	default:
 		h.logger.Warn("unhandled slash command", "command", cmd.Command)
 		h.sendDelayedResponse(cmd.ResponseURL, dto.NewEphemeralResponse("Unknown command"))
@@ -314,6 +353,79 @@ func (h *SlackCommandsHandler) handleSilence(ctx context.Context, cmd *dto.Slack
 		"sla_met", elapsed < 2*time.Second)
 }
 
+// handleUnsilence handles /unsilence command.
+// Usage: /unsilence <id>
+// Unlike /silence delete, the silence is kept with its end set to now.
+func (h *SlackCommandsHandler) handleUnsilence(ctx context.Context, cmd *dto.SlackCommandDTO, startTime time.Time) {
+	req := cmd.ParseUnsilenceRequest()
+	if req.SilenceID == "" {
+		h.sendDelayedResponse(cmd.ResponseURL, dto.NewEphemeralResponse("Usage: /unsilence <id>"))
+		return
+	}
+
+	result, err := h.manageSilence.Execute(ctx, req)
+	if err != nil {
+		h.logger.Error("failed to expire silence",
+			"error", err.Error(),
+			"user_id", cmd.UserID,
+			"silence_id", req.SilenceID)
+
+		h.sendDelayedResponse(cmd.ResponseURL,
+			dto.NewEphemeralResponse(fmt.Sprintf("Failed to expire silence: %v", err)))
+		return
+	}
+
+	blocks := h.formatter.FormatSilenceResult(result)
+	h.sendDelayedResponse(cmd.ResponseURL, dto.NewEphemeralWithBlocks(result.Message, blocks))
+
+	elapsed := time.Since(startTime)
+	h.logger.Info("slash command processed",
+		"command", cmd.Command,
+		"user_id", cmd.UserID,
+		"silence_id", req.SilenceID,
+		"response_time_ms", elapsed.Milliseconds(),
+		"sla_met", elapsed < 2*time.Second)
+}
+
+// handleAck handles /ack command.
+// Usage: /ack key=value [key=value ...]
+// Acknowledges every unacknowledged firing alert carrying all the labels.
+func (h *SlackCommandsHandler) handleAck(ctx context.Context, cmd *dto.SlackCommandDTO, startTime time.Time) {
+	if h.ackBySelector == nil {
+		h.sendDelayedResponse(cmd.ResponseURL, dto.NewEphemeralResponse("Acknowledging by selector is not enabled"))
+		return
+	}
+
+	selector, err := cmd.ParseLabelSelector()
+	if err != nil {
+		h.sendDelayedResponse(cmd.ResponseURL,
+			dto.NewEphemeralResponse(fmt.Sprintf("Invalid selector: %v. Usage: /ack key=value [key=value ...]", err)))
+		return
+	}
+
+	result, err := h.ackBySelector.Execute(ctx, cmd.UserID, cmd.UserName, selector)
+	if err != nil {
+		h.logger.Error("failed to acknowledge alerts by selector",
+			"error", err.Error(),
+			"user_id", cmd.UserID,
+			"selector", selector)
+
+		h.sendDelayedResponse(cmd.ResponseURL,
+			dto.NewEphemeralResponse("Failed to acknowledge alerts. Please try again later."))
+		return
+	}
+
+	h.sendDelayedResponse(cmd.ResponseURL, dto.NewEphemeralResponse(result.Message))
+
+	elapsed := time.Since(startTime)
+	h.logger.Info("slash command processed",
+		"command", cmd.Command,
+		"user_id", cmd.UserID,
+		"selector", selector,
+		"response_time_ms", elapsed.Milliseconds(),
+		"sla_met", elapsed < 2*time.Second)
+}
+
 // sendDelayedResponse sends a delayed response to Slack via response_url.
 func (h *SlackCommandsHandler) sendDelayedResponse(responseURL string, response *dto.SlackResponseDTO) {
 	if responseURL == "" {
@@ -321,27 +433,35 @@ func (h *SlackCommandsHandler) sendDelayedResponse(responseURL string, response
 		return
 	}
 
-	// Marshal response to JSON
+	if err := postToResponseURL(context.Background(), responseURL, response); err != nil {
+		h.logger.Error("failed to send delayed response", "error", err.Error())
+		return
+	}
+
+	h.logger.Debug("delayed response sent successfully")
+}
+
+// postToResponseURL posts a message to a Slack response_url.
+func postToResponseURL(ctx context.Context, responseURL string, response *dto.SlackResponseDTO) error {
 	jsonData, err := json.Marshal(response)
 	if err != nil {
-		h.logger.Error("failed to marshal delayed response", "error", err.Error())
-		return
+		return fmt.Errorf("marshaling response: %w", err)
 	}
 
-	// POST to response_url
-	resp, err := http.Post(responseURL, "application/json", bytes.NewBuffer(jsonData))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, responseURL, bytes.NewReader(jsonData))
 	if err != nil {
-		h.logger.Error("failed to send delayed response", "error", err.Error())
-		return
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		h.logger.Error("delayed response failed",
-			"status_code", resp.StatusCode,
-			"status", resp.Status)
-		return
+		return fmt.Errorf("unexpected status: %s", resp.Status)
 	}
-
-	h.logger.Debug("delayed response sent successfully")
+	return nil
 }
@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"context"
+
 	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/slack"
 	slackSDK "github.com/slack-go/slack"
 	"github.com/slack-go/slack/slackevents"
@@ -10,13 +12,16 @@ import (
 // SocketModeHandler wraps the Socket Mode client and routes events to use cases.
 type SocketModeHandler struct {
 	client *slack.SocketModeClient
+	events *SlackEventsHandler
 	logger slack.Logger
 }
 
 // NewSocketModeHandler creates a new Socket Mode handler.
-func NewSocketModeHandler(client *slack.SocketModeClient, logger slack.Logger) *SocketModeHandler {
+// Events API payloads are delegated to the given events handler (may be nil).
+func NewSocketModeHandler(client *slack.SocketModeClient, events *SlackEventsHandler, logger slack.Logger) *SocketModeHandler {
 	return &SocketModeHandler{
 		client: client,
+		events: events,
 		logger: logger,
 	}
 }
@@ -39,6 +44,10 @@ func (h *SocketModeHandler) HandleEvent(evt *socketmode.Event) error {
 	switch eventsAPI.InnerEvent.Type {
 	case "app_mention":
 		h.logger.Info("App mention event received")
+	case string(slackevents.ReactionAdded):
+		if h.events != nil {
+			h.events.HandleInnerEvent(context.Background(), eventsAPI.InnerEvent)
+		}
 	default:
 		h.logger.Debug("Unhandled inner event type", "type", eventsAPI.InnerEvent.Type)
 	}
@@ -0,0 +1,292 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/ack"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
+	slackUseCase "github.com/qj0r9j0vc2/alert-bridge/internal/usecase/slack"
+)
+
+// blockingSlack holds every Slack API call until release is closed.
+type blockingSlack struct {
+	recordingSlack
+	release chan struct{}
+}
+
+func (s *blockingSlack) GetUserEmail(ctx context.Context, userID string) (string, error) {
+	<-s.release
+	return "jane@example.com", nil
+}
+
+func blockActionRequest(t *testing.T, actionID, responseURL string) *http.Request {
+	t.Helper()
+	payload, err := json.Marshal(map[string]any{
+		"type":         "block_actions",
+		"user":         map[string]string{"id": "U1", "name": "jane"},
+		"channel":      map[string]string{"id": "C1"},
+		"message":      map[string]string{"ts": "1700000000.000100"},
+		"response_url": responseURL,
+		"actions":      []map[string]string{{"action_id": actionID, "block_id": "actions"}},
+	})
+	if err != nil {
+		t.Fatalf("failed to encode payload: %v", err)
+	}
+	form := url.Values{"payload": {string(payload)}}
+	req := httptest.NewRequest(http.MethodPost, "/webhook/slack/interactions", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return req
+}
+
+func newInteractionHandler(alertRepo *memory.AlertRepository, slackClient slackUseCase.SlackClient) *SlackInteractionHandler {
+	syncAck := ack.NewSyncAckUseCase(alertRepo, memory.NewAckEventRepository(), memory.NewTransactionManager(), nil, nopLogger{}, nil)
+	assign := alert.NewAssignUseCase(alertRepo, nopLogger{})
+	assign.SetSlackUpdater(slackClient)
+	uc := slackUseCase.NewHandleInteractionUseCase(alertRepo, memory.NewSilenceRepository(), syncAck, assign, slackClient, nopLogger{})
+	return NewSlackInteractionHandler(uc, nopLogger{})
+}
+
+func TestSlackInteractionHandler_RespondsBeforeSlowAck(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	a := entity.NewAlert("fp-1", "DiskFull", "db-1", "", "", entity.SeverityCritical)
+	if err := alertRepo.Save(ctx, a); err != nil {
+		t.Fatalf("failed to save alert: %v", err)
+	}
+
+	slackClient := &blockingSlack{release: make(chan struct{})}
+	h := newInteractionHandler(alertRepo, slackClient)
+
+	w := httptest.NewRecorder()
+	start := time.Now()
+	h.ServeHTTP(w, blockActionRequest(t, "ack_"+a.ID, ""))
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("expected an immediate response, took %v", elapsed)
+	}
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", w.Code)
+	}
+	if stored, _ := alertRepo.FindByID(ctx, a.ID); stored.IsAcked() {
+		t.Fatal("expected the ack to still be running")
+	}
+
+	// The ack finishes in the background once Slack answers
+	close(slackClient.release)
+	closeCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := h.Close(closeCtx); err != nil {
+		t.Fatalf("failed to drain interactions: %v", err)
+	}
+
+	stored, _ := alertRepo.FindByID(ctx, a.ID)
+	if !stored.IsAcked() || stored.AckedBy != "jane@example.com" {
+		t.Errorf("expected the alert acked by jane@example.com, got state %s by %q", stored.State, stored.AckedBy)
+	}
+	if len(slackClient.updated) != 1 {
+		t.Errorf("expected the Slack message to be updated once, got %v", slackClient.updated)
+	}
+}
+
+// directorySlack resolves the emails of the users it knows.
+type directorySlack struct {
+	recordingSlack
+	emails map[string]string
+}
+
+func (s *directorySlack) GetUserEmail(ctx context.Context, userID string) (string, error) {
+	if email, ok := s.emails[userID]; ok {
+		return email, nil
+	}
+	return "", errors.New("users_not_found")
+}
+
+func TestHandleInteraction_Assign(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	a := entity.NewAlert("fp-1", "DiskFull", "db-1", "", "", entity.SeverityCritical)
+	a.SetExternalReference("slack", "C1:1700000000.000100,C2:1700000000.000200")
+	if err := alertRepo.Save(ctx, a); err != nil {
+		t.Fatalf("failed to save alert: %v", err)
+	}
+
+	slackClient := &directorySlack{emails: map[string]string{"U1": "lead@example.com", "U2": "jane@example.com"}}
+	h := newInteractionHandler(alertRepo, slackClient)
+	assign := func(value string) *dto.SlackInteractionOutput {
+		t.Helper()
+		output, err := h.handleInteraction.Execute(ctx, dto.SlackInteractionInput{
+			ActionID:  "assign_" + a.ID,
+			Value:     value,
+			UserID:    "U1",
+			UserName:  "lead",
+			ChannelID: "C2",
+			MessageTS: "1700000000.000200",
+		})
+		if err != nil {
+			t.Fatalf("assign failed: %v", err)
+		}
+		return output
+	}
+
+	// Every copy of the message shows the assignee, who is mentioned
+	assign("U2")
+	stored, _ := alertRepo.FindByID(ctx, a.ID)
+	if stored.AssignedTo != "jane@example.com" || stored.Assignments[0].By != "lead@example.com" {
+		t.Errorf("expected the alert assigned to jane@example.com by lead@example.com, got %+v", stored.Assignments)
+	}
+	if len(slackClient.updated) != 1 || slackClient.updated[0] != "C1:1700000000.000100,C2:1700000000.000200" {
+		t.Errorf("expected both Slack messages to be updated, got %v", slackClient.updated)
+	}
+	if len(slackClient.replies) != 1 || !strings.Contains(slackClient.replies[0], "<@U2>") {
+		t.Errorf("expected the assignee to be mentioned, got %v", slackClient.replies)
+	}
+
+	if output := assign("U2"); !strings.Contains(output.Message, "already assigned") || len(slackClient.updated) != 1 {
+		t.Errorf("expected nothing to change, got %q and %d updates", output.Message, len(slackClient.updated))
+	}
+
+	// A user whose email cannot be looked up is assigned by user ID
+	assign("U3")
+	if stored, _ := alertRepo.FindByID(ctx, a.ID); stored.AssignedTo != "U3" {
+		t.Errorf("expected the alert assigned to U3, got %q", stored.AssignedTo)
+	}
+}
+
+func TestSlackInteractionHandler_RepliesOnFailure(t *testing.T) {
+	replies := make(chan string, 1)
+	responseServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		replies <- string(body)
+	}))
+	defer responseServer.Close()
+
+	slackClient := &blockingSlack{release: make(chan struct{})}
+	close(slackClient.release)
+	h := newInteractionHandler(memory.NewAlertRepository(), slackClient)
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, blockActionRequest(t, "ack_missing", responseServer.URL))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", w.Code)
+	}
+
+	select {
+	case reply := <-replies:
+		if !strings.Contains(reply, `"response_type":"ephemeral"`) || !strings.Contains(reply, "could not be completed") {
+			t.Errorf("unexpected error reply: %s", reply)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected an error reply on the response URL")
+	}
+}
+
+// homePublisher records the Home tabs published per user.
+type homePublisher struct {
+	mu    sync.Mutex
+	views map[string][]int // userID -> alert count of each publish
+}
+
+func (p *homePublisher) PublishHomeView(ctx context.Context, userID string, alerts []*entity.Alert) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.views[userID] = append(p.views[userID], len(alerts))
+	return nil
+}
+
+func TestSlackEventsHandler_AppHomeOpened(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	a := entity.NewAlert("fp-1", "DiskFull", "db-1", "", "", entity.SeverityCritical)
+	if err := alertRepo.Save(ctx, a); err != nil {
+		t.Fatalf("failed to save alert: %v", err)
+	}
+
+	publisher := &homePublisher{views: make(map[string][]int)}
+	appHome := slackUseCase.NewAppHomeUseCase(alertRepo, publisher, nopLogger{})
+	h := NewSlackEventsHandler(nil, nopLogger{})
+	h.SetAppHome(appHome)
+
+	body := `{"type":"event_callback","event":{"type":"app_home_opened","user":"U1","channel":"D1","tab":"home"}}`
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/webhook/slack/events", strings.NewReader(body)))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	if got := publisher.views["U1"]; len(got) != 1 || got[0] != 1 {
+		t.Fatalf("expected one home view listing 1 alert, got %v", got)
+	}
+
+	// The messages tab is left alone
+	body = `{"type":"event_callback","event":{"type":"app_home_opened","user":"U2","channel":"D2","tab":"messages"}}`
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/webhook/slack/events", strings.NewReader(body)))
+	if got := publisher.views["U2"]; len(got) != 0 {
+		t.Errorf("expected no home view for the messages tab, got %v", got)
+	}
+
+	// Refreshes republish only once alerts changed
+	if _, err := appHome.Refresh(ctx); err != nil {
+		t.Fatalf("refresh failed: %v", err)
+	}
+	if n, err := appHome.Refresh(ctx); err != nil || n != 0 {
+		t.Fatalf("expected unchanged alerts not to be republished, got %d, %v", n, err)
+	}
+	a.Resolve("alertmanager", time.Now())
+	if err := alertRepo.Update(ctx, a); err != nil {
+		t.Fatalf("failed to update alert: %v", err)
+	}
+	if n, err := appHome.Refresh(ctx); err != nil || n != 1 {
+		t.Fatalf("expected the home view to be republished, got %d, %v", n, err)
+	}
+	if got := publisher.views["U1"]; got[len(got)-1] != 0 {
+		t.Errorf("expected the republished view to list no alerts, got %v", got)
+	}
+}
+
+// staticTenants maps users to the tenants whose channels they are in.
+type staticTenants map[string][]string
+
+func (s staticTenants) TenantsOfUser(ctx context.Context, userID string) ([]string, error) {
+	return s[userID], nil
+}
+
+func TestSlackEventsHandler_AppHomeScopedToTenants(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	for _, tenantID := range []string{"", "payments", "search"} {
+		a := entity.NewAlert("fp-"+tenantID, "DiskFull", "db-1", "", "", entity.SeverityCritical)
+		a.TenantID = tenantID
+		if err := alertRepo.Save(ctx, a); err != nil {
+			t.Fatalf("failed to save alert: %v", err)
+		}
+	}
+
+	publisher := &homePublisher{views: make(map[string][]int)}
+	appHome := slackUseCase.NewAppHomeUseCase(alertRepo, publisher, nopLogger{})
+	appHome.SetViewerTenants(staticTenants{"U1": {"payments"}, "U2": {"payments", "search"}})
+	h := NewSlackEventsHandler(nil, nopLogger{})
+	h.SetAppHome(appHome)
+
+	for _, userID := range []string{"U1", "U2", "U3"} {
+		body := `{"type":"event_callback","event":{"type":"app_home_opened","user":"` + userID + `","channel":"D1","tab":"home"}}`
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/webhook/slack/events", strings.NewReader(body)))
+	}
+
+	// Users in no tenant channel see the default tenant's alerts
+	for userID, want := range map[string]int{"U1": 1, "U2": 2, "U3": 1} {
+		if got := publisher.views[userID]; len(got) != 1 || got[0] != want {
+			t.Errorf("expected %s's home view to list %d alerts, got %v", userID, want, got)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
+)
+
+// StatsHandler serves aggregate alert and silence counts for dashboards.
+type StatsHandler struct {
+	getStats *alert.GetStatsUseCase
+	logger   alert.Logger
+}
+
+// NewStatsHandler creates a new stats handler.
+func NewStatsHandler(getStats *alert.GetStatsUseCase, logger alert.Logger) *StatsHandler {
+	return &StatsHandler{
+		getStats: getStats,
+		logger:   logger,
+	}
+}
+
+// ServeHTTP handles GET /api/v1/stats.
+func (h *StatsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	output, err := h.getStats.Execute(r.Context())
+	if err != nil {
+		requestLogger(r.Context(), h.logger).Error("failed to compute alert stats", "error", err)
+		http.Error(w, "stats unavailable", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
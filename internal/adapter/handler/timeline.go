@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
+)
+
+// TimelineHandler serves the chronological history of an alert.
+type TimelineHandler struct {
+	timeline *alert.TimelineUseCase
+	logger   alert.Logger
+}
+
+// NewTimelineHandler creates a new timeline handler.
+func NewTimelineHandler(timeline *alert.TimelineUseCase, logger alert.Logger) *TimelineHandler {
+	return &TimelineHandler{
+		timeline: timeline,
+		logger:   logger,
+	}
+}
+
+// ServeHTTP handles GET /api/v1/alerts/{id}/timeline.
+func (h *TimelineHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	alertID := r.PathValue("id")
+	events, err := h.timeline.Execute(r.Context(), alertID)
+	if errors.Is(err, repository.ErrNotFound) {
+		http.Error(w, "alert not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		requestLogger(r.Context(), h.logger).Error("failed to build alert timeline", "alertID", alertID, "error", err)
+		http.Error(w, "timeline unavailable", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(events)
+}
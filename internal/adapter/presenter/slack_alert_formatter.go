@@ -343,6 +343,10 @@ func (f *SlackAlertFormatter) FormatSilenceResult(result *slackUseCase.SilenceRe
 		blocks = append(blocks, f.formatSilenceDetails(result.Deleted, "Deleted"))
 	}
 
+	if result.Expired != nil {
+		blocks = append(blocks, f.formatSilenceDetails(result.Expired, "Expired"))
+	}
+
 	if len(result.Silences) > 0 {
 		for i, silence := range result.Silences {
 			if i >= 10 {
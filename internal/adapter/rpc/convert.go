@@ -0,0 +1,84 @@
+package rpc
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/qj0r9j0vc2/alert-bridge/internal/adapter/rpc/alertbridgev1"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+)
+
+func alertToProto(alert *entity.Alert) *pb.Alert {
+	return &pb.Alert{
+		Id:          alert.ID,
+		Fingerprint: alert.Fingerprint,
+		Name:        alert.Name,
+		Instance:    alert.Instance,
+		Target:      alert.Target,
+		Summary:     alert.Summary,
+		Description: alert.Description,
+		Severity:    string(alert.Severity),
+		State:       string(alert.State),
+		Labels:      alert.Labels,
+		Annotations: alert.Annotations,
+		TenantId:    alert.TenantID,
+		FiredAt:     timestamp(alert.FiredAt),
+		AckedAt:     optionalTimestamp(alert.AckedAt),
+		AckedBy:     alert.AckedBy,
+		ResolvedAt:  optionalTimestamp(alert.ResolvedAt),
+		CreatedAt:   timestamp(alert.CreatedAt),
+		UpdatedAt:   timestamp(alert.UpdatedAt),
+	}
+}
+
+func ackEventToProto(event *entity.AckEvent) *pb.AckEvent {
+	message := &pb.AckEvent{
+		Id:        event.ID,
+		AlertId:   event.AlertID,
+		Source:    string(event.Source),
+		UserId:    event.UserID,
+		UserEmail: event.UserEmail,
+		UserName:  event.UserName,
+		Note:      event.Note,
+		CreatedAt: timestamp(event.CreatedAt),
+	}
+	if event.Duration != nil {
+		message.Duration = durationpb.New(*event.Duration)
+	}
+	return message
+}
+
+func silenceToProto(silence *entity.SilenceMark) *pb.Silence {
+	return &pb.Silence{
+		Id:             silence.ID,
+		AlertId:        silence.AlertID,
+		Instance:       silence.Instance,
+		Fingerprint:    silence.Fingerprint,
+		Labels:         silence.Labels,
+		StartAt:        timestamp(silence.StartAt),
+		EndAt:          timestamp(silence.EndAt),
+		CreatedBy:      silence.CreatedBy,
+		CreatedByEmail: silence.CreatedByEmail,
+		Reason:         silence.Reason,
+		Source:         string(silence.Source),
+		TenantId:       silence.TenantID,
+		CreatedAt:      timestamp(silence.CreatedAt),
+	}
+}
+
+// timestamp leaves zero times unset rather than sending 0001-01-01.
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamp(*t)
+}
@@ -0,0 +1,83 @@
+package rpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/handler/middleware"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+)
+
+// maxTenantIDLength matches the width of the tenant_id columns.
+const maxTenantIDLength = 255
+
+// publicServices are reachable without a token so probes and tooling such
+// as grpcurl work: the standard health service and server reflection.
+var publicServices = []string{
+	"/grpc.health.v1.Health/",
+	"/grpc.reflection.v1.ServerReflection/",
+	"/grpc.reflection.v1alpha.ServerReflection/",
+}
+
+// UnaryAuth rejects calls whose "authorization" metadata is not
+// "Bearer <token>", like middleware.AdminAuth does for HTTP.
+func UnaryAuth(token string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := authorize(ctx, info.FullMethod, token); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuth is UnaryAuth for streaming calls.
+func StreamAuth(token string) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authorize(stream.Context(), info.FullMethod, token); err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
+
+func authorize(ctx context.Context, method, token string) error {
+	for _, prefix := range publicServices {
+		if strings.HasPrefix(method, prefix) {
+			return nil
+		}
+	}
+
+	var provided string
+	var ok bool
+	if values := metadata.ValueFromIncomingContext(ctx, "authorization"); len(values) > 0 {
+		provided, ok = strings.CutPrefix(values[0], "Bearer ")
+	}
+	if token == "" || !ok || subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+		return status.Error(codes.Unauthenticated, "invalid or missing bearer token")
+	}
+	return nil
+}
+
+// UnaryTenantScope scopes the call to the tenant named by the x-tenant-id
+// metadata, the gRPC counterpart of middleware.TenantScope. Calls without
+// it see every tenant.
+func UnaryTenantScope(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	values := metadata.ValueFromIncomingContext(ctx, strings.ToLower(middleware.TenantHeader))
+	if len(values) == 0 {
+		return handler(ctx, req)
+	}
+	tenantID := strings.TrimSpace(values[0])
+	if tenantID == "" {
+		return handler(ctx, req)
+	}
+	if len(tenantID) > maxTenantIDLength {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID too long")
+	}
+	return handler(repository.NewContextWithTenant(ctx, tenantID), req)
+}
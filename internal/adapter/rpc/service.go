@@ -0,0 +1,174 @@
+// Package rpc implements the gRPC API defined in
+// api/proto/alertbridge/v1/alertbridge.proto on top of the same use cases
+// and repositories as the HTTP handlers.
+package rpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	pb "github.com/qj0r9j0vc2/alert-bridge/internal/adapter/rpc/alertbridgev1"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
+)
+
+// Service implements the AlertBridge gRPC service.
+type Service struct {
+	pb.UnimplementedAlertBridgeServer
+
+	processAlert    *alert.ProcessAlertUseCase
+	listAlerts      *alert.ListAlertsUseCase
+	alertRepo       repository.AlertRepository
+	ackEventRepo    repository.AckEventRepository
+	silenceRepo     repository.SilenceRepository
+	defaultSeverity string
+	logger          alert.Logger
+}
+
+// NewService creates the gRPC service. Alerts ingested without a severity
+// get "warning" unless SetDefaultSeverity says otherwise.
+func NewService(
+	processAlert *alert.ProcessAlertUseCase,
+	listAlerts *alert.ListAlertsUseCase,
+	alertRepo repository.AlertRepository,
+	ackEventRepo repository.AckEventRepository,
+	silenceRepo repository.SilenceRepository,
+	logger alert.Logger,
+) *Service {
+	return &Service{
+		processAlert:    processAlert,
+		listAlerts:      listAlerts,
+		alertRepo:       alertRepo,
+		ackEventRepo:    ackEventRepo,
+		silenceRepo:     silenceRepo,
+		defaultSeverity: string(entity.SeverityWarning),
+		logger:          logger,
+	}
+}
+
+// SetDefaultSeverity sets the severity of ingested alerts that do not specify one.
+func (s *Service) SetDefaultSeverity(severity string) {
+	if severity != "" {
+		s.defaultSeverity = severity
+	}
+}
+
+// IngestAlert converts the request like POST /api/v1/alerts does and
+// processes it as an Alertmanager alert.
+func (s *Service) IngestAlert(ctx context.Context, req *pb.IngestAlertRequest) (*pb.IngestAlertResponse, error) {
+	request := dto.IngestAlertRequest{
+		Name:        req.GetName(),
+		Severity:    req.GetSeverity(),
+		Instance:    req.GetInstance(),
+		Summary:     req.GetSummary(),
+		Labels:      req.GetLabels(),
+		Fingerprint: req.GetFingerprint(),
+		Status:      req.GetStatus(),
+	}
+	if err := request.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	input := request.ToProcessAlertInput(s.defaultSeverity, time.Now().UTC())
+	output, err := s.processAlert.Execute(ctx, input)
+	if errors.Is(err, entity.ErrTooManyLabels) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		s.logger.Error("failed to process alert",
+			"fingerprint", input.Fingerprint,
+			"status", input.Status,
+			"error", err,
+		)
+		return nil, status.Error(codes.Internal, "processing alert failed")
+	}
+	// The alert is stored and the other notifiers were still called
+	if err := output.NotificationErr(); err != nil {
+		s.logger.Warn("alert processed with failed notifications",
+			"alertID", output.AlertID,
+			"error", err,
+		)
+	}
+
+	return &pb.IngestAlertResponse{
+		AlertId:  output.AlertID,
+		IsNew:    output.IsNew,
+		Silenced: output.IsSilenced,
+	}, nil
+}
+
+// GetAlert returns an alert by ID.
+func (s *Service) GetAlert(ctx context.Context, req *pb.GetAlertRequest) (*pb.Alert, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	found, err := s.alertRepo.FindByID(ctx, req.GetId())
+	if err != nil {
+		s.logger.Error("failed to get alert", "alertID", req.GetId(), "error", err)
+		return nil, status.Error(codes.Internal, "getting alert failed")
+	}
+	if found == nil {
+		return nil, status.Errorf(codes.NotFound, "alert %q not found", req.GetId())
+	}
+	return alertToProto(found), nil
+}
+
+// ListAlerts returns the firing alerts, filtered and sorted like
+// GET /api/v1/alerts.
+func (s *Service) ListAlerts(ctx context.Context, req *pb.ListAlertsRequest) (*pb.ListAlertsResponse, error) {
+	sortBy := req.GetSort()
+	if sortBy != "" && sortBy != alert.SortByFiredAt && sortBy != alert.SortByPriority {
+		return nil, status.Error(codes.InvalidArgument, `sort must be "fired_at" or "priority"`)
+	}
+
+	alerts, err := s.listAlerts.Find(ctx, req.GetSeverity(), sortBy, req.GetLabels())
+	if err != nil {
+		s.logger.Error("failed to list alerts", "error", err)
+		return nil, status.Error(codes.Internal, "listing alerts failed")
+	}
+
+	response := &pb.ListAlertsResponse{Alerts: make([]*pb.Alert, 0, len(alerts))}
+	for _, a := range alerts {
+		response.Alerts = append(response.Alerts, alertToProto(a))
+	}
+	return response, nil
+}
+
+// ListAckEvents returns the acknowledgments of an alert.
+func (s *Service) ListAckEvents(ctx context.Context, req *pb.ListAckEventsRequest) (*pb.ListAckEventsResponse, error) {
+	if req.GetAlertId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "alert_id is required")
+	}
+	events, err := s.ackEventRepo.FindByAlertID(ctx, req.GetAlertId())
+	if err != nil {
+		s.logger.Error("failed to list ack events", "alertID", req.GetAlertId(), "error", err)
+		return nil, status.Error(codes.Internal, "listing acknowledgments failed")
+	}
+
+	response := &pb.ListAckEventsResponse{AckEvents: make([]*pb.AckEvent, 0, len(events))}
+	for _, event := range events {
+		response.AckEvents = append(response.AckEvents, ackEventToProto(event))
+	}
+	return response, nil
+}
+
+// ListSilences returns the silences in effect.
+func (s *Service) ListSilences(ctx context.Context, _ *pb.ListSilencesRequest) (*pb.ListSilencesResponse, error) {
+	silences, err := s.silenceRepo.FindActive(ctx)
+	if err != nil {
+		s.logger.Error("failed to list silences", "error", err)
+		return nil, status.Error(codes.Internal, "listing silences failed")
+	}
+
+	response := &pb.ListSilencesResponse{Silences: make([]*pb.Silence, 0, len(silences))}
+	for _, silence := range silences {
+		response.Silences = append(response.Silences, silenceToProto(silence))
+	}
+	return response, nil
+}
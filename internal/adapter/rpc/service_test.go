@@ -0,0 +1,128 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	pb "github.com/qj0r9j0vc2/alert-bridge/internal/adapter/rpc/alertbridgev1"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
+)
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...any) {}
+func (nopLogger) Info(string, ...any)  {}
+func (nopLogger) Warn(string, ...any)  {}
+func (nopLogger) Error(string, ...any) {}
+
+const testToken = "test-token"
+
+// newTestClient serves a Service over an in-memory connection with the
+// interceptors the server installs.
+func newTestClient(t *testing.T) pb.AlertBridgeClient {
+	t.Helper()
+	alertRepo := memory.NewAlertRepository()
+	silenceRepo := memory.NewSilenceRepository()
+	service := NewService(
+		alert.NewProcessAlertUseCase(alertRepo, silenceRepo, nil, nopLogger{}, nil),
+		alert.NewListAlertsUseCase(alertRepo),
+		alertRepo,
+		memory.NewAckEventRepository(),
+		silenceRepo,
+		nopLogger{},
+	)
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(UnaryAuth(testToken), UnaryTenantScope))
+	pb.RegisterAlertBridgeServer(server, service)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewAlertBridgeClient(conn)
+}
+
+func authorized(tenant string) context.Context {
+	md := metadata.Pairs("authorization", "Bearer "+testToken)
+	if tenant != "" {
+		md.Append("x-tenant-id", tenant)
+	}
+	return metadata.NewOutgoingContext(context.Background(), md)
+}
+
+func TestService(t *testing.T) {
+	client := newTestClient(t)
+
+	_, err := client.ListAlerts(context.Background(), &pb.ListAlertsRequest{})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated without a token, got %v", err)
+	}
+
+	_, err = client.IngestAlert(authorized(""), &pb.IngestAlertRequest{Instance: "db-1"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument without a name, got %v", err)
+	}
+
+	ingested, err := client.IngestAlert(authorized("team-a"), &pb.IngestAlertRequest{
+		Name:     "DiskFull",
+		Severity: "critical",
+		Instance: "db-1",
+		Labels:   map[string]string{"team": "storage"},
+	})
+	if err != nil {
+		t.Fatalf("IngestAlert failed: %v", err)
+	}
+	if ingested.GetAlertId() == "" || !ingested.GetIsNew() {
+		t.Fatalf("expected a new alert, got %v", ingested)
+	}
+
+	got, err := client.GetAlert(authorized(""), &pb.GetAlertRequest{Id: ingested.GetAlertId()})
+	if err != nil {
+		t.Fatalf("GetAlert failed: %v", err)
+	}
+	if got.GetName() != "DiskFull" || got.GetState() != "active" || got.GetTenantId() != "team-a" {
+		t.Errorf("unexpected alert: %v", got)
+	}
+	if got.GetFiredAt() == nil || got.GetAckedAt() != nil {
+		t.Errorf("expected fired_at set and acked_at unset, got %v", got)
+	}
+
+	_, err = client.GetAlert(authorized(""), &pb.GetAlertRequest{Id: "missing"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound, got %v", err)
+	}
+
+	// The tenant metadata scopes reads like the X-Tenant-ID header
+	for tenant, want := range map[string]int{"": 1, "team-a": 1, "team-b": 0} {
+		list, err := client.ListAlerts(authorized(tenant), &pb.ListAlertsRequest{Labels: map[string]string{"team": "storage"}})
+		if err != nil {
+			t.Fatalf("ListAlerts failed: %v", err)
+		}
+		if len(list.GetAlerts()) != want {
+			t.Errorf("tenant %q: expected %d alerts, got %d", tenant, want, len(list.GetAlerts()))
+		}
+	}
+
+	_, err = client.ListAlerts(authorized(""), &pb.ListAlertsRequest{Sort: "name"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument for an unknown sort, got %v", err)
+	}
+}
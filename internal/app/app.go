@@ -2,13 +2,16 @@ package app
 
 import (
 	"context"
+	"errors"
 	"io"
+	"sync"
 	"time"
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/config"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/observability"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/server"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
 )
 
 // dbPinger provides database connectivity check for readiness probes.
@@ -27,9 +30,16 @@ type Application struct {
 	alertRepo    repository.AlertRepository
 	ackEventRepo repository.AckEventRepository
 	silenceRepo  repository.SilenceRepository
+	idempotency  repository.IdempotencyStore
+	outboxRepo   repository.OutboxRepository
+	deliveryRepo repository.DeliveryRepository
 	txManager    repository.TransactionManager
-	dbCloser     io.Closer           // For cleanup
-	dbPinger     dbPinger            // For readiness checks
+	dbCloser     io.Closer // For cleanup
+	dbPinger     dbPinger  // For readiness checks
+
+	// startEviction sweeps expired alerts from in-memory storage until ctx
+	// is done; nil for persistent backends
+	startEviction func(ctx context.Context)
 
 	// Infrastructure clients
 	clients *Clients
@@ -37,14 +47,33 @@ type Application struct {
 	// Use cases
 	useCases *UseCases
 
+	// dryRun is set by the --dry-run flag and overrides alerting.dry_run
+	dryRun bool
+
 	// HTTP layer
 	handlers *server.Handlers
 	server   *server.Server
+
+	// grpcServer serves the gRPC API; nil unless server.grpc_port is set
+	grpcServer *server.GRPCServer
+}
+
+// Option customizes an Application before bootstrap.
+type Option func(*Application)
+
+// WithDryRun forces dry-run mode regardless of configuration.
+func WithDryRun(enabled bool) Option {
+	return func(app *Application) {
+		app.dryRun = enabled
+	}
 }
 
 // New creates a new Application instance
-func New(configPath string) (*Application, error) {
+func New(configPath string, opts ...Option) (*Application, error) {
 	app := &Application{}
+	for _, opt := range opts {
+		opt(app)
+	}
 
 	if err := app.bootstrap(configPath); err != nil {
 		return nil, err
@@ -57,9 +86,92 @@ func New(configPath string) (*Application, error) {
 func (app *Application) Start(ctx context.Context) error {
 	app.logger.Get().Info("starting alert-bridge",
 		"port", app.config.Server.Port,
+		"grpc_port", app.config.Server.GRPCPort,
 	)
 
-	return app.server.Run(ctx)
+	// Stop background workers with the server, before notifiers are closed
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if app.startEviction != nil {
+		app.startEviction(ctx)
+	}
+
+	var background sync.WaitGroup
+	if app.useCases.OutboxDispatcher != nil {
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			app.useCases.OutboxDispatcher.Run(ctx)
+		}()
+	}
+	if app.useCases.SilenceSync != nil {
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			app.useCases.SilenceSync.Run(ctx)
+		}()
+	}
+
+	if app.useCases.Digest != nil {
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			app.useCases.Digest.Run(ctx)
+		}()
+	}
+	if app.useCases.AppHome != nil {
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			app.useCases.AppHome.Run(ctx)
+		}()
+	}
+	if app.config.Alerting.QuietHours.Enabled() {
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			app.useCases.ProcessAlert.RunQuietHoursRelease(ctx, alert.DefaultQuietHoursReleaseInterval)
+		}()
+	}
+	if app.config.Alerting.AutoResolveAfter > 0 {
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			app.useCases.ProcessAlert.RunAutoResolve(ctx, alert.DefaultAutoResolveInterval)
+		}()
+	}
+	if app.config.Alerting.MaxMessagesPerWindow > 0 {
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			app.useCases.ProcessAlert.RunBurstGuard(ctx, alert.DefaultBurstFlushInterval)
+		}()
+	}
+	if app.config.Alerting.AckExpiry.Enabled {
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			app.useCases.ProcessAlert.RunAckExpiry(ctx, alert.DefaultAckExpiryInterval)
+		}()
+	}
+
+	// A gRPC server that fails to start takes the HTTP server down with it
+	var grpcErr error
+	if app.grpcServer != nil {
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			if grpcErr = app.grpcServer.Run(ctx); grpcErr != nil {
+				cancel()
+			}
+		}()
+	}
+
+	err := app.server.Run(ctx)
+	cancel()
+	background.Wait()
+	return errors.Join(err, grpcErr)
 }
 
 // Shutdown gracefully stops the application
@@ -69,6 +181,31 @@ func (app *Application) Shutdown() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
+	// Finish Slack button clicks still running in the background
+	if app.handlers != nil && app.handlers.SlackInteraction != nil {
+		if err := app.handlers.SlackInteraction.Close(ctx); err != nil {
+			app.logger.Get().Error("failed to drain Slack interactions", "error", err)
+		}
+	}
+
+	// Drain notifiers before telemetry and storage go away
+	if app.clients != nil {
+		for _, notifier := range app.clients.Notifiers {
+			if err := alert.Close(ctx, notifier); err != nil {
+				app.logger.Get().Error("failed to close notifier",
+					"notifier", notifier.Name(),
+					"error", err,
+				)
+			}
+		}
+	}
+
+	if app.clients != nil && app.clients.Audit != nil {
+		if err := app.clients.Audit.Close(); err != nil {
+			app.logger.Get().Error("failed to close audit log", "error", err)
+		}
+	}
+
 	// Shutdown telemetry
 	if app.telemetry != nil {
 		if err := app.telemetry.Shutdown(ctx); err != nil {
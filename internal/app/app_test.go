@@ -0,0 +1,73 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
+)
+
+// closeLog records the order in which shutdown closes things.
+type closeLog struct {
+	closed []string
+}
+
+// closingNotifier appends its name to the log when it is closed.
+type closingNotifier struct {
+	name string
+	log  *closeLog
+}
+
+func (n *closingNotifier) Notify(ctx context.Context, a *entity.Alert) (string, error) {
+	return "msg", nil
+}
+
+func (n *closingNotifier) UpdateMessage(ctx context.Context, messageID string, a *entity.Alert) error {
+	return nil
+}
+
+func (n *closingNotifier) Name() string { return n.name }
+
+func (n *closingNotifier) Close(ctx context.Context) error {
+	n.log.closed = append(n.log.closed, n.name)
+	return nil
+}
+
+// closingDB appends "database" to the log when it is closed.
+type closingDB struct {
+	log *closeLog
+}
+
+func (db *closingDB) Close() error {
+	db.log.closed = append(db.log.closed, "database")
+	return nil
+}
+
+func TestShutdown_ClosesNotifiersBeforeDatabase(t *testing.T) {
+	log := &closeLog{}
+	logger := slog.New(slog.DiscardHandler)
+
+	// Wrapped the way newClients wires the notifiers
+	var slack alert.Notifier = &closingNotifier{name: "slack", log: log}
+	slack = alert.NewConcurrencyLimitedNotifier(slack, 2)
+	slack = alert.NewRetryableNotifier(slack, alert.DefaultRetryPolicy(), logger, nil)
+	slack = alert.NewCircuitBreakingNotifier(slack, 3, time.Minute, logger, nil)
+	slack = alert.NewDryRunNotifier(slack, logger)
+	var pagerDuty alert.Notifier = &closingNotifier{name: "pagerduty", log: log}
+	pagerDuty = alert.NewRetryableNotifier(pagerDuty, alert.DefaultRetryPolicy(), logger, nil)
+
+	app := &Application{
+		logger:   NewAtomicLogger(logger, new(slog.LevelVar)),
+		clients:  &Clients{Notifiers: []alert.Notifier{slack, pagerDuty}},
+		dbCloser: &closingDB{log: log},
+	}
+	require.NoError(t, app.Shutdown())
+
+	assert.Equal(t, []string{"slack", "pagerduty", "database"}, log.closed)
+}
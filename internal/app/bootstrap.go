@@ -35,17 +35,22 @@ func (app *Application) bootstrap(configPath string) error {
 		return fmt.Errorf("initializing clients: %w", err)
 	}
 
-	// 7. Initialize use cases
+	// 7. Verify notifier credentials
+	if err := app.selfTestNotifiers(); err != nil {
+		return fmt.Errorf("notifier self-test: %w", err)
+	}
+
+	// 8. Initialize use cases
 	if err := app.initializeUseCases(); err != nil {
 		return fmt.Errorf("initializing use cases: %w", err)
 	}
 
-	// 8. Initialize HTTP handlers
+	// 9. Initialize HTTP handlers
 	if err := app.initializeHandlers(); err != nil {
 		return fmt.Errorf("initializing handlers: %w", err)
 	}
 
-	// 9. Setup HTTP server
+	// 10. Setup HTTP server
 	if err := app.setupServer(); err != nil {
 		return fmt.Errorf("setting up server: %w", err)
 	}
@@ -1,8 +1,21 @@
 package app
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/alertmanager"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/audit"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/config"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/discord"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/email"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/pagerduty"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/slack"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/telegram"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/templates"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/ack"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
 )
@@ -13,6 +26,58 @@ type Clients struct {
 	Syncers   []ack.AckSyncer
 	Slack     *slack.Client
 	PagerDuty *pagerduty.Client
+	Telegram  *telegram.Client
+	Discord   *discord.Client
+	Email     *email.Client
+
+	// Alertmanager reads silences for the silence sync; nil unless alertmanager.url is set
+	Alertmanager *alertmanager.Client
+
+	// Audit records acks, silence changes and resolutions; nil unless audit.enabled is set
+	Audit *audit.FileLogger
+}
+
+// AuditLogger returns the audit sink, or a nil interface when auditing is
+// disabled so use cases skip it.
+func (c *Clients) AuditLogger() alert.AuditLogger {
+	if c.Audit == nil {
+		return nil
+	}
+	return c.Audit
+}
+
+// severityMap converts the configured alerting.severity_map or
+// alerting.priority_map to its domain form.
+func severityMap(cfg map[string]config.SeverityMappingConfig) entity.SeverityMap {
+	severities := make(entity.SeverityMap, len(cfg))
+	for value, mapping := range cfg {
+		severities[value] = entity.SeverityMapping{
+			Severity:          entity.AlertSeverity(mapping.Severity),
+			PagerDutySeverity: mapping.PagerDutySeverity,
+			Color:             mapping.Color,
+		}
+	}
+	return severities
+}
+
+// bySeverity converts a config map keyed by severity name, such as
+// slack.mention_groups or pagerduty.routing_keys, into per-severity values.
+func bySeverity(cfg map[string]string) map[entity.AlertSeverity]string {
+	values := make(map[entity.AlertSeverity]string, len(cfg))
+	for severity, value := range cfg {
+		values[entity.AlertSeverity(severity)] = value
+	}
+	return values
+}
+
+// severityWeights overlays the configured alerting.priority_weights on the
+// default severity weights.
+func severityWeights(cfg map[string]int) entity.SeverityWeights {
+	weights := entity.DefaultSeverityWeights()
+	for severity, weight := range cfg {
+		weights[entity.AlertSeverity(severity)] = weight
+	}
+	return weights
 }
 
 func (app *Application) initializeClients() error {
@@ -24,6 +89,24 @@ func (app *Application) initializeClients() error {
 	logger := &slogAdapter{logger: app.logger.Get()}
 	retryPolicy := alert.DefaultRetryPolicy()
 
+	// Validated on load; parsed once and shared by the notifiers
+	messageTemplates, err := templates.NewRegistry(app.config.Templates, app.logger.Get())
+	if err != nil {
+		return fmt.Errorf("parsing notification templates: %w", err)
+	}
+
+	// resilient retries transient failures and stops calling a notifier that
+	// keeps failing, so a dead integration does not stall every alert. The
+	// concurrency limit bounds the calls themselves, not the retry backoffs.
+	resilient := func(notifier alert.Notifier) alert.Notifier {
+		if limit := app.config.Alerting.NotifierConcurrency; limit > 0 {
+			notifier = alert.NewConcurrencyLimitedNotifier(notifier, limit)
+		}
+		retryable := alert.NewRetryableNotifier(notifier, retryPolicy, logger, app.telemetry.Metrics)
+		return alert.NewCircuitBreakingNotifier(retryable,
+			alert.DefaultCircuitMaxFailures, alert.DefaultCircuitCooldown, logger, app.telemetry.Metrics)
+	}
+
 	if app.config.IsSlackEnabled() {
 		app.clients.Slack = slack.NewClient(
 			app.config.Slack.BotToken,
@@ -31,33 +114,182 @@ func (app *Application) initializeClients() error {
 			app.config.Alerting.SilenceDurations,
 			app.config.Slack.APIURL, // Optional: for E2E testing
 		)
+		app.clients.Slack.SetAdditionalChannels(app.config.Slack.AdditionalChannelIDs)
+		app.clients.Slack.SetFallbackChannel(app.config.Slack.FallbackChannelID)
+		app.clients.Slack.SetTenantChannels(app.config.Slack.TenantChannels)
+		app.clients.Slack.SetRepostOnMissing(app.config.Slack.RepostOnMissing)
+		app.clients.Slack.SetAllowCustomBody(app.config.Slack.AllowCustomBody)
+		app.clients.Slack.SetLabelDisplay(app.config.Slack.DisplayLabels, app.config.Slack.HideLabels, app.config.Slack.MaxDisplayLabels)
+		app.clients.Slack.SetPublicURL(app.config.Server.PublicURL)
+		app.clients.Slack.SetInstanceSilenceDuration(app.config.Slack.InstanceSilenceDuration)
+		app.clients.Slack.SetTimeFormat(app.config.Slack.TimeFormat)
+		if app.config.Slack.Timezone != "" {
+			// Validated on load
+			location, _ := time.LoadLocation(app.config.Slack.Timezone)
+			app.clients.Slack.SetTimezone(location)
+		}
+		app.clients.Slack.SetSeverityMap(severityMap(app.config.Alerting.SeverityMap))
+		app.clients.Slack.SetPriorityMap(severityMap(app.config.Alerting.PriorityMap))
+		app.clients.Slack.SetMentionGroups(bySeverity(app.config.Slack.MentionGroups))
+		app.clients.Slack.SetTemplates(messageTemplates)
 
-		// Wrap with retry logic
-		retryableSlack := alert.NewRetryableNotifier(app.clients.Slack, retryPolicy, logger, app.telemetry.Metrics)
-		app.clients.Notifiers = append(app.clients.Notifiers, retryableSlack)
+		app.clients.Notifiers = append(app.clients.Notifiers, resilient(app.clients.Slack))
 
 		app.logger.Get().Info("Slack integration enabled",
 			"channel", app.config.Slack.ChannelID,
+			"additional_channels", app.config.Slack.AdditionalChannelIDs,
+			"fallback_channel", app.config.Slack.FallbackChannelID,
 		)
 	}
 
 	if app.config.IsPagerDutyEnabled() {
-		app.clients.PagerDuty = pagerduty.NewClient(
+		pdClient, err := pagerduty.NewClient(
 			app.config.PagerDuty.APIToken,
 			app.config.PagerDuty.RoutingKey,
 			app.config.PagerDuty.ServiceID,
 			app.config.PagerDuty.FromEmail,
 			app.config.PagerDuty.DefaultSeverity,
+			app.config.PagerDuty.DedupKeyTemplate,
 			app.config.PagerDuty.APIURL, // Optional: for E2E testing
 		)
+		if err != nil {
+			return fmt.Errorf("creating pagerduty client: %w", err)
+		}
+		app.clients.PagerDuty = pdClient
+		app.clients.PagerDuty.SetSeverityMap(severityMap(app.config.Alerting.SeverityMap))
+		app.clients.PagerDuty.SetPriorityMap(severityMap(app.config.Alerting.PriorityMap))
+		app.clients.PagerDuty.SetRoutingKeys(bySeverity(app.config.PagerDuty.RoutingKeys))
+		app.clients.PagerDuty.SetTemplates(messageTemplates)
 
-		// Wrap with retry logic
-		retryablePagerDuty := alert.NewRetryableNotifier(app.clients.PagerDuty, retryPolicy, logger, app.telemetry.Metrics)
-		app.clients.Notifiers = append(app.clients.Notifiers, retryablePagerDuty)
+		app.clients.Notifiers = append(app.clients.Notifiers, resilient(app.clients.PagerDuty))
 		app.clients.Syncers = append(app.clients.Syncers, app.clients.PagerDuty)
 
 		app.logger.Get().Info("PagerDuty integration enabled")
 	}
 
+	if app.config.IsTelegramEnabled() {
+		app.clients.Telegram = telegram.NewClient(
+			app.config.Telegram.BotToken,
+			app.config.Telegram.ChatID,
+			app.config.Telegram.APIURL, // Optional: for E2E testing
+		)
+
+		app.clients.Notifiers = append(app.clients.Notifiers, resilient(app.clients.Telegram))
+
+		app.logger.Get().Info("Telegram integration enabled",
+			"chat_id", app.config.Telegram.ChatID,
+		)
+	}
+
+	if app.config.IsDiscordEnabled() {
+		app.clients.Discord = discord.NewClient(
+			app.config.Discord.WebhookURL,
+			app.config.Discord.Username,
+			app.config.Discord.AvatarURL,
+		)
+		app.clients.Discord.SetTemplates(messageTemplates)
+
+		app.clients.Notifiers = append(app.clients.Notifiers, resilient(app.clients.Discord))
+
+		app.logger.Get().Info("Discord integration enabled")
+	}
+
+	if app.config.IsEmailEnabled() {
+		app.clients.Email = email.NewClient(
+			app.config.Email.Host,
+			app.config.Email.Port,
+			app.config.Email.Username,
+			app.config.Email.Password,
+			app.config.Email.From,
+			app.config.Email.To,
+			app.config.Email.TLSMode,
+		)
+		app.clients.Email.SetTemplates(messageTemplates)
+
+		app.clients.Notifiers = append(app.clients.Notifiers, resilient(app.clients.Email))
+
+		app.logger.Get().Info("Email integration enabled",
+			"host", app.config.Email.Host,
+			"recipients", len(app.config.Email.To),
+		)
+	}
+
+	if app.config.IsAlertmanagerSilenceSyncEnabled() {
+		app.clients.Alertmanager = alertmanager.NewClient(app.config.Alertmanager.URL)
+
+		app.logger.Get().Info("Alertmanager silence sync enabled",
+			"url", app.config.Alertmanager.URL,
+			"interval", app.config.Alertmanager.SilenceSyncInterval,
+		)
+	}
+
+	if app.config.Audit.Enabled {
+		auditLogger, err := audit.NewFileLogger(app.config.Audit.Path, logger)
+		if err != nil {
+			return fmt.Errorf("creating audit logger: %w", err)
+		}
+		app.clients.Audit = auditLogger
+
+		app.logger.Get().Info("Audit log enabled", "path", app.config.Audit.Path)
+	}
+
+	// Dry run: render and log notifications instead of sending them,
+	// and skip ack syncing since it calls external APIs directly
+	if app.config.Alerting.DryRun {
+		for i, notifier := range app.clients.Notifiers {
+			app.clients.Notifiers[i] = alert.NewDryRunNotifier(notifier, logger)
+		}
+		app.clients.Syncers = nil
+
+		app.logger.Get().Warn("dry-run mode enabled, notifications will be logged but not sent",
+			"notifiers", len(app.clients.Notifiers),
+		)
+	}
+
+	return nil
+}
+
+// selfTestNotifiers verifies each enabled notifier's credentials at startup.
+// Depending on alerting.notifier_self_test, failures are logged ("warn") or abort startup ("fail").
+func (app *Application) selfTestNotifiers() error {
+	mode := app.config.Alerting.NotifierSelfTest
+	if mode == "off" {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	var passed, failed, untestable []string
+	for _, notifier := range app.clients.Notifiers {
+		err := alert.SelfTest(ctx, notifier)
+		if errors.Is(err, entity.ErrSelfTestNotSupported) {
+			untestable = append(untestable, notifier.Name())
+			app.logger.Get().Warn("notifier cannot be self-tested",
+				"notifier", notifier.Name(),
+				"reason", err,
+			)
+			continue
+		}
+		if err != nil {
+			failed = append(failed, notifier.Name())
+			app.logger.Get().Error("notifier self-test failed",
+				"notifier", notifier.Name(),
+				"error", err,
+			)
+			continue
+		}
+		passed = append(passed, notifier.Name())
+	}
+
+	app.logger.Get().Info("notifier self-test completed",
+		"passed", passed,
+		"failed", failed,
+		"untestable", untestable,
+	)
+
+	if len(failed) > 0 && mode == "fail" {
+		return fmt.Errorf("notifiers failed self-test: %v", failed)
+	}
 	return nil
 }
@@ -2,6 +2,7 @@ package app
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/config"
 	"github.com/spf13/viper"
@@ -13,10 +14,19 @@ func (app *Application) loadConfig(configPath string) error {
 		return fmt.Errorf("loading config: %w", err)
 	}
 
+	app.applyOverrides(cfg)
+
 	app.config = cfg
 	return nil
 }
 
+// applyOverrides applies the command-line overrides to a loaded config.
+func (app *Application) applyOverrides(cfg *config.Config) {
+	if app.dryRun {
+		cfg.Alerting.DryRun = true
+	}
+}
+
 func (app *Application) setupConfigManager(configPath string) error {
 	v := viper.New()
 	v.SetConfigFile(configPath)
@@ -25,16 +35,38 @@ func (app *Application) setupConfigManager(configPath string) error {
 	}
 
 	app.configManager = config.NewConfigManager(app.config, v, configPath, app.logger.Get())
+	app.configManager.SetOverride(app.applyOverrides)
 
 	// Setup reload callback for logger
-	app.configManager.SetReloadCallback(func(newCfg *config.Config) {
-		newLogger := createLogger(newCfg.Logging.Level, newCfg.Logging.Format)
-		app.logger.Set(newLogger)
-		app.logger.Get().Info("logger reloaded",
-			"level", newCfg.Logging.Level,
-			"format", newCfg.Logging.Format,
-		)
+	app.configManager.SetReloadCallback(func(newCfg *config.Config, diff config.ConfigDiff) {
+		// Level changes apply to existing loggers via the shared LevelVar;
+		// a format change only affects loggers obtained after the reload.
+		// Only changed keys are applied, so an unrelated edit keeps a level
+		// set through the log level endpoint.
+		levelChanged := slices.Contains(diff.ChangedKeys, "logging.level")
+		formatChanged := slices.Contains(diff.ChangedKeys, "logging.format")
+		if levelChanged {
+			app.logger.SetLevel(newCfg.Logging.Level)
+		}
+		if formatChanged {
+			app.logger.Set(createLogger(app.logger.LevelVar(), newCfg.Logging.Format))
+		}
+		if levelChanged || formatChanged {
+			app.logger.Get().Info("logger reloaded",
+				"level", newCfg.Logging.Level,
+				"format", newCfg.Logging.Format,
+			)
+		}
+
+		// New alert messages go to the reloaded channel
+		if slices.Contains(diff.ChangedKeys, "slack.channel_id") &&
+			app.clients != nil && app.clients.Slack != nil && newCfg.Slack.ChannelID != "" {
+			app.clients.Slack.SetChannel(newCfg.Slack.ChannelID)
+		}
 	})
 
+	// Watch the config file and apply reloadable keys on change
+	config.NewWatcher(v, app.configManager, app.logger.Get()).Start()
+
 	return nil
 }
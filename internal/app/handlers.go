@@ -4,7 +4,10 @@ import (
 	"fmt"
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/handler"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/handler/middleware"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/rpc"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/server"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/slack"
 	pdUseCase "github.com/qj0r9j0vc2/alert-bridge/internal/usecase/pagerduty"
 	slackUseCase "github.com/qj0r9j0vc2/alert-bridge/internal/usecase/slack"
 )
@@ -19,10 +22,19 @@ func (app *Application) initializeHandlers() error {
 	}
 
 	app.handlers = &server.Handlers{
-		Health:  handler.NewHealthHandler(),
-		Ready:   readyHandler,
-		Reload:  handler.NewReloadHandler(app.configManager, logger),
-		Metrics: handler.NewMetricsHandler(),
+		Health:     handler.NewHealthHandler(),
+		Ready:      readyHandler,
+		Reload:     handler.NewReloadHandler(app.configManager, logger),
+		Metrics:    handler.NewMetricsHandler(),
+		LogLevel:   handler.NewLogLevelHandler(app.logger.LevelVar(), logger),
+		Config:     handler.NewConfigHandler(app.configManager, logger),
+		Preview:    handler.NewPreviewHandler(app.useCases.PreviewAlert, logger),
+		Stats:      handler.NewStatsHandler(app.useCases.GetStats, logger),
+		ListAlerts: handler.NewListAlertsHandler(app.useCases.ListAlerts, logger),
+		Silenced:   handler.NewSilencedAlertsHandler(app.useCases.Silenced, logger),
+		Renotify:   handler.NewRenotifyHandler(app.useCases.ProcessAlert, logger),
+		Timeline:   handler.NewTimelineHandler(app.useCases.Timeline, logger),
+		Deliveries: handler.NewDeliveriesHandler(app.useCases.Deliveries, logger),
 	}
 
 	// Alertmanager handler
@@ -30,6 +42,27 @@ func (app *Application) initializeHandlers() error {
 		app.useCases.ProcessAlert,
 		logger,
 	)
+	app.handlers.Alertmanager.SetStrictJSON(app.config.Server.StrictJSON)
+	if app.idempotency != nil {
+		app.handlers.Alertmanager.SetIdempotencyStore(app.idempotency, app.config.Alertmanager.IdempotencyTTL)
+	}
+
+	// Generic ingestion shares the Alertmanager processing path
+	app.handlers.Ingest = handler.NewIngestHandler(app.useCases.ProcessAlert, logger)
+	app.handlers.Ingest.SetDefaultSeverity(app.config.Alerting.DefaultSeverity)
+	app.handlers.Ingest.SetStrictJSON(app.config.Server.StrictJSON)
+
+	app.handlers.BulkAck = handler.NewBulkAckHandler(app.useCases.SyncAck, logger)
+	app.handlers.BulkAck.SetStrictJSON(app.config.Server.StrictJSON)
+	app.handlers.Ack = handler.NewAckHandler(app.useCases.Acknowledge, logger)
+	app.handlers.Ack.SetStrictJSON(app.config.Server.StrictJSON)
+	app.handlers.Assign = handler.NewAssignHandler(app.useCases.Assign, logger)
+	app.handlers.Assign.SetStrictJSON(app.config.Server.StrictJSON)
+
+	app.handlers.SilencePreview = handler.NewSilencePreviewHandler(app.useCases.SilencePreview, logger)
+	app.handlers.SilencePreview.SetStrictJSON(app.config.Server.StrictJSON)
+
+	app.handlers.SilenceExpire = handler.NewSilenceExpireHandler(app.useCases.ExpireSilence, logger)
 
 	// Slack handlers (if enabled)
 	if app.config.IsSlackEnabled() {
@@ -44,6 +77,7 @@ func (app *Application) initializeHandlers() error {
 			app.alertRepo,
 			app.clients.Slack,
 		)
+		manageSilenceUC.SetAuditLogger(app.clients.AuditLogger())
 
 		app.handlers.SlackCommands = handler.NewSlackCommandsHandler(
 			queryAlertStatusUC,
@@ -51,21 +85,51 @@ func (app *Application) initializeHandlers() error {
 			manageSilenceUC,
 			app.logger.Get(),
 		)
+		channelTenants := make(map[string]string, len(app.config.Slack.TenantChannels))
+		for tenantID, channelID := range app.config.Slack.TenantChannels {
+			channelTenants[channelID] = tenantID
+		}
+		app.handlers.SlackCommands.SetChannelTenants(channelTenants)
+		app.handlers.SlackCommands.SetAckBySelector(slackUseCase.NewAckBySelectorUseCase(
+			app.alertRepo,
+			app.useCases.SyncAck,
+			app.clients.Slack,
+			logger,
+		))
 
 		handleSlackInteractionUC := slackUseCase.NewHandleInteractionUseCase(
 			app.alertRepo,
 			app.silenceRepo,
 			app.useCases.SyncAck,
+			app.useCases.Assign,
 			app.clients.Slack,
 			logger,
 		)
+		handleSlackInteractionUC.SetInstanceSilenceDuration(app.config.Slack.InstanceSilenceDuration)
+		handleSlackInteractionUC.SetAuditLogger(app.clients.AuditLogger())
 		app.handlers.SlackInteraction = handler.NewSlackInteractionHandler(
 			handleSlackInteractionUC,
 			logger,
 		)
+
+		var handleReactionUC *slackUseCase.HandleReactionUseCase
+		if app.config.Slack.AckReaction != "" {
+			handleReactionUC = slackUseCase.NewHandleReactionUseCase(
+				app.alertRepo,
+				app.useCases.SyncAck,
+				app.clients.Slack,
+				app.config.Slack.AckReaction,
+				logger,
+			)
+		}
 		app.handlers.SlackEvents = handler.NewSlackEventsHandler(
+			handleReactionUC,
 			logger,
 		)
+		if app.useCases.AppHome != nil {
+			app.handlers.SlackInteraction.SetAppHome(app.useCases.AppHome)
+			app.handlers.SlackEvents.SetAppHome(app.useCases.AppHome)
+		}
 	}
 
 	// PagerDuty handler (if enabled)
@@ -76,10 +140,16 @@ func (app *Application) initializeHandlers() error {
 			app.clients.Slack,
 			logger,
 		)
+		handlePDWebhookUC.SetAuditLogger(app.clients.AuditLogger())
+		handlePDWebhookUC.SetDependentReleaser(app.useCases.ProcessAlert)
+		if app.clients.Slack != nil {
+			handlePDWebhookUC.SetThreadReplier(app.clients.Slack)
+		}
 		app.handlers.PagerDutyWebhook = handler.NewPagerDutyWebhookHandler(
 			handlePDWebhookUC,
 			logger,
 		)
+		app.handlers.PagerDutyWebhook.SetStrictJSON(app.config.Server.StrictJSON)
 	}
 
 	return nil
@@ -90,9 +160,17 @@ func (app *Application) setupServer() error {
 		ConfigManager:             app.configManager, // Enable hot-reload
 		AlertmanagerWebhookSecret: app.config.Alertmanager.WebhookSecret,
 		SlackSigningSecret:        app.config.Slack.SigningSecret,
+		SlackRequestMaxAge:        app.config.Slack.RequestMaxAge,
 		PagerDutyWebhookSecret:    app.config.PagerDuty.WebhookSecret,
 		RequestTimeout:            app.config.Server.RequestTimeout,
 		Metrics:                   app.telemetry.Metrics,
+		AdminToken:                app.config.Server.AdminToken,
+		MaxBodyBytes:              app.config.Server.MaxBodyBytes,
+		UserAuth: middleware.JWTConfig{
+			Secret:   app.config.Server.UserAuth.JWTSecret,
+			Issuer:   app.config.Server.UserAuth.Issuer,
+			Audience: app.config.Server.UserAuth.Audience,
+		},
 	}
 	router := server.NewRouterWithConfig(app.handlers, app.logger.Get(), routerConfig)
 	srv, err := server.New(*app.config, router, app.logger.Get())
@@ -100,6 +178,15 @@ func (app *Application) setupServer() error {
 		return fmt.Errorf("failed to create server: %w", err)
 	}
 
+	// Route Socket Mode events through the same handlers as HTTP mode
+	if client := srv.SocketModeClient(); client != nil {
+		client.SetEventHandler(handler.NewSocketModeHandler(
+			client,
+			app.handlers.SlackEvents,
+			slack.NewSlogAdapter(app.logger.Get()),
+		))
+	}
+
 	// Configure health check to report Slack status
 	if app.config.IsSlackEnabled() && app.handlers.Health != nil {
 		app.handlers.Health.SetSlackStatus(
@@ -110,5 +197,22 @@ func (app *Application) setupServer() error {
 	}
 
 	app.server = srv
+
+	if app.config.Server.GRPCPort > 0 {
+		service := rpc.NewService(
+			app.useCases.ProcessAlert,
+			app.useCases.ListAlerts,
+			app.alertRepo,
+			app.ackEventRepo,
+			app.silenceRepo,
+			&slogAdapter{logger: app.logger.Get()},
+		)
+		service.SetDefaultSeverity(app.config.Alerting.DefaultSeverity)
+		grpcServer, err := server.NewGRPC(app.config.Server, service, app.logger.Get())
+		if err != nil {
+			return fmt.Errorf("failed to create gRPC server: %w", err)
+		}
+		app.grpcServer = grpcServer
+	}
 	return nil
 }
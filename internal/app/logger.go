@@ -6,14 +6,17 @@ import (
 	"sync/atomic"
 )
 
-// AtomicLogger provides thread-safe logger access for hot reload
+// AtomicLogger provides thread-safe logger access for hot reload.
+// The level is held in a shared LevelVar so that loggers already handed
+// out to components pick up level changes without being replaced.
 type AtomicLogger struct {
 	value atomic.Value
+	level *slog.LevelVar
 }
 
 // NewAtomicLogger creates a new atomic logger wrapper
-func NewAtomicLogger(logger *slog.Logger) *AtomicLogger {
-	al := &AtomicLogger{}
+func NewAtomicLogger(logger *slog.Logger, level *slog.LevelVar) *AtomicLogger {
+	al := &AtomicLogger{level: level}
 	al.value.Store(logger)
 	return al
 }
@@ -28,29 +31,42 @@ func (al *AtomicLogger) Set(logger *slog.Logger) {
 	al.value.Store(logger)
 }
 
+// LevelVar returns the dynamic level shared by all loggers created for this wrapper.
+func (al *AtomicLogger) LevelVar() *slog.LevelVar {
+	return al.level
+}
+
+// SetLevel changes the log level for every logger sharing the LevelVar.
+func (al *AtomicLogger) SetLevel(level string) {
+	al.level.Set(parseLogLevel(level))
+}
+
 // setupLogger creates the initial logger
 func (app *Application) setupLogger() error {
-	logger := createLogger(app.config.Logging.Level, app.config.Logging.Format)
-	app.logger = NewAtomicLogger(logger)
+	level := new(slog.LevelVar)
+	level.Set(parseLogLevel(app.config.Logging.Level))
+	logger := createLogger(level, app.config.Logging.Format)
+	app.logger = NewAtomicLogger(logger, level)
 	return nil
 }
 
-func createLogger(level, format string) *slog.Logger {
-	var logLevel slog.Level
+func parseLogLevel(level string) slog.Level {
 	switch level {
 	case "debug":
-		logLevel = slog.LevelDebug
+		return slog.LevelDebug
 	case "info":
-		logLevel = slog.LevelInfo
+		return slog.LevelInfo
 	case "warn":
-		logLevel = slog.LevelWarn
+		return slog.LevelWarn
 	case "error":
-		logLevel = slog.LevelError
+		return slog.LevelError
 	default:
-		logLevel = slog.LevelInfo
+		return slog.LevelInfo
 	}
+}
 
-	opts := &slog.HandlerOptions{Level: logLevel}
+func createLogger(level *slog.LevelVar, format string) *slog.Logger {
+	opts := &slog.HandlerOptions{Level: level}
 
 	var handler slog.Handler
 	if format == "json" {
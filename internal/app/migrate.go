@@ -0,0 +1,152 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/config"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/mysql"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/sqlite"
+)
+
+// Migration actions accepted by Migrate.
+const (
+	MigrateUp     = "up"
+	MigrateDown   = "down"
+	MigrateStatus = "status"
+)
+
+// PreviousVersion makes a down migration revert only the latest applied migration.
+const PreviousVersion = -1
+
+// migrationBackend is the common surface of the MySQL migrator and the SQLite DB.
+type migrationBackend struct {
+	up        func(ctx context.Context) error
+	down      func(ctx context.Context, toVersion int) error
+	applied   func(ctx context.Context) ([]int, error)
+	available []int
+}
+
+// Migrate runs a schema migration action against the configured storage
+// backend without starting the server. For MigrateDown, toVersion is the
+// version to roll back to, or PreviousVersion. Status goes to out.
+func Migrate(ctx context.Context, configPath, action string, toVersion int, out io.Writer) error {
+	switch action {
+	case MigrateUp, MigrateDown, MigrateStatus:
+	default:
+		return fmt.Errorf("unknown migrate action %q (expected up, down or status)", action)
+	}
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	var backend migrationBackend
+	switch cfg.Storage.Type {
+	case "mysql":
+		db, err := mysql.NewDB(&cfg.Storage.MySQL)
+		if err != nil {
+			return fmt.Errorf("mysql init: %w", err)
+		}
+		defer db.Close()
+
+		migrator := mysql.NewMigrator(db.Primary())
+		migrations, err := migrator.Migrations()
+		if err != nil {
+			return err
+		}
+		backend = migrationBackend{up: migrator.Up, down: migrator.Down, applied: migrator.AppliedVersions}
+		for _, m := range migrations {
+			backend.available = append(backend.available, m.Version)
+		}
+
+	case "sqlite":
+		db, err := sqlite.NewDB(cfg.Storage.SQLite.Path)
+		if err != nil {
+			return fmt.Errorf("sqlite init: %w", err)
+		}
+		defer db.Close()
+
+		backend = migrationBackend{
+			up:        db.Migrate,
+			down:      db.MigrateDown,
+			applied:   db.AppliedVersions,
+			available: sqlite.MigrationVersions(),
+		}
+
+	case "memory", "":
+		return fmt.Errorf("memory storage has no schema to migrate")
+
+	default:
+		return fmt.Errorf("unknown storage type: %s", cfg.Storage.Type)
+	}
+
+	return runMigration(ctx, backend, action, toVersion, out)
+}
+
+func runMigration(ctx context.Context, backend migrationBackend, action string, toVersion int, out io.Writer) error {
+	switch action {
+	case MigrateUp:
+		if err := backend.up(ctx); err != nil {
+			return err
+		}
+	case MigrateDown:
+		if toVersion == PreviousVersion {
+			applied, err := backend.applied(ctx)
+			if err != nil {
+				return err
+			}
+			toVersion = 0
+			if len(applied) > 1 {
+				toVersion = applied[len(applied)-2]
+			}
+		}
+		if err := backend.down(ctx, toVersion); err != nil {
+			return err
+		}
+	}
+
+	applied, err := backend.applied(ctx)
+	if err != nil {
+		return err
+	}
+	printMigrationStatus(out, applied, backend.available)
+	return nil
+}
+
+// printMigrationStatus writes the current version and the applied, available and pending versions.
+func printMigrationStatus(out io.Writer, applied, available []int) {
+	current := 0
+	isApplied := make(map[int]bool, len(applied))
+	for _, version := range applied {
+		isApplied[version] = true
+		current = max(current, version)
+	}
+
+	var pending []int
+	for _, version := range available {
+		if !isApplied[version] {
+			pending = append(pending, version)
+		}
+	}
+
+	fmt.Fprintf(out, "current version: %d\n", current)
+	fmt.Fprintf(out, "applied versions: %s\n", joinVersions(applied))
+	fmt.Fprintf(out, "available versions: %s\n", joinVersions(available))
+	fmt.Fprintf(out, "pending versions: %s\n", joinVersions(pending))
+}
+
+func joinVersions(versions []int) string {
+	if len(versions) == 0 {
+		return "none"
+	}
+	parts := make([]string, len(versions))
+	for i, version := range versions {
+		parts[i] = strconv.Itoa(version)
+	}
+	return strings.Join(parts, ", ")
+}
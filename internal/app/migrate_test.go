@@ -0,0 +1,155 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/config"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/sqlite"
+)
+
+// writeSQLiteConfig writes a config storing alerts in a SQLite file in a
+// temporary directory and returns the paths of the config and the database.
+func writeSQLiteConfig(t *testing.T, extra string) (configPath, dbPath string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	dbPath = filepath.Join(dir, "alert-bridge.db")
+	configPath = filepath.Join(dir, "config.yaml")
+	data := fmt.Sprintf("storage:\n  type: sqlite\n  sqlite:\n    path: %s\n%s", dbPath, extra)
+	require.NoError(t, os.WriteFile(configPath, []byte(data), 0o600))
+	return configPath, dbPath
+}
+
+// appliedVersions returns the versions recorded in schema_version.
+func appliedVersions(t *testing.T, dbPath string) []int {
+	t.Helper()
+
+	db, err := sqlite.NewDB(dbPath)
+	require.NoError(t, err)
+	defer db.Close()
+
+	versions, err := db.AppliedVersions(context.Background())
+	require.NoError(t, err)
+	return versions
+}
+
+// statusLine returns the value of the named line of the migration status.
+func statusLine(t *testing.T, out, name string) string {
+	t.Helper()
+
+	for _, line := range strings.Split(out, "\n") {
+		if value, ok := strings.CutPrefix(line, name+": "); ok {
+			return value
+		}
+	}
+	t.Fatalf("no %q line in %q", name, out)
+	return ""
+}
+
+func TestMigrate_SQLiteUpStatusDown(t *testing.T) {
+	ctx := context.Background()
+	configPath, dbPath := writeSQLiteConfig(t, "")
+	available := sqlite.MigrationVersions()
+	latest := available[len(available)-1]
+	previous := available[len(available)-2]
+
+	// A fresh database has everything pending
+	var out bytes.Buffer
+	require.NoError(t, Migrate(ctx, configPath, MigrateStatus, PreviousVersion, &out))
+	assert.Equal(t, "0", statusLine(t, out.String(), "current version"))
+	assert.Equal(t, "none", statusLine(t, out.String(), "applied versions"))
+	assert.Equal(t, joinVersions(available), statusLine(t, out.String(), "pending versions"))
+	assert.Empty(t, appliedVersions(t, dbPath))
+
+	out.Reset()
+	require.NoError(t, Migrate(ctx, configPath, MigrateUp, PreviousVersion, &out))
+	assert.Equal(t, fmt.Sprint(latest), statusLine(t, out.String(), "current version"))
+	assert.Equal(t, "none", statusLine(t, out.String(), "pending versions"))
+	assert.Equal(t, available, appliedVersions(t, dbPath))
+
+	out.Reset()
+	require.NoError(t, Migrate(ctx, configPath, MigrateStatus, PreviousVersion, &out))
+	assert.Equal(t, joinVersions(available), statusLine(t, out.String(), "applied versions"))
+
+	// down without a version reverts only the latest migration
+	out.Reset()
+	require.NoError(t, Migrate(ctx, configPath, MigrateDown, PreviousVersion, &out))
+	assert.Equal(t, fmt.Sprint(previous), statusLine(t, out.String(), "current version"))
+	assert.Equal(t, fmt.Sprint(latest), statusLine(t, out.String(), "pending versions"))
+	assert.Equal(t, available[:len(available)-1], appliedVersions(t, dbPath))
+
+	// down to a version reverts every migration above it
+	out.Reset()
+	require.NoError(t, Migrate(ctx, configPath, MigrateDown, 1, &out))
+	assert.Equal(t, "1", statusLine(t, out.String(), "current version"))
+	assert.Equal(t, []int{1}, appliedVersions(t, dbPath))
+
+	// up applies the reverted migrations again
+	out.Reset()
+	require.NoError(t, Migrate(ctx, configPath, MigrateUp, PreviousVersion, &out))
+	assert.Equal(t, available, appliedVersions(t, dbPath))
+}
+
+func TestMigrate_RejectsUnknownActionAndMemoryStorage(t *testing.T) {
+	ctx := context.Background()
+	configPath, _ := writeSQLiteConfig(t, "")
+
+	err := Migrate(ctx, configPath, "sideways", PreviousVersion, &bytes.Buffer{})
+	assert.ErrorContains(t, err, "unknown migrate action")
+
+	memoryConfig := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(memoryConfig, []byte("storage:\n  type: memory\n"), 0o600))
+	err = Migrate(ctx, memoryConfig, MigrateStatus, PreviousVersion, &bytes.Buffer{})
+	assert.ErrorContains(t, err, "no schema to migrate")
+}
+
+func TestInitializeStorage_AutoMigrate(t *testing.T) {
+	tests := []struct {
+		name        string
+		extra       string
+		wantApplied bool
+	}{
+		{name: "default", wantApplied: true},
+		{name: "enabled", extra: "  auto_migrate: true\n", wantApplied: true},
+		{name: "disabled", extra: "  auto_migrate: false\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath, dbPath := writeSQLiteConfig(t, tt.extra)
+			cfg, err := config.Load(configPath)
+			require.NoError(t, err)
+
+			app := &Application{
+				config: cfg,
+				logger: NewAtomicLogger(slog.New(slog.DiscardHandler), new(slog.LevelVar)),
+			}
+			require.NoError(t, app.initializeStorage())
+			require.NoError(t, app.dbCloser.Close())
+
+			if tt.wantApplied {
+				assert.Equal(t, sqlite.MigrationVersions(), appliedVersions(t, dbPath))
+				return
+			}
+			assert.Empty(t, appliedVersions(t, dbPath))
+
+			// The schema is left to `alert-bridge migrate up`
+			db, err := sql.Open("sqlite", dbPath)
+			require.NoError(t, err)
+			defer db.Close()
+			var tables int
+			require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'alerts'`).Scan(&tables))
+			assert.Zero(t, tables)
+		})
+	}
+}
@@ -5,10 +5,11 @@ import (
 	"fmt"
 	"io"
 
-	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/cache"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/mysql"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/sqlite"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/traced"
 )
 
 func (app *Application) initializeStorage() error {
@@ -16,13 +17,16 @@ func (app *Application) initializeStorage() error {
 
 	switch app.config.Storage.Type {
 	case "mysql":
-		repos, db, err := mysql.NewRepositories(&app.config.Storage.MySQL)
+		repos, db, err := mysql.NewRepositories(&app.config.Storage.MySQL, app.config.Storage.AutoMigrateEnabled())
 		if err != nil {
 			return fmt.Errorf("mysql init: %w", err)
 		}
 		app.alertRepo = repos.Alert
 		app.ackEventRepo = repos.AckEvent
 		app.silenceRepo = repos.Silence
+		app.idempotency = repos.Idempotency
+		app.outboxRepo = repos.Outbox
+		app.deliveryRepo = repos.Delivery
 		app.txManager = db // MySQL DB implements TransactionManager
 		app.dbPinger = db  // MySQL DB implements dbPinger for readiness checks
 		closer = db
@@ -38,15 +42,20 @@ func (app *Application) initializeStorage() error {
 			return fmt.Errorf("sqlite init: %w", err)
 		}
 
-		if err := db.Migrate(context.Background()); err != nil {
-			db.Close()
-			return fmt.Errorf("sqlite migration: %w", err)
+		if app.config.Storage.AutoMigrateEnabled() {
+			if err := db.Migrate(context.Background()); err != nil {
+				db.Close()
+				return fmt.Errorf("sqlite migration: %w", err)
+			}
 		}
 
 		repos := sqlite.NewRepositories(db)
 		app.alertRepo = repos.Alert
 		app.ackEventRepo = repos.AckEvent
 		app.silenceRepo = repos.Silence
+		app.idempotency = repos.Idempotency
+		app.outboxRepo = repos.Outbox
+		app.deliveryRepo = repos.Delivery
 		app.txManager = db // SQLite DB implements TransactionManager
 		app.dbPinger = db  // SQLite DB implements dbPinger for readiness checks
 		closer = db
@@ -56,40 +65,56 @@ func (app *Application) initializeStorage() error {
 		)
 
 	case "memory", "":
-		app.alertRepo = memory.NewAlertRepository()
-		app.ackEventRepo = memory.NewAckEventRepository()
+		alertRepo := memory.NewAlertRepository()
+		alertRepo.SetTTL(app.config.Storage.Memory.TTL)
+		ackEventRepo := memory.NewAckEventRepository()
+		deliveryRepo := memory.NewDeliveryRepository()
+		app.startEviction = func(ctx context.Context) {
+			alertRepo.StartEviction(ctx, app.config.Storage.Memory.EvictionInterval, func(alertIDs []string) {
+				ackEventRepo.DeleteByAlertIDs(alertIDs)
+				deliveryRepo.DeleteByAlertIDs(alertIDs)
+			})
+		}
+
+		app.alertRepo = alertRepo
+		app.ackEventRepo = ackEventRepo
 		app.silenceRepo = memory.NewSilenceRepository()
-		app.txManager = &noOpTransactionManager{} // No-op for in-memory
+		app.idempotency = memory.NewIdempotencyStore(memory.DefaultIdempotencyCapacity)
+		app.outboxRepo = memory.NewOutboxRepository()
+		app.deliveryRepo = deliveryRepo
+		app.txManager = memory.NewTransactionManager()
 
-		app.logger.Get().Info("in-memory storage initialized")
+		app.logger.Get().Info("in-memory storage initialized",
+			"ttl", app.config.Storage.Memory.TTL,
+		)
 
 	default:
 		return fmt.Errorf("unknown storage type: %s", app.config.Storage.Type)
 	}
 
-	app.dbCloser = closer
-	return nil
-}
-
-// noOpTransactionManager is a no-op implementation for in-memory storage.
-type noOpTransactionManager struct{}
-
-func (n *noOpTransactionManager) BeginTx(ctx context.Context) (repository.Transaction, error) {
-	return &noOpTransaction{}, nil
-}
-
-func (n *noOpTransactionManager) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
-	// For in-memory storage, just execute the function without transaction
-	return fn(ctx)
-}
+	// Record a span per repository call when traces are exported
+	if app.telemetry != nil && app.telemetry.TracingEnabled() {
+		app.alertRepo = traced.NewAlertRepository(app.alertRepo)
+		app.ackEventRepo = traced.NewAckEventRepository(app.ackEventRepo)
+		app.silenceRepo = traced.NewSilenceRepository(app.silenceRepo)
+		app.deliveryRepo = traced.NewDeliveryRepository(app.deliveryRepo)
+	}
 
-// noOpTransaction is a no-op transaction.
-type noOpTransaction struct{}
+	// Serve repeated lookups by ID and external reference from memory
+	if app.config.Storage.Cache.Enabled {
+		cached := cache.NewAlertRepository(app.alertRepo, app.config.Storage.Cache.Size, app.config.Storage.Cache.TTL)
+		if app.telemetry != nil {
+			cached.SetMetrics(app.telemetry.Metrics)
+		}
+		app.alertRepo = cached
+		app.txManager = cache.NewTransactionManager(app.txManager, cached)
 
-func (n *noOpTransaction) Commit() error {
-	return nil
-}
+		app.logger.Get().Info("alert cache enabled",
+			"size", app.config.Storage.Cache.Size,
+			"ttl", app.config.Storage.Cache.TTL,
+		)
+	}
 
-func (n *noOpTransaction) Rollback() error {
+	app.dbCloser = closer
 	return nil
 }
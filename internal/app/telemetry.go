@@ -6,7 +6,7 @@ import (
 
 // setupTelemetry initializes OpenTelemetry tracing and metrics.
 func (app *Application) setupTelemetry() error {
-	telemetry, err := observability.NewTelemetry("alert-bridge", "v1.0.0")
+	telemetry, err := observability.NewTelemetry("alert-bridge", "v1.0.0", app.config.Observability.OTLPEndpoint)
 	if err != nil {
 		return err
 	}
@@ -16,7 +16,7 @@ func (app *Application) setupTelemetry() error {
 	app.logger.Get().Info("telemetry initialized",
 		"service", "alert-bridge",
 		"metrics_enabled", true,
-		"tracing_enabled", false, // NoOp tracer for now
+		"tracing_enabled", telemetry.TracingEnabled(),
 	)
 
 	return nil
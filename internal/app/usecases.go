@@ -1,16 +1,52 @@
 package app
 
 import (
+	"fmt"
 	"log/slog"
+	"time"
 
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/ack"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/outbox"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/silence"
+	slackUseCase "github.com/qj0r9j0vc2/alert-bridge/internal/usecase/slack"
 )
 
 // UseCases holds all business logic use cases
 type UseCases struct {
 	ProcessAlert *alert.ProcessAlertUseCase
+	PreviewAlert *alert.PreviewAlertUseCase
+	GetStats     *alert.GetStatsUseCase
+	ListAlerts   *alert.ListAlertsUseCase
+	Silenced     *alert.FindSilencedAlertsUseCase
+	Timeline     *alert.TimelineUseCase
+	Deliveries   *alert.DeliveriesUseCase
 	SyncAck      *ack.SyncAckUseCase
+
+	// SilencePreview reports the firing alerts a proposed silence would match
+	SilencePreview *silence.PreviewSilenceUseCase
+
+	// ExpireSilence ends silences early through the admin API
+	ExpireSilence *silence.ExpireSilenceUseCase
+
+	// Acknowledge acks single alerts for authenticated API users
+	Acknowledge *ack.AcknowledgeUseCase
+
+	// Assign assigns alerts to responders through the admin API
+	Assign *alert.AssignUseCase
+
+	// OutboxDispatcher delivers queued notifications; nil unless alerting.outbox is enabled
+	OutboxDispatcher *outbox.Dispatcher
+
+	// SilenceSync imports Alertmanager silences; nil unless alertmanager.url is set
+	SilenceSync *silence.SyncAlertmanagerSilencesUseCase
+
+	// Digest posts periodic Slack summaries; nil unless alerting.digest is enabled
+	Digest *alert.DigestUseCase
+
+	// AppHome keeps the Slack App Home tab up to date; nil unless slack.app_home is enabled
+	AppHome *slackUseCase.AppHomeUseCase
 }
 
 func (app *Application) initializeUseCases() error {
@@ -24,6 +60,14 @@ func (app *Application) initializeUseCases() error {
 			logger,
 			app.telemetry.Metrics,
 		),
+		PreviewAlert:   alert.NewPreviewAlertUseCase(app.clients.Notifiers),
+		GetStats:       alert.NewGetStatsUseCase(app.alertRepo, app.silenceRepo),
+		ListAlerts:     alert.NewListAlertsUseCase(app.alertRepo),
+		Silenced:       alert.NewFindSilencedAlertsUseCase(app.alertRepo, app.silenceRepo),
+		Timeline:       alert.NewTimelineUseCase(app.alertRepo, app.ackEventRepo),
+		Deliveries:     alert.NewDeliveriesUseCase(app.alertRepo, app.deliveryRepo),
+		SilencePreview: silence.NewPreviewSilenceUseCase(app.alertRepo),
+		ExpireSilence:  silence.NewExpireSilenceUseCase(app.silenceRepo),
 		SyncAck: ack.NewSyncAckUseCase(
 			app.alertRepo,
 			app.ackEventRepo,
@@ -34,6 +78,151 @@ func (app *Application) initializeUseCases() error {
 		),
 	}
 
+	app.useCases.ProcessAlert.SetDeterministicIDs(app.config.Alerting.DeterministicIDs)
+	app.useCases.ProcessAlert.SetCorrelateBy(app.config.Alerting.CorrelateBy)
+	app.useCases.ProcessAlert.SetIdentityLabels(app.config.Alerting.IdentityLabels)
+	app.useCases.ProcessAlert.SetTenantLabel(app.config.Alerting.TenantLabel)
+	app.useCases.ProcessAlert.SetLabelLimits(app.config.Alerting.MaxLabels, app.config.Alerting.MaxLabelValueLen)
+	app.useCases.ProcessAlert.SetAuditLogger(app.clients.AuditLogger())
+	app.useCases.ProcessAlert.SetDeliveryRepository(app.deliveryRepo)
+	// Slack messages of critical alerts name the PagerDuty on-call user
+	if app.clients.Slack != nil && app.clients.PagerDuty != nil &&
+		app.config.PagerDuty.APIToken != "" && app.config.PagerDuty.ServiceID != "" {
+		app.useCases.ProcessAlert.SetOnCallLookup(app.clients.PagerDuty, app.config.PagerDuty.ServiceID)
+	}
+	app.useCases.ListAlerts.SetSeverityWeights(severityWeights(app.config.Alerting.PriorityWeights))
+	app.useCases.SyncAck.SetAuditLogger(app.clients.AuditLogger())
+	app.useCases.ExpireSilence.SetAuditLogger(app.clients.AuditLogger())
+
+	app.useCases.Acknowledge = ack.NewAcknowledgeUseCase(app.alertRepo, app.useCases.SyncAck, logger)
+	if app.clients.Slack != nil {
+		app.useCases.Acknowledge.SetSlackUpdater(app.clients.Slack)
+	}
+	app.useCases.Assign = alert.NewAssignUseCase(app.alertRepo, logger)
+	if app.clients.Slack != nil {
+		app.useCases.Assign.SetSlackUpdater(app.clients.Slack)
+	}
+
+	if app.config.Alerting.Outbox.Enabled {
+		app.useCases.ProcessAlert.SetOutbox(app.outboxRepo, app.txManager)
+
+		dispatcher := outbox.NewDispatcher(app.outboxRepo, app.alertRepo, app.useCases.ProcessAlert, logger)
+		dispatcher.SetPollInterval(app.config.Alerting.Outbox.PollInterval)
+		dispatcher.SetMaxAttempts(app.config.Alerting.Outbox.MaxAttempts)
+		dispatcher.SetRetryBackoff(app.config.Alerting.Outbox.RetryBackoff)
+		app.useCases.OutboxDispatcher = dispatcher
+	}
+
+	if app.clients.Alertmanager != nil {
+		silenceSync := silence.NewSyncAlertmanagerSilencesUseCase(app.clients.Alertmanager, app.silenceRepo, logger)
+		silenceSync.SetInterval(app.config.Alertmanager.SilenceSyncInterval)
+		silenceSync.SetTenantLabel(app.config.Alerting.TenantLabel)
+		app.useCases.SilenceSync = silenceSync
+	}
+
+	if len(app.config.Alerting.Routes) > 0 {
+		routes := make([]alert.Route, 0, len(app.config.Alerting.Routes))
+		for _, route := range app.config.Alerting.Routes {
+			routes = append(routes, alert.Route{
+				Match:     route.Match,
+				MatchRE:   route.MatchRE,
+				Notifiers: route.Notifiers,
+			})
+		}
+		router, err := alert.NewRouter(routes)
+		if err != nil {
+			return fmt.Errorf("creating notifier router: %w", err)
+		}
+		app.useCases.ProcessAlert.SetRouter(router)
+	}
+
+	if len(app.config.Alerting.Dependencies) > 0 {
+		dependencies := make([]alert.Dependency, 0, len(app.config.Alerting.Dependencies))
+		for _, dependency := range app.config.Alerting.Dependencies {
+			dependencies = append(dependencies, alert.Dependency{
+				Parent:   dependency.Parent,
+				Children: dependency.Children,
+				Equal:    dependency.Equal,
+			})
+		}
+		app.useCases.ProcessAlert.SetDependencySuppressor(alert.NewDependencySuppressor(app.alertRepo, dependencies))
+	}
+	app.useCases.ProcessAlert.SetAutoResolveAfter(app.config.Alerting.AutoResolveAfter)
+	if app.config.Alerting.AckExpiry.Enabled {
+		app.useCases.ProcessAlert.SetAckExpiry(app.ackEventRepo, app.config.Alerting.AckExpiry.DefaultDuration)
+		if app.clients.Slack != nil {
+			app.useCases.ProcessAlert.SetThreadReplier(app.clients.Slack)
+		}
+	}
+	if limit := app.config.Alerting.MaxMessagesPerWindow; limit > 0 {
+		// Without Slack the overflow is only logged
+		var overflow alert.OverflowNotifier
+		if app.clients.Slack != nil {
+			overflow = app.clients.Slack
+		}
+		guard := alert.NewBurstGuard(limit, app.config.Alerting.MessageWindow)
+		app.useCases.ProcessAlert.SetBurstGuard(guard, overflow)
+	}
+
+	if quietHours := app.config.Alerting.QuietHours; quietHours.Enabled() {
+		location, err := time.LoadLocation(quietHours.Timezone)
+		if err != nil {
+			return fmt.Errorf("loading quiet hours timezone: %w", err)
+		}
+		severities := make([]entity.AlertSeverity, 0, len(quietHours.Severities))
+		for _, severity := range quietHours.Severities {
+			severities = append(severities, entity.AlertSeverity(severity))
+		}
+		window, err := alert.NewQuietHours(quietHours.Start, quietHours.End, location, severities)
+		if err != nil {
+			return fmt.Errorf("creating quiet hours: %w", err)
+		}
+		app.useCases.ProcessAlert.SetQuietHours(window)
+		if quietHours.Digest && app.clients.Slack != nil {
+			app.useCases.ProcessAlert.SetQuietHoursDigest(app.clients.Slack)
+		}
+	}
+
+	if digest := app.config.Alerting.Digest; digest.Enabled && app.clients.Slack != nil {
+		severities := make([]entity.AlertSeverity, 0, len(digest.Severities))
+		for _, severity := range digest.Severities {
+			severities = append(severities, entity.AlertSeverity(severity))
+		}
+		app.useCases.Digest = alert.NewDigestUseCase(app.alertRepo, app.clients.Slack, logger)
+		app.useCases.Digest.SetInterval(digest.Interval)
+		app.useCases.Digest.SetSeverities(severities)
+		app.useCases.Digest.SetLock(app.idempotency)
+		if digest.Only {
+			app.useCases.ProcessAlert.SetDigestOnly(severities)
+		}
+	}
+
+	if app.config.Slack.AppHome.Enabled && app.clients.Slack != nil {
+		app.useCases.AppHome = slackUseCase.NewAppHomeUseCase(app.alertRepo, app.clients.Slack, logger)
+		app.useCases.AppHome.SetInterval(app.config.Slack.AppHome.RefreshInterval)
+		if len(app.config.Slack.TenantChannels) > 0 {
+			app.useCases.AppHome.SetViewerTenants(app.clients.Slack)
+		}
+	}
+
+	if len(app.config.Alerting.SeverityMap) > 0 {
+		enricher := alert.NewSeverityEnricher(severityMap(app.config.Alerting.SeverityMap))
+		app.useCases.ProcessAlert.AddEnricher(enricher)
+		app.useCases.PreviewAlert.AddEnricher(enricher)
+	}
+
+	if app.config.Alerting.RunbookBaseURL != "" {
+		enricher, err := alert.NewRunbookEnricher(
+			app.config.Alerting.RunbookBaseURL,
+			app.config.Alerting.RunbookURLTemplate,
+		)
+		if err != nil {
+			return fmt.Errorf("creating runbook enricher: %w", err)
+		}
+		app.useCases.ProcessAlert.AddEnricher(enricher)
+		app.useCases.PreviewAlert.AddEnricher(enricher)
+	}
+
 	return nil
 }
 
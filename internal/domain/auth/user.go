@@ -0,0 +1,25 @@
+// Package auth carries the identity of authenticated API callers.
+package auth
+
+import "context"
+
+// User is the authenticated caller of an API request.
+type User struct {
+	ID    string // Subject of the credential, e.g. the JWT "sub" claim
+	Email string
+	Name  string
+}
+
+// userKey is the context key of the authenticated user.
+type userKey struct{}
+
+// NewContextWithUser returns a copy of ctx carrying the authenticated user.
+func NewContextWithUser(ctx context.Context, user User) context.Context {
+	return context.WithValue(ctx, userKey{}, user)
+}
+
+// UserFromContext returns the authenticated user of ctx and whether there is one.
+func UserFromContext(ctx context.Context) (User, bool) {
+	user, ok := ctx.Value(userKey{}).(User)
+	return user, ok
+}
@@ -13,6 +13,9 @@ const (
 	AckSourceSlack     AckSource = "slack"
 	AckSourcePagerDuty AckSource = "pagerduty"
 	AckSourceAPI       AckSource = "api"
+
+	// AckSourceAlertmanager marks silences imported from Alertmanager.
+	AckSourceAlertmanager AckSource = "alertmanager"
 )
 
 // AckEvent represents an acknowledgment action on an alert.
@@ -44,6 +47,9 @@ type AckEvent struct {
 
 	// CreatedAt is when the ack event was created.
 	CreatedAt time.Time
+
+	// TenantID is the tenant of the acknowledged alert, empty for the default tenant.
+	TenantID string
 }
 
 // NewAckEvent creates a new acknowledgment event.
@@ -59,6 +65,12 @@ func NewAckEvent(alertID string, source AckSource, userID, userEmail, userName s
 	}
 }
 
+// WithTenant sets the tenant of the event and returns the event.
+func (e *AckEvent) WithTenant(tenantID string) *AckEvent {
+	e.TenantID = tenantID
+	return e
+}
+
 // WithNote adds an optional note to the ack event and returns the event.
 func (e *AckEvent) WithNote(note string) *AckEvent {
 	e.Note = note
@@ -81,6 +93,19 @@ func (e *AckEvent) HasNote() bool {
 	return e.Note != ""
 }
 
+// Actor names the user behind the event, preferring the email used for
+// cross-platform correlation.
+func (e *AckEvent) Actor() string {
+	switch {
+	case e.UserEmail != "":
+		return e.UserEmail
+	case e.UserName != "":
+		return e.UserName
+	default:
+		return e.UserID
+	}
+}
+
 // IsFromSlack returns true if the ack originated from Slack.
 func (e *AckEvent) IsFromSlack() bool {
 	return e.Source == AckSourceSlack
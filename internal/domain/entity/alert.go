@@ -1,6 +1,12 @@
 package entity
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -24,6 +30,34 @@ const (
 	StateResolved AlertState = "resolved"
 )
 
+// StateTransition records a change of an alert's lifecycle state.
+type StateTransition struct {
+	// State is the state the alert moved into.
+	State AlertState
+
+	// At is when the transition happened.
+	At time.Time
+
+	// By identifies the user or system that made the change.
+	By string
+}
+
+// Assignment records a change of who is responsible for an alert.
+type Assignment struct {
+	// To is the assignee after the change, empty if the alert was unassigned.
+	To string
+
+	// At is when the change happened.
+	At time.Time
+
+	// By identifies the user or system that made the change.
+	By string
+}
+
+// maxAssignments is how many assignment changes an alert keeps; older ones
+// are dropped.
+const maxAssignments = 50
+
 // Alert represents a monitored event that requires attention.
 // This is the core domain entity - pure business logic, no infrastructure dependencies.
 type Alert struct {
@@ -61,7 +95,9 @@ type Alert struct {
 	Annotations map[string]string
 
 	// ExternalReferences stores integration-specific message/incident IDs.
-	// Keys: "slack", "pagerduty", "discord", etc.
+	// Keys: "slack", "pagerduty", "discord", etc. A value may hold several
+	// IDs joined by ReferenceSeparator when a system keeps more than one copy
+	// (e.g. one Slack message per channel).
 	ExternalReferences map[string]string
 
 	// FiredAt is when the alert first fired.
@@ -81,6 +117,162 @@ type Alert struct {
 
 	// UpdatedAt is when this record was last updated.
 	UpdatedAt time.Time
+
+	// UpdatedBy identifies the user or system behind the last state change.
+	UpdatedBy string
+
+	// LastSeenAt is when the source last reported the alert firing, i.e. its
+	// creation or latest re-fire. Re-fires are only recorded while
+	// auto-resolution is enabled.
+	LastSeenAt time.Time
+
+	// LastTransition is the most recent state change, nil if the alert never transitioned.
+	LastTransition *StateTransition
+
+	// CorrelationID groups alerts that share the configured correlation
+	// labels, empty if correlation is off or the alert lacks those labels.
+	CorrelationID string
+
+	// TenantID is the team or tenant owning the alert, empty for the default
+	// tenant. Tenant-scoped reads only see alerts of their own tenant.
+	TenantID string
+
+	// AssignedTo identifies the responder who owns the alert, usually by
+	// email, empty if nobody does.
+	AssignedTo string
+
+	// Assignments lists the changes of AssignedTo, oldest first.
+	Assignments []Assignment
+
+	// Version is the stored revision used for optimistic locking. Repositories
+	// set it on save and read, and reject updates made from a stale revision.
+	// Zero means the alert was not loaded from a repository and skips the check.
+	Version int
+}
+
+// PagerDutyIncidentReference is the ExternalReferences key holding the
+// PagerDuty incident ID. The "pagerduty" key holds the Events API dedup key;
+// the REST API addresses incidents by ID instead.
+const PagerDutyIncidentReference = "pagerduty_incident"
+
+// PagerDutyURLReference is the ExternalReferences key holding the web URL of
+// the PagerDuty incident, which Slack messages link to.
+const PagerDutyURLReference = "pagerduty_url"
+
+// reopenedKeySeparator separates the original PagerDuty dedup key of an
+// alert from the reactivation time in the key of an incident reopened for it.
+const reopenedKeySeparator = "/reopened-"
+
+// ReopenedDedupKey returns the PagerDuty dedup key of the incident opened
+// again for an alert whose acknowledgment expired at reactivatedAt, given its
+// original dedup key. PagerDuty does not re-open an acknowledged incident
+// when triggered again, so a new incident pages the responders.
+func ReopenedDedupKey(dedupKey string, reactivatedAt time.Time) string {
+	return fmt.Sprintf("%s%s%d", dedupKey, reopenedKeySeparator, reactivatedAt.Unix())
+}
+
+// IsSupersededDedupKey reports whether incidentKey is the dedup key of an
+// incident replaced by current, the alert's dedup key, when it was reopened.
+func IsSupersededDedupKey(current, incidentKey string) bool {
+	if incidentKey == "" || incidentKey == current {
+		return false
+	}
+	base, _, _ := strings.Cut(current, reopenedKeySeparator)
+	keyBase, _, _ := strings.Cut(incidentKey, reopenedKeySeparator)
+	return base == keyBase
+}
+
+// PagerDutyRoutingReference is the ExternalReferences key holding the
+// severity whose routing key the PagerDuty incident was triggered with, so
+// later events reach the same service after the alert's severity changes.
+const PagerDutyRoutingReference = "pagerduty_routing"
+
+// SlackThreadReference is the ExternalReferences key holding the Slack
+// message ID ("channel:ts") a correlated alert is posted under as a thread
+// reply instead of a new top-level message.
+const SlackThreadReference = "slack_thread"
+
+// SilenceReference is the ExternalReferences key holding the ID of the
+// silence created from the alert's Slack message, so the message can offer
+// to delete that silence again.
+const SilenceReference = "silence"
+
+// QuietHoursReference is the ExternalReferences key holding the time
+// (RFC 3339) until which the alert's notifications are held back for quiet
+// hours. It is removed once they are sent.
+const QuietHoursReference = "quiet_hours"
+
+// SuppressedByReference is the ExternalReferences key holding the ID of the
+// firing parent alert the alert depends on. While it is set the alert is
+// only posted to Slack. It is removed once the parent resolves.
+const SuppressedByReference = "suppressed_by"
+
+// BurstHeldReference is the ExternalReferences key holding the time
+// (RFC 3339) the alert was held back from chat because too many alerts
+// fired at once. It is removed once the alert is posted.
+const BurstHeldReference = "burst_held"
+
+// RunbookAnnotation is the annotation key holding an alert's runbook link.
+const RunbookAnnotation = "runbook_url"
+
+// alertIDNamespace is the UUIDv5 namespace for deterministic alert IDs.
+var alertIDNamespace = uuid.MustParse("5b0f7c1e-3d2a-4e8b-9f61-a1e2b3c4d5e6")
+
+// DeterministicAlertID derives a stable alert ID from the fingerprint and the
+// second the alert fired, so every instance receiving the same firing event
+// computes the same ID.
+func DeterministicAlertID(fingerprint string, firedAt time.Time) string {
+	key := fingerprint + "|" + firedAt.UTC().Truncate(time.Second).Format(time.RFC3339)
+	return uuid.NewSHA1(alertIDNamespace, []byte(key)).String()
+}
+
+// IdentityKey derives the key identifying repeated deliveries of the alert
+// from the values of the given labels, regardless of their order, so changes
+// to other labels do not make it a new alert. Returns the fingerprint if no
+// labels are given or the alert lacks any of them.
+func (a *Alert) IdentityKey(labels []string) string {
+	if len(labels) == 0 {
+		return a.Fingerprint
+	}
+
+	sorted := slices.Clone(labels)
+	slices.Sort(sorted)
+
+	hash := sha256.New()
+	for _, label := range sorted {
+		value := a.GetLabel(label)
+		if value == "" {
+			return a.Fingerprint
+		}
+		hash.Write([]byte(label + "=" + value + "\n"))
+	}
+	// 16 hex digits, the length of an Alertmanager fingerprint
+	return hex.EncodeToString(hash.Sum(nil))[:16]
+}
+
+// correlationNamespace is the UUIDv5 namespace for correlation IDs.
+var correlationNamespace = uuid.MustParse("8d4e2a6f-71c3-4b95-a0de-3f5c9e1b7a24")
+
+// CorrelationKey derives the correlation ID shared by every alert with the
+// same values for the given labels, regardless of their order. Returns an
+// empty string if no labels are given or the alert lacks any of them.
+func (a *Alert) CorrelationKey(labels []string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	sorted := slices.Clone(labels)
+	slices.Sort(sorted)
+
+	var key strings.Builder
+	for _, label := range sorted {
+		value := a.GetLabel(label)
+		if value == "" {
+			return ""
+		}
+		key.WriteString(label + "=" + value + "\n")
+	}
+	return uuid.NewSHA1(correlationNamespace, []byte(key.String())).String()
 }
 
 // NewAlert creates a new Alert with the given parameters.
@@ -101,32 +293,179 @@ func NewAlert(fingerprint, name, instance, target, summary string, severity Aler
 		FiredAt:            now,
 		CreatedAt:          now,
 		UpdatedAt:          now,
+		LastSeenAt:         now,
 	}
 }
 
+// MarkSeen records that the source reported the alert firing again at the given time.
+func (a *Alert) MarkSeen(at time.Time) {
+	a.LastSeenAt = at
+}
+
+// alertTransitions lists the states each state may change to. Resolved is
+// final: an alert firing again after its resolution is a new alert.
+var alertTransitions = map[AlertState][]AlertState{
+	StateActive:   {StateAcked, StateResolved},
+	StateAcked:    {StateActive, StateResolved},
+	StateResolved: nil,
+}
+
+// TransitionError reports a state change the alert's current state does not
+// allow, e.g. a replayed webhook acknowledging a resolved alert. It matches
+// ErrAlertAlreadyResolved, ErrAlertAlreadyAcked or ErrInvalidAlertState.
+type TransitionError struct {
+	Action string // acknowledge, reactivate or resolve
+	From   AlertState
+	Err    error
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("cannot %s %s alert: %v", e.Action, e.From, e.Err)
+}
+
+func (e *TransitionError) Unwrap() error {
+	return e.Err
+}
+
+// CanTransitionTo reports whether the alert may change to state.
+func (a *Alert) CanTransitionTo(state AlertState) bool {
+	return slices.Contains(alertTransitions[a.State], state)
+}
+
+// checkTransition returns a TransitionError unless the alert may change to state.
+func (a *Alert) checkTransition(action string, state AlertState) error {
+	if a.CanTransitionTo(state) {
+		return nil
+	}
+	err := ErrInvalidAlertState
+	switch a.State {
+	case StateResolved:
+		err = ErrAlertAlreadyResolved
+	case StateAcked:
+		if state == StateAcked {
+			err = ErrAlertAlreadyAcked
+		}
+	}
+	return &TransitionError{Action: action, From: a.State, Err: err}
+}
+
 // Acknowledge marks the alert as acknowledged.
 // Returns ErrAlertAlreadyResolved if the alert is already resolved.
 // Returns ErrAlertAlreadyAcked if the alert is already acknowledged.
 func (a *Alert) Acknowledge(by string, at time.Time) error {
-	if a.State == StateResolved {
-		return ErrAlertAlreadyResolved
-	}
-	if a.State == StateAcked {
-		return ErrAlertAlreadyAcked
+	if err := a.checkTransition("acknowledge", StateAcked); err != nil {
+		return err
 	}
 
 	a.State = StateAcked
 	a.AckedAt = &at
 	a.AckedBy = by
-	a.UpdatedAt = at
+	a.recordTransition(by, at)
+	return nil
+}
+
+// Reactivate returns an acknowledged alert to active, e.g. when its
+// acknowledgment expired while the alert kept firing.
+// Returns ErrAlertAlreadyResolved if the alert is resolved and
+// ErrInvalidAlertState if it is already active.
+func (a *Alert) Reactivate(by string, at time.Time) error {
+	if err := a.checkTransition("reactivate", StateActive); err != nil {
+		return err
+	}
+
+	a.State = StateActive
+	a.AckedAt = nil
+	a.AckedBy = ""
+	a.recordTransition(by, at)
 	return nil
 }
 
 // Resolve marks the alert as resolved.
-func (a *Alert) Resolve(at time.Time) {
+// by identifies who resolved it (e.g., "alertmanager" or a user's email).
+// Returns ErrAlertAlreadyResolved if the alert is already resolved, so a
+// replayed resolution keeps the original resolver and time.
+func (a *Alert) Resolve(by string, at time.Time) error {
+	if err := a.checkTransition("resolve", StateResolved); err != nil {
+		return err
+	}
+
 	a.State = StateResolved
 	a.ResolvedAt = &at
+	a.recordTransition(by, at)
+	return nil
+}
+
+// ChangeSeverity sets a new severity, e.g. when a firing alert re-fires at a
+// different level. Returns false if the severity is unchanged.
+func (a *Alert) ChangeSeverity(severity AlertSeverity, at time.Time) bool {
+	if a.Severity == severity {
+		return false
+	}
+	a.Severity = severity
+	a.UpdatedAt = at
+	return true
+}
+
+// Assign makes assignee responsible for the alert, replacing any previous
+// assignee, or unassigns the alert if assignee is empty. The change is
+// recorded in Assignments. Returns false if the alert already has that
+// assignee, and ErrAlertAlreadyResolved when assigning a resolved alert;
+// resolved alerts can still be unassigned.
+func (a *Alert) Assign(assignee, by string, at time.Time) (bool, error) {
+	if a.AssignedTo == assignee {
+		return false, nil
+	}
+	if assignee != "" && a.IsResolved() {
+		return false, ErrAlertAlreadyResolved
+	}
+
+	a.AssignedTo = assignee
+	// Clipped so copies of the alert sharing the history never see the append
+	a.Assignments = append(slices.Clip(a.Assignments), Assignment{To: assignee, At: at, By: by})
+	if len(a.Assignments) > maxAssignments {
+		a.Assignments = slices.Clone(a.Assignments[len(a.Assignments)-maxAssignments:])
+	}
+	a.UpdatedAt = at
+	return true, nil
+}
+
+// ReactivatedAt returns when the alert returned to active after its
+// acknowledgment expired, or nil if it is not active again since then.
+func (a *Alert) ReactivatedAt() *time.Time {
+	if !a.IsActive() || a.LastTransition == nil || a.LastTransition.State != StateActive {
+		return nil
+	}
+	return &a.LastTransition.At
+}
+
+// Clone returns a deep copy of the alert that shares no maps, slices or
+// pointers with it, so changing one never changes the other.
+func (a *Alert) Clone() *Alert {
+	clone := *a
+	clone.Labels = maps.Clone(a.Labels)
+	clone.Annotations = maps.Clone(a.Annotations)
+	clone.ExternalReferences = maps.Clone(a.ExternalReferences)
+	clone.Assignments = slices.Clone(a.Assignments)
+	if a.AckedAt != nil {
+		ackedAt := *a.AckedAt
+		clone.AckedAt = &ackedAt
+	}
+	if a.ResolvedAt != nil {
+		resolvedAt := *a.ResolvedAt
+		clone.ResolvedAt = &resolvedAt
+	}
+	if a.LastTransition != nil {
+		transition := *a.LastTransition
+		clone.LastTransition = &transition
+	}
+	return &clone
+}
+
+// recordTransition stamps the current state change with its actor and time.
+func (a *Alert) recordTransition(by string, at time.Time) {
 	a.UpdatedAt = at
+	a.UpdatedBy = by
+	a.LastTransition = &StateTransition{State: a.State, At: at, By: by}
 }
 
 // IsActive returns true if the alert is in active state.
@@ -174,6 +513,15 @@ func (a *Alert) SetExternalReference(system, referenceID string) {
 	a.UpdatedAt = time.Now().UTC()
 }
 
+// RemoveExternalReference removes the external reference for a system.
+func (a *Alert) RemoveExternalReference(system string) {
+	if _, ok := a.ExternalReferences[system]; !ok {
+		return
+	}
+	delete(a.ExternalReferences, system)
+	a.UpdatedAt = time.Now().UTC()
+}
+
 // GetExternalReference returns the external reference ID for a system.
 func (a *Alert) GetExternalReference(system string) string {
 	if a.ExternalReferences == nil {
@@ -187,6 +535,44 @@ func (a *Alert) HasExternalReference(system string) bool {
 	return a.GetExternalReference(system) != ""
 }
 
+// ExternalReferenceIDs returns every reference ID stored for a system.
+func (a *Alert) ExternalReferenceIDs(system string) []string {
+	return SplitReferenceIDs(a.GetExternalReference(system))
+}
+
+// ReferenceSeparator separates multiple reference IDs stored for one system.
+const ReferenceSeparator = ","
+
+// JoinReferenceIDs combines reference IDs into a single stored value.
+func JoinReferenceIDs(ids []string) string {
+	return strings.Join(ids, ReferenceSeparator)
+}
+
+// SplitReferenceIDs splits a stored reference value into its individual IDs.
+func SplitReferenceIDs(value string) []string {
+	if value == "" {
+		return nil
+	}
+	var ids []string
+	for _, id := range strings.Split(value, ReferenceSeparator) {
+		if id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// ReferenceLookupIDs returns the IDs a stored reference value is looked up
+// by: each of its reference IDs, and the whole value when it holds several,
+// so IDs containing the separator still match.
+func ReferenceLookupIDs(value string) []string {
+	ids := SplitReferenceIDs(value)
+	if len(ids) > 1 {
+		ids = append(ids, value)
+	}
+	return ids
+}
+
 // GetLabel returns the value of a label, or empty string if not found.
 func (a *Alert) GetLabel(key string) string {
 	if a.Labels == nil {
@@ -195,6 +581,17 @@ func (a *Alert) GetLabel(key string) string {
 	return a.Labels[key]
 }
 
+// HasLabels reports whether the alert carries every matcher label with the
+// given value.
+func (a *Alert) HasLabels(matchers map[string]string) bool {
+	for key, value := range matchers {
+		if actual, ok := a.Labels[key]; !ok || actual != value {
+			return false
+		}
+	}
+	return true
+}
+
 // GetAnnotation returns the value of an annotation, or empty string if not found.
 func (a *Alert) GetAnnotation(key string) string {
 	if a.Annotations == nil {
@@ -1,5 +1,7 @@
 package entity
 
+import "time"
+
 // AlertSummary holds aggregated statistics about alerts.
 type AlertSummary struct {
 	// TotalAlerts is the total number of non-resolved alerts.
@@ -30,6 +32,21 @@ type UserAckCount struct {
 	Count int
 }
 
+// StateSeverityCount is the number of stored alerts in one state and severity.
+type StateSeverityCount struct {
+	// State is the alert state counted.
+	State AlertState
+
+	// Severity is the alert severity counted.
+	Severity AlertSeverity
+
+	// Count is the number of alerts in the state and severity.
+	Count int
+
+	// OldestFiredAt is the earliest fire time among the counted alerts.
+	OldestFiredAt time.Time
+}
+
 // NewAlertSummary creates an empty alert summary.
 func NewAlertSummary() *AlertSummary {
 	return &AlertSummary{
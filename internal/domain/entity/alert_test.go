@@ -0,0 +1,202 @@
+package entity
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlert_Transitions(t *testing.T) {
+	firedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	at := firedAt.Add(time.Hour)
+
+	// newAlertIn returns an alert brought into state by valid transitions
+	newAlertIn := func(t *testing.T, state AlertState) *Alert {
+		alert := NewAlert("fp", "High CPU", "host-1", "", "", SeverityCritical)
+		alert.FiredAt = firedAt
+		switch state {
+		case StateAcked:
+			require.NoError(t, alert.Acknowledge("first@example.com", firedAt))
+		case StateResolved:
+			require.NoError(t, alert.Resolve("alertmanager", firedAt))
+		}
+		return alert
+	}
+
+	actions := map[string]func(*Alert) error{
+		"acknowledge": func(a *Alert) error { return a.Acknowledge("oncall@example.com", at) },
+		"reactivate":  func(a *Alert) error { return a.Reactivate("ack-expiry", at) },
+		"resolve":     func(a *Alert) error { return a.Resolve("oncall@example.com", at) },
+	}
+
+	tests := []struct {
+		from    AlertState
+		action  string
+		want    AlertState
+		wantErr error
+	}{
+		{StateActive, "acknowledge", StateAcked, nil},
+		{StateActive, "reactivate", StateActive, ErrInvalidAlertState},
+		{StateActive, "resolve", StateResolved, nil},
+		{StateAcked, "acknowledge", StateAcked, ErrAlertAlreadyAcked},
+		{StateAcked, "reactivate", StateActive, nil},
+		{StateAcked, "resolve", StateResolved, nil},
+		{StateResolved, "acknowledge", StateResolved, ErrAlertAlreadyResolved},
+		{StateResolved, "reactivate", StateResolved, ErrAlertAlreadyResolved},
+		{StateResolved, "resolve", StateResolved, ErrAlertAlreadyResolved},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.action+" "+string(tt.from), func(t *testing.T) {
+			alert := newAlertIn(t, tt.from)
+			before := *alert
+			assert.Equal(t, tt.wantErr == nil, alert.CanTransitionTo(tt.want))
+
+			err := actions[tt.action](alert)
+			assert.Equal(t, tt.want, alert.State)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				var transitionErr *TransitionError
+				require.ErrorAs(t, err, &transitionErr)
+				assert.Equal(t, tt.action, transitionErr.Action)
+				assert.Equal(t, tt.from, transitionErr.From)
+				assert.Contains(t, err.Error(), "cannot "+tt.action)
+
+				// A rejected transition leaves the alert untouched
+				assert.Equal(t, before, *alert)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, alert.LastTransition)
+			assert.Equal(t, tt.want, alert.LastTransition.State)
+			assert.Equal(t, at, alert.LastTransition.At)
+			assert.Equal(t, at, alert.UpdatedAt)
+		})
+	}
+}
+
+func TestAlert_TransitionSideEffects(t *testing.T) {
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	alert := NewAlert("fp", "High CPU", "host-1", "", "", SeverityCritical)
+
+	require.NoError(t, alert.Acknowledge("oncall@example.com", at))
+	require.NotNil(t, alert.AckedAt)
+	assert.Equal(t, "oncall@example.com", alert.AckedBy)
+
+	// Reactivation clears the acknowledgment
+	require.NoError(t, alert.Reactivate("ack-expiry", at.Add(time.Hour)))
+	assert.Nil(t, alert.AckedAt)
+	assert.Empty(t, alert.AckedBy)
+
+	// A replayed resolution keeps the original resolver and time
+	require.NoError(t, alert.Resolve("alertmanager", at.Add(2*time.Hour)))
+	require.Error(t, alert.Resolve("pagerduty", at.Add(3*time.Hour)))
+	require.NotNil(t, alert.ResolvedAt)
+	assert.Equal(t, at.Add(2*time.Hour), *alert.ResolvedAt)
+	assert.Equal(t, "alertmanager", alert.LastTransition.By)
+}
+
+func TestAlert_Assign(t *testing.T) {
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	alert := NewAlert("fp", "High CPU", "host-1", "", "", SeverityCritical)
+
+	changed, err := alert.Assign("jane@example.com", "lead@example.com", at)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, "jane@example.com", alert.AssignedTo)
+	assert.Equal(t, at, alert.UpdatedAt)
+
+	// Assigning the same responder again changes nothing
+	changed, err = alert.Assign("jane@example.com", "lead@example.com", at.Add(time.Minute))
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	// Reassignment and unassignment are recorded in order
+	_, err = alert.Assign("bob@example.com", "jane@example.com", at.Add(2*time.Minute))
+	require.NoError(t, err)
+	_, err = alert.Assign("", "bob@example.com", at.Add(3*time.Minute))
+	require.NoError(t, err)
+	assert.Empty(t, alert.AssignedTo)
+	assert.Equal(t, []Assignment{
+		{To: "jane@example.com", At: at, By: "lead@example.com"},
+		{To: "bob@example.com", At: at.Add(2 * time.Minute), By: "jane@example.com"},
+		{To: "", At: at.Add(3 * time.Minute), By: "bob@example.com"},
+	}, alert.Assignments)
+
+	// Resolved alerts can be unassigned but not assigned
+	require.NoError(t, alert.Resolve("alertmanager", at.Add(time.Hour)))
+	_, err = alert.Assign("jane@example.com", "lead@example.com", at.Add(2*time.Hour))
+	assert.ErrorIs(t, err, ErrAlertAlreadyResolved)
+	assert.Empty(t, alert.AssignedTo)
+
+	// Only the latest changes are kept
+	alert = NewAlert("fp", "High CPU", "host-1", "", "", SeverityCritical)
+	for i := range maxAssignments + 10 {
+		_, err := alert.Assign(fmt.Sprintf("responder-%d", i), "lead", at.Add(time.Duration(i)*time.Second))
+		require.NoError(t, err)
+	}
+	assert.Len(t, alert.Assignments, maxAssignments)
+	assert.Equal(t, "responder-10", alert.Assignments[0].To)
+}
+
+func TestAlert_ReopenedDedupKey(t *testing.T) {
+	at := time.Date(2025, 1, 2, 3, 0, 0, 0, time.UTC)
+	alert := NewAlert("fp", "High CPU", "host-1", "", "", SeverityCritical)
+	assert.Nil(t, alert.ReactivatedAt(), "never acknowledged")
+
+	require.NoError(t, alert.Acknowledge("alice@example.com", at))
+	assert.Nil(t, alert.ReactivatedAt())
+	require.NoError(t, alert.Reactivate("ack-expiry", at.Add(time.Hour)))
+	require.NotNil(t, alert.ReactivatedAt())
+	assert.Equal(t, at.Add(time.Hour), *alert.ReactivatedAt())
+
+	reopened := ReopenedDedupKey("team-a/fp", *alert.ReactivatedAt())
+	assert.Equal(t, "team-a/fp/reopened-1735790400", reopened)
+
+	// Incidents replaced by the reopened one are superseded, others are not
+	assert.True(t, IsSupersededDedupKey(reopened, "team-a/fp"))
+	assert.True(t, IsSupersededDedupKey(reopened, ReopenedDedupKey("team-a/fp", at)))
+	assert.False(t, IsSupersededDedupKey(reopened, reopened))
+	assert.False(t, IsSupersededDedupKey(reopened, ""))
+	assert.False(t, IsSupersededDedupKey(reopened, "team-b/fp"))
+	assert.False(t, IsSupersededDedupKey("team-a/fp", "fp"))
+
+	require.NoError(t, alert.Acknowledge("alice@example.com", at.Add(2*time.Hour)))
+	assert.Nil(t, alert.ReactivatedAt(), "acknowledged again")
+}
+
+func TestAlert_Clone(t *testing.T) {
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	alert := NewAlert("fp", "High CPU", "host-1", "", "", SeverityCritical)
+	alert.AddLabel("team", "infra")
+	alert.AddAnnotation("runbook", "https://runbooks/cpu")
+	alert.SetExternalReference("slack", "C1:1")
+	_, err := alert.Assign("jane@example.com", "lead@example.com", at)
+	require.NoError(t, err)
+	require.NoError(t, alert.Acknowledge("jane@example.com", at))
+	require.NoError(t, alert.Resolve("alertmanager", at.Add(time.Hour)))
+
+	clone := alert.Clone()
+	assert.Equal(t, alert, clone)
+
+	clone.Labels["team"] = "changed"
+	clone.Annotations["runbook"] = "changed"
+	clone.ExternalReferences["slack"] = "changed"
+	clone.Assignments[0].To = "changed"
+	*clone.AckedAt = at.Add(time.Minute)
+	*clone.ResolvedAt = at.Add(time.Minute)
+	clone.LastTransition.By = "changed"
+
+	assert.Equal(t, "infra", alert.Labels["team"])
+	assert.Equal(t, "https://runbooks/cpu", alert.Annotations["runbook"])
+	assert.Equal(t, "C1:1", alert.ExternalReferences["slack"])
+	assert.Equal(t, "jane@example.com", alert.Assignments[0].To)
+	assert.Equal(t, at, *alert.AckedAt)
+	assert.Equal(t, at.Add(time.Hour), *alert.ResolvedAt)
+	assert.Equal(t, "alertmanager", alert.LastTransition.By)
+}
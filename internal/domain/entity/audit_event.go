@@ -0,0 +1,70 @@
+package entity
+
+import "time"
+
+// AuditAction identifies a state-changing action recorded in the audit log.
+type AuditAction string
+
+const (
+	AuditActionAcknowledge   AuditAction = "acknowledge"
+	AuditActionAckExpire     AuditAction = "ack_expire"
+	AuditActionResolve       AuditAction = "resolve"
+	AuditActionSilenceCreate AuditAction = "silence_create"
+	AuditActionSilenceDelete AuditAction = "silence_delete"
+	AuditActionSilenceExpire AuditAction = "silence_expire"
+)
+
+// AuditEvent records who changed an alert or silence, for compliance.
+// Unlike operational logs, audit events are written for every action and
+// never sampled or filtered by level.
+type AuditEvent struct {
+	// Timestamp is when the action happened.
+	Timestamp time.Time
+
+	// Action is what was done.
+	Action AuditAction
+
+	// AlertID references the affected alert; empty for silence actions.
+	AlertID string
+
+	// SilenceID references the affected silence; empty for alert actions.
+	SilenceID string
+
+	// Actor is who performed the action: a user email or name, or the
+	// system that reported it (e.g. "alertmanager").
+	Actor string
+
+	// Source identifies where the action originated (slack, pagerduty, api, alertmanager).
+	Source string
+
+	// Note is an optional comment or reason.
+	Note string
+}
+
+// NewAuditEvent creates an audit event timestamped now.
+func NewAuditEvent(action AuditAction, actor, source string) *AuditEvent {
+	return &AuditEvent{
+		Timestamp: time.Now().UTC(),
+		Action:    action,
+		Actor:     actor,
+		Source:    source,
+	}
+}
+
+// ForAlert sets the affected alert.
+func (e *AuditEvent) ForAlert(alertID string) *AuditEvent {
+	e.AlertID = alertID
+	return e
+}
+
+// ForSilence sets the affected silence.
+func (e *AuditEvent) ForSilence(silenceID string) *AuditEvent {
+	e.SilenceID = silenceID
+	return e
+}
+
+// WithNote adds a note to the audit event.
+func (e *AuditEvent) WithNote(note string) *AuditEvent {
+	e.Note = note
+	return e
+}
@@ -25,8 +25,24 @@ var (
 	// ErrSilenceExpired indicates the silence has already expired.
 	ErrSilenceExpired = errors.New("silence expired")
 
+	// ErrSilenceImported indicates the silence was imported from Alertmanager
+	// and can only be changed there; the next sync would undo local changes.
+	ErrSilenceImported = errors.New("silence is managed by Alertmanager; expire it there")
+
 	// ErrInvalidSilenceDuration indicates an invalid silence duration was provided.
 	ErrInvalidSilenceDuration = errors.New("invalid silence duration")
+
+	// ErrTooManyLabels indicates an alert exceeds the configured label limit.
+	ErrTooManyLabels = errors.New("too many labels")
+
+	// ErrAckNoteNotRecorded indicates an acknowledgment was synced to an
+	// external system but its note could not be added there. Retrying would
+	// acknowledge again, so it is reported rather than retried.
+	ErrAckNoteNotRecorded = errors.New("ack note not recorded")
+
+	// ErrSelfTestNotSupported indicates a notifier's configuration cannot be
+	// verified without sending a notification.
+	ErrSelfTestNotSupported = errors.New("self-test not supported")
 )
 
 // IsNotFound checks if the error indicates a not-found condition.
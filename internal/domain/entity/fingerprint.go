@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash/fnv"
+	"maps"
+	"slices"
+)
+
+// labelSeparator separates label names and values in fingerprints; it
+// cannot occur in valid UTF-8.
+const labelSeparator = 0xff
+
+// ComputeFingerprint derives a stable fingerprint from a label set for
+// sources that do not send one. It uses Alertmanager's algorithm, a 64-bit
+// FNV-1a hash over the labels sorted by name, so an alert gets the same
+// fingerprint whichever source reports it and whatever order its labels
+// arrive in.
+func ComputeFingerprint(labels map[string]string) string {
+	hash := fnv.New64a()
+	for _, name := range slices.Sorted(maps.Keys(labels)) {
+		hash.Write([]byte(name))
+		hash.Write([]byte{labelSeparator})
+		hash.Write([]byte(labels[name]))
+		hash.Write([]byte{labelSeparator})
+	}
+	return fmt.Sprintf("%016x", hash.Sum64())
+}
+
+// ComputeLegacyFingerprint derives the fingerprint earlier releases computed
+// for a label set, a truncated sha256 hash. Alerts stored under it keep being
+// matched until they resolve, instead of firing again under the new
+// fingerprint after an upgrade.
+func ComputeLegacyFingerprint(labels map[string]string) string {
+	hash := sha256.New()
+	for _, name := range slices.Sorted(maps.Keys(labels)) {
+		fmt.Fprintf(hash, "%s=%s\n", name, labels[name])
+	}
+	return hex.EncodeToString(hash.Sum(nil))[:16]
+}
@@ -0,0 +1,40 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeFingerprint(t *testing.T) {
+	// The value Prometheus and Alertmanager compute for this label set
+	labels := map[string]string{"name": "garland, briggs", "fear": "love is not enough"}
+	assert.Equal(t, "507a62d79ee76c9a", ComputeFingerprint(labels))
+
+	// Independent of insertion order
+	reordered := make(map[string]string)
+	reordered["fear"] = "love is not enough"
+	reordered["name"] = "garland, briggs"
+	for range 10 {
+		assert.Equal(t, ComputeFingerprint(labels), ComputeFingerprint(reordered))
+	}
+
+	// Names and values cannot run into each other
+	assert.NotEqual(t,
+		ComputeFingerprint(map[string]string{"ab": "c"}),
+		ComputeFingerprint(map[string]string{"a": "bc"}),
+	)
+	assert.NotEqual(t,
+		ComputeFingerprint(map[string]string{"a": "1"}),
+		ComputeFingerprint(map[string]string{"a": "1", "b": ""}),
+	)
+
+	assert.Equal(t, "cbf29ce484222325", ComputeFingerprint(nil))
+}
+
+func TestComputeLegacyFingerprint(t *testing.T) {
+	// The value earlier releases stored for this label set
+	labels := map[string]string{"name": "garland, briggs", "fear": "love is not enough"}
+	assert.Equal(t, "48f66be4057989b6", ComputeLegacyFingerprint(labels))
+	assert.NotEqual(t, ComputeFingerprint(labels), ComputeLegacyFingerprint(labels))
+}
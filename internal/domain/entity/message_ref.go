@@ -0,0 +1,98 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ErrInvalidMessageRef is returned when a message reference cannot be parsed.
+var ErrInvalidMessageRef = errors.New("invalid message reference")
+
+// messageRefSeparator separates the parts of a MessageRef string.
+const messageRefSeparator = ":"
+
+// MessageRef identifies one copy of a chat message by its channel and
+// timestamp, the way Slack addresses messages. Its string form
+// "channel:ts" is what alerts store in ExternalReferences; several copies
+// are joined with ReferenceSeparator.
+type MessageRef struct {
+	Channel   string
+	Timestamp string
+
+	// ThreadTimestamp is the timestamp of the thread's parent message when
+	// the message is a thread reply. It is not part of stored references,
+	// which identify a message by channel and timestamp alone.
+	ThreadTimestamp string
+}
+
+// String returns "channel:ts", or "channel:ts:thread_ts" for a reply whose
+// thread is known.
+func (r MessageRef) String() string {
+	if r.ThreadTimestamp == "" {
+		return r.Channel + messageRefSeparator + r.Timestamp
+	}
+	return r.Channel + messageRefSeparator + r.Timestamp + messageRefSeparator + r.ThreadTimestamp
+}
+
+// ThreadRoot returns the timestamp replies to this message are posted
+// under: the thread's parent for a reply, or the message itself.
+func (r MessageRef) ThreadRoot() string {
+	if r.ThreadTimestamp != "" {
+		return r.ThreadTimestamp
+	}
+	return r.Timestamp
+}
+
+// WithoutThread returns the reference as stored, without the thread timestamp.
+func (r MessageRef) WithoutThread() MessageRef {
+	r.ThreadTimestamp = ""
+	return r
+}
+
+// ParseMessageRef parses a single "channel:ts" or "channel:ts:thread_ts"
+// reference. Returns ErrInvalidMessageRef if a part is missing or empty.
+func ParseMessageRef(value string) (MessageRef, error) {
+	parts := strings.Split(value, messageRefSeparator)
+	if len(parts) < 2 || len(parts) > 3 || slices.Contains(parts, "") {
+		return MessageRef{}, fmt.Errorf("%w: %q", ErrInvalidMessageRef, value)
+	}
+
+	ref := MessageRef{Channel: parts[0], Timestamp: parts[1]}
+	if len(parts) == 3 {
+		ref.ThreadTimestamp = parts[2]
+	}
+	return ref, nil
+}
+
+// ParseMessageRefs parses a stored reference value holding one or more
+// references joined with ReferenceSeparator. Every reference is parsed;
+// the valid ones are returned along with the errors of the others.
+func ParseMessageRefs(value string) ([]MessageRef, error) {
+	ids := SplitReferenceIDs(value)
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidMessageRef, value)
+	}
+
+	refs := make([]MessageRef, 0, len(ids))
+	var errs []error
+	for _, id := range ids {
+		ref, err := ParseMessageRef(id)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		refs = append(refs, ref)
+	}
+	return refs, errors.Join(errs...)
+}
+
+// JoinMessageRefs combines references into a single stored value.
+func JoinMessageRefs(refs []MessageRef) string {
+	ids := make([]string, len(refs))
+	for i, ref := range refs {
+		ids[i] = ref.String()
+	}
+	return JoinReferenceIDs(ids)
+}
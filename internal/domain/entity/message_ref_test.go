@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageRef_RoundTrip(t *testing.T) {
+	for _, ref := range []MessageRef{
+		{Channel: "C123", Timestamp: "1700000000.000100"},
+		{Channel: "C123", Timestamp: "1700000000.000200", ThreadTimestamp: "1700000000.000100"},
+	} {
+		parsed, err := ParseMessageRef(ref.String())
+		require.NoError(t, err)
+		assert.Equal(t, ref, parsed)
+	}
+
+	refs := []MessageRef{
+		{Channel: "C1", Timestamp: "1700000000.000100"},
+		{Channel: "C2", Timestamp: "1700000000.000200"},
+	}
+	value := JoinMessageRefs(refs)
+	assert.Equal(t, "C1:1700000000.000100,C2:1700000000.000200", value)
+
+	parsed, err := ParseMessageRefs(value)
+	require.NoError(t, err)
+	assert.Equal(t, refs, parsed)
+}
+
+func TestMessageRef_ThreadRoot(t *testing.T) {
+	ref := MessageRef{Channel: "C1", Timestamp: "2.0", ThreadTimestamp: "1.0"}
+	assert.Equal(t, "1.0", ref.ThreadRoot())
+	assert.Equal(t, "C1:2.0", ref.WithoutThread().String())
+	assert.Equal(t, "2.0", ref.WithoutThread().ThreadRoot())
+}
+
+func TestParseMessageRef_Malformed(t *testing.T) {
+	for _, value := range []string{
+		"",
+		"C123",
+		"C123:",
+		":1700000000.000100",
+		"C123:1700000000.000100:",
+		"C123:1:2:3",
+	} {
+		_, err := ParseMessageRef(value)
+		assert.ErrorIs(t, err, ErrInvalidMessageRef, "value %q", value)
+	}
+}
+
+func TestParseMessageRefs_KeepsValidReferences(t *testing.T) {
+	refs, err := ParseMessageRefs("C1:1.0,bogus,C2:2.0")
+	assert.ErrorIs(t, err, ErrInvalidMessageRef)
+	assert.Equal(t, []MessageRef{
+		{Channel: "C1", Timestamp: "1.0"},
+		{Channel: "C2", Timestamp: "2.0"},
+	}, refs)
+
+	_, err = ParseMessageRefs("")
+	assert.ErrorIs(t, err, ErrInvalidMessageRef)
+}
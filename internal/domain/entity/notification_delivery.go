@@ -0,0 +1,73 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DeliveryStatus is the outcome of one notification attempt.
+type DeliveryStatus string
+
+const (
+	// DeliveryStatusSent means the notifier accepted the notification.
+	DeliveryStatusSent DeliveryStatus = "sent"
+
+	// DeliveryStatusFailed means the notifier returned an error.
+	DeliveryStatusFailed DeliveryStatus = "failed"
+)
+
+// NotificationDelivery records the outcome of sending or updating one
+// notification for an alert, so it can be audited whether an alert paged.
+type NotificationDelivery struct {
+	// ID is the unique identifier for this delivery.
+	ID string
+
+	// AlertID references the alert notified about.
+	AlertID string
+
+	// Notifier is the name of the notifier, e.g. "slack" or "pagerduty".
+	Notifier string
+
+	// Action is whether a new notification was sent or an existing one updated.
+	Action OutboxAction
+
+	// Status is the outcome of the attempt.
+	Status DeliveryStatus
+
+	// ExternalReference is the notifier's message ID, e.g. the Slack message
+	// or PagerDuty dedup key. Empty if the attempt failed.
+	ExternalReference string
+
+	// Error is the notifier error of a failed attempt.
+	Error string
+
+	// CreatedAt is when the attempt finished.
+	CreatedAt time.Time
+}
+
+// NewSentDelivery records a notification the notifier accepted.
+func NewSentDelivery(alertID, notifier string, action OutboxAction, externalReference string) *NotificationDelivery {
+	return &NotificationDelivery{
+		ID:                uuid.New().String(),
+		AlertID:           alertID,
+		Notifier:          notifier,
+		Action:            action,
+		Status:            DeliveryStatusSent,
+		ExternalReference: externalReference,
+		CreatedAt:         time.Now().UTC(),
+	}
+}
+
+// NewFailedDelivery records a notification the notifier failed to deliver.
+func NewFailedDelivery(alertID, notifier string, action OutboxAction, err error) *NotificationDelivery {
+	return &NotificationDelivery{
+		ID:        uuid.New().String(),
+		AlertID:   alertID,
+		Notifier:  notifier,
+		Action:    action,
+		Status:    DeliveryStatusFailed,
+		Error:     err.Error(),
+		CreatedAt: time.Now().UTC(),
+	}
+}
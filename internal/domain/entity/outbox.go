@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxAction identifies which notification an outbox entry delivers.
+type OutboxAction string
+
+const (
+	// OutboxActionNotify sends the first notification for a new alert.
+	OutboxActionNotify OutboxAction = "notify"
+
+	// OutboxActionUpdate updates existing notifications after a state change.
+	OutboxActionUpdate OutboxAction = "update"
+)
+
+// OutboxEntry is a pending notification recorded in the same transaction as
+// the alert change that caused it, so it survives a crash before delivery.
+type OutboxEntry struct {
+	// ID is the unique identifier for this entry.
+	ID string
+
+	// AlertID references the alert to notify about.
+	AlertID string
+
+	// Action is the notification to deliver.
+	Action OutboxAction
+
+	// Attempts counts failed delivery attempts.
+	Attempts int
+
+	// LastError is the error of the last failed attempt.
+	LastError string
+
+	// CreatedAt is when the entry was recorded.
+	CreatedAt time.Time
+
+	// SentAt is when the entry was delivered. Nil while pending.
+	SentAt *time.Time
+}
+
+// NewOutboxEntry creates a pending outbox entry for an alert.
+func NewOutboxEntry(alertID string, action OutboxAction) *OutboxEntry {
+	return &OutboxEntry{
+		ID:        uuid.New().String(),
+		AlertID:   alertID,
+		Action:    action,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+// IsPending returns true if the entry has not been delivered yet.
+func (e *OutboxEntry) IsPending() bool {
+	return e.SentAt == nil
+}
@@ -0,0 +1,80 @@
+package entity
+
+import (
+	"sort"
+	"time"
+)
+
+// Alert priority is the severity weight, plus UnackedPriorityBoost while
+// nobody has acknowledged the alert, plus one point per AgePriorityInterval
+// it has been firing, up to MaxAgePriority. With the default weights this
+// ranks by severity, then acknowledgement, then age.
+const (
+	UnackedPriorityBoost = 50
+	AgePriorityInterval  = 10 * time.Minute
+	MaxAgePriority       = 48
+)
+
+// SeverityWeights is the base priority of each severity. Severities not
+// listed weigh 0.
+type SeverityWeights map[AlertSeverity]int
+
+// DefaultSeverityWeights returns the built-in severity weights.
+func DefaultSeverityWeights() SeverityWeights {
+	return SeverityWeights{
+		SeverityCritical: 300,
+		SeverityWarning:  200,
+		SeverityInfo:     100,
+	}
+}
+
+// Severities returns the weighted severities in a stable order, for
+// building queries from the weights.
+func (w SeverityWeights) Severities() []AlertSeverity {
+	severities := make([]AlertSeverity, 0, len(w))
+	for severity := range w {
+		severities = append(severities, severity)
+	}
+	sort.Slice(severities, func(i, j int) bool { return severities[i] < severities[j] })
+	return severities
+}
+
+// rank is the priority without the age term.
+func (w SeverityWeights) rank(alert *Alert) int {
+	rank := w[alert.Severity]
+	if alert.IsActive() {
+		rank += UnackedPriorityBoost
+	}
+	return rank
+}
+
+// SortByPriority orders alerts by severity weight and the unacknowledged
+// boost, highest first, then oldest first. It matches the order of
+// PriorityWith except that age only breaks ties, which lets SQL backends
+// sort the same way without computing ages.
+func (w SeverityWeights) SortByPriority(alerts []*Alert) {
+	sort.SliceStable(alerts, func(i, j int) bool {
+		if ri, rj := w.rank(alerts[i]), w.rank(alerts[j]); ri != rj {
+			return ri > rj
+		}
+		return alerts[i].FiredAt.Before(alerts[j].FiredAt)
+	})
+}
+
+// Priority returns how urgently the alert needs attention, using the
+// default severity weights. Higher is more urgent.
+func (a *Alert) Priority() int {
+	return a.PriorityWith(DefaultSeverityWeights(), time.Now())
+}
+
+// PriorityWith returns the alert's priority at now using the given weights.
+func (a *Alert) PriorityWith(weights SeverityWeights, now time.Time) int {
+	age := int(now.Sub(a.FiredAt) / AgePriorityInterval)
+	if age < 0 {
+		age = 0
+	}
+	if age > MaxAgePriority {
+		age = MaxAgePriority
+	}
+	return weights.rank(a) + age
+}
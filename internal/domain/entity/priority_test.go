@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlert_PriorityWith(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	weights := DefaultSeverityWeights()
+
+	alert := NewAlert("fp", "High CPU", "host-1", "", "", SeverityWarning)
+	alert.FiredAt = now.Add(-35 * time.Minute)
+	assert.Equal(t, 200+UnackedPriorityBoost+3, alert.PriorityWith(weights, now))
+
+	// Age is capped
+	alert.FiredAt = now.Add(-48 * time.Hour)
+	assert.Equal(t, 200+UnackedPriorityBoost+MaxAgePriority, alert.PriorityWith(weights, now))
+
+	require.NoError(t, alert.Acknowledge("oncall@example.com", now))
+	assert.Equal(t, 200+MaxAgePriority, alert.PriorityWith(weights, now))
+
+	// Unweighted severities only get the age term
+	assert.Equal(t, MaxAgePriority, alert.PriorityWith(SeverityWeights{}, now))
+}
+
+func TestSeverityWeights_SortByPriority(t *testing.T) {
+	now := time.Now()
+	newCritical := func(fp string, firedAt time.Time) *Alert {
+		alert := NewAlert(fp, "High CPU", "host-1", "", "", SeverityCritical)
+		alert.FiredAt = firedAt
+		return alert
+	}
+
+	older := newCritical("fp-older", now.Add(-2*time.Hour))
+	newer := newCritical("fp-newer", now.Add(-time.Hour))
+	acked := newCritical("fp-acked", now.Add(-3*time.Hour))
+	require.NoError(t, acked.Acknowledge("oncall@example.com", now))
+	warning := newCritical("fp-warning", now.Add(-4*time.Hour))
+	warning.Severity = SeverityWarning
+
+	alerts := []*Alert{warning, newer, acked, older}
+	DefaultSeverityWeights().SortByPriority(alerts)
+	assert.Equal(t, []*Alert{older, newer, acked, warning}, alerts)
+
+	// The order agrees with the priorities
+	for i := 1; i < len(alerts); i++ {
+		assert.GreaterOrEqual(t, alerts[i-1].Priority(), alerts[i].Priority())
+	}
+}
@@ -0,0 +1,50 @@
+package entity
+
+// SeverityLabel is the alert label holding the incoming severity value.
+const SeverityLabel = "severity"
+
+// PriorityAnnotation is the alert annotation, e.g. priority: P1, whose
+// mapped handling takes precedence over that of the severity label.
+const PriorityAnnotation = "priority"
+
+// SeverityMapping describes how one incoming severity label value is handled.
+type SeverityMapping struct {
+	// Severity is the internal severity the value maps to.
+	Severity AlertSeverity
+
+	// PagerDutySeverity overrides the PagerDuty event severity (optional).
+	PagerDutySeverity string
+
+	// Color overrides the "#RRGGBB" color of firing alert messages (optional).
+	Color string
+}
+
+// SeverityMap maps incoming severity label values (e.g. "page", "ticket") to
+// their handling. Values missing from the map keep the built-in behavior.
+type SeverityMap map[string]SeverityMapping
+
+// Lookup returns the mapping for an incoming severity label value.
+func (m SeverityMap) Lookup(value string) (SeverityMapping, bool) {
+	mapping, ok := m[value]
+	return mapping, ok
+}
+
+// ForAlert returns the mapping for the alert's severity label.
+func (m SeverityMap) ForAlert(alert *Alert) (SeverityMapping, bool) {
+	if alert == nil {
+		return SeverityMapping{}, false
+	}
+	return m.Lookup(alert.GetLabel(SeverityLabel))
+}
+
+// ForPriority returns the mapping for the alert's priority annotation.
+func (m SeverityMap) ForPriority(alert *Alert) (SeverityMapping, bool) {
+	if alert == nil {
+		return SeverityMapping{}, false
+	}
+	priority := alert.GetAnnotation(PriorityAnnotation)
+	if priority == "" {
+		return SeverityMapping{}, false
+	}
+	return m.Lookup(priority)
+}
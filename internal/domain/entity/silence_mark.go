@@ -41,11 +41,19 @@ type SilenceMark struct {
 	// Reason explains why the silence was created.
 	Reason string
 
-	// Source indicates where the silence was created (slack, pagerduty, api).
+	// Source indicates where the silence was created (slack, pagerduty, api,
+	// or alertmanager for silences imported from Alertmanager).
 	Source AckSource
 
 	// CreatedAt is when this record was created.
 	CreatedAt time.Time
+
+	// TenantID is the tenant owning the silence, empty for the default
+	// tenant. A silence only matches alerts of its own tenant.
+	TenantID string
+
+	// Version is the stored revision used for optimistic locking; see Alert.Version.
+	Version int
 }
 
 // NewSilenceMark creates a new silence with the given duration.
@@ -85,6 +93,12 @@ func (s *SilenceMark) ForFingerprint(fingerprint string) *SilenceMark {
 	return s
 }
 
+// ForTenant sets the tenant owning the silence.
+func (s *SilenceMark) ForTenant(tenantID string) *SilenceMark {
+	s.TenantID = tenantID
+	return s
+}
+
 // WithLabel adds a label matcher to the silence.
 func (s *SilenceMark) WithLabel(key, value string) *SilenceMark {
 	if s.Labels == nil {
@@ -158,6 +172,11 @@ func (s *SilenceMark) MatchesAlert(alert *Alert) bool {
 		return false
 	}
 
+	// Tenant silences never cross tenants; global ones apply to every tenant
+	if s.TenantID != "" && s.TenantID != alert.TenantID {
+		return false
+	}
+
 	// Check specific alert ID match
 	if s.AlertID != "" && s.AlertID == alert.ID {
 		return true
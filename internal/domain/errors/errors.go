@@ -3,6 +3,7 @@ package errors
 import (
 	"errors"
 	"fmt"
+	"time"
 )
 
 // Error categories for classification and handling
@@ -60,6 +61,28 @@ func (e *DomainError) WithField(key string, value interface{}) *DomainError {
 	return e
 }
 
+// FieldRetryAfter names the field holding how long a rate-limiting service
+// asked callers to wait before retrying, as a time.Duration.
+const FieldRetryAfter = "retry_after"
+
+// WithRetryAfter records how long to wait before retrying, if positive.
+func (e *DomainError) WithRetryAfter(d time.Duration) *DomainError {
+	if d <= 0 {
+		return e
+	}
+	return e.WithField(FieldRetryAfter, d)
+}
+
+// RetryAfter returns the wait before retrying recorded in err, if any.
+func RetryAfter(err error) (time.Duration, bool) {
+	var domainErr *DomainError
+	if !errors.As(err, &domainErr) {
+		return 0, false
+	}
+	d, ok := domainErr.Fields[FieldRetryAfter].(time.Duration)
+	return d, ok && d > 0
+}
+
 // Constructor functions
 
 // NewValidationError creates a validation error for invalid input
@@ -0,0 +1,17 @@
+package logger
+
+import (
+	"context"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+)
+
+// AuditLogger receives a record of every acknowledgment, silence change and
+// resolution. It is separate from the operational Logger: audit records are
+// kept for compliance and are never filtered by log level.
+//
+// Audit never fails the action being recorded; implementations report their
+// own write failures.
+type AuditLogger interface {
+	Audit(ctx context.Context, event *entity.AuditEvent)
+}
@@ -0,0 +1,61 @@
+package logger
+
+import (
+	"context"
+	"slices"
+)
+
+// RequestIDField is the log field holding the ID of the request being served.
+const RequestIDField = "request_id"
+
+// requestIDKey is the context key of the request ID.
+type requestIDKey struct{}
+
+// NewContextWithRequestID returns a copy of ctx carrying the request ID.
+func NewContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext returns the request ID carried by ctx, or "".
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// WithContext returns l with the request ID of ctx added to every log line,
+// so the lines logged while serving one request can be correlated. Returns
+// l itself if ctx carries no request ID.
+func WithContext(ctx context.Context, l Logger) Logger {
+	requestID := RequestIDFromContext(ctx)
+	if requestID == "" {
+		return l
+	}
+	return With(l, RequestIDField, requestID)
+}
+
+// With returns l with the key-value pairs added to every log line.
+func With(l Logger, keysAndValues ...any) Logger {
+	return &fieldLogger{logger: l, fields: slices.Clip(keysAndValues)}
+}
+
+// fieldLogger prepends fixed key-value pairs to those of each log line.
+type fieldLogger struct {
+	logger Logger
+	fields []any
+}
+
+func (l *fieldLogger) Debug(msg string, keysAndValues ...any) {
+	l.logger.Debug(msg, append(l.fields, keysAndValues...)...)
+}
+
+func (l *fieldLogger) Info(msg string, keysAndValues ...any) {
+	l.logger.Info(msg, append(l.fields, keysAndValues...)...)
+}
+
+func (l *fieldLogger) Warn(msg string, keysAndValues ...any) {
+	l.logger.Warn(msg, append(l.fields, keysAndValues...)...)
+}
+
+func (l *fieldLogger) Error(msg string, keysAndValues ...any) {
+	l.logger.Error(msg, append(l.fields, keysAndValues...)...)
+}
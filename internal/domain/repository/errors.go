@@ -1,6 +1,10 @@
 package repository
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+)
 
 // Common repository errors.
 // These errors provide a consistent error interface across different storage implementations.
@@ -15,3 +19,31 @@ var (
 	// This error signals that a retry is needed.
 	ErrConcurrentUpdate = errors.New("concurrent update detected")
 )
+
+// Entity-specific forms of the common errors, returned by every backend.
+// Each matches both the common error and the older entity-level error with
+// errors.Is, so checks against either keep working.
+var (
+	// ErrAlertNotFound matches ErrNotFound and entity.ErrAlertNotFound.
+	ErrAlertNotFound error = &entityError{common: ErrNotFound, entity: entity.ErrAlertNotFound}
+
+	// ErrDuplicateAlert matches ErrAlreadyExists and entity.ErrDuplicateAlert.
+	ErrDuplicateAlert error = &entityError{common: ErrAlreadyExists, entity: entity.ErrDuplicateAlert}
+
+	// ErrSilenceNotFound matches ErrNotFound and entity.ErrSilenceNotFound.
+	ErrSilenceNotFound error = &entityError{common: ErrNotFound, entity: entity.ErrSilenceNotFound}
+)
+
+// entityError pairs a common repository error with its entity-level counterpart.
+type entityError struct {
+	common error
+	entity error
+}
+
+func (e *entityError) Error() string {
+	return e.entity.Error()
+}
+
+func (e *entityError) Unwrap() []error {
+	return []error{e.common, e.entity}
+}
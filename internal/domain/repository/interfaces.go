@@ -2,22 +2,32 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
 )
 
 // AlertRepository defines the contract for alert persistence.
 // Following ISP: focused on alert storage operations only.
+//
+// All repositories report missing and duplicate entities with errors that
+// match ErrNotFound and ErrAlreadyExists under errors.Is.
 type AlertRepository interface {
 	// Save persists a new alert.
-	// Returns ErrDuplicateAlert if an alert with the same ID already exists.
+	// Returns ErrDuplicateAlert if an alert with the same ID already exists,
+	// or if the alert is firing and another firing alert has its fingerprint.
 	Save(ctx context.Context, alert *entity.Alert) error
 
+	// UpsertByFingerprint atomically saves the alert unless a firing alert
+	// (active or acknowledged) with the same fingerprint already exists.
+	// Returns the stored firing alert and whether it was newly created.
+	UpsertByFingerprint(ctx context.Context, alert *entity.Alert) (*entity.Alert, bool, error)
+
 	// FindByID retrieves an alert by its unique identifier.
 	// Returns nil, nil if not found.
 	FindByID(ctx context.Context, id string) (*entity.Alert, error)
 
-	// FindByFingerprint finds alerts matching the Alertmanager fingerprint.
+	// FindByFingerprint finds alerts matching the Alertmanager fingerprint, newest first.
 	// Returns empty slice if none found.
 	FindByFingerprint(ctx context.Context, fingerprint string) ([]*entity.Alert, error)
 
@@ -30,17 +40,41 @@ type AlertRepository interface {
 	// Returns ErrAlertNotFound if the alert doesn't exist.
 	Update(ctx context.Context, alert *entity.Alert) error
 
-	// FindActive returns all currently active (non-resolved) alerts.
+	// FindActive returns all currently active (non-resolved) alerts,
+	// most recently fired first.
 	FindActive(ctx context.Context) ([]*entity.Alert, error)
 
 	// GetActiveAlerts returns active alerts, optionally filtered by severity.
-	// If severity is empty, returns all active alerts.
+	// If severity is empty, returns all active alerts. Most recently fired first.
 	// Valid severity values: "critical", "warning", "info"
 	GetActiveAlerts(ctx context.Context, severity string) ([]*entity.Alert, error)
 
-	// FindFiring returns all firing alerts (active or acknowledged).
+	// GetActiveAlertsByPriority returns active alerts like GetActiveAlerts,
+	// most urgent first as ordered by SeverityWeights.SortByPriority.
+	GetActiveAlertsByPriority(ctx context.Context, severity string, weights entity.SeverityWeights) ([]*entity.Alert, error)
+
+	// FindFiring returns all firing alerts (active or acknowledged),
+	// most recently fired first.
 	FindFiring(ctx context.Context) ([]*entity.Alert, error)
 
+	// FindFiringByCorrelationID returns the firing alerts sharing a
+	// correlation ID, oldest first. Returns empty slice if none found.
+	FindFiringByCorrelationID(ctx context.Context, correlationID string) ([]*entity.Alert, error)
+
+	// FindNotSeenSince returns the firing alerts last seen before the given
+	// time, least recently seen first. Returns empty slice if none found.
+	FindNotSeenSince(ctx context.Context, before time.Time) ([]*entity.Alert, error)
+
+	// FindByLabels returns the firing alerts (active or acknowledged) carrying
+	// every matcher label with the given value, most recently fired first.
+	// With no matchers it is equivalent to FindFiring.
+	FindByLabels(ctx context.Context, matchers map[string]string) ([]*entity.Alert, error)
+
+	// CountByStateSeverity aggregates stored alerts by state and severity
+	// without loading them. Returns one entry per combination present,
+	// ordered by state then severity, or an empty slice if there are no alerts.
+	CountByStateSeverity(ctx context.Context) ([]*entity.StateSeverityCount, error)
+
 	// Delete removes an alert by ID.
 	// Returns ErrAlertNotFound if the alert doesn't exist.
 	Delete(ctx context.Context, id string) error
@@ -49,9 +83,11 @@ type AlertRepository interface {
 // AckEventRepository stores acknowledgment events for audit trail.
 type AckEventRepository interface {
 	// Save persists a new ack event.
+	// Returns ErrAlertNotFound if the alert doesn't exist (where enforced)
+	// and ErrAlreadyExists if an event with the same ID exists.
 	Save(ctx context.Context, event *entity.AckEvent) error
 
-	// FindByAlertID retrieves all ack events for an alert.
+	// FindByAlertID retrieves all ack events for an alert, oldest first.
 	// Returns empty slice if none found.
 	FindByAlertID(ctx context.Context, alertID string) ([]*entity.AckEvent, error)
 
@@ -72,13 +108,15 @@ type AckEventRepository interface {
 // SilenceRepository stores silence/snooze rules.
 type SilenceRepository interface {
 	// Save persists a new silence.
+	// Returns ErrAlreadyExists if a silence with the same ID exists.
 	Save(ctx context.Context, silence *entity.SilenceMark) error
 
 	// FindByID retrieves a silence by its ID.
 	// Returns nil, nil if not found.
 	FindByID(ctx context.Context, id string) (*entity.SilenceMark, error)
 
-	// FindActive returns all currently active silences.
+	// FindActive returns all currently active silences, newest first.
+	// Like the other finders, returns empty slice if none found.
 	FindActive(ctx context.Context) ([]*entity.SilenceMark, error)
 
 	// FindByAlertID retrieves active silences for a specific alert.
@@ -93,6 +131,9 @@ type SilenceRepository interface {
 	// FindMatchingAlert returns all active silences that match the given alert.
 	FindMatchingAlert(ctx context.Context, alert *entity.Alert) ([]*entity.SilenceMark, error)
 
+	// CountActive returns the number of currently active silences.
+	CountActive(ctx context.Context) (int, error)
+
 	// Update modifies an existing silence.
 	// Returns ErrSilenceNotFound if the silence doesn't exist.
 	Update(ctx context.Context, silence *entity.SilenceMark) error
@@ -105,3 +146,48 @@ type SilenceRepository interface {
 	// Returns the number of deleted silences.
 	DeleteExpired(ctx context.Context) (int, error)
 }
+
+// DeliveryRepository stores the outcome of every notification attempt.
+type DeliveryRepository interface {
+	// Save records a delivery.
+	// Returns ErrAlreadyExists if a delivery with the same ID exists.
+	Save(ctx context.Context, delivery *entity.NotificationDelivery) error
+
+	// FindByAlertID returns the deliveries of an alert, oldest first.
+	// Returns empty slice if none found.
+	FindByAlertID(ctx context.Context, alertID string) ([]*entity.NotificationDelivery, error)
+}
+
+// IdempotencyStore records processed request keys to suppress duplicate deliveries.
+type IdempotencyStore interface {
+	// MarkProcessed atomically records the key for the given TTL.
+	// Returns false if the key was already recorded and has not yet expired.
+	MarkProcessed(ctx context.Context, key string, ttl time.Duration) (bool, error)
+
+	// Release removes the key, so it can be recorded again.
+	// Releasing a key that is not recorded is not an error.
+	Release(ctx context.Context, key string) error
+}
+
+// OutboxRepository stores notifications pending delivery.
+// Save must join a transaction carried by the context so the entry commits
+// together with the alert change that produced it.
+type OutboxRepository interface {
+	// Save records a new pending entry.
+	Save(ctx context.Context, entry *entity.OutboxEntry) error
+
+	// ClaimPending claims and returns up to limit undelivered entries with
+	// fewer than maxAttempts failed attempts, oldest first. A claimed entry
+	// is not returned again, to this or another instance, for the lease
+	// duration, or until the retry time MarkFailed set for it.
+	ClaimPending(ctx context.Context, maxAttempts, limit int, lease time.Duration) ([]*entity.OutboxEntry, error)
+
+	// MarkSent records the entry as delivered.
+	// Returns ErrNotFound if the entry doesn't exist.
+	MarkSent(ctx context.Context, id string, sentAt time.Time) error
+
+	// MarkFailed increments the entry's attempt count, records the error and
+	// keeps the entry claimed until retryAt, when it is pending again.
+	// Returns ErrNotFound if the entry doesn't exist.
+	MarkFailed(ctx context.Context, id string, lastError string, retryAt time.Time) error
+}
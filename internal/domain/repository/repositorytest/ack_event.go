@@ -0,0 +1,80 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+)
+
+// RunAckEventRepository asserts empty results and ordering of an AckEventRepository.
+func RunAckEventRepository(t *testing.T, newRepos Factory) {
+	ctx := context.Background()
+
+	t.Run("no events", func(t *testing.T) {
+		repos := newRepos(t)
+
+		found, err := repos.AckEvent.FindByID(ctx, "missing")
+		require.NoError(t, err)
+		assert.Nil(t, found)
+
+		latest, err := repos.AckEvent.FindLatestByAlertID(ctx, "missing")
+		require.NoError(t, err)
+		assert.Nil(t, latest)
+
+		events, err := repos.AckEvent.FindByAlertID(ctx, "missing")
+		require.NoError(t, err)
+		assert.NotNil(t, events)
+		assert.Empty(t, events)
+
+		top, err := repos.AckEvent.GetTopAcknowledgers(ctx, 5)
+		require.NoError(t, err)
+		assert.NotNil(t, top)
+		assert.Empty(t, top)
+	})
+
+	t.Run("events are oldest first", func(t *testing.T) {
+		repos := newRepos(t)
+
+		alert := newAlert("fp-acks", time.Now())
+		require.NoError(t, repos.Alert.Save(ctx, alert))
+
+		now := time.Now().UTC().Truncate(time.Second)
+		later := newAckEvent(alert.ID, "b@example.com", now)
+		earlier := newAckEvent(alert.ID, "a@example.com", now.Add(-time.Minute))
+		require.NoError(t, repos.AckEvent.Save(ctx, later))
+		require.NoError(t, repos.AckEvent.Save(ctx, earlier))
+
+		events, err := repos.AckEvent.FindByAlertID(ctx, alert.ID)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.Equal(t, earlier.ID, events[0].ID)
+		assert.Equal(t, later.ID, events[1].ID)
+
+		latest, err := repos.AckEvent.FindLatestByAlertID(ctx, alert.ID)
+		require.NoError(t, err)
+		require.NotNil(t, latest)
+		assert.Equal(t, later.ID, latest.ID)
+	})
+
+	t.Run("duplicate event", func(t *testing.T) {
+		repos := newRepos(t)
+
+		alert := newAlert("fp-dup-ack", time.Now())
+		require.NoError(t, repos.Alert.Save(ctx, alert))
+
+		event := newAckEvent(alert.ID, "a@example.com", time.Now().UTC())
+		require.NoError(t, repos.AckEvent.Save(ctx, event))
+		requireAlreadyExists(t, repos.AckEvent.Save(ctx, event), nil)
+	})
+}
+
+func newAckEvent(alertID, email string, createdAt time.Time) *entity.AckEvent {
+	event := entity.NewAckEvent(alertID, entity.AckSourceAPI, email, email, email)
+	event.CreatedAt = createdAt
+	return event
+}
@@ -0,0 +1,494 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+)
+
+// RunAlertRepository asserts error types, empty results, state filtering and
+// ordering of an AlertRepository.
+func RunAlertRepository(t *testing.T, newRepos Factory) {
+	ctx := context.Background()
+
+	t.Run("missing alert", func(t *testing.T) {
+		repo := newRepos(t).Alert
+
+		found, err := repo.FindByID(ctx, "missing")
+		require.NoError(t, err)
+		assert.Nil(t, found)
+
+		found, err = repo.FindByExternalReference(ctx, "slack", "missing")
+		require.NoError(t, err)
+		assert.Nil(t, found)
+
+		err = repo.Update(ctx, newAlert("fp-missing", time.Now()))
+		requireNotFound(t, err, entity.ErrAlertNotFound)
+
+		err = repo.Delete(ctx, "missing")
+		requireNotFound(t, err, entity.ErrAlertNotFound)
+	})
+
+	t.Run("empty results are empty slices", func(t *testing.T) {
+		repo := newRepos(t).Alert
+
+		byFingerprint, err := repo.FindByFingerprint(ctx, "missing")
+		require.NoError(t, err)
+		assert.NotNil(t, byFingerprint)
+		assert.Empty(t, byFingerprint)
+
+		active, err := repo.FindActive(ctx)
+		require.NoError(t, err)
+		assert.NotNil(t, active)
+		assert.Empty(t, active)
+
+		firing, err := repo.FindFiring(ctx)
+		require.NoError(t, err)
+		assert.NotNil(t, firing)
+		assert.Empty(t, firing)
+
+		bySeverity, err := repo.GetActiveAlerts(ctx, string(entity.SeverityCritical))
+		require.NoError(t, err)
+		assert.NotNil(t, bySeverity)
+		assert.Empty(t, bySeverity)
+
+		correlated, err := repo.FindFiringByCorrelationID(ctx, "missing")
+		require.NoError(t, err)
+		assert.NotNil(t, correlated)
+		assert.Empty(t, correlated)
+	})
+
+	t.Run("duplicate alert", func(t *testing.T) {
+		repo := newRepos(t).Alert
+
+		alert := newAlert("fp-dup", time.Now())
+		require.NoError(t, repo.Save(ctx, alert))
+
+		err := repo.Save(ctx, alert)
+		requireAlreadyExists(t, err, entity.ErrDuplicateAlert)
+
+		// Only one alert per fingerprint may be firing
+		err = repo.Save(ctx, newAlert("fp-dup", time.Now()))
+		requireAlreadyExists(t, err, entity.ErrDuplicateAlert)
+	})
+
+	t.Run("update and delete", func(t *testing.T) {
+		repo := newRepos(t).Alert
+
+		alert := newAlert("fp-update", time.Now())
+		require.NoError(t, repo.Save(ctx, alert))
+
+		require.NoError(t, alert.Acknowledge("oncall@example.com", time.Now().UTC()))
+		alert.SetExternalReference("slack", "C1:1700000000.000100")
+		require.NoError(t, repo.Update(ctx, alert))
+
+		found, err := repo.FindByID(ctx, alert.ID)
+		require.NoError(t, err)
+		require.NotNil(t, found)
+		assert.Equal(t, entity.StateAcked, found.State)
+
+		found, err = repo.FindByExternalReference(ctx, "slack", "C1:1700000000.000100")
+		require.NoError(t, err)
+		require.NotNil(t, found)
+		assert.Equal(t, alert.ID, found.ID)
+
+		require.NoError(t, repo.Delete(ctx, alert.ID))
+		found, err = repo.FindByID(ctx, alert.ID)
+		require.NoError(t, err)
+		assert.Nil(t, found)
+	})
+
+	t.Run("stale update", func(t *testing.T) {
+		repo := newRepos(t).Alert
+
+		alert := newAlert("fp-stale", time.Now())
+		require.NoError(t, repo.Save(ctx, alert))
+
+		first, err := repo.FindByID(ctx, alert.ID)
+		require.NoError(t, err)
+		second, err := repo.FindByID(ctx, alert.ID)
+		require.NoError(t, err)
+
+		require.NoError(t, first.Acknowledge("first@example.com", time.Now().UTC()))
+		require.NoError(t, repo.Update(ctx, first))
+
+		// The second copy was read before the first update committed
+		second.Resolve("second@example.com", time.Now().UTC())
+		require.ErrorIs(t, repo.Update(ctx, second), repository.ErrConcurrentUpdate)
+
+		// Updating again from the returned revision succeeds
+		first.Resolve("first@example.com", time.Now().UTC())
+		require.NoError(t, repo.Update(ctx, first))
+
+		stored, err := repo.FindByID(ctx, alert.ID)
+		require.NoError(t, err)
+		assert.Equal(t, entity.StateResolved, stored.State)
+		assert.Equal(t, "first@example.com", stored.UpdatedBy)
+	})
+
+	t.Run("rejected update leaves the stored alert unchanged", func(t *testing.T) {
+		repo := newRepos(t).Alert
+
+		alert := newAlert("fp-rejected", time.Now())
+		alert.SetExternalReference("slack", "C1:1")
+		alert.Labels["team"] = "infra"
+		require.NoError(t, repo.Save(ctx, alert))
+
+		first, err := repo.FindByID(ctx, alert.ID)
+		require.NoError(t, err)
+		stale, err := repo.FindByID(ctx, alert.ID)
+		require.NoError(t, err)
+
+		require.NoError(t, first.Acknowledge("first@example.com", time.Now().UTC()))
+		require.NoError(t, repo.Update(ctx, first))
+
+		stale.SetExternalReference("slack", "C9:9")
+		stale.Labels["team"] = "stale"
+		_, err = stale.Assign("stale@example.com", "stale@example.com", time.Now().UTC())
+		require.NoError(t, err)
+		require.ErrorIs(t, repo.Update(ctx, stale), repository.ErrConcurrentUpdate)
+
+		stored, err := repo.FindByID(ctx, alert.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "C1:1", stored.GetExternalReference("slack"))
+		assert.Equal(t, "infra", stored.Labels["team"])
+		assert.Empty(t, stored.AssignedTo)
+		assert.Empty(t, stored.Assignments)
+
+		byRef, err := repo.FindByExternalReference(ctx, "slack", "C1:1")
+		require.NoError(t, err)
+		require.NotNil(t, byRef)
+		assert.Equal(t, alert.ID, byRef.ID)
+
+		byStaleRef, err := repo.FindByExternalReference(ctx, "slack", "C9:9")
+		require.NoError(t, err)
+		assert.Nil(t, byStaleRef)
+
+		// Mutating a returned alert does not change the stored one either
+		require.NotNil(t, stored.AckedAt)
+		require.NotNil(t, stored.LastTransition)
+		ackedAt := *stored.AckedAt
+		stored.Labels["team"] = "mutated"
+		*stored.AckedAt = ackedAt.Add(time.Hour)
+		stored.LastTransition.By = "mutated"
+		again, err := repo.FindByID(ctx, alert.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "infra", again.Labels["team"])
+		assert.True(t, again.AckedAt.Equal(ackedAt))
+		assert.Equal(t, "first@example.com", again.LastTransition.By)
+	})
+
+	t.Run("state filters and ordering", func(t *testing.T) {
+		repo := newRepos(t).Alert
+
+		// Whole seconds keep the ordering stable on second-precision backends
+		now := time.Now().UTC().Truncate(time.Second)
+		oldest := newAlert("fp-oldest", now.Add(-3*time.Hour))
+		acked := newAlert("fp-acked", now.Add(-2*time.Hour))
+		require.NoError(t, acked.Acknowledge("oncall@example.com", now))
+		newest := newAlert("fp-newest", now.Add(-time.Hour))
+		newest.Severity = entity.SeverityWarning
+		resolved := newAlert("fp-resolved", now)
+		resolved.Resolve("", now)
+
+		for _, alert := range []*entity.Alert{acked, resolved, oldest, newest} {
+			require.NoError(t, repo.Save(ctx, alert))
+		}
+
+		// Active means not resolved, so acknowledged alerts are included
+		active, err := repo.FindActive(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{newest.ID, acked.ID, oldest.ID}, alertIDs(active))
+
+		firing, err := repo.FindFiring(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{newest.ID, acked.ID, oldest.ID}, alertIDs(firing))
+
+		all, err := repo.GetActiveAlerts(ctx, "")
+		require.NoError(t, err)
+		assert.Equal(t, []string{newest.ID, acked.ID, oldest.ID}, alertIDs(all))
+
+		critical, err := repo.GetActiveAlerts(ctx, string(entity.SeverityCritical))
+		require.NoError(t, err)
+		assert.Equal(t, []string{acked.ID, oldest.ID}, alertIDs(critical))
+	})
+
+	t.Run("active alerts by priority", func(t *testing.T) {
+		repo := newRepos(t).Alert
+
+		now := time.Now().UTC().Truncate(time.Second)
+		older := newAlert("fp-older", now.Add(-2*time.Hour))
+		newer := newAlert("fp-newer", now.Add(-time.Hour))
+		acked := newAlert("fp-acked", now.Add(-3*time.Hour))
+		require.NoError(t, acked.Acknowledge("oncall@example.com", now))
+		warning := newAlert("fp-warning", now.Add(-4*time.Hour))
+		warning.Severity = entity.SeverityWarning
+		info := newAlert("fp-info", now.Add(-5*time.Hour))
+		info.Severity = entity.SeverityInfo
+		resolved := newAlert("fp-resolved", now)
+		resolved.Resolve("", now)
+
+		for _, alert := range []*entity.Alert{info, newer, resolved, acked, warning, older} {
+			require.NoError(t, repo.Save(ctx, alert))
+		}
+
+		// Severity first, then unacknowledged, then oldest
+		byPriority, err := repo.GetActiveAlertsByPriority(ctx, "", entity.DefaultSeverityWeights())
+		require.NoError(t, err)
+		assert.Equal(t, []string{older.ID, newer.ID, acked.ID, warning.ID, info.ID}, alertIDs(byPriority))
+
+		critical, err := repo.GetActiveAlertsByPriority(ctx, string(entity.SeverityCritical), entity.DefaultSeverityWeights())
+		require.NoError(t, err)
+		assert.Equal(t, []string{older.ID, newer.ID, acked.ID}, alertIDs(critical))
+
+		// Weights decide which severity ranks first
+		weights := entity.SeverityWeights{entity.SeverityInfo: 1000}
+		byPriority, err = repo.GetActiveAlertsByPriority(ctx, "", weights)
+		require.NoError(t, err)
+		assert.Equal(t, []string{info.ID, warning.ID, older.ID, newer.ID, acked.ID}, alertIDs(byPriority))
+	})
+
+	t.Run("correlated alerts are oldest first", func(t *testing.T) {
+		repo := newRepos(t).Alert
+
+		now := time.Now().UTC().Truncate(time.Second)
+		root := newAlert("fp-root", now.Add(-2*time.Hour))
+		root.CorrelationID = "group-1"
+		reply := newAlert("fp-reply", now.Add(-time.Hour))
+		reply.CorrelationID = "group-1"
+		resolved := newAlert("fp-resolved", now.Add(-3*time.Hour))
+		resolved.CorrelationID = "group-1"
+		resolved.Resolve("", now)
+		other := newAlert("fp-other", now)
+		other.CorrelationID = "group-2"
+
+		for _, alert := range []*entity.Alert{reply, other, resolved, root} {
+			require.NoError(t, repo.Save(ctx, alert))
+		}
+
+		correlated, err := repo.FindFiringByCorrelationID(ctx, "group-1")
+		require.NoError(t, err)
+		assert.Equal(t, []string{root.ID, reply.ID}, alertIDs(correlated))
+		assert.Equal(t, "group-1", correlated[0].CorrelationID)
+	})
+
+	t.Run("alerts not seen since are least recently seen first", func(t *testing.T) {
+		repo := newRepos(t).Alert
+
+		now := time.Now().UTC().Truncate(time.Second)
+		stale := newAlert("fp-stale", now.Add(-3*time.Hour))
+		refired := newAlert("fp-refired", now.Add(-4*time.Hour))
+		older := newAlert("fp-older", now.Add(-2*time.Hour))
+		fresh := newAlert("fp-fresh", now.Add(-time.Hour))
+		resolved := newAlert("fp-resolved", now.Add(-5*time.Hour))
+		resolved.Resolve("", now.Add(-5*time.Hour))
+
+		for _, alert := range []*entity.Alert{fresh, stale, resolved, refired, older} {
+			require.NoError(t, repo.Save(ctx, alert))
+		}
+
+		refired.MarkSeen(now)
+		require.NoError(t, repo.Update(ctx, refired))
+
+		notSeen, err := repo.FindNotSeenSince(ctx, now.Add(-90*time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, []string{stale.ID, older.ID}, alertIDs(notSeen))
+		assert.True(t, notSeen[0].LastSeenAt.Equal(stale.LastSeenAt))
+
+		found, err := repo.FindByID(ctx, refired.ID)
+		require.NoError(t, err)
+		assert.True(t, found.LastSeenAt.Equal(now))
+	})
+
+	t.Run("counts by state and severity", func(t *testing.T) {
+		repo := newRepos(t).Alert
+
+		counts, err := repo.CountByStateSeverity(ctx)
+		require.NoError(t, err)
+		assert.NotNil(t, counts)
+		assert.Empty(t, counts)
+
+		now := time.Now().UTC().Truncate(time.Second)
+		older := newAlert("fp-older", now.Add(-2*time.Hour))
+		newer := newAlert("fp-newer", now.Add(-time.Hour))
+		warning := newAlert("fp-warning", now.Add(-3*time.Hour))
+		warning.Severity = entity.SeverityWarning
+		acked := newAlert("fp-acked", now.Add(-4*time.Hour))
+		require.NoError(t, acked.Acknowledge("oncall@example.com", now))
+		resolved := newAlert("fp-resolved", now.Add(-5*time.Hour))
+		resolved.Resolve("", now)
+
+		for _, alert := range []*entity.Alert{newer, resolved, older, acked, warning} {
+			require.NoError(t, repo.Save(ctx, alert))
+		}
+
+		counts, err = repo.CountByStateSeverity(ctx)
+		require.NoError(t, err)
+		want := []entity.StateSeverityCount{
+			{State: entity.StateAcked, Severity: entity.SeverityCritical, Count: 1, OldestFiredAt: acked.FiredAt},
+			{State: entity.StateActive, Severity: entity.SeverityCritical, Count: 2, OldestFiredAt: older.FiredAt},
+			{State: entity.StateActive, Severity: entity.SeverityWarning, Count: 1, OldestFiredAt: warning.FiredAt},
+			{State: entity.StateResolved, Severity: entity.SeverityCritical, Count: 1, OldestFiredAt: resolved.FiredAt},
+		}
+		require.Len(t, counts, len(want))
+		for i, count := range counts {
+			assert.Equal(t, want[i].State, count.State, "state %d", i)
+			assert.Equal(t, want[i].Severity, count.Severity, "severity %d", i)
+			assert.Equal(t, want[i].Count, count.Count, "count %d", i)
+			assert.True(t, want[i].OldestFiredAt.Equal(count.OldestFiredAt), "oldest fired at %d", i)
+		}
+	})
+
+	t.Run("fingerprint history is newest first", func(t *testing.T) {
+		repo := newRepos(t).Alert
+
+		now := time.Now().UTC().Truncate(time.Second)
+		first := newAlert("fp-history", now.Add(-2*time.Hour))
+		first.Resolve("", now.Add(-90*time.Minute))
+		second := newAlert("fp-history", now.Add(-time.Hour))
+		require.NoError(t, repo.Save(ctx, first))
+		require.NoError(t, repo.Save(ctx, second))
+
+		history, err := repo.FindByFingerprint(ctx, "fp-history")
+		require.NoError(t, err)
+		assert.Equal(t, []string{second.ID, first.ID}, alertIDs(history))
+	})
+
+	t.Run("label matchers", func(t *testing.T) {
+		repo := newRepos(t).Alert
+
+		now := time.Now()
+		apiProd := newAlert("fp-api-prod", now.Add(-2*time.Hour))
+		apiProd.Labels = map[string]string{"service": "api", "env": "prod", "k8s.io/zone": "a", `team"quoted`: "x"}
+		apiProdNewer := newAlert("fp-api-prod-2", now.Add(-time.Hour))
+		apiProdNewer.Labels = map[string]string{"service": "api", "env": "prod"}
+		require.NoError(t, apiProdNewer.Acknowledge("oncall@example.com", now.UTC()))
+		apiStaging := newAlert("fp-api-staging", now)
+		apiStaging.Labels = map[string]string{"service": "api", "env": "staging"}
+		resolved := newAlert("fp-api-resolved", now)
+		resolved.Labels = map[string]string{"service": "api", "env": "prod"}
+		resolved.Resolve("alertmanager", now.UTC())
+		for _, alert := range []*entity.Alert{apiProd, apiProdNewer, apiStaging, resolved} {
+			require.NoError(t, repo.Save(ctx, alert))
+		}
+
+		tests := []struct {
+			name     string
+			matchers map[string]string
+			want     []string
+		}{
+			{name: "one matcher", matchers: map[string]string{"service": "api"}, want: []string{apiStaging.ID, apiProdNewer.ID, apiProd.ID}},
+			{name: "every matcher must match", matchers: map[string]string{"service": "api", "env": "prod"}, want: []string{apiProdNewer.ID, apiProd.ID}},
+			{name: "dotted label name", matchers: map[string]string{"env": "prod", "k8s.io/zone": "a"}, want: []string{apiProd.ID}},
+			{name: "quoted label name", matchers: map[string]string{"service": "api", `team"quoted`: "x"}, want: []string{apiProd.ID}},
+			{name: "value mismatch", matchers: map[string]string{"service": "api", "env": "dev"}, want: []string{}},
+			{name: "missing label", matchers: map[string]string{"owner": ""}, want: []string{}},
+			{name: "no matchers", matchers: nil, want: []string{apiStaging.ID, apiProdNewer.ID, apiProd.ID}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				found, err := repo.FindByLabels(ctx, tt.matchers)
+				require.NoError(t, err)
+				assert.NotNil(t, found)
+				assert.Equal(t, tt.want, alertIDs(found))
+			})
+		}
+
+		found, err := repo.FindByLabels(repository.NewContextWithTenant(ctx, "team-a"), map[string]string{"service": "api"})
+		require.NoError(t, err)
+		assert.Empty(t, found)
+	})
+
+	t.Run("tenant scoping", func(t *testing.T) {
+		repo := newRepos(t).Alert
+
+		now := time.Now()
+		shared := newAlert("fp-tenant", now.Add(-time.Hour))
+		require.NoError(t, repo.Save(ctx, shared))
+
+		// The same fingerprint may fire once per tenant
+		team := newAlert("fp-tenant", now)
+		team.TenantID = "team-a"
+		saved, created, err := repo.UpsertByFingerprint(ctx, team)
+		require.NoError(t, err)
+		assert.True(t, created)
+		assert.Equal(t, team.ID, saved.ID)
+
+		teamCtx := repository.NewContextWithTenant(ctx, "team-a")
+		defaultCtx := repository.NewContextWithTenant(ctx, "")
+
+		found, err := repo.FindByID(teamCtx, shared.ID)
+		require.NoError(t, err)
+		assert.Nil(t, found)
+
+		found, err = repo.FindByID(teamCtx, team.ID)
+		require.NoError(t, err)
+		require.NotNil(t, found)
+		assert.Equal(t, "team-a", found.TenantID)
+
+		active, err := repo.FindActive(teamCtx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{team.ID}, alertIDs(active))
+
+		active, err = repo.FindActive(defaultCtx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{shared.ID}, alertIDs(active))
+
+		// An unscoped context sees every tenant
+		history, err := repo.FindByFingerprint(ctx, "fp-tenant")
+		require.NoError(t, err)
+		assert.Equal(t, []string{team.ID, shared.ID}, alertIDs(history))
+	})
+
+	t.Run("assignment round-trip", func(t *testing.T) {
+		repo := newRepos(t).Alert
+
+		now := time.Now().Truncate(time.Second)
+		alert := newAlert("fp-assign", now)
+		require.NoError(t, repo.Save(ctx, alert))
+
+		_, err := alert.Assign("jane@example.com", "lead@example.com", now)
+		require.NoError(t, err)
+		_, err = alert.Assign("", "jane@example.com", now.Add(time.Minute))
+		require.NoError(t, err)
+		_, err = alert.Assign("sam@example.com", "jane@example.com", now.Add(2*time.Minute))
+		require.NoError(t, err)
+		require.NoError(t, repo.Update(ctx, alert))
+
+		found, err := repo.FindByID(ctx, alert.ID)
+		require.NoError(t, err)
+		require.NotNil(t, found)
+		assert.Equal(t, "sam@example.com", found.AssignedTo)
+		require.Len(t, found.Assignments, 3)
+		assert.Equal(t, "jane@example.com", found.Assignments[0].To)
+		assert.Equal(t, "lead@example.com", found.Assignments[0].By)
+		assert.True(t, found.Assignments[0].At.Equal(now))
+		assert.Empty(t, found.Assignments[1].To)
+		assert.True(t, found.Assignments[2].At.Equal(now.Add(2*time.Minute)))
+	})
+}
+
+// newAlert returns a critical, active alert that fired and was created at firedAt.
+func newAlert(fingerprint string, firedAt time.Time) *entity.Alert {
+	alert := entity.NewAlert(fingerprint, "High CPU", "host-1", "", "", entity.SeverityCritical)
+	firedAt = firedAt.UTC().Truncate(time.Second)
+	alert.FiredAt = firedAt
+	alert.CreatedAt = firedAt
+	alert.UpdatedAt = firedAt
+	alert.LastSeenAt = firedAt
+	return alert
+}
+
+func alertIDs(alerts []*entity.Alert) []string {
+	ids := make([]string, 0, len(alerts))
+	for _, alert := range alerts {
+		ids = append(ids, alert.ID)
+	}
+	return ids
+}
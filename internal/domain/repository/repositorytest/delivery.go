@@ -0,0 +1,71 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+)
+
+// RunDeliveryRepository asserts empty results, ordering and round-tripping of
+// a DeliveryRepository.
+func RunDeliveryRepository(t *testing.T, newRepos Factory) {
+	ctx := context.Background()
+
+	t.Run("no deliveries", func(t *testing.T) {
+		repos := newRepos(t)
+
+		deliveries, err := repos.Delivery.FindByAlertID(ctx, "missing")
+		require.NoError(t, err)
+		assert.NotNil(t, deliveries)
+		assert.Empty(t, deliveries)
+	})
+
+	t.Run("deliveries are oldest first", func(t *testing.T) {
+		repos := newRepos(t)
+
+		alert := newAlert("fp-deliveries", time.Now())
+		require.NoError(t, repos.Alert.Save(ctx, alert))
+
+		now := time.Now().UTC().Truncate(time.Second)
+		failed := entity.NewFailedDelivery(alert.ID, "pagerduty", entity.OutboxActionNotify, errors.New("rate limited"))
+		failed.CreatedAt = now
+		sent := entity.NewSentDelivery(alert.ID, "slack", entity.OutboxActionNotify, "C123:1700000000.000100")
+		sent.CreatedAt = now.Add(-time.Minute)
+		require.NoError(t, repos.Delivery.Save(ctx, failed))
+		require.NoError(t, repos.Delivery.Save(ctx, sent))
+
+		deliveries, err := repos.Delivery.FindByAlertID(ctx, alert.ID)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 2)
+
+		assert.Equal(t, sent.ID, deliveries[0].ID)
+		assert.Equal(t, "slack", deliveries[0].Notifier)
+		assert.Equal(t, entity.OutboxActionNotify, deliveries[0].Action)
+		assert.Equal(t, entity.DeliveryStatusSent, deliveries[0].Status)
+		assert.Equal(t, "C123:1700000000.000100", deliveries[0].ExternalReference)
+		assert.Empty(t, deliveries[0].Error)
+		assert.WithinDuration(t, sent.CreatedAt, deliveries[0].CreatedAt, time.Second)
+
+		assert.Equal(t, failed.ID, deliveries[1].ID)
+		assert.Equal(t, entity.DeliveryStatusFailed, deliveries[1].Status)
+		assert.Equal(t, "rate limited", deliveries[1].Error)
+		assert.Empty(t, deliveries[1].ExternalReference)
+	})
+
+	t.Run("duplicate delivery", func(t *testing.T) {
+		repos := newRepos(t)
+
+		alert := newAlert("fp-dup-delivery", time.Now())
+		require.NoError(t, repos.Alert.Save(ctx, alert))
+
+		delivery := entity.NewSentDelivery(alert.ID, "slack", entity.OutboxActionUpdate, "ts")
+		require.NoError(t, repos.Delivery.Save(ctx, delivery))
+		requireAlreadyExists(t, repos.Delivery.Save(ctx, delivery), nil)
+	})
+}
@@ -0,0 +1,66 @@
+// Package repositorytest provides behavioural tests shared by every repository
+// implementation, so the memory, SQLite and MySQL backends cannot drift apart.
+// Each backend's test file calls Run with a constructor for fresh, empty
+// repositories; backends that need a server skip inside the constructor.
+package repositorytest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+)
+
+// Repositories is the set of repositories under test. They must share one
+// store, since ack events and deliveries reference alerts.
+type Repositories struct {
+	Alert    repository.AlertRepository
+	AckEvent repository.AckEventRepository
+	Silence  repository.SilenceRepository
+	Delivery repository.DeliveryRepository
+}
+
+// Factory returns empty repositories for one subtest.
+type Factory func(t *testing.T) Repositories
+
+// Run runs the whole conformance suite against the repositories built by newRepos.
+func Run(t *testing.T, newRepos Factory) {
+	t.Run("AlertRepository", func(t *testing.T) {
+		RunAlertRepository(t, newRepos)
+	})
+	t.Run("AckEventRepository", func(t *testing.T) {
+		RunAckEventRepository(t, newRepos)
+	})
+	t.Run("SilenceRepository", func(t *testing.T) {
+		RunSilenceRepository(t, newRepos)
+	})
+	t.Run("DeliveryRepository", func(t *testing.T) {
+		RunDeliveryRepository(t, newRepos)
+	})
+	t.Run("FindMatchingAlert", func(t *testing.T) {
+		RunFindMatchingAlert(t, func(t *testing.T) repository.SilenceRepository {
+			return newRepos(t).Silence
+		})
+	})
+}
+
+// requireNotFound asserts err matches repository.ErrNotFound and, when given,
+// the entity-level error older callers check for.
+func requireNotFound(t *testing.T, err, entityErr error) {
+	t.Helper()
+	require.ErrorIs(t, err, repository.ErrNotFound)
+	if entityErr != nil {
+		require.ErrorIs(t, err, entityErr)
+	}
+}
+
+// requireAlreadyExists asserts err matches repository.ErrAlreadyExists and,
+// when given, the entity-level error older callers check for.
+func requireAlreadyExists(t *testing.T, err, entityErr error) {
+	t.Helper()
+	require.ErrorIs(t, err, repository.ErrAlreadyExists)
+	if entityErr != nil {
+		require.ErrorIs(t, err, entityErr)
+	}
+}
@@ -0,0 +1,316 @@
+package repositorytest
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+)
+
+// SilenceRepositoryFactory returns an empty silence repository for one subtest.
+type SilenceRepositoryFactory func(t *testing.T) repository.SilenceRepository
+
+// silenceFixture describes one stored silence in a matching scenario.
+type silenceFixture struct {
+	name        string
+	alertID     string
+	fingerprint string
+	instance    string
+	labels      map[string]string
+	expired     bool
+	pending     bool
+}
+
+// matchingAlert is the alert every scenario is matched against.
+func matchingAlert() *entity.Alert {
+	alert := entity.NewAlert("fp-1", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	alert.ID = "alert-1"
+	alert.AddLabel("env", "prod")
+	alert.AddLabel("team", "platform")
+	return alert
+}
+
+var findMatchingAlertCases = []struct {
+	name     string
+	silences []silenceFixture
+	want     []string
+}{
+	{
+		name: "no silences",
+		want: nil,
+	},
+	{
+		name: "by alert ID",
+		silences: []silenceFixture{
+			{name: "match", alertID: "alert-1"},
+			{name: "other", alertID: "alert-2"},
+		},
+		want: []string{"match"},
+	},
+	{
+		name: "by fingerprint",
+		silences: []silenceFixture{
+			{name: "match", fingerprint: "fp-1"},
+			{name: "other", fingerprint: "fp-2"},
+		},
+		want: []string{"match"},
+	},
+	{
+		name: "by instance",
+		silences: []silenceFixture{
+			{name: "match", instance: "host-1"},
+			{name: "other", instance: "host-2"},
+		},
+		want: []string{"match"},
+	},
+	{
+		name: "instance with labels",
+		silences: []silenceFixture{
+			{name: "match", instance: "host-1", labels: map[string]string{"env": "prod"}},
+			{name: "wrong label", instance: "host-1", labels: map[string]string{"env": "staging"}},
+		},
+		want: []string{"match"},
+	},
+	{
+		name: "labels only",
+		silences: []silenceFixture{
+			{name: "subset", labels: map[string]string{"env": "prod"}},
+			{name: "all", labels: map[string]string{"env": "prod", "team": "platform"}},
+			{name: "missing label", labels: map[string]string{"env": "prod", "region": "eu"}},
+		},
+		want: []string{"all", "subset"},
+	},
+	{
+		name: "alert ID wins over mismatched instance",
+		silences: []silenceFixture{
+			{name: "match", alertID: "alert-1", instance: "host-2"},
+		},
+		want: []string{"match"},
+	},
+	{
+		name: "no criteria never matches",
+		silences: []silenceFixture{
+			{name: "empty"},
+		},
+		want: nil,
+	},
+	{
+		name: "inactive silences are ignored",
+		silences: []silenceFixture{
+			{name: "expired", fingerprint: "fp-1", expired: true},
+			{name: "pending", fingerprint: "fp-1", pending: true},
+			{name: "active", fingerprint: "fp-1"},
+		},
+		want: []string{"active"},
+	},
+}
+
+// RunFindMatchingAlert asserts that FindMatchingAlert returns exactly the
+// active silences for which entity.SilenceMark.MatchesAlert holds.
+func RunFindMatchingAlert(t *testing.T, newRepo SilenceRepositoryFactory) {
+	for _, tc := range findMatchingAlertCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := newRepo(t)
+
+			names := make(map[string]string)
+			for _, fixture := range tc.silences {
+				silence := newSilence(t, fixture)
+				require.NoError(t, repo.Save(ctx, silence))
+				names[silence.ID] = fixture.name
+			}
+
+			require.Equal(t, tc.want, matchedNames(t, repo, names))
+		})
+	}
+
+	t.Run("reflects updated targets", func(t *testing.T) {
+		ctx := context.Background()
+		repo := newRepo(t)
+
+		silence := newSilence(t, silenceFixture{name: "moved", instance: "host-2"})
+		require.NoError(t, repo.Save(ctx, silence))
+		names := map[string]string{silence.ID: "moved"}
+		require.Empty(t, matchedNames(t, repo, names))
+
+		silence.Instance = "host-1"
+		require.NoError(t, repo.Update(ctx, silence))
+		require.Equal(t, []string{"moved"}, matchedNames(t, repo, names))
+	})
+}
+
+func newSilence(t *testing.T, fixture silenceFixture) *entity.SilenceMark {
+	t.Helper()
+
+	silence, err := entity.NewSilenceMark(time.Hour, "tester", "tester@example.com", entity.AckSourceAPI)
+	require.NoError(t, err)
+	silence.ForAlert(fixture.alertID)
+	silence.ForFingerprint(fixture.fingerprint)
+	silence.ForInstance(fixture.instance)
+	for key, value := range fixture.labels {
+		silence.WithLabel(key, value)
+	}
+
+	// Whole seconds keep the window identical across backends that store
+	// timestamps at different precisions.
+	now := time.Now().UTC().Truncate(time.Second)
+	switch {
+	case fixture.expired:
+		silence.StartAt = now.Add(-2 * time.Hour)
+		silence.EndAt = now.Add(-time.Hour)
+	case fixture.pending:
+		silence.StartAt = now.Add(time.Hour)
+		silence.EndAt = now.Add(2 * time.Hour)
+	default:
+		silence.StartAt = now.Add(-time.Minute)
+		silence.EndAt = now.Add(time.Hour)
+	}
+	return silence
+}
+
+// matchedNames returns the sorted fixture names of the silences matching the alert.
+func matchedNames(t *testing.T, repo repository.SilenceRepository, names map[string]string) []string {
+	t.Helper()
+
+	matches, err := repo.FindMatchingAlert(context.Background(), matchingAlert())
+	require.NoError(t, err)
+
+	var got []string
+	for _, silence := range matches {
+		got = append(got, names[silence.ID])
+	}
+	sort.Strings(got)
+	return got
+}
+
+// RunSilenceRepository asserts error types, empty results, activity filtering
+// and ordering of a SilenceRepository.
+func RunSilenceRepository(t *testing.T, newRepos Factory) {
+	ctx := context.Background()
+
+	t.Run("missing silence", func(t *testing.T) {
+		repo := newRepos(t).Silence
+
+		found, err := repo.FindByID(ctx, "missing")
+		require.NoError(t, err)
+		require.Nil(t, found)
+
+		err = repo.Update(ctx, newSilence(t, silenceFixture{instance: "host-1"}))
+		requireNotFound(t, err, entity.ErrSilenceNotFound)
+
+		err = repo.Delete(ctx, "missing")
+		requireNotFound(t, err, entity.ErrSilenceNotFound)
+	})
+
+	t.Run("duplicate silence", func(t *testing.T) {
+		repo := newRepos(t).Silence
+
+		silence := newSilence(t, silenceFixture{instance: "host-1"})
+		require.NoError(t, repo.Save(ctx, silence))
+		requireAlreadyExists(t, repo.Save(ctx, silence), nil)
+	})
+
+	t.Run("stale update", func(t *testing.T) {
+		repo := newRepos(t).Silence
+
+		silence := newSilence(t, silenceFixture{instance: "host-1"})
+		require.NoError(t, repo.Save(ctx, silence))
+
+		first, err := repo.FindByID(ctx, silence.ID)
+		require.NoError(t, err)
+		second, err := repo.FindByID(ctx, silence.ID)
+		require.NoError(t, err)
+
+		first.Reason = "extended"
+		require.NoError(t, repo.Update(ctx, first))
+
+		second.Reason = "stale"
+		require.ErrorIs(t, repo.Update(ctx, second), repository.ErrConcurrentUpdate)
+
+		stored, err := repo.FindByID(ctx, silence.ID)
+		require.NoError(t, err)
+		require.Equal(t, "extended", stored.Reason)
+	})
+
+	t.Run("empty results are empty slices", func(t *testing.T) {
+		repo := newRepos(t).Silence
+
+		finders := map[string]func() ([]*entity.SilenceMark, error){
+			"FindActive":        func() ([]*entity.SilenceMark, error) { return repo.FindActive(ctx) },
+			"FindByAlertID":     func() ([]*entity.SilenceMark, error) { return repo.FindByAlertID(ctx, "alert-1") },
+			"FindByInstance":    func() ([]*entity.SilenceMark, error) { return repo.FindByInstance(ctx, "host-1") },
+			"FindByFingerprint": func() ([]*entity.SilenceMark, error) { return repo.FindByFingerprint(ctx, "fp-1") },
+			"FindMatchingAlert": func() ([]*entity.SilenceMark, error) { return repo.FindMatchingAlert(ctx, matchingAlert()) },
+		}
+		for name, find := range finders {
+			silences, err := find()
+			require.NoError(t, err, name)
+			require.NotNil(t, silences, name)
+			require.Empty(t, silences, name)
+		}
+
+		count, err := repo.CountActive(ctx)
+		require.NoError(t, err)
+		require.Zero(t, count)
+	})
+
+	t.Run("imported silence source", func(t *testing.T) {
+		repo := newRepos(t).Silence
+
+		silence := newSilence(t, silenceFixture{labels: map[string]string{"env": "prod"}})
+		silence.Source = entity.AckSourceAlertmanager
+		require.NoError(t, repo.Save(ctx, silence))
+
+		stored, err := repo.FindByID(ctx, silence.ID)
+		require.NoError(t, err)
+		require.Equal(t, entity.AckSourceAlertmanager, stored.Source)
+	})
+
+	t.Run("active silences are newest first", func(t *testing.T) {
+		repo := newRepos(t).Silence
+
+		now := time.Now().UTC().Truncate(time.Second)
+		older := newSilence(t, silenceFixture{instance: "host-1"})
+		older.CreatedAt = now.Add(-2 * time.Minute)
+		newer := newSilence(t, silenceFixture{instance: "host-1"})
+		newer.CreatedAt = now.Add(-time.Minute)
+		expired := newSilence(t, silenceFixture{instance: "host-1", expired: true})
+
+		for _, silence := range []*entity.SilenceMark{newer, expired, older} {
+			require.NoError(t, repo.Save(ctx, silence))
+		}
+
+		active, err := repo.FindActive(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []string{newer.ID, older.ID}, silenceIDs(active))
+
+		count, err := repo.CountActive(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+
+		byInstance, err := repo.FindByInstance(ctx, "host-1")
+		require.NoError(t, err)
+		require.Equal(t, []string{newer.ID, older.ID}, silenceIDs(byInstance))
+
+		deleted, err := repo.DeleteExpired(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, deleted)
+
+		found, err := repo.FindByID(ctx, expired.ID)
+		require.NoError(t, err)
+		require.Nil(t, found)
+	})
+}
+
+func silenceIDs(silences []*entity.SilenceMark) []string {
+	ids := make([]string, 0, len(silences))
+	for _, silence := range silences {
+		ids = append(ids, silence.ID)
+	}
+	return ids
+}
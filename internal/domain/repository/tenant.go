@@ -0,0 +1,28 @@
+package repository
+
+import "context"
+
+// tenantKey is the context key for the tenant scope.
+type tenantKey struct{}
+
+// NewContextWithTenant scopes repository reads made with the returned
+// context to one tenant: Find and Count methods only return entities whose
+// TenantID equals tenantID. An empty tenantID scopes to the default tenant.
+// Writes are not scoped; entities keep the TenantID they were created with.
+func NewContextWithTenant(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenantID)
+}
+
+// TenantFromContext returns the tenant scope of the context and whether it
+// has one. Reads with an unscoped context see every tenant, which keeps
+// single-tenant deployments and background jobs unchanged.
+func TenantFromContext(ctx context.Context) (string, bool) {
+	tenantID, ok := ctx.Value(tenantKey{}).(string)
+	return tenantID, ok
+}
+
+// InTenant reports whether an entity of tenantID is visible to ctx.
+func InTenant(ctx context.Context, tenantID string) bool {
+	scope, ok := TenantFromContext(ctx)
+	return !ok || scope == tenantID
+}
@@ -14,15 +14,13 @@ type Transaction interface {
 	Rollback() error
 }
 
-// TransactionManager provides transaction lifecycle management.
+// TransactionManager runs several repository operations as one unit of work.
+// Repositories join the transaction through the context passed to fn, so
+// use cases stay unaware of the storage backend.
 type TransactionManager interface {
-	// BeginTx starts a new transaction.
-	// The context should be the same context used for the operations within the transaction.
-	BeginTx(ctx context.Context) (Transaction, error)
-
-	// WithTransaction executes a function within a transaction.
-	// If the function returns an error, the transaction is rolled back.
-	// Otherwise, the transaction is committed.
+	// WithTransaction executes fn with a transaction attached to its context.
+	// The transaction commits if fn returns nil and rolls back otherwise.
+	// Calls nested in fn's context join the outer transaction.
 	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
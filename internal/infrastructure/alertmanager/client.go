@@ -0,0 +1,94 @@
+package alertmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// silenceStateExpired is the state of a silence that no longer applies.
+const silenceStateExpired = "expired"
+
+// Client reads silences from the Alertmanager v2 API.
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+}
+
+// NewClient creates a client for the Alertmanager at baseURL,
+// e.g. http://alertmanager:9093.
+func NewClient(baseURL string) *Client {
+	return &Client{
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+// Silence is a silence as returned by GET /api/v2/silences.
+type Silence struct {
+	ID        string        `json:"id"`
+	Matchers  []Matcher     `json:"matchers"`
+	StartsAt  time.Time     `json:"startsAt"`
+	EndsAt    time.Time     `json:"endsAt"`
+	CreatedBy string        `json:"createdBy"`
+	Comment   string        `json:"comment"`
+	Status    SilenceStatus `json:"status"`
+}
+
+// SilenceStatus holds the state of a silence: active, pending or expired.
+type SilenceStatus struct {
+	State string `json:"state"`
+}
+
+// Matcher selects alerts by one label. IsRegex matchers are fully anchored
+// regular expressions; IsEqual false negates the match.
+type Matcher struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	IsRegex bool   `json:"isRegex"`
+	IsEqual *bool  `json:"isEqual,omitempty"` // absent before Alertmanager 0.22, meaning true
+}
+
+// Equal reports whether the matcher selects alerts whose label matches,
+// as opposed to alerts whose label does not.
+func (m Matcher) Equal() bool {
+	return m.IsEqual == nil || *m.IsEqual
+}
+
+// ListSilences returns the active and pending silences. Expired silences,
+// which Alertmanager keeps around for a while, are left out.
+func (c *Client) ListSilences(ctx context.Context) ([]Silence, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/api/v2/silences", nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating alertmanager request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("listing alertmanager silences: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("listing alertmanager silences: status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var silences []Silence
+	if err := json.NewDecoder(resp.Body).Decode(&silences); err != nil {
+		return nil, fmt.Errorf("decoding alertmanager silences: %w", err)
+	}
+
+	current := make([]Silence, 0, len(silences))
+	for _, silence := range silences {
+		if silence.Status.State != silenceStateExpired {
+			current = append(current, silence)
+		}
+	}
+	return current, nil
+}
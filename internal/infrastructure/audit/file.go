@@ -0,0 +1,88 @@
+// Package audit provides sinks for the compliance audit log.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/logger"
+)
+
+// record is the JSON form of one audit log line.
+type record struct {
+	Timestamp time.Time `json:"timestamp"`
+	Action    string    `json:"action"`
+	AlertID   string    `json:"alert_id,omitempty"`
+	SilenceID string    `json:"silence_id,omitempty"`
+	Actor     string    `json:"actor"`
+	Source    string    `json:"source"`
+	Note      string    `json:"note,omitempty"`
+}
+
+// FileLogger appends audit events to a file as JSON lines. The file is
+// opened append-only, so existing records are never rewritten.
+type FileLogger struct {
+	mu     sync.Mutex
+	file   *os.File
+	logger logger.Logger
+}
+
+// NewFileLogger opens path for appending, creating it and its directory if
+// needed. Write failures are reported to logger.
+func NewFileLogger(path string, logger logger.Logger) (*FileLogger, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, fmt.Errorf("creating audit log directory: %w", err)
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("opening audit log: %w", err)
+	}
+	return &FileLogger{file: file, logger: logger}, nil
+}
+
+// Audit writes the event as one line and syncs it to disk.
+func (l *FileLogger) Audit(ctx context.Context, event *entity.AuditEvent) {
+	line, err := json.Marshal(record{
+		Timestamp: event.Timestamp,
+		Action:    string(event.Action),
+		AlertID:   event.AlertID,
+		SilenceID: event.SilenceID,
+		Actor:     event.Actor,
+		Source:    event.Source,
+		Note:      event.Note,
+	})
+	if err != nil {
+		l.logger.Error("failed to encode audit event", "action", event.Action, "error", err)
+		return
+	}
+	line = append(line, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, err := l.file.Write(line); err != nil {
+		l.logger.Error("failed to write audit event",
+			"action", event.Action,
+			"alertID", event.AlertID,
+			"silenceID", event.SilenceID,
+			"error", err,
+		)
+		return
+	}
+	if err := l.file.Sync(); err != nil {
+		l.logger.Error("failed to sync audit log", "error", err)
+	}
+}
+
+// Close closes the audit log file.
+func (l *FileLogger) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.file.Close()
+}
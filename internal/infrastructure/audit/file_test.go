@@ -0,0 +1,75 @@
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+)
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...any) {}
+func (nopLogger) Info(string, ...any)  {}
+func (nopLogger) Warn(string, ...any)  {}
+func (nopLogger) Error(string, ...any) {}
+
+func readRecords(t *testing.T, path string) []map[string]any {
+	t.Helper()
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var records []map[string]any
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record map[string]any
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	require.NoError(t, scanner.Err())
+	return records
+}
+
+func TestFileLogger_AppendsJSONLines(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "audit", "audit.log")
+
+	auditLogger, err := NewFileLogger(path, nopLogger{})
+	require.NoError(t, err)
+
+	ack := entity.NewAuditEvent(entity.AuditActionAcknowledge, "jane@example.com", "slack").
+		ForAlert("alert-1").
+		WithNote("looking into it")
+	ack.Timestamp = time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	auditLogger.Audit(ctx, ack)
+	require.NoError(t, auditLogger.Close())
+
+	// Reopening appends instead of truncating
+	auditLogger, err = NewFileLogger(path, nopLogger{})
+	require.NoError(t, err)
+	auditLogger.Audit(ctx, entity.NewAuditEvent(entity.AuditActionSilenceDelete, "bob", "slack").ForSilence("silence-1"))
+	require.NoError(t, auditLogger.Close())
+
+	records := readRecords(t, path)
+	require.Len(t, records, 2)
+	assert.Equal(t, map[string]any{
+		"timestamp": "2025-01-15T10:00:00Z",
+		"action":    "acknowledge",
+		"alert_id":  "alert-1",
+		"actor":     "jane@example.com",
+		"source":    "slack",
+		"note":      "looking into it",
+	}, records[0])
+	assert.Equal(t, "silence_delete", records[1]["action"])
+	assert.Equal(t, "silence-1", records[1]["silence_id"])
+	assert.NotContains(t, records[1], "alert_id")
+}
@@ -0,0 +1,216 @@
+package cache
+
+import (
+	"context"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/observability"
+)
+
+// Cache names reported in lookup metrics.
+const (
+	alertsByIDCache  = "alerts_by_id"
+	alertsByRefCache = "alerts_by_reference"
+)
+
+// AlertRepository wraps a repository.AlertRepository with a read-through
+// cache for lookups by ID and external reference, the hot paths of Slack
+// and PagerDuty webhook bursts. Writes go straight to the wrapped repository
+// and evict the alert, so the next read loads the stored version and
+// optimistic locking keeps working. Alerts not found are not cached.
+// Reads in a transaction bypass the cache; wrap the repository's
+// TransactionManager in a TransactionManager so alerts written in a
+// transaction are evicted again once it ends.
+//
+// The cache is per process and never sees writes made by other instances
+// sharing the database, so it is meant for single-instance deployments.
+type AlertRepository struct {
+	next    repository.AlertRepository
+	byID    *LRU[string, *entity.Alert]
+	byRef   *LRU[string, string] // system and reference ID -> alert ID
+	metrics *observability.Metrics
+
+	// evictions counts writes, so a read that raced a write does not cache
+	// the version it loaded before the write.
+	evictions atomic.Uint64
+}
+
+// NewAlertRepository creates a caching decorator holding up to size alerts,
+// each for at most ttl.
+func NewAlertRepository(next repository.AlertRepository, size int, ttl time.Duration) *AlertRepository {
+	return &AlertRepository{
+		next:  next,
+		byID:  NewLRU[string, *entity.Alert](size, ttl),
+		byRef: NewLRU[string, string](size, ttl),
+	}
+}
+
+// SetMetrics records a hit or miss for every cached lookup.
+func (r *AlertRepository) SetMetrics(metrics *observability.Metrics) {
+	r.metrics = metrics
+}
+
+// Save persists a new alert.
+func (r *AlertRepository) Save(ctx context.Context, alert *entity.Alert) error {
+	defer r.evictWritten(ctx, alert.ID)
+	return r.next.Save(ctx, alert)
+}
+
+// UpsertByFingerprint saves the alert unless a firing alert with the same fingerprint exists.
+func (r *AlertRepository) UpsertByFingerprint(ctx context.Context, alert *entity.Alert) (*entity.Alert, bool, error) {
+	defer r.evictWritten(ctx, alert.ID)
+	return r.next.UpsertByFingerprint(ctx, alert)
+}
+
+// FindByID retrieves an alert by its unique identifier, from the cache if possible.
+// Cached alerts of another tenant than the context is scoped to are not found.
+func (r *AlertRepository) FindByID(ctx context.Context, id string) (*entity.Alert, error) {
+	if inTx(ctx) {
+		return r.next.FindByID(ctx, id)
+	}
+	if alert, ok := r.byID.Get(id); ok {
+		r.record(ctx, alertsByIDCache, true)
+		if !repository.InTenant(ctx, alert.TenantID) {
+			return nil, nil
+		}
+		return alert.Clone(), nil
+	}
+	r.record(ctx, alertsByIDCache, false)
+
+	evictions := r.evictions.Load()
+	alert, err := r.next.FindByID(ctx, id)
+	if err != nil || alert == nil {
+		return alert, err
+	}
+	r.store(evictions, alert)
+	return alert, nil
+}
+
+// FindByExternalReference finds an alert by its external system reference,
+// from the cache if possible.
+func (r *AlertRepository) FindByExternalReference(ctx context.Context, system, referenceID string) (*entity.Alert, error) {
+	if inTx(ctx) {
+		return r.next.FindByExternalReference(ctx, system, referenceID)
+	}
+	key := system + "\x00" + referenceID
+	if id, ok := r.byRef.Get(key); ok {
+		alert, err := r.FindByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		// The alert may have moved to another reference, e.g. a re-posted message
+		if alert != nil && hasReference(alert, system, referenceID) {
+			r.record(ctx, alertsByRefCache, true)
+			return alert, nil
+		}
+		r.byRef.Remove(key)
+	}
+	r.record(ctx, alertsByRefCache, false)
+
+	evictions := r.evictions.Load()
+	alert, err := r.next.FindByExternalReference(ctx, system, referenceID)
+	if err != nil || alert == nil {
+		return alert, err
+	}
+	r.byRef.Add(key, alert.ID)
+	r.store(evictions, alert)
+	return alert, nil
+}
+
+// Update modifies an existing alert. The cached copy is evicted whether or
+// not the update succeeds: a rejected concurrent update means it is stale.
+func (r *AlertRepository) Update(ctx context.Context, alert *entity.Alert) error {
+	defer r.evictWritten(ctx, alert.ID)
+	return r.next.Update(ctx, alert)
+}
+
+// Delete removes an alert by ID.
+func (r *AlertRepository) Delete(ctx context.Context, id string) error {
+	defer r.evictWritten(ctx, id)
+	return r.next.Delete(ctx, id)
+}
+
+// FindByFingerprint finds alerts matching the Alertmanager fingerprint.
+func (r *AlertRepository) FindByFingerprint(ctx context.Context, fingerprint string) ([]*entity.Alert, error) {
+	return r.next.FindByFingerprint(ctx, fingerprint)
+}
+
+// FindActive returns all currently active alerts.
+func (r *AlertRepository) FindActive(ctx context.Context) ([]*entity.Alert, error) {
+	return r.next.FindActive(ctx)
+}
+
+// GetActiveAlerts returns active alerts, optionally filtered by severity.
+func (r *AlertRepository) GetActiveAlerts(ctx context.Context, severity string) ([]*entity.Alert, error) {
+	return r.next.GetActiveAlerts(ctx, severity)
+}
+
+// GetActiveAlertsByPriority returns active alerts, most urgent first.
+func (r *AlertRepository) GetActiveAlertsByPriority(ctx context.Context, severity string, weights entity.SeverityWeights) ([]*entity.Alert, error) {
+	return r.next.GetActiveAlertsByPriority(ctx, severity, weights)
+}
+
+// FindFiring returns all firing alerts.
+func (r *AlertRepository) FindFiring(ctx context.Context) ([]*entity.Alert, error) {
+	return r.next.FindFiring(ctx)
+}
+
+// FindFiringByCorrelationID returns the firing alerts sharing a correlation ID.
+func (r *AlertRepository) FindFiringByCorrelationID(ctx context.Context, correlationID string) ([]*entity.Alert, error) {
+	return r.next.FindFiringByCorrelationID(ctx, correlationID)
+}
+
+// FindNotSeenSince returns the firing alerts last seen before the given time.
+func (r *AlertRepository) FindNotSeenSince(ctx context.Context, before time.Time) ([]*entity.Alert, error) {
+	return r.next.FindNotSeenSince(ctx, before)
+}
+
+// FindByLabels returns the firing alerts carrying every matcher label.
+func (r *AlertRepository) FindByLabels(ctx context.Context, matchers map[string]string) ([]*entity.Alert, error) {
+	return r.next.FindByLabels(ctx, matchers)
+}
+
+// CountByStateSeverity aggregates stored alerts by state and severity.
+func (r *AlertRepository) CountByStateSeverity(ctx context.Context) ([]*entity.StateSeverityCount, error) {
+	return r.next.CountByStateSeverity(ctx)
+}
+
+// store caches a copy of an alert loaded from the wrapped repository, unless
+// a write happened since the load started.
+func (r *AlertRepository) store(evictions uint64, alert *entity.Alert) {
+	if r.evictions.Load() != evictions {
+		return
+	}
+	r.byID.Add(alert.ID, alert.Clone())
+}
+
+// evictWritten evicts an alert written with ctx, and once more when the
+// transaction ctx runs in ends.
+func (r *AlertRepository) evictWritten(ctx context.Context, id string) {
+	if writes := txWritesFrom(ctx); writes != nil {
+		writes.add(id)
+	}
+	r.evict(id)
+}
+
+// evict drops the cached alert. Reference entries are checked on use instead.
+func (r *AlertRepository) evict(id string) {
+	r.evictions.Add(1)
+	r.byID.Remove(id)
+}
+
+func (r *AlertRepository) record(ctx context.Context, cache string, hit bool) {
+	if r.metrics != nil {
+		r.metrics.RecordCacheLookup(ctx, cache, hit)
+	}
+}
+
+// hasReference reports whether the alert still holds the external reference.
+func hasReference(alert *entity.Alert, system, referenceID string) bool {
+	return alert.GetExternalReference(system) == referenceID ||
+		slices.Contains(alert.ExternalReferenceIDs(system), referenceID)
+}
@@ -0,0 +1,168 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository/repositorytest"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+func TestRepositoryConformance(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) repositorytest.Repositories {
+		return repositorytest.Repositories{
+			Alert:    NewAlertRepository(memory.NewAlertRepository(), 100, time.Minute),
+			AckEvent: memory.NewAckEventRepository(),
+			Silence:  memory.NewSilenceRepository(),
+			Delivery: memory.NewDeliveryRepository(),
+		}
+	})
+}
+
+// countingRepository counts the lookups reaching the wrapped repository.
+type countingRepository struct {
+	repository.AlertRepository
+	lookups int
+}
+
+func (r *countingRepository) FindByID(ctx context.Context, id string) (*entity.Alert, error) {
+	r.lookups++
+	return r.AlertRepository.FindByID(ctx, id)
+}
+
+func (r *countingRepository) FindByExternalReference(ctx context.Context, system, referenceID string) (*entity.Alert, error) {
+	r.lookups++
+	return r.AlertRepository.FindByExternalReference(ctx, system, referenceID)
+}
+
+func TestAlertRepository_ReadThrough(t *testing.T) {
+	ctx := context.Background()
+	backend := &countingRepository{AlertRepository: memory.NewAlertRepository()}
+	repo := NewAlertRepository(backend, 100, time.Minute)
+
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	alert.SetExternalReference("slack", "C1:1.0")
+	require.NoError(t, repo.Save(ctx, alert))
+
+	first, err := repo.FindByExternalReference(ctx, "slack", "C1:1.0")
+	require.NoError(t, err)
+	require.NotNil(t, first)
+	again, err := repo.FindByID(ctx, alert.ID)
+	require.NoError(t, err)
+	assert.Equal(t, first, again)
+	assert.Equal(t, 1, backend.lookups)
+
+	// Mutating a returned alert does not change the cached one
+	require.NoError(t, again.Acknowledge("oncall@example.com", time.Now()))
+	cached, err := repo.FindByID(ctx, alert.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.StateActive, cached.State)
+	assert.Equal(t, 1, backend.lookups)
+}
+
+func TestAlertRepository_UpdateEvictsStaleVersion(t *testing.T) {
+	ctx := context.Background()
+	repo := NewAlertRepository(memory.NewAlertRepository(), 100, time.Minute)
+
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	alert.SetExternalReference("slack", "C1:1.0")
+	require.NoError(t, repo.Save(ctx, alert))
+
+	loaded, err := repo.FindByID(ctx, alert.ID)
+	require.NoError(t, err)
+	stale, err := repo.FindByID(ctx, alert.ID)
+	require.NoError(t, err)
+
+	// The message was re-posted under a new reference
+	loaded.SetExternalReference("slack", "C1:2.0")
+	require.NoError(t, repo.Update(ctx, loaded))
+
+	// The next read sees the stored version, so a stale copy still loses the race
+	fresh, err := repo.FindByID(ctx, alert.ID)
+	require.NoError(t, err)
+	assert.Equal(t, loaded.Version, fresh.Version)
+	assert.ErrorIs(t, repo.Update(ctx, stale), repository.ErrConcurrentUpdate)
+
+	found, err := repo.FindByExternalReference(ctx, "slack", "C1:2.0")
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, alert.ID, found.ID)
+}
+
+// stagingKey marks a context in a stagingTransactionManager transaction.
+type stagingKey struct{}
+
+// stagingRepository holds back updates made in a transaction until it
+// commits, as a database does for readers outside the transaction.
+type stagingRepository struct {
+	repository.AlertRepository
+	staged []*entity.Alert
+}
+
+func (r *stagingRepository) Update(ctx context.Context, alert *entity.Alert) error {
+	if ctx.Value(stagingKey{}) != nil {
+		r.staged = append(r.staged, alert.Clone())
+		return nil
+	}
+	return r.AlertRepository.Update(ctx, alert)
+}
+
+// stagingTransactionManager commits the updates staged in its transactions.
+type stagingTransactionManager struct {
+	repo *stagingRepository
+}
+
+func (m *stagingTransactionManager) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := fn(context.WithValue(ctx, stagingKey{}, true)); err != nil {
+		return err
+	}
+	for _, alert := range m.repo.staged {
+		if err := m.repo.AlertRepository.Update(ctx, alert); err != nil {
+			return err
+		}
+	}
+	m.repo.staged = nil
+	return nil
+}
+
+func TestTransactionManager_EvictsAfterCommit(t *testing.T) {
+	ctx := context.Background()
+	backend := &stagingRepository{AlertRepository: memory.NewAlertRepository()}
+	repo := NewAlertRepository(backend, 100, time.Minute)
+	txManager := NewTransactionManager(&stagingTransactionManager{repo: backend}, repo)
+
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	require.NoError(t, repo.Save(ctx, alert))
+
+	err := txManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		loaded, err := repo.FindByID(txCtx, alert.ID)
+		if err != nil {
+			return err
+		}
+		require.NoError(t, loaded.Acknowledge("oncall@example.com", time.Now()))
+		if err := repo.Update(txCtx, loaded); err != nil {
+			return err
+		}
+
+		// A concurrent read outside the transaction caches the committed row
+		outside, err := repo.FindByID(ctx, alert.ID)
+		require.NoError(t, err)
+		assert.Equal(t, entity.StateActive, outside.State)
+		return nil
+	})
+	require.NoError(t, err)
+
+	// The commit evicted it, so the next read sees the acknowledged version
+	stored, err := backend.AlertRepository.FindByID(ctx, alert.ID)
+	require.NoError(t, err)
+	fresh, err := repo.FindByID(ctx, alert.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.StateAcked, fresh.State)
+	assert.Equal(t, stored.Version, fresh.Version)
+}
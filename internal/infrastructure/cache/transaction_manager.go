@@ -0,0 +1,73 @@
+package cache
+
+import (
+	"context"
+	"sync"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+)
+
+// TransactionManager wraps a repository.TransactionManager so an
+// AlertRepository cache stays consistent with transactions. Alerts written in a transaction are evicted
+// again once it ends: until the commit, reads outside the transaction still
+// load the old row and may cache it.
+type TransactionManager struct {
+	next   repository.TransactionManager
+	alerts *AlertRepository
+}
+
+// NewTransactionManager creates a TransactionManager evicting the alerts
+// written in its transactions from the given cache.
+func NewTransactionManager(next repository.TransactionManager, alerts *AlertRepository) *TransactionManager {
+	return &TransactionManager{next: next, alerts: alerts}
+}
+
+// WithTransaction runs fn in a transaction of the wrapped manager. Nested
+// calls join the outer transaction, whose end evicts the alerts written by
+// both.
+func (m *TransactionManager) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if txWritesFrom(ctx) != nil {
+		return m.next.WithTransaction(ctx, fn)
+	}
+
+	writes := &txWrites{}
+	defer func() {
+		for _, id := range writes.list() {
+			m.alerts.evict(id)
+		}
+	}()
+	return m.next.WithTransaction(context.WithValue(ctx, txWritesKey{}, writes), fn)
+}
+
+// txWritesKey marks a context running in a transaction of a TransactionManager.
+type txWritesKey struct{}
+
+// txWrites collects the IDs of the alerts written in a transaction.
+type txWrites struct {
+	mu  sync.Mutex
+	ids []string
+}
+
+func (w *txWrites) add(id string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.ids = append(w.ids, id)
+}
+
+func (w *txWrites) list() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.ids
+}
+
+// txWritesFrom returns the writes of the transaction ctx runs in, or nil.
+func txWritesFrom(ctx context.Context) *txWrites {
+	writes, _ := ctx.Value(txWritesKey{}).(*txWrites)
+	return writes
+}
+
+// inTx reports whether ctx runs in a transaction, whose reads must see its
+// own uncommitted writes and must not cache them.
+func inTx(ctx context.Context) bool {
+	return txWritesFrom(ctx) != nil || repository.TxFromContext(ctx) != nil
+}
@@ -1,31 +1,68 @@
 package config
 
 import (
+	"crypto/tls"
 	"fmt"
+	"net"
 	"os"
 	"strconv"
 	"strings"
 	"time"
 
+	mysqldriver "github.com/go-sql-driver/mysql"
 	"gopkg.in/yaml.v3"
 )
 
 // Config holds all application configuration.
 type Config struct {
-	Server       ServerConfig       `yaml:"server"`
-	Storage      StorageConfig      `yaml:"storage"`
-	Slack        SlackConfig        `yaml:"slack"`
-	PagerDuty    PagerDutyConfig    `yaml:"pagerduty"`
-	Alerting     AlertingConfig     `yaml:"alerting"`
-	Logging      LoggingConfig      `yaml:"logging"`
-	Alertmanager AlertmanagerConfig `yaml:"alertmanager"`
+	Server        ServerConfig        `yaml:"server"`
+	Storage       StorageConfig       `yaml:"storage"`
+	Slack         SlackConfig         `yaml:"slack"`
+	PagerDuty     PagerDutyConfig     `yaml:"pagerduty"`
+	Telegram      TelegramConfig      `yaml:"telegram"`
+	Discord       DiscordConfig       `yaml:"discord"`
+	Email         EmailConfig         `yaml:"email"`
+	Alerting      AlertingConfig      `yaml:"alerting"`
+	Logging       LoggingConfig       `yaml:"logging"`
+	Audit         AuditConfig         `yaml:"audit"`
+	Alertmanager  AlertmanagerConfig  `yaml:"alertmanager"`
+	Observability ObservabilityConfig `yaml:"observability"`
+	Templates     TemplatesConfig     `yaml:"templates"`
 }
 
+// TemplatesConfig holds the notification templates of each notifier, keyed
+// by notifier (slack, pagerduty, email, discord) and then template name.
+// Notifiers render their built-in messages where no template is set.
+type TemplatesConfig map[string]map[string]string
+
 // StorageConfig holds persistence storage settings.
 type StorageConfig struct {
-	Type   string       `yaml:"type"` // "memory", "sqlite", or "mysql"
-	SQLite SQLiteConfig `yaml:"sqlite"`
-	MySQL  MySQLConfig  `yaml:"mysql"`
+	Type        string       `yaml:"type"`         // "memory", "sqlite", or "mysql"
+	AutoMigrate *bool        `yaml:"auto_migrate"` // Run pending migrations at startup (default: true)
+	Memory      MemoryConfig `yaml:"memory"`
+	SQLite      SQLiteConfig `yaml:"sqlite"`
+	MySQL       MySQLConfig  `yaml:"mysql"`
+	Cache       CacheConfig  `yaml:"cache"`
+}
+
+// CacheConfig holds the read-through cache for alert lookups by ID and
+// external reference.
+type CacheConfig struct {
+	Enabled bool          `yaml:"enabled"`
+	Size    int           `yaml:"size"` // Maximum number of cached alerts (default: 1000)
+	TTL     time.Duration `yaml:"ttl"`  // How long an alert stays cached (default: 30s)
+}
+
+// AutoMigrateEnabled reports whether the server applies pending migrations at startup.
+// Defaults to true when auto_migrate is not set.
+func (s StorageConfig) AutoMigrateEnabled() bool {
+	return s.AutoMigrate == nil || *s.AutoMigrate
+}
+
+// MemoryConfig holds in-memory storage settings.
+type MemoryConfig struct {
+	TTL              time.Duration `yaml:"ttl"`               // How long resolved alerts and their ack events are kept (default: 24h)
+	EvictionInterval time.Duration `yaml:"eviction_interval"` // How often expired alerts are swept (default: 5m)
 }
 
 // SQLiteConfig holds SQLite-specific settings.
@@ -41,6 +78,46 @@ type MySQLConfig struct {
 	Timeout   time.Duration       `yaml:"timeout"`
 	ParseTime bool                `yaml:"parse_time"`
 	Charset   string              `yaml:"charset"`
+
+	// DSN is a go-sql-driver connection string for the primary, e.g.
+	// user:pass@tcp(db:3306)/alert_bridge?parseTime=true. When set it is used
+	// as is in place of the primary fields, which are filled in from it.
+	DSN string `yaml:"dsn"`
+}
+
+// applyDSN fills the primary fields from the DSN, overriding what is set,
+// along with the connect timeout if the DSN has one. A replica without its
+// own database or credentials shares the primary's. An invalid DSN is left
+// for Validate to report.
+func (c *MySQLConfig) applyDSN() {
+	if c.DSN == "" {
+		return
+	}
+	dsn, err := mysqldriver.ParseDSN(c.DSN)
+	if err != nil {
+		return
+	}
+
+	c.Primary.Host, c.Primary.Port = dsn.Addr, 0
+	if host, port, err := net.SplitHostPort(dsn.Addr); err == nil && dsn.Net != "unix" {
+		c.Primary.Host = host
+		c.Primary.Port, _ = strconv.Atoi(port)
+	}
+	c.Primary.Database = dsn.DBName
+	c.Primary.Username = dsn.User
+	c.Primary.Password = dsn.Passwd
+	c.ParseTime = dsn.ParseTime
+	if dsn.Timeout > 0 {
+		c.Timeout = dsn.Timeout
+	}
+
+	if c.Replica.Database == "" {
+		c.Replica.Database = dsn.DBName
+	}
+	if c.Replica.Username == "" && c.Replica.Password == "" {
+		c.Replica.Username = dsn.User
+		c.Replica.Password = dsn.Passwd
+	}
 }
 
 // MySQLInstanceConfig holds MySQL instance connection settings.
@@ -60,6 +137,10 @@ type MySQLReplicaConfig struct {
 	Database string `yaml:"database"`
 	Username string `yaml:"username"`
 	Password string `yaml:"password"`
+
+	// MaxStaleness is how long rows written by this instance are read from the
+	// primary instead of the replica, to cover replication lag (default: 1s).
+	MaxStaleness time.Duration `yaml:"max_staleness"`
 }
 
 // MySQLPoolConfig holds MySQL connection pool settings.
@@ -68,6 +149,7 @@ type MySQLPoolConfig struct {
 	MaxIdleConns    int           `yaml:"max_idle_conns"`
 	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
 	ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time"`
+	RetryAttempts   int           `yaml:"retry_attempts"` // Tries per query on deadlocks, lock wait timeouts and lost connections (default: 3, 1 disables retries)
 }
 
 // ServerConfig holds HTTP server settings.
@@ -77,6 +159,64 @@ type ServerConfig struct {
 	WriteTimeout    time.Duration `yaml:"write_timeout"`
 	RequestTimeout  time.Duration `yaml:"request_timeout"`
 	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+	AdminToken      string        `yaml:"admin_token"` // Bearer token for /api/v1/admin endpoints (empty disables them)
+
+	// MaxBodyBytes caps request body size; larger requests get 413 (default: 1 MiB).
+	MaxBodyBytes int64 `yaml:"max_body_bytes"`
+
+	// StrictJSON rejects Alertmanager and PagerDuty webhook payloads with
+	// fields the bridge does not know about.
+	StrictJSON bool `yaml:"strict_json"`
+
+	// PublicURL is the base URL the bridge is reachable at, used to link to
+	// its API from notifications (optional).
+	PublicURL string `yaml:"public_url"`
+
+	// GRPCPort serves the gRPC API on this port next to the HTTP server
+	// (0 disables it). Calls authenticate with AdminToken.
+	GRPCPort int `yaml:"grpc_port"`
+
+	// TLS serves HTTPS instead of plain HTTP when a certificate is set.
+	TLS ServerTLSConfig `yaml:"tls"`
+
+	// UserAuth authenticates API users acknowledging alerts through
+	// POST /api/v1/alerts/{id}/ack, so acks are attributed to them. The
+	// endpoint is disabled while no JWT secret is set.
+	UserAuth UserAuthConfig `yaml:"user_auth"`
+}
+
+// UserAuthConfig holds the JWT bearer tokens API users authenticate with.
+// Tokens must be HS256-signed, unexpired and carry an email claim.
+type UserAuthConfig struct {
+	JWTSecret string `yaml:"jwt_secret"` // HMAC key of the tokens, at least 32 bytes
+	Issuer    string `yaml:"issuer"`     // Required "iss" claim (optional)
+	Audience  string `yaml:"audience"`   // Required "aud" claim entry (optional)
+}
+
+// ServerTLSConfig holds the certificate the server terminates TLS with.
+// The files are watched and reloaded when they change, so renewed
+// certificates are picked up without a restart.
+type ServerTLSConfig struct {
+	CertFile   string `yaml:"cert_file"`   // PEM certificate chain
+	KeyFile    string `yaml:"key_file"`    // PEM private key
+	MinVersion string `yaml:"min_version"` // Oldest accepted TLS version: "1.2" or "1.3" (default: 1.2)
+}
+
+// Enabled reports whether the server serves HTTPS.
+func (c ServerTLSConfig) Enabled() bool {
+	return c.CertFile != "" || c.KeyFile != ""
+}
+
+// TLSMinVersion returns the crypto/tls constant for MinVersion.
+func (c ServerTLSConfig) TLSMinVersion() (uint16, error) {
+	switch c.MinVersion {
+	case "", "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	default:
+		return 0, fmt.Errorf("unsupported TLS version %q (must be 1.2 or 1.3)", c.MinVersion)
+	}
 }
 
 // SlackConfig holds Slack integration settings.
@@ -87,7 +227,72 @@ type SlackConfig struct {
 	ChannelID     string           `yaml:"channel_id"`
 	AppID         string           `yaml:"app_id"`
 	APIURL        string           `yaml:"api_url,omitempty"` // Optional: for E2E testing with mock services
+	AckReaction   string           `yaml:"ack_reaction"`      // Emoji name that acknowledges an alert when added to its message (empty disables)
 	SocketMode    SocketModeConfig `yaml:"socket_mode"`
+	AppHome       AppHomeConfig    `yaml:"app_home"`
+
+	// AdditionalChannelIDs receive a copy of every alert message besides ChannelID.
+	// Alerts can add further channels with a comma-separated "slack_channels" label.
+	AdditionalChannelIDs []string `yaml:"additional_channel_ids"`
+
+	// FallbackChannelID receives alert messages whose channel no longer
+	// exists or was archived. Empty disables the fallback.
+	FallbackChannelID string `yaml:"fallback_channel_id"`
+
+	// RepostOnMissing posts a fresh message when an update finds the original
+	// deleted, instead of failing the update.
+	RepostOnMissing bool `yaml:"repost_on_missing"`
+
+	// AllowCustomBody renders an alert's "slack_message" annotation in
+	// place of the generated summary and details, keeping the buttons.
+	AllowCustomBody bool `yaml:"allow_custom_body"`
+
+	// DisplayLabels lists the labels shown, in this order, in alert messages.
+	// HideLabels instead shows every label but these. With neither set no
+	// labels are shown; only one of them may be set.
+	DisplayLabels []string `yaml:"display_labels"`
+	HideLabels    []string `yaml:"hide_labels"`
+
+	// MaxDisplayLabels is how many labels a message shows before summarizing
+	// the rest as "+N more" (default: 10).
+	MaxDisplayLabels int `yaml:"max_display_labels"`
+
+	// InstanceSilenceDuration is how long the "silence this instance" button
+	// silences every alert from the alert's instance (default: 1h).
+	InstanceSilenceDuration time.Duration `yaml:"instance_silence_duration"`
+
+	// TenantChannels maps a tenant ID to the channel receiving its alerts in
+	// place of ChannelID. Slash commands run in a tenant channel only see that
+	// tenant's alerts and silences.
+	TenantChannels map[string]string `yaml:"tenant_channels"`
+
+	// MentionGroups maps a severity (critical, warning, info) to the Slack
+	// user group ID (e.g. S0123ABCDEF) mentioned when such an alert is first posted.
+	MentionGroups map[string]string `yaml:"mention_groups"`
+
+	// TimeFormat is how message times are rendered: "slack" (default) for
+	// Slack date tokens that each user sees in their own timezone, or a Go
+	// time layout such as "Jan 2, 15:04 MST" for static text.
+	TimeFormat string `yaml:"time_format"`
+
+	// Timezone is the IANA timezone (e.g. Europe/Berlin) of static times and
+	// of the fallback text of date tokens. Empty uses the server's timezone.
+	Timezone string `yaml:"timezone"`
+
+	// RequestMaxAge is how old a signed request's X-Slack-Request-Timestamp
+	// may be before it is rejected as a replay (default: 5m).
+	RequestMaxAge time.Duration `yaml:"request_max_age"`
+}
+
+// AppHomeConfig holds settings of the Slack App Home tab listing active alerts.
+type AppHomeConfig struct {
+	// Enabled publishes the Home tab when a user opens it
+	// (requires the app_home_opened event).
+	Enabled bool `yaml:"enabled"`
+
+	// RefreshInterval is how often the alerts are checked for changes and
+	// the Home tab of every user who opened it republished (default: 30s).
+	RefreshInterval time.Duration `yaml:"refresh_interval"`
 }
 
 // SocketModeConfig holds Socket Mode settings for local development.
@@ -100,21 +305,156 @@ type SocketModeConfig struct {
 
 // PagerDutyConfig holds PagerDuty integration settings.
 type PagerDutyConfig struct {
-	Enabled         bool   `yaml:"enabled"`
-	APIToken        string `yaml:"api_token"`
-	RoutingKey      string `yaml:"routing_key"`
-	ServiceID       string `yaml:"service_id"`
-	WebhookSecret   string `yaml:"webhook_secret"`
-	FromEmail       string `yaml:"from_email"`
-	DefaultSeverity string `yaml:"default_severity"`
-	APIURL          string `yaml:"api_url,omitempty"` // Optional: for E2E testing with mock services
+	Enabled          bool   `yaml:"enabled"`
+	APIToken         string `yaml:"api_token"`
+	RoutingKey       string `yaml:"routing_key"`
+	ServiceID        string `yaml:"service_id"`
+	WebhookSecret    string `yaml:"webhook_secret"`
+	FromEmail        string `yaml:"from_email"`
+	DefaultSeverity  string `yaml:"default_severity"`
+	DedupKeyTemplate string `yaml:"dedup_key_template"` // Optional: Go template over the alert; empty uses fingerprint
+	APIURL           string `yaml:"api_url,omitempty"`  // Optional: for E2E testing with mock services
+
+	// RoutingKeys maps a severity (critical, warning, info) to the Events API
+	// routing key its incidents are triggered with, so each severity can reach
+	// a service with its own urgency and escalation policy. Severities without
+	// an entry use RoutingKey.
+	RoutingKeys map[string]string `yaml:"routing_keys"`
+}
+
+// TelegramConfig holds Telegram integration settings.
+type TelegramConfig struct {
+	Enabled  bool   `yaml:"enabled"`
+	BotToken string `yaml:"bot_token"`
+	ChatID   string `yaml:"chat_id"`
+	APIURL   string `yaml:"api_url,omitempty"` // Optional: for E2E testing with mock services
+}
+
+// DiscordConfig holds Discord webhook integration settings.
+type DiscordConfig struct {
+	Enabled    bool   `yaml:"enabled"`
+	WebhookURL string `yaml:"webhook_url"`
+	Username   string `yaml:"username,omitempty"`   // Optional: overrides the webhook's default name
+	AvatarURL  string `yaml:"avatar_url,omitempty"` // Optional: overrides the webhook's default avatar
+}
+
+// EmailConfig holds SMTP email integration settings.
+type EmailConfig struct {
+	Enabled  bool     `yaml:"enabled"`
+	Host     string   `yaml:"host"`
+	Port     int      `yaml:"port"`
+	Username string   `yaml:"username"`
+	Password string   `yaml:"password"`
+	From     string   `yaml:"from"`
+	To       []string `yaml:"to"`
+	TLSMode  string   `yaml:"tls_mode"` // "none", "starttls", or "tls"
 }
 
 // AlertingConfig holds alerting behavior settings.
 type AlertingConfig struct {
-	DeduplicationWindow time.Duration   `yaml:"deduplication_window"`
-	ResendInterval      time.Duration   `yaml:"resend_interval"`
-	SilenceDurations    []time.Duration `yaml:"silence_durations"`
+	DeduplicationWindow time.Duration      `yaml:"deduplication_window"`
+	ResendInterval      time.Duration      `yaml:"resend_interval"`
+	AutoResolveAfter    time.Duration      `yaml:"auto_resolve_after"` // Resolve firing alerts not re-fired for this long (default: 0, disabled)
+	SilenceDurations    []time.Duration    `yaml:"silence_durations"`
+	RunbookBaseURL      string             `yaml:"runbook_base_url"`         // Optional: enables runbook link enrichment
+	RunbookURLTemplate  string             `yaml:"runbook_url_template"`     // Optional: Go template, defaults to "{{ .BaseURL }}/{{ pathEscape .Name }}"
+	NotifierSelfTest    string             `yaml:"notifier_self_test"`       // "off", "warn", or "fail" (default: "warn")
+	NotifierConcurrency int                `yaml:"notifier_max_concurrency"` // In-flight calls allowed per notifier; further calls queue (default: 0, unlimited)
+	MaxLabels           int                `yaml:"max_labels"`               // Labels or annotations a firing alert may have before it is rejected (default: 0, unlimited)
+	MaxLabelValueLen    int                `yaml:"max_label_value_len"`      // Bytes a label value may have before it is truncated (default: 0, unlimited)
+	DryRun              bool               `yaml:"dry_run"`                  // Log rendered notifications instead of sending them
+	DeterministicIDs    bool               `yaml:"deterministic_ids"`        // Derive alert IDs from fingerprint + fire time (multi-instance dedup)
+	Routes              []RouteConfig      `yaml:"routes"`                   // Label-based notifier selection; unmatched alerts go to all notifiers
+	Dependencies        []DependencyConfig `yaml:"dependencies"`             // Parent alerts that suppress the alerts depending on them
+	Outbox              OutboxConfig       `yaml:"outbox"`
+	AckExpiry           AckExpiryConfig    `yaml:"ack_expiry"`
+	QuietHours          QuietHoursConfig   `yaml:"quiet_hours"`
+	Digest              DigestConfig       `yaml:"digest"`
+	CorrelateBy         []string           `yaml:"correlate_by"`     // Labels whose shared values thread alerts under one Slack message
+	IdentityLabels      []string           `yaml:"identity_labels"`  // Labels identifying repeated deliveries of an alert; empty uses the fingerprint
+	DefaultSeverity     string             `yaml:"default_severity"` // Severity of alerts posted to /api/v1/alerts without one (default: warning)
+	TenantLabel         string             `yaml:"tenant_label"`     // Label naming the tenant owning an alert; empty keeps a single tenant
+
+	// PriorityWeights overrides the base priority of each severity used by
+	// GET /api/v1/alerts?sort=priority (default: critical 300, warning 200, info 100).
+	PriorityWeights map[string]int `yaml:"priority_weights"`
+
+	// SeverityMap maps incoming "severity" label values (e.g. page, ticket, none)
+	// to the internal severity and optional PagerDuty severity and Slack color.
+	// Values not listed keep the built-in mapping.
+	SeverityMap map[string]SeverityMappingConfig `yaml:"severity_map"`
+
+	// PriorityMap maps values of the "priority" annotation (e.g. P1, P2) to the
+	// severity, PagerDuty severity and Slack color that style and route alerts
+	// carrying them, overriding the severity label. Values not listed are ignored.
+	PriorityMap map[string]SeverityMappingConfig `yaml:"priority_map"`
+
+	// MaxMessagesPerWindow caps how many new alerts are posted to chat per
+	// MessageWindow; the rest are counted in one overflow message and posted
+	// as the window has room. Pages and emails are not capped
+	// (default: 0, unlimited).
+	MaxMessagesPerWindow int           `yaml:"max_messages_per_window"`
+	MessageWindow        time.Duration `yaml:"message_window"` // Rolling window of MaxMessagesPerWindow (default: 1m)
+}
+
+// SeverityMappingConfig describes how one incoming severity label value is handled.
+type SeverityMappingConfig struct {
+	Severity          string `yaml:"severity"`           // Internal severity: critical, warning, or info
+	PagerDutySeverity string `yaml:"pagerduty_severity"` // Optional: critical, error, warning, or info
+	Color             string `yaml:"color"`              // Optional: "#RRGGBB" color for firing Slack messages
+}
+
+// OutboxConfig controls delivering notifications through the transactional outbox.
+type OutboxConfig struct {
+	Enabled      bool          `yaml:"enabled"`       // Record notifications with the alert save and send them from a background dispatcher
+	PollInterval time.Duration `yaml:"poll_interval"` // How often pending notifications are sent (default: 1s)
+	MaxAttempts  int           `yaml:"max_attempts"`  // Delivery attempts before a notification is abandoned (default: 10)
+	RetryBackoff time.Duration `yaml:"retry_backoff"` // Wait before retrying a failed notification, doubled per attempt up to 5m (default: 5s)
+}
+
+// AckExpiryConfig returns acknowledged alerts that keep firing to active
+// once their acknowledgment expires, re-triggering their notifications.
+type AckExpiryConfig struct {
+	Enabled         bool          `yaml:"enabled"`          // Expire acks after the duration given with them, e.g. a snooze
+	DefaultDuration time.Duration `yaml:"default_duration"` // Expiry of acks given without a duration (default: 0, kept until resolved)
+}
+
+// QuietHoursConfig holds back the notifications of less urgent alerts during
+// a daily window until it ends. Critical alerts always break through.
+type QuietHoursConfig struct {
+	Start      string   `yaml:"start"`      // Window start as HH:MM, e.g. "22:00"; empty disables quiet hours
+	End        string   `yaml:"end"`        // Window end as HH:MM; earlier than start spans midnight
+	Timezone   string   `yaml:"timezone"`   // IANA timezone of start and end (default: UTC)
+	Severities []string `yaml:"severities"` // Severities held back: warning and/or info (default: both)
+	Digest     bool     `yaml:"digest"`     // Send the held alerts as one Slack digest when the window ends
+}
+
+// DigestConfig controls the periodic Slack summary of unacknowledged alerts.
+type DigestConfig struct {
+	Enabled    bool          `yaml:"enabled"`
+	Interval   time.Duration `yaml:"interval"`   // How often a digest is posted (default: 24h)
+	Severities []string      `yaml:"severities"` // Severities listed: warning and/or info (default: both)
+	Only       bool          `yaml:"only"`       // Skip individual notifications for these severities
+}
+
+// Enabled reports whether quiet hours are configured.
+func (c QuietHoursConfig) Enabled() bool {
+	return c.Start != ""
+}
+
+// RouteConfig sends alerts whose labels match to a subset of notifiers.
+type RouteConfig struct {
+	Match     map[string]string `yaml:"match"`     // Label must equal value
+	MatchRE   map[string]string `yaml:"match_re"`  // Label must fully match regex
+	Notifiers []string          `yaml:"notifiers"` // Notifier names: slack, pagerduty, telegram, discord, email
+}
+
+// DependencyConfig suppresses child alerts while a parent alert sharing the
+// Equal label values is firing, e.g. service alerts while their node is down.
+type DependencyConfig struct {
+	Parent   map[string]string `yaml:"parent"`   // Labels a parent alert carries, e.g. {alertname: NodeDown}
+	Children map[string]string `yaml:"children"` // Labels a child alert carries (default: any alert)
+	Equal    []string          `yaml:"equal"`    // Labels a child shares with its parent, e.g. [instance]
 }
 
 // LoggingConfig holds logging settings.
@@ -123,10 +463,29 @@ type LoggingConfig struct {
 	Format string `yaml:"format"`
 }
 
+// AuditConfig holds the compliance audit log settings. The audit log records
+// every acknowledgment, silence change and resolution, separately from the
+// operational logs.
+type AuditConfig struct {
+	Enabled bool   `yaml:"enabled"`
+	Path    string `yaml:"path"` // JSON-lines file the records are appended to (default: ./data/audit.log)
+}
+
 // AlertmanagerConfig holds Alertmanager webhook settings.
 type AlertmanagerConfig struct {
-	WebhookSecret string   `yaml:"webhook_secret"`
-	AllowedIPs    []string `yaml:"allowed_ips"` // Optional IP whitelist (not yet implemented)
+	WebhookSecret  string        `yaml:"webhook_secret"`
+	AllowedIPs     []string      `yaml:"allowed_ips"`     // Optional IP whitelist (not yet implemented)
+	IdempotencyTTL time.Duration `yaml:"idempotency_ttl"` // How long redelivered payloads are skipped (default: 5m)
+
+	// URL is the Alertmanager base URL, e.g. http://alertmanager:9093.
+	// When set, its active silences are imported into the silence store.
+	URL                 string        `yaml:"url"`
+	SilenceSyncInterval time.Duration `yaml:"silence_sync_interval"` // How often silences are imported (default: 1m)
+}
+
+// ObservabilityConfig holds tracing export settings.
+type ObservabilityConfig struct {
+	OTLPEndpoint string `yaml:"otlp_endpoint"` // OTLP/HTTP traces URL, e.g. http://otel-collector:4318 (empty disables tracing)
 }
 
 // Load reads configuration from file and environment.
@@ -151,6 +510,14 @@ func Load(path string) (*Config, error) {
 	// Override with environment variables
 	cfg.overrideFromEnv()
 
+	// Resolve secret references (env://, file://, vault://, awssm://)
+	if err := cfg.resolveSecrets(); err != nil {
+		return nil, err
+	}
+
+	// A MySQL DSN takes precedence over the structured connection fields
+	cfg.Storage.MySQL.applyDSN()
+
 	// Apply defaults
 	cfg.applyDefaults()
 
@@ -170,6 +537,37 @@ func (c *Config) overrideFromEnv() {
 			c.Server.Port = port
 		}
 	}
+	if v := os.Getenv("SERVER_ADMIN_TOKEN"); v != "" {
+		c.Server.AdminToken = v
+	}
+	if v := os.Getenv("SERVER_PUBLIC_URL"); v != "" {
+		c.Server.PublicURL = v
+	}
+	if v := os.Getenv("SERVER_USER_AUTH_JWT_SECRET"); v != "" {
+		c.Server.UserAuth.JWTSecret = v
+	}
+	if v := os.Getenv("SERVER_GRPC_PORT"); v != "" {
+		if port, err := strconv.Atoi(v); err == nil {
+			c.Server.GRPCPort = port
+		}
+	}
+	if v := os.Getenv("SERVER_MAX_BODY_BYTES"); v != "" {
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+			c.Server.MaxBodyBytes = n
+		}
+	}
+	if v := os.Getenv("SERVER_STRICT_JSON"); v != "" {
+		c.Server.StrictJSON = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("SERVER_TLS_CERT_FILE"); v != "" {
+		c.Server.TLS.CertFile = v
+	}
+	if v := os.Getenv("SERVER_TLS_KEY_FILE"); v != "" {
+		c.Server.TLS.KeyFile = v
+	}
+	if v := os.Getenv("SERVER_TLS_MIN_VERSION"); v != "" {
+		c.Server.TLS.MinVersion = v
+	}
 
 	// Slack
 	if v := os.Getenv("SLACK_ENABLED"); v != "" {
@@ -184,9 +582,61 @@ func (c *Config) overrideFromEnv() {
 	if v := os.Getenv("SLACK_CHANNEL_ID"); v != "" {
 		c.Slack.ChannelID = v
 	}
+	if v := os.Getenv("SLACK_ADDITIONAL_CHANNEL_IDS"); v != "" {
+		c.Slack.AdditionalChannelIDs = strings.Split(v, ",")
+	}
+	if v := os.Getenv("SLACK_FALLBACK_CHANNEL_ID"); v != "" {
+		c.Slack.FallbackChannelID = v
+	}
+	if v := os.Getenv("SLACK_REPOST_ON_MISSING"); v != "" {
+		c.Slack.RepostOnMissing = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("SLACK_ALLOW_CUSTOM_BODY"); v != "" {
+		c.Slack.AllowCustomBody = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("SLACK_DISPLAY_LABELS"); v != "" {
+		c.Slack.DisplayLabels = strings.Split(v, ",")
+	}
+	if v := os.Getenv("SLACK_HIDE_LABELS"); v != "" {
+		c.Slack.HideLabels = strings.Split(v, ",")
+	}
+	if v := os.Getenv("SLACK_MAX_DISPLAY_LABELS"); v != "" {
+		if limit, err := strconv.Atoi(v); err == nil {
+			c.Slack.MaxDisplayLabels = limit
+		}
+	}
 	if v := os.Getenv("SLACK_APP_ID"); v != "" {
 		c.Slack.AppID = v
 	}
+	if v := os.Getenv("SLACK_ACK_REACTION"); v != "" {
+		c.Slack.AckReaction = v
+	}
+	if v := os.Getenv("SLACK_INSTANCE_SILENCE_DURATION"); v != "" {
+		if duration, err := time.ParseDuration(v); err == nil {
+			c.Slack.InstanceSilenceDuration = duration
+		}
+	}
+	if v := os.Getenv("SLACK_TIME_FORMAT"); v != "" {
+		c.Slack.TimeFormat = v
+	}
+	if v := os.Getenv("SLACK_TIMEZONE"); v != "" {
+		c.Slack.Timezone = v
+	}
+	if v := os.Getenv("SLACK_REQUEST_MAX_AGE"); v != "" {
+		if duration, err := time.ParseDuration(v); err == nil {
+			c.Slack.RequestMaxAge = duration
+		}
+	}
+
+	// Slack App Home
+	if v := os.Getenv("SLACK_APP_HOME_ENABLED"); v != "" {
+		c.Slack.AppHome.Enabled = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("SLACK_APP_HOME_REFRESH_INTERVAL"); v != "" {
+		if duration, err := time.ParseDuration(v); err == nil {
+			c.Slack.AppHome.RefreshInterval = duration
+		}
+	}
 
 	// Slack Socket Mode
 	if v := os.Getenv("SLACK_SOCKET_MODE_ENABLED"); v != "" {
@@ -226,6 +676,141 @@ func (c *Config) overrideFromEnv() {
 	if v := os.Getenv("PAGERDUTY_DEFAULT_SEVERITY"); v != "" {
 		c.PagerDuty.DefaultSeverity = v
 	}
+	if v := os.Getenv("PAGERDUTY_DEDUP_KEY_TEMPLATE"); v != "" {
+		c.PagerDuty.DedupKeyTemplate = v
+	}
+
+	// Telegram
+	if v := os.Getenv("TELEGRAM_ENABLED"); v != "" {
+		c.Telegram.Enabled = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("TELEGRAM_BOT_TOKEN"); v != "" {
+		c.Telegram.BotToken = v
+	}
+	if v := os.Getenv("TELEGRAM_CHAT_ID"); v != "" {
+		c.Telegram.ChatID = v
+	}
+
+	// Discord
+	if v := os.Getenv("DISCORD_ENABLED"); v != "" {
+		c.Discord.Enabled = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("DISCORD_WEBHOOK_URL"); v != "" {
+		c.Discord.WebhookURL = v
+	}
+
+	// Email
+	if v := os.Getenv("EMAIL_ENABLED"); v != "" {
+		c.Email.Enabled = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("SMTP_HOST"); v != "" {
+		c.Email.Host = v
+	}
+	if v := os.Getenv("SMTP_PORT"); v != "" {
+		if port, err := strconv.Atoi(v); err == nil {
+			c.Email.Port = port
+		}
+	}
+	if v := os.Getenv("SMTP_USERNAME"); v != "" {
+		c.Email.Username = v
+	}
+	if v := os.Getenv("SMTP_PASSWORD"); v != "" {
+		c.Email.Password = v
+	}
+	if v := os.Getenv("EMAIL_FROM"); v != "" {
+		c.Email.From = v
+	}
+	if v := os.Getenv("EMAIL_TO"); v != "" {
+		c.Email.To = strings.Split(v, ",")
+	}
+
+	// Alerting
+	if v := os.Getenv("NOTIFIER_SELF_TEST"); v != "" {
+		c.Alerting.NotifierSelfTest = strings.ToLower(v)
+	}
+	if v := os.Getenv("NOTIFIER_MAX_CONCURRENCY"); v != "" {
+		if limit, err := strconv.Atoi(v); err == nil {
+			c.Alerting.NotifierConcurrency = limit
+		}
+	}
+	if v := os.Getenv("ALERTING_AUTO_RESOLVE_AFTER"); v != "" {
+		if duration, err := time.ParseDuration(v); err == nil {
+			c.Alerting.AutoResolveAfter = duration
+		}
+	}
+	if v := os.Getenv("ALERTING_MAX_MESSAGES_PER_WINDOW"); v != "" {
+		if limit, err := strconv.Atoi(v); err == nil {
+			c.Alerting.MaxMessagesPerWindow = limit
+		}
+	}
+	if v := os.Getenv("ALERTING_MESSAGE_WINDOW"); v != "" {
+		if duration, err := time.ParseDuration(v); err == nil {
+			c.Alerting.MessageWindow = duration
+		}
+	}
+	if v := os.Getenv("ALERTING_MAX_LABELS"); v != "" {
+		if limit, err := strconv.Atoi(v); err == nil {
+			c.Alerting.MaxLabels = limit
+		}
+	}
+	if v := os.Getenv("ALERTING_MAX_LABEL_VALUE_LEN"); v != "" {
+		if limit, err := strconv.Atoi(v); err == nil {
+			c.Alerting.MaxLabelValueLen = limit
+		}
+	}
+	if v := os.Getenv("ALERTING_DETERMINISTIC_IDS"); v != "" {
+		c.Alerting.DeterministicIDs = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("ALERTING_CORRELATE_BY"); v != "" {
+		c.Alerting.CorrelateBy = strings.Split(v, ",")
+	}
+	if v := os.Getenv("ALERTING_IDENTITY_LABELS"); v != "" {
+		c.Alerting.IdentityLabels = strings.Split(v, ",")
+	}
+	if v := os.Getenv("ALERTING_TENANT_LABEL"); v != "" {
+		c.Alerting.TenantLabel = v
+	}
+	if v := os.Getenv("ALERTING_DEFAULT_SEVERITY"); v != "" {
+		c.Alerting.DefaultSeverity = v
+	}
+	if v := os.Getenv("ALERTING_QUIET_HOURS_START"); v != "" {
+		c.Alerting.QuietHours.Start = v
+	}
+	if v := os.Getenv("ALERTING_QUIET_HOURS_END"); v != "" {
+		c.Alerting.QuietHours.End = v
+	}
+	if v := os.Getenv("ALERTING_QUIET_HOURS_TIMEZONE"); v != "" {
+		c.Alerting.QuietHours.Timezone = v
+	}
+	if v := os.Getenv("ALERTING_DIGEST_ENABLED"); v != "" {
+		c.Alerting.Digest.Enabled = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("ALERTING_DIGEST_INTERVAL"); v != "" {
+		if duration, err := time.ParseDuration(v); err == nil {
+			c.Alerting.Digest.Interval = duration
+		}
+	}
+	if v := os.Getenv("ALERTING_ACK_EXPIRY_ENABLED"); v != "" {
+		c.Alerting.AckExpiry.Enabled = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("ALERTING_ACK_EXPIRY_DEFAULT_DURATION"); v != "" {
+		if duration, err := time.ParseDuration(v); err == nil {
+			c.Alerting.AckExpiry.DefaultDuration = duration
+		}
+	}
+	if v := os.Getenv("ALERTING_OUTBOX_ENABLED"); v != "" {
+		c.Alerting.Outbox.Enabled = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("ALERTING_OUTBOX_POLL_INTERVAL"); v != "" {
+		if duration, err := time.ParseDuration(v); err == nil {
+			c.Alerting.Outbox.PollInterval = duration
+		}
+	}
+	if v := os.Getenv("ALERTING_OUTBOX_RETRY_BACKOFF"); v != "" {
+		if duration, err := time.ParseDuration(v); err == nil {
+			c.Alerting.Outbox.RetryBackoff = duration
+		}
+	}
 
 	// Logging
 	if v := os.Getenv("LOG_LEVEL"); v != "" {
@@ -235,15 +820,63 @@ func (c *Config) overrideFromEnv() {
 		c.Logging.Format = v
 	}
 
+	// Audit
+	if v := os.Getenv("AUDIT_ENABLED"); v != "" {
+		c.Audit.Enabled = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("AUDIT_PATH"); v != "" {
+		c.Audit.Path = v
+	}
+
 	// Alertmanager
 	if v := os.Getenv("ALERTMANAGER_WEBHOOK_SECRET"); v != "" {
 		c.Alertmanager.WebhookSecret = v
 	}
+	if v := os.Getenv("ALERTMANAGER_IDEMPOTENCY_TTL"); v != "" {
+		if duration, err := time.ParseDuration(v); err == nil {
+			c.Alertmanager.IdempotencyTTL = duration
+		}
+	}
+	if v := os.Getenv("ALERTMANAGER_URL"); v != "" {
+		c.Alertmanager.URL = v
+	}
+	if v := os.Getenv("ALERTMANAGER_SILENCE_SYNC_INTERVAL"); v != "" {
+		if duration, err := time.ParseDuration(v); err == nil {
+			c.Alertmanager.SilenceSyncInterval = duration
+		}
+	}
+
+	// Dry run
+	if v := os.Getenv("DRY_RUN"); v != "" {
+		c.Alerting.DryRun = strings.ToLower(v) == "true"
+	}
+
+	// Observability
+	if v := os.Getenv("OBSERVABILITY_OTLP_ENDPOINT"); v != "" {
+		c.Observability.OTLPEndpoint = v
+	}
 
 	// Storage
 	if v := os.Getenv("STORAGE_TYPE"); v != "" {
 		c.Storage.Type = v
 	}
+	if v := os.Getenv("STORAGE_AUTO_MIGRATE"); v != "" {
+		autoMigrate := strings.ToLower(v) == "true"
+		c.Storage.AutoMigrate = &autoMigrate
+	}
+	if v := os.Getenv("STORAGE_CACHE_ENABLED"); v != "" {
+		c.Storage.Cache.Enabled = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("MEMORY_TTL"); v != "" {
+		if duration, err := time.ParseDuration(v); err == nil {
+			c.Storage.Memory.TTL = duration
+		}
+	}
+	if v := os.Getenv("MEMORY_EVICTION_INTERVAL"); v != "" {
+		if duration, err := time.ParseDuration(v); err == nil {
+			c.Storage.Memory.EvictionInterval = duration
+		}
+	}
 	if v := os.Getenv("SQLITE_DATABASE_PATH"); v != "" {
 		c.Storage.SQLite.Path = v
 	}
@@ -266,6 +899,9 @@ func (c *Config) overrideFromEnv() {
 	if v := os.Getenv("MYSQL_PASSWORD"); v != "" {
 		c.Storage.MySQL.Primary.Password = v
 	}
+	if v := os.Getenv("MYSQL_DSN"); v != "" {
+		c.Storage.MySQL.DSN = v
+	}
 	if v := os.Getenv("MYSQL_MAX_OPEN_CONNS"); v != "" {
 		if conns, err := strconv.Atoi(v); err == nil {
 			c.Storage.MySQL.Pool.MaxOpenConns = conns
@@ -286,6 +922,11 @@ func (c *Config) overrideFromEnv() {
 			c.Storage.MySQL.Pool.ConnMaxIdleTime = duration
 		}
 	}
+	if v := os.Getenv("MYSQL_RETRY_ATTEMPTS"); v != "" {
+		if attempts, err := strconv.Atoi(v); err == nil {
+			c.Storage.MySQL.Pool.RetryAttempts = attempts
+		}
+	}
 
 	// MySQL Replica (optional)
 	if v := os.Getenv("MYSQL_REPLICA_ENABLED"); v != "" {
@@ -308,6 +949,11 @@ func (c *Config) overrideFromEnv() {
 	if v := os.Getenv("MYSQL_REPLICA_PASSWORD"); v != "" {
 		c.Storage.MySQL.Replica.Password = v
 	}
+	if v := os.Getenv("MYSQL_REPLICA_MAX_STALENESS"); v != "" {
+		if duration, err := time.ParseDuration(v); err == nil {
+			c.Storage.MySQL.Replica.MaxStaleness = duration
+		}
+	}
 }
 
 // applyDefaults sets default values for unset config options.
@@ -328,6 +974,12 @@ func (c *Config) applyDefaults() {
 	if c.Server.ShutdownTimeout == 0 {
 		c.Server.ShutdownTimeout = 30 * time.Second
 	}
+	if c.Server.TLS.Enabled() && c.Server.TLS.MinVersion == "" {
+		c.Server.TLS.MinVersion = "1.2"
+	}
+	if c.Server.MaxBodyBytes == 0 {
+		c.Server.MaxBodyBytes = 1 << 20
+	}
 
 	// Alerting defaults
 	if c.Alerting.DeduplicationWindow == 0 {
@@ -336,6 +988,9 @@ func (c *Config) applyDefaults() {
 	if c.Alerting.ResendInterval == 0 {
 		c.Alerting.ResendInterval = 30 * time.Minute
 	}
+	if c.Alerting.MessageWindow == 0 {
+		c.Alerting.MessageWindow = time.Minute
+	}
 	if len(c.Alerting.SilenceDurations) == 0 {
 		c.Alerting.SilenceDurations = []time.Duration{
 			15 * time.Minute,
@@ -345,6 +1000,64 @@ func (c *Config) applyDefaults() {
 		}
 	}
 
+	if c.Alerting.NotifierSelfTest == "" {
+		c.Alerting.NotifierSelfTest = "warn"
+	}
+	if c.Alerting.DefaultSeverity == "" {
+		c.Alerting.DefaultSeverity = "warning"
+	}
+	if c.Alerting.Outbox.PollInterval == 0 {
+		c.Alerting.Outbox.PollInterval = time.Second
+	}
+	if c.Alerting.Outbox.MaxAttempts == 0 {
+		c.Alerting.Outbox.MaxAttempts = 10
+	}
+	if c.Alerting.Outbox.RetryBackoff == 0 {
+		c.Alerting.Outbox.RetryBackoff = 5 * time.Second
+	}
+	if c.Alerting.Digest.Enabled {
+		if c.Alerting.Digest.Interval == 0 {
+			c.Alerting.Digest.Interval = 24 * time.Hour
+		}
+		if len(c.Alerting.Digest.Severities) == 0 {
+			c.Alerting.Digest.Severities = []string{"warning", "info"}
+		}
+	}
+	if c.Alerting.QuietHours.Enabled() {
+		if c.Alerting.QuietHours.Timezone == "" {
+			c.Alerting.QuietHours.Timezone = "UTC"
+		}
+		if len(c.Alerting.QuietHours.Severities) == 0 {
+			c.Alerting.QuietHours.Severities = []string{"warning", "info"}
+		}
+	}
+
+	// Alertmanager defaults
+	if c.Alertmanager.IdempotencyTTL == 0 {
+		c.Alertmanager.IdempotencyTTL = 5 * time.Minute
+	}
+	if c.Alertmanager.SilenceSyncInterval == 0 {
+		c.Alertmanager.SilenceSyncInterval = time.Minute
+	}
+
+	// Slack defaults
+	if c.Slack.InstanceSilenceDuration == 0 {
+		c.Slack.InstanceSilenceDuration = time.Hour
+	}
+	if c.Slack.RequestMaxAge == 0 {
+		c.Slack.RequestMaxAge = 5 * time.Minute
+	}
+	if c.Slack.TimeFormat == "" {
+		c.Slack.TimeFormat = "slack"
+	}
+	if c.Slack.MaxDisplayLabels == 0 {
+		c.Slack.MaxDisplayLabels = 10
+	}
+
+	if c.Slack.AppHome.RefreshInterval == 0 {
+		c.Slack.AppHome.RefreshInterval = 30 * time.Second
+	}
+
 	// Slack Socket Mode defaults
 	if c.Slack.SocketMode.PingInterval == 0 {
 		c.Slack.SocketMode.PingInterval = 30 * time.Second
@@ -355,6 +1068,21 @@ func (c *Config) applyDefaults() {
 		c.PagerDuty.DefaultSeverity = "warning"
 	}
 
+	// Email defaults
+	if c.Email.TLSMode == "" {
+		c.Email.TLSMode = "starttls"
+	}
+	if c.Email.Port == 0 {
+		switch c.Email.TLSMode {
+		case "tls":
+			c.Email.Port = 465
+		case "none":
+			c.Email.Port = 25
+		default:
+			c.Email.Port = 587
+		}
+	}
+
 	// Logging defaults
 	if c.Logging.Level == "" {
 		c.Logging.Level = "info"
@@ -363,10 +1091,27 @@ func (c *Config) applyDefaults() {
 		c.Logging.Format = "json"
 	}
 
+	// Audit defaults
+	if c.Audit.Path == "" {
+		c.Audit.Path = "./data/audit.log"
+	}
+
 	// Storage defaults
 	if c.Storage.Type == "" {
 		c.Storage.Type = "memory"
 	}
+	if c.Storage.Memory.TTL == 0 {
+		c.Storage.Memory.TTL = 24 * time.Hour
+	}
+	if c.Storage.Memory.EvictionInterval == 0 {
+		c.Storage.Memory.EvictionInterval = 5 * time.Minute
+	}
+	if c.Storage.Cache.Size == 0 {
+		c.Storage.Cache.Size = 1000
+	}
+	if c.Storage.Cache.TTL == 0 {
+		c.Storage.Cache.TTL = 30 * time.Second
+	}
 	if c.Storage.SQLite.Path == "" {
 		c.Storage.SQLite.Path = "./data/alert-bridge.db"
 	}
@@ -384,6 +1129,9 @@ func (c *Config) applyDefaults() {
 	if c.Storage.MySQL.Pool.ConnMaxIdleTime == 0 {
 		c.Storage.MySQL.Pool.ConnMaxIdleTime = 1 * time.Minute
 	}
+	if c.Storage.MySQL.Pool.RetryAttempts == 0 {
+		c.Storage.MySQL.Pool.RetryAttempts = 3
+	}
 	if c.Storage.MySQL.Timeout == 0 {
 		c.Storage.MySQL.Timeout = 5 * time.Second
 	}
@@ -399,6 +1147,9 @@ func (c *Config) applyDefaults() {
 	if c.Storage.MySQL.Replica.Port == 0 {
 		c.Storage.MySQL.Replica.Port = 3306
 	}
+	if c.Storage.MySQL.Replica.MaxStaleness == 0 {
+		c.Storage.MySQL.Replica.MaxStaleness = time.Second
+	}
 }
 
 // validate checks that required configuration is present.
@@ -417,3 +1168,23 @@ func (c *Config) IsSlackEnabled() bool {
 func (c *Config) IsPagerDutyEnabled() bool {
 	return c.PagerDuty.Enabled
 }
+
+// IsTelegramEnabled returns true if Telegram integration is enabled.
+func (c *Config) IsTelegramEnabled() bool {
+	return c.Telegram.Enabled
+}
+
+// IsDiscordEnabled returns true if Discord integration is enabled.
+func (c *Config) IsDiscordEnabled() bool {
+	return c.Discord.Enabled
+}
+
+// IsAlertmanagerSilenceSyncEnabled returns true if silences are imported from Alertmanager.
+func (c *Config) IsAlertmanagerSilenceSyncEnabled() bool {
+	return c.Alertmanager.URL != ""
+}
+
+// IsEmailEnabled returns true if email integration is enabled.
+func (c *Config) IsEmailEnabled() bool {
+	return c.Email.Enabled
+}
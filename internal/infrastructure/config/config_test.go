@@ -0,0 +1,141 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// loadMySQLConfig loads a configuration with MySQL storage set up by the
+// given YAML lines of storage.mysql.
+func loadMySQLConfig(t *testing.T, mysql string) (*Config, error) {
+	t.Helper()
+
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	data := "storage:\n  type: mysql\n  mysql:\n" + mysql + "slack:\n  enabled: false\n"
+	if err := os.WriteFile(configPath, []byte(data), 0644); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+	return Load(configPath)
+}
+
+func TestLoad_MySQLDSN(t *testing.T) {
+	structured := `    primary:
+      host: db.internal
+      port: 3307
+      database: structured
+      username: structured_user
+      password: structured_pass
+`
+
+	t.Run("structured fields without a DSN", func(t *testing.T) {
+		cfg, err := loadMySQLConfig(t, structured)
+		if err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+		if got := cfg.Storage.MySQL.Primary; got.Host != "db.internal" || got.Port != 3307 || got.Database != "structured" {
+			t.Errorf("unexpected primary: %+v", got)
+		}
+	})
+
+	t.Run("DSN overrides structured fields", func(t *testing.T) {
+		cfg, err := loadMySQLConfig(t, structured+`    dsn: app:s3cret@tcp(mysql.example.com:3306)/alerts?parseTime=true&timeout=10s
+    replica:
+      enabled: true
+      host: replica.example.com
+`)
+		if err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+		mysql := cfg.Storage.MySQL
+		want := MySQLInstanceConfig{Host: "mysql.example.com", Port: 3306, Database: "alerts", Username: "app", Password: "s3cret"}
+		if mysql.Primary != want {
+			t.Errorf("expected primary %+v from the DSN, got %+v", want, mysql.Primary)
+		}
+		if mysql.Timeout != 10*time.Second {
+			t.Errorf("expected the DSN timeout, got %s", mysql.Timeout)
+		}
+		// The replica shares the DSN's database and credentials
+		if mysql.Replica.Database != "alerts" || mysql.Replica.Username != "app" || mysql.Replica.Password != "s3cret" {
+			t.Errorf("unexpected replica: %+v", mysql.Replica)
+		}
+		if got := cfg.Redacted().Storage.MySQL.DSN; got != redactedValue {
+			t.Errorf("expected the DSN redacted, got %q", got)
+		}
+	})
+
+	t.Run("DSN without other fields", func(t *testing.T) {
+		if _, err := loadMySQLConfig(t, "    dsn: app:s3cret@tcp(mysql.example.com)/alerts?parseTime=true\n"); err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+	})
+
+	invalid := []struct {
+		name string
+		dsn  string
+		want string
+	}{
+		{"malformed", "app:s3cret@tcp(mysql.example.com/alerts", "storage.mysql.dsn is invalid"},
+		{"no database", "app:s3cret@tcp(mysql.example.com:3306)/?parseTime=true", "must name a database"},
+		{"no parseTime", "app:s3cret@tcp(mysql.example.com:3306)/alerts", "parseTime=true"},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := loadMySQLConfig(t, "    dsn: "+tt.dsn+"\n")
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+// loadEmailConfig loads a configuration with email enabled and set up by the
+// given YAML lines of email.
+func loadEmailConfig(t *testing.T, email string) (*Config, error) {
+	t.Helper()
+
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	data := "slack:\n  enabled: false\nemail:\n  enabled: true\n" + email
+	if err := os.WriteFile(configPath, []byte(data), 0644); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+	return Load(configPath)
+}
+
+func TestLoad_Email(t *testing.T) {
+	valid := []struct {
+		name  string
+		email string
+	}{
+		{"display names", "  host: smtp.example.com\n  from: Alert Bridge <alerts@example.com>\n  to: [On-call <oncall@example.com>]\n"},
+		{"credentials over starttls", "  host: smtp.example.com\n  username: alerts\n  password: s3cret\n  from: alerts@example.com\n  to: [oncall@example.com]\n"},
+		{"credentials to a local relay", "  host: localhost\n  tls_mode: none\n  username: alerts\n  from: alerts@example.com\n  to: [oncall@example.com]\n"},
+	}
+	for _, tt := range valid {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := loadEmailConfig(t, tt.email); err != nil {
+				t.Errorf("Load failed: %v", err)
+			}
+		})
+	}
+
+	invalid := []struct {
+		name  string
+		email string
+		want  string
+	}{
+		{"malformed from", "  host: smtp.example.com\n  from: Alert Bridge\n  to: [oncall@example.com]\n", "email.from must be an email address"},
+		{"malformed recipient", "  host: smtp.example.com\n  from: alerts@example.com\n  to: [oncall]\n", "email.to must contain email addresses"},
+		{"credentials without TLS", "  host: smtp.example.com\n  tls_mode: none\n  username: alerts\n  from: alerts@example.com\n  to: [oncall@example.com]\n", "email.username requires tls_mode"},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := loadEmailConfig(t, tt.email)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
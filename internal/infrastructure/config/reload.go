@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log/slog"
 	"reflect"
+	"strings"
 	"sync"
 
 	"github.com/spf13/viper"
@@ -16,7 +17,8 @@ type ConfigManager struct {
 	viper           *viper.Viper
 	configPath      string
 	logger          *slog.Logger
-	onReloadSuccess func(*Config) // Callback after successful reload
+	override        func(*Config)             // Applied to every loaded config
+	onReloadSuccess func(*Config, ConfigDiff) // Callback after successful reload
 }
 
 // NewConfigManager creates a new ConfigManager with the initial configuration.
@@ -29,13 +31,22 @@ func NewConfigManager(cfg *Config, v *viper.Viper, configPath string, logger *sl
 	}
 }
 
-// SetReloadCallback sets a callback function to be called after successful reload.
-func (cm *ConfigManager) SetReloadCallback(callback func(*Config)) {
+// SetReloadCallback sets a callback function to be called after successful
+// reload. It receives the applied config and the reloadable keys that changed.
+func (cm *ConfigManager) SetReloadCallback(callback func(*Config, ConfigDiff)) {
 	cm.mu.Lock()
 	defer cm.mu.Unlock()
 	cm.onReloadSuccess = callback
 }
 
+// SetOverride sets a function applied to every reloaded config before it is
+// compared with the running one, e.g. to keep command-line overrides.
+func (cm *ConfigManager) SetOverride(override func(*Config)) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.override = override
+}
+
 // Get returns a copy of the current configuration (thread-safe read).
 func (cm *ConfigManager) Get() *Config {
 	cm.mu.RLock()
@@ -44,8 +55,9 @@ func (cm *ConfigManager) Get() *Config {
 }
 
 // TryReload attempts to reload configuration from file.
-// Returns error if parsing, validation, or static config changes detected.
-// On success, atomically swaps to new configuration.
+// Only reloadable keys are applied; static changes are logged and ignored,
+// in which case ErrRequiresRestart is returned after the swap.
+// Returns error if parsing or validation fails, preserving the running config.
 func (cm *ConfigManager) TryReload() error {
 	// Parse new config using the existing Load function
 	newCfg, err := Load(cm.configPath)
@@ -58,29 +70,34 @@ func (cm *ConfigManager) TryReload() error {
 		return fmt.Errorf("parse failed: %w", err)
 	}
 
+	cm.mu.RLock()
+	override := cm.override
+	callback := cm.onReloadSuccess
+	cm.mu.RUnlock()
+	if override != nil {
+		override(newCfg)
+	}
+
 	// Check for static config changes
 	cm.mu.RLock()
 	oldCfg := cm.config
 	staticChanges := detectStaticChanges(oldCfg, newCfg)
+	diff := extractConfigDiff(oldCfg, newCfg)
+	applied := applyReloadable(oldCfg, newCfg)
 	cm.mu.RUnlock()
 
-	if len(staticChanges) > 0 {
-		// Log warning for static changes
+	// Static changes are ignored; only whitelisted keys are applied
+	for _, key := range staticChanges {
 		cm.logger.Warn("configuration change requires restart",
-			"changed_keys", staticChanges,
-			"reason", getRestartReason(staticChanges[0]),
+			"changed_keys", []string{key},
+			"reason", getRestartReason(key),
+			"ignored", true,
 		)
-		return ErrRequiresRestart
 	}
 
-	// Extract diff for logging
-	cm.mu.RLock()
-	diff := extractConfigDiff(cm.config, newCfg)
-	cm.mu.RUnlock()
-
 	// Atomic config swap
 	cm.mu.Lock()
-	cm.config = newCfg
+	cm.config = applied
 	cm.mu.Unlock()
 
 	// Log successful reload only if there are changes
@@ -99,13 +116,28 @@ func (cm *ConfigManager) TryReload() error {
 	}
 
 	// Call reload callback if set
-	if cm.onReloadSuccess != nil {
-		cm.onReloadSuccess(newCfg)
+	if callback != nil {
+		callback(applied, diff)
 	}
 
+	if len(staticChanges) > 0 {
+		return ErrRequiresRestart
+	}
 	return nil
 }
 
+// applyReloadable returns a copy of the running config with only the
+// hot-reloadable keys taken from the newly loaded config: the logging
+// settings and the Slack channel. The alerting settings are applied to the
+// use cases once at startup, so they stay as they are until a restart.
+func applyReloadable(running, loaded *Config) *Config {
+	applied := *running
+	applied.Logging.Level = loaded.Logging.Level
+	applied.Logging.Format = loaded.Logging.Format
+	applied.Slack.ChannelID = loaded.Slack.ChannelID
+	return &applied
+}
+
 // ConfigDiff represents configuration changes.
 type ConfigDiff struct {
 	ChangedKeys []string
@@ -140,18 +172,6 @@ func extractConfigDiff(oldCfg, newCfg *Config) ConfigDiff {
 		diff.NewValues["slack.channel_id"] = newCfg.Slack.ChannelID
 	}
 
-	if oldCfg.Alerting.DeduplicationWindow != newCfg.Alerting.DeduplicationWindow {
-		diff.ChangedKeys = append(diff.ChangedKeys, "alerting.deduplication_window")
-		diff.OldValues["alerting.deduplication_window"] = oldCfg.Alerting.DeduplicationWindow.String()
-		diff.NewValues["alerting.deduplication_window"] = newCfg.Alerting.DeduplicationWindow.String()
-	}
-
-	if oldCfg.Alerting.ResendInterval != newCfg.Alerting.ResendInterval {
-		diff.ChangedKeys = append(diff.ChangedKeys, "alerting.resend_interval")
-		diff.OldValues["alerting.resend_interval"] = oldCfg.Alerting.ResendInterval.String()
-		diff.NewValues["alerting.resend_interval"] = newCfg.Alerting.ResendInterval.String()
-	}
-
 	return diff
 }
 
@@ -179,8 +199,20 @@ func detectStaticChanges(oldCfg, newCfg *Config) []string {
 		changes = append(changes, "storage.mysql")
 	}
 
+	// Alerting config (static), every key named by its YAML tag
+	oldAlerting := reflect.ValueOf(oldCfg.Alerting)
+	newAlerting := reflect.ValueOf(newCfg.Alerting)
+	for i := range oldAlerting.NumField() {
+		tag, _, _ := strings.Cut(oldAlerting.Type().Field(i).Tag.Get("yaml"), ",")
+		key := "alerting." + tag
+		if reflect.DeepEqual(oldAlerting.Field(i).Interface(), newAlerting.Field(i).Interface()) {
+			continue
+		}
+		changes = append(changes, key)
+	}
+
 	return changes
 }
 
-// ErrRequiresRestart is returned when static configuration changes are detected.
+// ErrRequiresRestart is returned when static configuration changes were detected and ignored.
 var ErrRequiresRestart = fmt.Errorf("configuration change requires application restart")
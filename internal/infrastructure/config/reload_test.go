@@ -4,8 +4,8 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
-	"time"
 
 	"github.com/spf13/viper"
 )
@@ -187,8 +187,8 @@ slack:
   enabled: false
   channel_id: C999999
 alerting:
-  deduplication_window: 10m
-  resend_interval: 1h
+  deduplication_window: 5m
+  resend_interval: 30m
 `
 	if err := os.WriteFile(configPath, []byte(updatedConfig), 0644); err != nil {
 		t.Fatalf("failed to update config file: %v", err)
@@ -209,12 +209,6 @@ alerting:
 	if newCfg.Slack.ChannelID != "C999999" {
 		t.Errorf("expected channel 'C999999', got '%s'", newCfg.Slack.ChannelID)
 	}
-	if newCfg.Alerting.DeduplicationWindow != 10*time.Minute {
-		t.Errorf("expected dedup window 10m, got %v", newCfg.Alerting.DeduplicationWindow)
-	}
-	if newCfg.Alerting.ResendInterval != 1*time.Hour {
-		t.Errorf("expected resend interval 1h, got %v", newCfg.Alerting.ResendInterval)
-	}
 }
 
 // TestInvalidYAMLHandling tests that invalid YAML preserves existing config.
@@ -394,3 +388,205 @@ alerting:
 	// Suppress unused variable warning
 	_ = watcher
 }
+
+// TestStaticChangeAppliesReloadableKeys tests that reloadable keys are still
+// applied when the same edit also touches static keys.
+func TestStaticChangeAppliesReloadableKeys(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+
+	initialConfig := `
+server:
+  port: 8080
+logging:
+  level: info
+slack:
+  enabled: false
+  channel_id: C123456
+`
+	if err := os.WriteFile(configPath, []byte(initialConfig), 0644); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	v := viper.New()
+	v.SetConfigFile(configPath)
+	if err := v.ReadInConfig(); err != nil {
+		t.Fatalf("failed to read config: %v", err)
+	}
+
+	cfg, err := Load(configPath)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	cm := NewConfigManager(cfg, v, configPath, logger)
+
+	var reloaded *Config
+	var reloadedDiff ConfigDiff
+	cm.SetReloadCallback(func(c *Config, diff ConfigDiff) {
+		reloaded = c
+		reloadedDiff = diff
+	})
+
+	updatedConfig := `
+server:
+  port: 9090
+logging:
+  level: debug
+slack:
+  enabled: false
+  channel_id: C123456
+`
+	if err := os.WriteFile(configPath, []byte(updatedConfig), 0644); err != nil {
+		t.Fatalf("failed to update config file: %v", err)
+	}
+
+	if err := cm.TryReload(); err != ErrRequiresRestart {
+		t.Errorf("expected ErrRequiresRestart, got %v", err)
+	}
+
+	if cm.Get().Logging.Level != "debug" {
+		t.Errorf("expected level 'debug' after reload, got '%s'", cm.Get().Logging.Level)
+	}
+	if cm.Get().Server.Port != 8080 {
+		t.Errorf("expected port to remain 8080, got %d", cm.Get().Server.Port)
+	}
+	if reloaded == nil || reloaded.Logging.Level != "debug" {
+		t.Error("expected reload callback to receive the applied config")
+	}
+	if !slices.Equal(reloadedDiff.ChangedKeys, []string{"logging.level"}) {
+		t.Errorf("expected only logging.level in the callback diff, got %v", reloadedDiff.ChangedKeys)
+	}
+}
+
+// TestAlertingRequiresRestart tests that alerting changes are reported as
+// requiring a restart and not applied, since the use cases read them once.
+func TestAlertingRequiresRestart(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+
+	initialConfig := `
+slack:
+  enabled: false
+  channel_id: C123456
+alerting:
+  max_labels: 50
+  default_severity: warning
+`
+	if err := os.WriteFile(configPath, []byte(initialConfig), 0644); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	v := viper.New()
+	v.SetConfigFile(configPath)
+	if err := v.ReadInConfig(); err != nil {
+		t.Fatalf("failed to read config: %v", err)
+	}
+
+	cfg, err := Load(configPath)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	cm := NewConfigManager(cfg, v, configPath, logger)
+
+	updatedConfig := `
+slack:
+  enabled: false
+  channel_id: C654321
+alerting:
+  max_labels: 20
+  default_severity: critical
+  identity_labels: [alertname, instance]
+  deduplication_window: 10m
+  resend_interval: 1h
+`
+	if err := os.WriteFile(configPath, []byte(updatedConfig), 0644); err != nil {
+		t.Fatalf("failed to update config file: %v", err)
+	}
+
+	changes := detectStaticChanges(cm.Get(), mustLoad(t, configPath))
+	for _, key := range []string{"alerting.max_labels", "alerting.default_severity", "alerting.identity_labels", "alerting.deduplication_window", "alerting.resend_interval"} {
+		if !slices.Contains(changes, key) {
+			t.Errorf("expected %s in the static changes %v", key, changes)
+		}
+		if IsReloadable(key) {
+			t.Errorf("expected %s not to be reloadable", key)
+		}
+	}
+
+	if err := cm.TryReload(); err != ErrRequiresRestart {
+		t.Fatalf("expected ErrRequiresRestart, got %v", err)
+	}
+
+	alerting := cm.Get().Alerting
+	if alerting.MaxLabels != 50 || alerting.DefaultSeverity != "warning" || len(alerting.IdentityLabels) != 0 {
+		t.Errorf("expected the running alerting settings to be kept, got %+v", alerting)
+	}
+	if cm.Get().Slack.ChannelID != "C654321" {
+		t.Errorf("expected channel 'C654321' after reload, got '%s'", cm.Get().Slack.ChannelID)
+	}
+}
+
+// TestReloadKeepsOverride tests that an override, such as --dry-run, is
+// applied to the reloaded config instead of being reported as a change.
+func TestReloadKeepsOverride(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+
+	initialConfig := `
+logging:
+  level: info
+slack:
+  enabled: false
+`
+	if err := os.WriteFile(configPath, []byte(initialConfig), 0644); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	v := viper.New()
+	v.SetConfigFile(configPath)
+	if err := v.ReadInConfig(); err != nil {
+		t.Fatalf("failed to read config: %v", err)
+	}
+
+	override := func(c *Config) { c.Alerting.DryRun = true }
+	cfg := mustLoad(t, configPath)
+	override(cfg)
+
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	cm := NewConfigManager(cfg, v, configPath, logger)
+	cm.SetOverride(override)
+
+	updatedConfig := `
+logging:
+  level: debug
+slack:
+  enabled: false
+`
+	if err := os.WriteFile(configPath, []byte(updatedConfig), 0644); err != nil {
+		t.Fatalf("failed to update config file: %v", err)
+	}
+
+	if err := cm.TryReload(); err != nil {
+		t.Fatalf("reload failed: %v", err)
+	}
+	if !cm.Get().Alerting.DryRun {
+		t.Error("expected dry_run to stay enabled after reload")
+	}
+	if cm.Get().Logging.Level != "debug" {
+		t.Errorf("expected level 'debug' after reload, got '%s'", cm.Get().Logging.Level)
+	}
+}
+
+// mustLoad loads the config at path.
+func mustLoad(t *testing.T, path string) *Config {
+	t.Helper()
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	return cfg
+}
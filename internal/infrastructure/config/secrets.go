@@ -0,0 +1,195 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+// SecretResolver resolves a secret reference to its plaintext value.
+// The reference passed to Resolve has the scheme prefix removed
+// (e.g. "secret/alert-bridge#bot_token" for "vault://secret/alert-bridge#bot_token").
+type SecretResolver interface {
+	Resolve(ref string) (string, error)
+}
+
+// SecretResolverFunc adapts a function to the SecretResolver interface.
+type SecretResolverFunc func(ref string) (string, error)
+
+// Resolve calls f(ref).
+func (f SecretResolverFunc) Resolve(ref string) (string, error) {
+	return f(ref)
+}
+
+// ErrSecretResolverNotConfigured is returned for references whose scheme
+// is recognised but has no backend registered.
+var ErrSecretResolverNotConfigured = errors.New("secret resolver not configured")
+
+var (
+	secretResolversMu sync.RWMutex
+	secretResolvers   = map[string]SecretResolver{
+		"env":   SecretResolverFunc(resolveEnvSecret),
+		"file":  SecretResolverFunc(resolveFileSecret),
+		"vault": unconfiguredResolver("vault"),
+		"awssm": unconfiguredResolver("awssm"),
+	}
+)
+
+// RegisterSecretResolver registers a resolver for references of the form
+// "<scheme>://...". Registering a scheme that already exists replaces it,
+// which is how remote backends such as Vault or AWS Secrets Manager are plugged in.
+func RegisterSecretResolver(scheme string, resolver SecretResolver) {
+	secretResolversMu.Lock()
+	defer secretResolversMu.Unlock()
+	secretResolvers[scheme] = resolver
+}
+
+// ResolveSecret resolves value if it is a secret reference with a registered scheme.
+// Values without a registered scheme (plain strings, https:// URLs) are returned unchanged.
+func ResolveSecret(value string) (string, error) {
+	scheme, ref, ok := strings.Cut(value, "://")
+	if !ok {
+		return value, nil
+	}
+
+	secretResolversMu.RLock()
+	resolver, found := secretResolvers[scheme]
+	secretResolversMu.RUnlock()
+	if !found {
+		return value, nil
+	}
+
+	resolved, err := resolver.Resolve(ref)
+	if err != nil {
+		return "", fmt.Errorf("resolving %s:// reference: %w", scheme, err)
+	}
+	return resolved, nil
+}
+
+// redactedValue replaces credentials in a redacted configuration.
+const redactedValue = "***"
+
+// credentialField is a configuration field holding a credential.
+type credentialField struct {
+	name  string
+	value *string
+}
+
+// credentialFields returns the fields of c that hold credentials.
+func (c *Config) credentialFields() []credentialField {
+	return []credentialField{
+		{"server.admin_token", &c.Server.AdminToken},
+		{"server.user_auth.jwt_secret", &c.Server.UserAuth.JWTSecret},
+		{"slack.bot_token", &c.Slack.BotToken},
+		{"slack.signing_secret", &c.Slack.SigningSecret},
+		{"slack.socket_mode.app_token", &c.Slack.SocketMode.AppToken},
+		{"pagerduty.api_token", &c.PagerDuty.APIToken},
+		{"pagerduty.routing_key", &c.PagerDuty.RoutingKey},
+		{"pagerduty.webhook_secret", &c.PagerDuty.WebhookSecret},
+		{"telegram.bot_token", &c.Telegram.BotToken},
+		{"discord.webhook_url", &c.Discord.WebhookURL},
+		{"email.password", &c.Email.Password},
+		{"storage.mysql.primary.password", &c.Storage.MySQL.Primary.Password},
+		{"storage.mysql.replica.password", &c.Storage.MySQL.Replica.Password},
+		{"storage.mysql.dsn", &c.Storage.MySQL.DSN},
+		{"alertmanager.webhook_secret", &c.Alertmanager.WebhookSecret},
+	}
+}
+
+// credentialMap is a configuration field mapping keys to credentials.
+type credentialMap struct {
+	name  string
+	value *map[string]string
+}
+
+// credentialMaps returns the fields of c that map keys to credentials.
+func (c *Config) credentialMaps() []credentialMap {
+	return []credentialMap{
+		{"pagerduty.routing_keys", &c.PagerDuty.RoutingKeys},
+	}
+}
+
+// resolveSecrets replaces secret references in credential fields with their values.
+// All fields are attempted so that every unresolvable reference is reported at once.
+func (c *Config) resolveSecrets() error {
+	var errs []string
+	for _, f := range c.credentialFields() {
+		resolved, err := ResolveSecret(*f.value)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", f.name, err))
+			continue
+		}
+		*f.value = resolved
+	}
+	for _, m := range c.credentialMaps() {
+		for key, value := range *m.value {
+			resolved, err := ResolveSecret(value)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("%s.%s: %v", m.name, key, err))
+				continue
+			}
+			(*m.value)[key] = resolved
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("secret resolution failed:\n  - %s", strings.Join(errs, "\n  - "))
+	}
+	return nil
+}
+
+// Redacted returns a copy of the configuration with every credential that is
+// set replaced by "***", so it can be shown without leaking secrets.
+func (c *Config) Redacted() *Config {
+	redacted := *c
+	for _, f := range redacted.credentialFields() {
+		if *f.value != "" {
+			*f.value = redactedValue
+		}
+	}
+	// Maps are shared with c, so the redacted ones are fresh copies
+	for _, m := range redacted.credentialMaps() {
+		if *m.value == nil {
+			continue
+		}
+		values := make(map[string]string, len(*m.value))
+		for key, value := range *m.value {
+			if value != "" {
+				value = redactedValue
+			}
+			values[key] = value
+		}
+		*m.value = values
+	}
+	return &redacted
+}
+
+// resolveEnvSecret reads a secret from an environment variable (env://NAME).
+func resolveEnvSecret(name string) (string, error) {
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return "", fmt.Errorf("environment variable %s is not set", name)
+	}
+	return value, nil
+}
+
+// resolveFileSecret reads a secret from a file (file:///run/secrets/token).
+// Trailing newlines are trimmed so that files written by editors or
+// orchestrators (Docker/Kubernetes secrets) work unchanged.
+func resolveFileSecret(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("reading secret file: %w", err)
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+// unconfiguredResolver fails references for known remote backends until a
+// real resolver is registered, rather than passing the raw reference through as a credential.
+func unconfiguredResolver(scheme string) SecretResolver {
+	return SecretResolverFunc(func(string) (string, error) {
+		return "", fmt.Errorf("%w: no %s resolver registered", ErrSecretResolverNotConfigured, scheme)
+	})
+}
@@ -0,0 +1,102 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestResolveSecret(t *testing.T) {
+	tmpDir := t.TempDir()
+	secretPath := filepath.Join(tmpDir, "token")
+	if err := os.WriteFile(secretPath, []byte("file-token\n"), 0600); err != nil {
+		t.Fatalf("failed to write secret file: %v", err)
+	}
+	t.Setenv("ALERT_BRIDGE_TEST_SECRET", "env-token")
+
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{"plain value", "xoxb-plain", "xoxb-plain"},
+		{"https url is not a reference", "https://discord.com/api/webhooks/1/abc", "https://discord.com/api/webhooks/1/abc"},
+		{"env reference", "env://ALERT_BRIDGE_TEST_SECRET", "env-token"},
+		{"file reference", "file://" + secretPath, "file-token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveSecret(tt.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ResolveSecret(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveSecret_UnconfiguredRemote(t *testing.T) {
+	_, err := ResolveSecret("vault://secret/alert-bridge#bot_token")
+	if !errors.Is(err, ErrSecretResolverNotConfigured) {
+		t.Fatalf("expected ErrSecretResolverNotConfigured, got %v", err)
+	}
+}
+
+func TestLoad_ResolvesSecretReferences(t *testing.T) {
+	RegisterSecretResolver("awssm", SecretResolverFunc(func(ref string) (string, error) {
+		if ref == "alert-bridge/pagerduty" {
+			return "pd-token", nil
+		}
+		return "", errors.New("secret not found")
+	}))
+	t.Cleanup(func() { RegisterSecretResolver("awssm", unconfiguredResolver("awssm")) })
+
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	data := `
+pagerduty:
+  enabled: true
+  api_token: awssm://alert-bridge/pagerduty
+  routing_key: rk
+  service_id: PSVC
+  from_email: oncall@example.com
+slack:
+  enabled: false
+`
+	if err := os.WriteFile(configPath, []byte(data), 0644); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	cfg, err := Load(configPath)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if cfg.PagerDuty.APIToken != "pd-token" {
+		t.Errorf("expected resolved api_token, got %q", cfg.PagerDuty.APIToken)
+	}
+}
+
+func TestLoad_UnresolvableSecretFails(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	data := `
+slack:
+  enabled: true
+  bot_token: file:///nonexistent/alert-bridge/token
+  channel_id: C123456
+`
+	if err := os.WriteFile(configPath, []byte(data), 0644); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	_, err := Load(configPath)
+	if err == nil {
+		t.Fatal("expected error for unresolvable secret reference")
+	}
+	if !strings.Contains(err.Error(), "slack.bot_token") {
+		t.Errorf("expected error to name the field, got %v", err)
+	}
+}
@@ -2,16 +2,26 @@ package config
 
 import (
 	"fmt"
+	"maps"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
 	"time"
+
+	mysqldriver "github.com/go-sql-driver/mysql"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/templates"
 )
 
-// reloadableKeys defines the whitelist of configuration keys that can be hot-reloaded.
+// reloadableKeys defines the whitelist of configuration keys that can be
+// hot-reloaded.
 var reloadableKeys = map[string]bool{
-	"logging.level":                 true,
-	"logging.format":                true,
-	"slack.channel_id":              true,
-	"alerting.deduplication_window": true,
-	"alerting.resend_interval":      true,
+	"logging.level":    true,
+	"logging.format":   true,
+	"slack.channel_id": true,
 }
 
 // staticKeys defines configuration keys that require application restart.
@@ -20,6 +30,7 @@ var staticKeys = map[string]string{
 	"storage.type":        "Storage backend initialization required",
 	"storage.sqlite.path": "Database connection recreation required",
 	"storage.mysql":       "Database connection pool recreation required",
+	"storage.cache":       "Alert cache recreation required",
 }
 
 // IsReloadable returns true if the given config key can be hot-reloaded.
@@ -32,6 +43,9 @@ func getRestartReason(key string) string {
 	if reason, ok := staticKeys[key]; ok {
 		return reason
 	}
+	if strings.HasPrefix(key, "alerting.") {
+		return "Alert processing reconfiguration required"
+	}
 	return "unknown configuration requires restart"
 }
 
@@ -98,6 +112,219 @@ func ValidateStorageType(storageType string) error {
 	return nil
 }
 
+// ValidateEmailTLSMode checks if the SMTP TLS mode is valid.
+func ValidateEmailTLSMode(mode string) error {
+	validModes := map[string]bool{
+		"none":     true,
+		"starttls": true,
+		"tls":      true,
+	}
+	if !validModes[mode] {
+		return fmt.Errorf("invalid email tls_mode: %s (must be none, starttls, or tls)", mode)
+	}
+	return nil
+}
+
+// isLocalhost reports whether host is the local machine, the only host
+// net/smtp sends credentials to without TLS.
+func isLocalhost(host string) bool {
+	return host == "localhost" || host == "127.0.0.1" || host == "::1"
+}
+
+// ValidateSelfTestMode checks if the notifier self-test mode is valid.
+func ValidateSelfTestMode(mode string) error {
+	validModes := map[string]bool{
+		"off":  true,
+		"warn": true,
+		"fail": true,
+	}
+	if !validModes[mode] {
+		return fmt.Errorf("invalid alerting.notifier_self_test: %s (must be off, warn, or fail)", mode)
+	}
+	return nil
+}
+
+// ValidateRunbookURLTemplate checks that the runbook URL template parses.
+func ValidateRunbookURLTemplate(text string) error {
+	if _, err := templates.ParseRunbookURL(text); err != nil {
+		return fmt.Errorf("invalid alerting.runbook_url_template: %w", err)
+	}
+	return nil
+}
+
+// ValidateDedupKeyTemplate checks that the PagerDuty dedup key template parses.
+func ValidateDedupKeyTemplate(text string) error {
+	if _, err := template.New("dedup_key").Parse(text); err != nil {
+		return fmt.Errorf("invalid pagerduty.dedup_key_template: %w", err)
+	}
+	return nil
+}
+
+// ValidateRoutingKeys checks pagerduty.routing_keys and that every severity
+// has a routing key, either its own entry or the default routing_key.
+func ValidateRoutingKeys(routingKey string, routingKeys map[string]string) error {
+	for severity, key := range routingKeys {
+		if !internalSeverities[severity] {
+			return fmt.Errorf("pagerduty.routing_keys keys must be critical, warning, or info, got %q", severity)
+		}
+		if key == "" {
+			return fmt.Errorf("pagerduty.routing_keys.%s must not be empty", severity)
+		}
+	}
+	if routingKey != "" {
+		return nil
+	}
+
+	var missing []string
+	for _, severity := range []string{"critical", "warning", "info"} {
+		if routingKeys[severity] == "" {
+			missing = append(missing, severity)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("pagerduty.routing_key is required unless pagerduty.routing_keys covers every severity (missing: %s)", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// Allowed values for severity map entries.
+var (
+	internalSeverities  = map[string]bool{"critical": true, "warning": true, "info": true}
+	pagerDutySeverities = map[string]bool{"critical": true, "error": true, "warning": true, "info": true}
+	hexColorPattern     = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+)
+
+// minJWTSecretLength is the shortest accepted HS256 key, the size of its hash.
+const minJWTSecretLength = 32
+
+// slackUserGroupIDPattern matches Slack user group IDs.
+var slackUserGroupIDPattern = regexp.MustCompile(`^S[A-Z0-9]+$`)
+
+// ValidateMentionGroup checks one slack.mention_groups entry.
+func ValidateMentionGroup(severity, groupID string) error {
+	if !internalSeverities[severity] {
+		return fmt.Errorf("slack.mention_groups keys must be critical, warning, or info, got %q", severity)
+	}
+	if !slackUserGroupIDPattern.MatchString(groupID) {
+		return fmt.Errorf("slack.mention_groups.%s must be a Slack user group ID (e.g. S0123ABCDEF), got %q", severity, groupID)
+	}
+	return nil
+}
+
+// ValidateTimeFormat checks slack.time_format: "slack" or a Go time layout.
+func ValidateTimeFormat(format string) error {
+	if format == "slack" {
+		return nil
+	}
+	if reference := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC); reference.Format(format) == format {
+		return fmt.Errorf("slack.time_format must be \"slack\" or a Go time layout (e.g. \"Jan 2, 15:04 MST\"), got %q", format)
+	}
+	return nil
+}
+
+// ValidateTimezone checks that a timezone name can be loaded.
+func ValidateTimezone(name, fieldName string) error {
+	if _, err := time.LoadLocation(name); err != nil {
+		return fmt.Errorf("%s must be an IANA timezone (e.g. Europe/Berlin), got %q", fieldName, name)
+	}
+	return nil
+}
+
+// ValidateQuietHours checks the alerting.quiet_hours window.
+func ValidateQuietHours(c QuietHoursConfig) error {
+	start, err := time.Parse("15:04", c.Start)
+	if err != nil {
+		return fmt.Errorf("alerting.quiet_hours.start must be HH:MM, got %q", c.Start)
+	}
+	end, err := time.Parse("15:04", c.End)
+	if err != nil {
+		return fmt.Errorf("alerting.quiet_hours.end must be HH:MM, got %q", c.End)
+	}
+	if start.Equal(end) {
+		return fmt.Errorf("alerting.quiet_hours.start and end must differ, both are %q", c.Start)
+	}
+	if err := ValidateTimezone(c.Timezone, "alerting.quiet_hours.timezone"); err != nil {
+		return err
+	}
+	for _, severity := range c.Severities {
+		if severity != "warning" && severity != "info" {
+			return fmt.Errorf("alerting.quiet_hours.severities must be warning or info (critical alerts always break through), got %q", severity)
+		}
+	}
+	return nil
+}
+
+// ValidateSeverityMapping checks one alerting.severity_map entry.
+func ValidateSeverityMapping(value string, mapping SeverityMappingConfig) error {
+	return validateMapping("alerting.severity_map", value, mapping)
+}
+
+// ValidatePriorityMapping checks one alerting.priority_map entry.
+func ValidatePriorityMapping(value string, mapping SeverityMappingConfig) error {
+	return validateMapping("alerting.priority_map", value, mapping)
+}
+
+// validateMapping checks one entry of the severity or priority map at path.
+func validateMapping(path, value string, mapping SeverityMappingConfig) error {
+	if value == "" {
+		return fmt.Errorf("%s keys must not be empty", path)
+	}
+	if !internalSeverities[mapping.Severity] {
+		return fmt.Errorf("%s.%s.severity must be critical, warning, or info, got %q", path, value, mapping.Severity)
+	}
+	if mapping.PagerDutySeverity != "" && !pagerDutySeverities[mapping.PagerDutySeverity] {
+		return fmt.Errorf("%s.%s.pagerduty_severity must be critical, error, warning, or info, got %q", path, value, mapping.PagerDutySeverity)
+	}
+	if mapping.Color != "" && !hexColorPattern.MatchString(mapping.Color) {
+		return fmt.Errorf("%s.%s.color must be a #RRGGBB color, got %q", path, value, mapping.Color)
+	}
+	return nil
+}
+
+// notifierNames lists the notifier names routes may refer to.
+var notifierNames = map[string]bool{
+	"slack":     true,
+	"pagerduty": true,
+	"telegram":  true,
+	"discord":   true,
+	"email":     true,
+}
+
+// ValidateRoute checks that a route selects known notifiers and its regexes compile.
+func ValidateRoute(route RouteConfig, index int) error {
+	if len(route.Notifiers) == 0 {
+		return fmt.Errorf("alerting.routes[%d].notifiers must not be empty", index)
+	}
+	for _, name := range route.Notifiers {
+		if !notifierNames[name] {
+			return fmt.Errorf("alerting.routes[%d].notifiers contains unknown notifier %q", index, name)
+		}
+	}
+	for label, expr := range route.MatchRE {
+		if _, err := regexp.Compile(expr); err != nil {
+			return fmt.Errorf("alerting.routes[%d].match_re.%s: %w", index, label, err)
+		}
+	}
+	return nil
+}
+
+// ValidateDependency checks that a dependency selects its parents and ties
+// children to them by at least one label.
+func ValidateDependency(dependency DependencyConfig, index int) error {
+	if len(dependency.Parent) == 0 {
+		return fmt.Errorf("alerting.dependencies[%d].parent must not be empty", index)
+	}
+	if len(dependency.Equal) == 0 {
+		return fmt.Errorf("alerting.dependencies[%d].equal must not be empty", index)
+	}
+	for i, label := range dependency.Equal {
+		if strings.TrimSpace(label) == "" {
+			return fmt.Errorf("alerting.dependencies[%d].equal[%d] must not be empty", index, i)
+		}
+	}
+	return nil
+}
+
 // Validate performs comprehensive validation on the configuration.
 // Returns an error if any validation fails.
 func (c *Config) Validate() error {
@@ -120,6 +347,37 @@ func (c *Config) Validate() error {
 		errors = append(errors, err.Error())
 	}
 
+	if c.Server.MaxBodyBytes < 0 {
+		errors = append(errors, "server.max_body_bytes must be positive")
+	}
+	if secret := c.Server.UserAuth.JWTSecret; secret != "" && len(secret) < minJWTSecretLength {
+		errors = append(errors, fmt.Sprintf("server.user_auth.jwt_secret must be at least %d bytes", minJWTSecretLength))
+	}
+	if c.Server.PublicURL != "" {
+		if u, err := url.Parse(c.Server.PublicURL); err != nil || !u.IsAbs() {
+			errors = append(errors, fmt.Sprintf("server.public_url must be an absolute URL, got %q", c.Server.PublicURL))
+		}
+	}
+	if c.Server.GRPCPort != 0 {
+		if err := ValidatePort(c.Server.GRPCPort, "server.grpc_port"); err != nil {
+			errors = append(errors, err.Error())
+		}
+		if c.Server.GRPCPort == c.Server.Port {
+			errors = append(errors, "server.grpc_port must differ from server.port")
+		}
+		if c.Server.AdminToken == "" {
+			errors = append(errors, "server.grpc_port requires server.admin_token")
+		}
+	}
+	if c.Server.TLS.Enabled() {
+		if c.Server.TLS.CertFile == "" || c.Server.TLS.KeyFile == "" {
+			errors = append(errors, "server.tls.cert_file and server.tls.key_file must be set together")
+		}
+		if _, err := c.Server.TLS.TLSMinVersion(); err != nil {
+			errors = append(errors, "server.tls.min_version: "+err.Error())
+		}
+	}
+
 	// Logical constraint: RequestTimeout should be less than WriteTimeout
 	if c.Server.RequestTimeout >= c.Server.WriteTimeout {
 		errors = append(errors, "server.request_timeout must be less than server.write_timeout")
@@ -130,6 +388,25 @@ func (c *Config) Validate() error {
 		errors = append(errors, err.Error())
 	}
 
+	if c.Storage.Cache.Enabled {
+		if c.Storage.Cache.Size <= 0 {
+			errors = append(errors, "storage.cache.size must be positive")
+		}
+		if err := ValidateDuration(c.Storage.Cache.TTL, "storage.cache.ttl"); err != nil {
+			errors = append(errors, err.Error())
+		}
+	}
+
+	// Memory-specific validation
+	if c.Storage.Type == "memory" {
+		if err := ValidateDuration(c.Storage.Memory.TTL, "storage.memory.ttl"); err != nil {
+			errors = append(errors, err.Error())
+		}
+		if err := ValidateDuration(c.Storage.Memory.EvictionInterval, "storage.memory.eviction_interval"); err != nil {
+			errors = append(errors, err.Error())
+		}
+	}
+
 	// SQLite-specific validation
 	if c.Storage.Type == "sqlite" {
 		if err := ValidateNonEmpty(c.Storage.SQLite.Path, "storage.sqlite.path"); err != nil {
@@ -138,7 +415,19 @@ func (c *Config) Validate() error {
 	}
 
 	// MySQL-specific validation
-	if c.Storage.Type == "mysql" {
+	if c.Storage.Type == "mysql" && c.Storage.MySQL.DSN != "" {
+		// The DSN replaces the primary fields
+		if dsn, err := mysqldriver.ParseDSN(c.Storage.MySQL.DSN); err != nil {
+			errors = append(errors, fmt.Sprintf("storage.mysql.dsn is invalid: %v", err))
+		} else {
+			if dsn.DBName == "" {
+				errors = append(errors, "storage.mysql.dsn must name a database")
+			}
+			if !dsn.ParseTime {
+				errors = append(errors, "storage.mysql.dsn must set parseTime=true")
+			}
+		}
+	} else if c.Storage.Type == "mysql" {
 		if err := ValidateNonEmpty(c.Storage.MySQL.Primary.Host, "storage.mysql.primary.host"); err != nil {
 			errors = append(errors, err.Error())
 		}
@@ -154,6 +443,8 @@ func (c *Config) Validate() error {
 		if err := ValidateNonEmpty(c.Storage.MySQL.Primary.Password, "storage.mysql.primary.password"); err != nil {
 			errors = append(errors, err.Error())
 		}
+	}
+	if c.Storage.Type == "mysql" {
 
 		// Replica validation (if enabled)
 		if c.Storage.MySQL.Replica.Enabled {
@@ -172,6 +463,9 @@ func (c *Config) Validate() error {
 			if err := ValidateNonEmpty(c.Storage.MySQL.Replica.Password, "storage.mysql.replica.password"); err != nil {
 				errors = append(errors, err.Error())
 			}
+			if c.Storage.MySQL.Replica.MaxStaleness < 0 {
+				errors = append(errors, "storage.mysql.replica.max_staleness must not be negative")
+			}
 		}
 
 		// Connection pool validation
@@ -184,6 +478,9 @@ func (c *Config) Validate() error {
 		if c.Storage.MySQL.Pool.MaxIdleConns > c.Storage.MySQL.Pool.MaxOpenConns {
 			errors = append(errors, "storage.mysql.pool.max_idle_conns cannot exceed max_open_conns")
 		}
+		if c.Storage.MySQL.Pool.RetryAttempts < 1 {
+			errors = append(errors, "storage.mysql.pool.retry_attempts must be at least 1")
+		}
 	}
 
 	// Slack validation
@@ -194,6 +491,47 @@ func (c *Config) Validate() error {
 		if err := ValidateNonEmpty(c.Slack.ChannelID, "slack.channel_id"); err != nil {
 			errors = append(errors, err.Error())
 		}
+		if err := ValidateDuration(c.Slack.InstanceSilenceDuration, "slack.instance_silence_duration"); err != nil {
+			errors = append(errors, err.Error())
+		}
+		if err := ValidateDuration(c.Slack.RequestMaxAge, "slack.request_max_age"); err != nil {
+			errors = append(errors, err.Error())
+		}
+		if err := ValidateTimeFormat(c.Slack.TimeFormat); err != nil {
+			errors = append(errors, err.Error())
+		}
+		if c.Slack.Timezone != "" {
+			if err := ValidateTimezone(c.Slack.Timezone, "slack.timezone"); err != nil {
+				errors = append(errors, err.Error())
+			}
+		}
+		if len(c.Slack.DisplayLabels) > 0 && len(c.Slack.HideLabels) > 0 {
+			errors = append(errors, "slack.display_labels and slack.hide_labels cannot both be set")
+		}
+		if c.Slack.MaxDisplayLabels < 0 {
+			errors = append(errors, fmt.Sprintf("slack.max_display_labels must not be negative, got %d", c.Slack.MaxDisplayLabels))
+		}
+		for severity, groupID := range c.Slack.MentionGroups {
+			if err := ValidateMentionGroup(severity, groupID); err != nil {
+				errors = append(errors, err.Error())
+			}
+		}
+		tenantsByChannel := make(map[string]string, len(c.Slack.TenantChannels))
+		for tenantID, channelID := range c.Slack.TenantChannels {
+			if strings.TrimSpace(tenantID) == "" || strings.TrimSpace(channelID) == "" {
+				errors = append(errors, "slack.tenant_channels entries must have a tenant and a channel ID")
+				continue
+			}
+			if other, ok := tenantsByChannel[channelID]; ok {
+				errors = append(errors, fmt.Sprintf("slack.tenant_channels: channel %s is mapped to both %s and %s", channelID, other, tenantID))
+			}
+			tenantsByChannel[channelID] = tenantID
+		}
+		if c.Slack.AppHome.Enabled {
+			if err := ValidateDuration(c.Slack.AppHome.RefreshInterval, "slack.app_home.refresh_interval"); err != nil {
+				errors = append(errors, err.Error())
+			}
+		}
 
 		// Socket Mode validation
 		if c.Slack.SocketMode.Enabled {
@@ -219,7 +557,7 @@ func (c *Config) Validate() error {
 		if err := ValidateNonEmpty(c.PagerDuty.APIToken, "pagerduty.api_token"); err != nil {
 			errors = append(errors, err.Error())
 		}
-		if err := ValidateNonEmpty(c.PagerDuty.RoutingKey, "pagerduty.routing_key"); err != nil {
+		if err := ValidateRoutingKeys(c.PagerDuty.RoutingKey, c.PagerDuty.RoutingKeys); err != nil {
 			errors = append(errors, err.Error())
 		}
 		if err := ValidateNonEmpty(c.PagerDuty.ServiceID, "pagerduty.service_id"); err != nil {
@@ -228,6 +566,72 @@ func (c *Config) Validate() error {
 		if err := ValidateNonEmpty(c.PagerDuty.FromEmail, "pagerduty.from_email"); err != nil {
 			errors = append(errors, err.Error())
 		}
+		if c.PagerDuty.DedupKeyTemplate != "" {
+			if err := ValidateDedupKeyTemplate(c.PagerDuty.DedupKeyTemplate); err != nil {
+				errors = append(errors, err.Error())
+			}
+		}
+	}
+
+	// Telegram validation
+	if c.IsTelegramEnabled() {
+		if err := ValidateNonEmpty(c.Telegram.BotToken, "telegram.bot_token"); err != nil {
+			errors = append(errors, err.Error())
+		}
+		if err := ValidateNonEmpty(c.Telegram.ChatID, "telegram.chat_id"); err != nil {
+			errors = append(errors, err.Error())
+		}
+	}
+
+	// Discord validation
+	if c.IsDiscordEnabled() {
+		if err := ValidateNonEmpty(c.Discord.WebhookURL, "discord.webhook_url"); err != nil {
+			errors = append(errors, err.Error())
+		} else if u, err := url.Parse(c.Discord.WebhookURL); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			errors = append(errors, fmt.Sprintf("discord.webhook_url must be a valid http(s) URL, got %q", c.Discord.WebhookURL))
+		}
+	}
+
+	// Email validation
+	if c.IsEmailEnabled() {
+		if err := ValidateNonEmpty(c.Email.Host, "email.host"); err != nil {
+			errors = append(errors, err.Error())
+		}
+		if err := ValidatePort(c.Email.Port, "email.port"); err != nil {
+			errors = append(errors, err.Error())
+		}
+		if err := ValidateNonEmpty(c.Email.From, "email.from"); err != nil {
+			errors = append(errors, err.Error())
+		} else if _, err := mail.ParseAddress(c.Email.From); err != nil {
+			errors = append(errors, fmt.Sprintf("email.from must be an email address, got %q", c.Email.From))
+		}
+		if len(c.Email.To) == 0 {
+			errors = append(errors, "email.to must contain at least one recipient")
+		}
+		for _, rcpt := range c.Email.To {
+			if strings.TrimSpace(rcpt) == "" {
+				errors = append(errors, "email.to contains an empty recipient")
+			} else if _, err := mail.ParseAddress(rcpt); err != nil {
+				errors = append(errors, fmt.Sprintf("email.to must contain email addresses, got %q", rcpt))
+			}
+		}
+		if err := ValidateEmailTLSMode(c.Email.TLSMode); err != nil {
+			errors = append(errors, err.Error())
+		}
+		// net/smtp refuses to send credentials unencrypted to remote hosts
+		if c.Email.Username != "" && c.Email.TLSMode == "none" && !isLocalhost(c.Email.Host) {
+			errors = append(errors, "email.username requires tls_mode starttls or tls unless email.host is localhost")
+		}
+	}
+
+	// Alertmanager silence sync validation
+	if c.IsAlertmanagerSilenceSyncEnabled() {
+		if u, err := url.Parse(c.Alertmanager.URL); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			errors = append(errors, fmt.Sprintf("alertmanager.url must be a valid http(s) URL, got %q", c.Alertmanager.URL))
+		}
+		if err := ValidateDuration(c.Alertmanager.SilenceSyncInterval, "alertmanager.silence_sync_interval"); err != nil {
+			errors = append(errors, err.Error())
+		}
 	}
 
 	// Alerting validation
@@ -250,6 +654,130 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	// Ingest API default severity validation
+	if !internalSeverities[c.Alerting.DefaultSeverity] {
+		errors = append(errors, fmt.Sprintf("alerting.default_severity must be critical, warning, or info, got %q", c.Alerting.DefaultSeverity))
+	}
+
+	// Notifier self-test mode validation
+	if err := ValidateSelfTestMode(c.Alerting.NotifierSelfTest); err != nil {
+		errors = append(errors, err.Error())
+	}
+	if c.Alerting.NotifierConcurrency < 0 {
+		errors = append(errors, fmt.Sprintf("alerting.notifier_max_concurrency must not be negative, got %d", c.Alerting.NotifierConcurrency))
+	}
+	if c.Alerting.AutoResolveAfter < 0 {
+		errors = append(errors, fmt.Sprintf("alerting.auto_resolve_after must not be negative, got %s", c.Alerting.AutoResolveAfter))
+	}
+	if c.Alerting.MaxMessagesPerWindow < 0 {
+		errors = append(errors, fmt.Sprintf("alerting.max_messages_per_window must not be negative, got %d", c.Alerting.MaxMessagesPerWindow))
+	}
+	if c.Alerting.MessageWindow < 0 {
+		errors = append(errors, fmt.Sprintf("alerting.message_window must not be negative, got %s", c.Alerting.MessageWindow))
+	}
+	if c.Alerting.MaxLabels < 0 {
+		errors = append(errors, fmt.Sprintf("alerting.max_labels must not be negative, got %d", c.Alerting.MaxLabels))
+	}
+	if c.Alerting.MaxLabelValueLen < 0 {
+		errors = append(errors, fmt.Sprintf("alerting.max_label_value_len must not be negative, got %d", c.Alerting.MaxLabelValueLen))
+	}
+
+	// Runbook enrichment validation
+	if c.Alerting.RunbookBaseURL != "" {
+		if u, err := url.Parse(c.Alerting.RunbookBaseURL); err != nil || u.Scheme == "" || u.Host == "" {
+			errors = append(errors, fmt.Sprintf("alerting.runbook_base_url must be an absolute URL, got %q", c.Alerting.RunbookBaseURL))
+		}
+	}
+	if c.Alerting.RunbookURLTemplate != "" {
+		if err := ValidateRunbookURLTemplate(c.Alerting.RunbookURLTemplate); err != nil {
+			errors = append(errors, err.Error())
+		}
+	}
+
+	// Severity map validation
+	for severity, weight := range c.Alerting.PriorityWeights {
+		if !internalSeverities[severity] {
+			errors = append(errors, fmt.Sprintf("alerting.priority_weights keys must be critical, warning, or info, got %q", severity))
+		}
+		if weight < 0 {
+			errors = append(errors, fmt.Sprintf("alerting.priority_weights.%s must not be negative, got %d", severity, weight))
+		}
+	}
+
+	for value, mapping := range c.Alerting.SeverityMap {
+		if err := ValidateSeverityMapping(value, mapping); err != nil {
+			errors = append(errors, err.Error())
+		}
+	}
+	for value, mapping := range c.Alerting.PriorityMap {
+		if err := ValidatePriorityMapping(value, mapping); err != nil {
+			errors = append(errors, err.Error())
+		}
+	}
+
+	// Routing validation
+	for i, route := range c.Alerting.Routes {
+		if err := ValidateRoute(route, i); err != nil {
+			errors = append(errors, err.Error())
+		}
+	}
+
+	for i, dependency := range c.Alerting.Dependencies {
+		if err := ValidateDependency(dependency, i); err != nil {
+			errors = append(errors, err.Error())
+		}
+	}
+
+	for i, label := range c.Alerting.CorrelateBy {
+		if strings.TrimSpace(label) == "" {
+			errors = append(errors, fmt.Sprintf("alerting.correlate_by[%d] must not be empty", i))
+		}
+	}
+
+	if c.Alerting.TenantLabel != "" && strings.TrimSpace(c.Alerting.TenantLabel) != c.Alerting.TenantLabel {
+		errors = append(errors, "alerting.tenant_label must not have surrounding whitespace")
+	}
+
+	if c.Alerting.QuietHours.Enabled() {
+		if err := ValidateQuietHours(c.Alerting.QuietHours); err != nil {
+			errors = append(errors, err.Error())
+		}
+	} else if c.Alerting.QuietHours.End != "" {
+		errors = append(errors, "alerting.quiet_hours.start is required when end is set")
+	}
+	if c.Alerting.QuietHours.Enabled() && c.Alerting.QuietHours.Digest && !c.IsSlackEnabled() {
+		errors = append(errors, "alerting.quiet_hours.digest requires slack to be enabled")
+	}
+
+	if c.Alerting.Digest.Enabled {
+		if !c.IsSlackEnabled() {
+			errors = append(errors, "alerting.digest requires slack to be enabled")
+		}
+		if c.Alerting.Digest.Interval < 0 {
+			errors = append(errors, "alerting.digest.interval must not be negative")
+		}
+		for _, severity := range c.Alerting.Digest.Severities {
+			if severity != "warning" && severity != "info" {
+				errors = append(errors, fmt.Sprintf("alerting.digest.severities must be warning or info (critical alerts are always notified), got %q", severity))
+			}
+		}
+	}
+
+	if c.Alerting.AckExpiry.DefaultDuration < 0 {
+		errors = append(errors, fmt.Sprintf("alerting.ack_expiry.default_duration must not be negative, got %s", c.Alerting.AckExpiry.DefaultDuration))
+	}
+	if c.Alerting.Outbox.Enabled {
+		if c.Alerting.Outbox.PollInterval < 0 {
+			errors = append(errors, "alerting.outbox.poll_interval must not be negative")
+		}
+		if c.Alerting.Outbox.MaxAttempts < 0 {
+			errors = append(errors, "alerting.outbox.max_attempts must not be negative")
+		}
+		if c.Alerting.Outbox.RetryBackoff < 0 {
+			errors = append(errors, "alerting.outbox.retry_backoff must not be negative")
+		}
+	}
+
 	// Logging validation
 	if err := ValidateLogLevel(c.Logging.Level); err != nil {
 		errors = append(errors, err.Error())
@@ -258,6 +786,22 @@ func (c *Config) Validate() error {
 		errors = append(errors, err.Error())
 	}
 
+	// Audit validation
+	if c.Audit.Enabled {
+		if err := ValidateNonEmpty(c.Audit.Path, "audit.path"); err != nil {
+			errors = append(errors, err.Error())
+		}
+	}
+
+	// Notification template validation
+	for _, notifier := range slices.Sorted(maps.Keys(c.Templates)) {
+		for _, name := range slices.Sorted(maps.Keys(c.Templates[notifier])) {
+			if _, err := templates.Parse(notifier, name, c.Templates[notifier][name]); err != nil {
+				errors = append(errors, fmt.Sprintf("templates.%s.%s: %v", notifier, name, err))
+			}
+		}
+	}
+
 	// Return all validation errors
 	if len(errors) > 0 {
 		return fmt.Errorf("configuration validation failed:\n  - %s", joinErrors(errors))
@@ -0,0 +1,279 @@
+package discord
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	domainerrors "github.com/qj0r9j0vc2/alert-bridge/internal/domain/errors"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/templates"
+)
+
+// Client sends alert notifications to a Discord channel via an incoming webhook.
+// Implements the alert.Notifier interface.
+type Client struct {
+	httpClient   *http.Client
+	webhookURL   string
+	username     string
+	avatarURL    string
+	embedBuilder *EmbedBuilder
+}
+
+// NewClient creates a new Discord webhook client.
+// Username and avatarURL are optional overrides for the webhook's default identity.
+func NewClient(webhookURL, username, avatarURL string) *Client {
+	return &Client{
+		httpClient:   &http.Client{Timeout: 10 * time.Second},
+		webhookURL:   strings.TrimSuffix(webhookURL, "/"),
+		username:     username,
+		avatarURL:    avatarURL,
+		embedBuilder: NewEmbedBuilder(),
+	}
+}
+
+// SetTemplates sets the configured Discord template of the embed description.
+func (c *Client) SetTemplates(registry *templates.Registry) {
+	c.embedBuilder.SetDescriptionTemplate(registry.Lookup(templates.Discord, "description"))
+}
+
+// webhookPayload is the body for executing or editing a webhook message.
+type webhookPayload struct {
+	Username  string  `json:"username,omitempty"`
+	AvatarURL string  `json:"avatar_url,omitempty"`
+	Embeds    []Embed `json:"embeds"`
+}
+
+// webhookMessage is the subset of the Discord Message object we need.
+type webhookMessage struct {
+	ID string `json:"id"`
+}
+
+// apiError describes a failed Discord API call.
+type apiError struct {
+	StatusCode int
+	Body       string
+	RetryAfter time.Duration
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("discord api error (status %d): %s", e.StatusCode, e.Body)
+}
+
+// Notify posts a new embed for the alert.
+// Returns the Discord message ID as the message reference.
+func (c *Client) Notify(ctx context.Context, alert *entity.Alert) (string, error) {
+	payload := webhookPayload{
+		Username:  c.username,
+		AvatarURL: c.avatarURL,
+		Embeds:    []Embed{c.embedBuilder.Build(alert)},
+	}
+
+	// wait=true makes Discord return the created message so we can edit it later
+	var msg webhookMessage
+	if err := c.do(ctx, http.MethodPost, c.webhookURL+"?wait=true", payload, &msg); err != nil {
+		return "", categorizeDiscordError(err, "posting discord message")
+	}
+
+	return msg.ID, nil
+}
+
+// Preview returns the webhook payload that Notify would post for the alert.
+func (c *Client) Preview(alert *entity.Alert) (any, error) {
+	return webhookPayload{
+		Username:  c.username,
+		AvatarURL: c.avatarURL,
+		Embeds:    []Embed{c.embedBuilder.Build(alert)},
+	}, nil
+}
+
+// UpdateMessage edits a previously posted embed with the alert's current state.
+func (c *Client) UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error {
+	if messageID == "" {
+		return domainerrors.NewPermanentError("discord message ID is empty", nil)
+	}
+
+	// Username and avatar cannot be changed when editing a webhook message
+	payload := webhookPayload{
+		Embeds: []Embed{c.embedBuilder.Build(alert)},
+	}
+
+	endpoint := fmt.Sprintf("%s/messages/%s", c.webhookURL, messageID)
+	if err := c.do(ctx, http.MethodPatch, endpoint, payload, nil); err != nil {
+		return categorizeDiscordError(err, "updating discord message")
+	}
+
+	return nil
+}
+
+// Name returns the notifier name.
+func (c *Client) Name() string {
+	return "discord"
+}
+
+// SelfTest verifies the webhook exists by fetching it.
+func (c *Client) SelfTest(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.webhookURL, nil)
+	if err != nil {
+		return domainerrors.NewPermanentError("creating discord request", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return categorizeDiscordError(fmt.Errorf("sending request: %w", redactURL(err)), "fetching discord webhook")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return categorizeDiscordError(&apiError{
+			StatusCode: resp.StatusCode,
+			Body:       string(body),
+			RetryAfter: parseRetryAfter(resp.Header, body),
+		}, "fetching discord webhook")
+	}
+	return nil
+}
+
+// do sends a JSON request to the webhook and decodes the response into out (if non-nil).
+func (c *Client) do(ctx context.Context, method, endpoint string, body interface{}, out interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("marshaling request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending request: %w", redactURL(err))
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return &apiError{
+			StatusCode: resp.StatusCode,
+			Body:       string(respBody),
+			RetryAfter: parseRetryAfter(resp.Header, respBody),
+		}
+	}
+
+	if out != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return fmt.Errorf("unmarshaling response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// redactURL removes the webhook ID and token, which make up the webhook
+// URL's path, from a transport error's URL so the error can be logged.
+func redactURL(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		redacted := "<redacted>"
+		if u, parseErr := url.Parse(urlErr.URL); parseErr == nil {
+			redacted = u.Scheme + "://" + u.Host + "/<redacted>"
+		}
+		urlErr.URL = redacted
+	}
+	return err
+}
+
+// parseRetryAfter extracts the rate-limit backoff from Discord's response.
+// Discord sends Retry-After (seconds) and X-RateLimit-Reset-After headers,
+// and a retry_after field in the JSON body for 429 responses.
+func parseRetryAfter(header http.Header, body []byte) time.Duration {
+	for _, key := range []string{"Retry-After", "X-RateLimit-Reset-After"} {
+		if v := header.Get(key); v != "" {
+			if secs, err := strconv.ParseFloat(v, 64); err == nil && secs > 0 {
+				return time.Duration(secs * float64(time.Second))
+			}
+		}
+	}
+
+	var rateLimit struct {
+		RetryAfter float64 `json:"retry_after"`
+	}
+	if err := json.Unmarshal(body, &rateLimit); err == nil && rateLimit.RetryAfter > 0 {
+		return time.Duration(rateLimit.RetryAfter * float64(time.Second))
+	}
+
+	return 0
+}
+
+// categorizeDiscordError wraps Discord API errors as transient or permanent domain errors.
+func categorizeDiscordError(err error, operation string) error {
+	if err == nil {
+		return nil
+	}
+
+	// Check for network errors (transient)
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return domainerrors.NewTransientError(
+			fmt.Sprintf("%s: network error", operation),
+			err,
+		)
+	}
+
+	var apiErr *apiError
+	if errors.As(err, &apiErr) {
+		// Rate limiting (HTTP 429) - transient
+		if apiErr.StatusCode == http.StatusTooManyRequests {
+			return domainerrors.NewTransientError(
+				fmt.Sprintf("%s: rate limited", operation),
+				err,
+			).WithRetryAfter(apiErr.RetryAfter)
+		}
+
+		// Server errors (5xx) - transient
+		if apiErr.StatusCode >= 500 && apiErr.StatusCode < 600 {
+			return domainerrors.NewTransientError(
+				fmt.Sprintf("%s: discord server error (status %d)", operation, apiErr.StatusCode),
+				err,
+			)
+		}
+
+		// Client errors (4xx) - permanent
+		if apiErr.StatusCode >= 400 && apiErr.StatusCode < 500 {
+			return domainerrors.NewPermanentError(
+				fmt.Sprintf("%s: client error (status %d)", operation, apiErr.StatusCode),
+				err,
+			)
+		}
+	}
+
+	// Check for context errors (transient)
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return domainerrors.NewTransientError(
+			fmt.Sprintf("%s: context timeout", operation),
+			err,
+		)
+	}
+
+	// Default to permanent error
+	return domainerrors.NewPermanentError(
+		fmt.Sprintf("%s: %v", operation, err),
+		err,
+	)
+}
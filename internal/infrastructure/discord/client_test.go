@@ -0,0 +1,124 @@
+package discord
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainerrors "github.com/qj0r9j0vc2/alert-bridge/internal/domain/errors"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/notifiertest"
+)
+
+const testWebhookPath = "/api/webhooks/1234/secret-token"
+
+// newTestServer serves webhook calls with the given handler.
+func newTestServer(t *testing.T, handler http.HandlerFunc) *Client {
+	return NewClient(notifiertest.NewServer(t, handler)+testWebhookPath, "alert-bridge", "")
+}
+
+func TestClient_Notify(t *testing.T) {
+	var gotRequest *http.Request
+	var gotPayload webhookPayload
+	client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		gotRequest = r
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&gotPayload))
+		w.Write([]byte(`{"id": "987654"}`))
+	})
+
+	messageID, err := client.Notify(context.Background(), notifiertest.Alert())
+	require.NoError(t, err)
+
+	assert.Equal(t, "987654", messageID)
+	assert.Equal(t, http.MethodPost, gotRequest.Method)
+	assert.Equal(t, testWebhookPath, gotRequest.URL.Path)
+	assert.Equal(t, "true", gotRequest.URL.Query().Get("wait"))
+	assert.Equal(t, "alert-bridge", gotPayload.Username)
+	require.Len(t, gotPayload.Embeds, 1)
+}
+
+func TestClient_UpdateMessage(t *testing.T) {
+	var gotRequest *http.Request
+	client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		gotRequest = r
+		w.Write([]byte(`{"id": "987654"}`))
+	})
+
+	require.NoError(t, client.UpdateMessage(context.Background(), "987654", notifiertest.Alert()))
+	assert.Equal(t, http.MethodPatch, gotRequest.Method)
+	assert.Equal(t, testWebhookPath+"/messages/987654", gotRequest.URL.Path)
+}
+
+func TestClient_RateLimited(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		body   string
+		want   time.Duration
+	}{
+		{name: "Retry-After header", header: "2", body: `{"message": "You are being rate limited."}`, want: 2 * time.Second},
+		{name: "retry_after in body", body: `{"message": "You are being rate limited.", "retry_after": 0.5}`, want: 500 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				if tt.header != "" {
+					w.Header().Set("Retry-After", tt.header)
+				}
+				w.WriteHeader(http.StatusTooManyRequests)
+				w.Write([]byte(tt.body))
+			})
+
+			_, err := client.Notify(context.Background(), notifiertest.Alert())
+			require.Error(t, err)
+
+			assert.True(t, domainerrors.IsTransientError(err))
+			retryAfter, ok := domainerrors.RetryAfter(err)
+			assert.True(t, ok)
+			assert.Equal(t, tt.want, retryAfter)
+		})
+	}
+}
+
+func TestClient_ErrorClassification(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		transient bool
+	}{
+		{name: "server error", status: http.StatusBadGateway, transient: true},
+		{name: "unknown webhook", status: http.StatusNotFound},
+		{name: "invalid payload", status: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(`{"message": "error"}`))
+			})
+
+			_, err := client.Notify(context.Background(), notifiertest.Alert())
+			require.Error(t, err)
+			assert.Equal(t, tt.transient, domainerrors.IsTransientError(err))
+		})
+	}
+}
+
+func TestClient_TransportErrorRedactsWebhookURL(t *testing.T) {
+	client := NewClient(notifiertest.UnreachableURL()+testWebhookPath, "", "")
+
+	_, err := client.Notify(context.Background(), notifiertest.Alert())
+	require.Error(t, err)
+	assert.True(t, domainerrors.IsTransientError(err))
+	assert.NotContains(t, err.Error(), "secret-token")
+
+	err = client.SelfTest(context.Background())
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "secret-token")
+}
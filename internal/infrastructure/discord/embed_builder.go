@@ -0,0 +1,140 @@
+package discord
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/templates"
+)
+
+// Severity color codes for embed side bars (decimal RGB as Discord expects)
+const (
+	colorCritical = templates.ColorCritical
+	colorWarning  = templates.ColorWarning
+	colorInfo     = templates.ColorInfo
+	colorResolved = templates.ColorResolved
+	colorAcked    = templates.ColorAcked
+)
+
+// Embed is a Discord rich embed.
+type Embed struct {
+	Title       string       `json:"title,omitempty"`
+	Description string       `json:"description,omitempty"`
+	Color       int          `json:"color,omitempty"`
+	Fields      []EmbedField `json:"fields,omitempty"`
+	Footer      *EmbedFooter `json:"footer,omitempty"`
+	Timestamp   string       `json:"timestamp,omitempty"`
+}
+
+// maxDescriptionLength is the longest embed description Discord accepts.
+const maxDescriptionLength = 4096
+
+// EmbedField is a name/value pair shown inside an embed.
+type EmbedField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline,omitempty"`
+}
+
+// EmbedFooter is the small text shown at the bottom of an embed.
+type EmbedFooter struct {
+	Text string `json:"text"`
+}
+
+// EmbedBuilder constructs Discord embeds for alerts.
+type EmbedBuilder struct {
+	descriptionTmpl *templates.Template // Optional: custom embed description
+}
+
+// NewEmbedBuilder creates a new embed builder.
+func NewEmbedBuilder() *EmbedBuilder {
+	return &EmbedBuilder{}
+}
+
+// SetDescriptionTemplate sets the template of the embed description,
+// replacing the alert summary.
+func (b *EmbedBuilder) SetDescriptionTemplate(tmpl *templates.Template) {
+	b.descriptionTmpl = tmpl
+}
+
+// Build creates an embed reflecting the alert's current state.
+func (b *EmbedBuilder) Build(alert *entity.Alert) Embed {
+	emoji, statusText, color := b.getStatusInfo(alert)
+
+	embed := Embed{
+		Title:     fmt.Sprintf("%s %s: %s", emoji, statusText, alert.Name),
+		Color:     color,
+		Timestamp: alert.FiredAt.UTC().Format(time.RFC3339),
+		Footer:    &EmbedFooter{Text: fmt.Sprintf("Severity: %s", strings.ToUpper(string(alert.Severity)))},
+	}
+
+	if alert.Summary != "" {
+		embed.Description = fmt.Sprintf("*%s*", alert.Summary)
+	}
+	if description := b.descriptionTmpl.Text(alert); description != "" {
+		embed.Description = shorten(description, maxDescriptionLength)
+	}
+
+	if alert.Instance != "" {
+		embed.Fields = append(embed.Fields, EmbedField{Name: "🖥️ Instance", Value: fmt.Sprintf("`%s`", alert.Instance), Inline: true})
+	}
+	if alert.Target != "" {
+		embed.Fields = append(embed.Fields, EmbedField{Name: "🎯 Target", Value: fmt.Sprintf("`%s`", alert.Target), Inline: true})
+	}
+	embed.Fields = append(embed.Fields, EmbedField{Name: "📊 State", Value: b.formatState(alert.State), Inline: true})
+
+	if alert.IsAcked() && alert.AckedBy != "" {
+		ackedAt := "unknown"
+		if alert.AckedAt != nil {
+			ackedAt = alert.AckedAt.Format("Jan 2, 15:04 MST")
+		}
+		embed.Fields = append(embed.Fields, EmbedField{Name: "👁️ Acknowledged", Value: fmt.Sprintf("by **%s** at %s", alert.AckedBy, ackedAt)})
+	}
+
+	if alert.IsResolved() && alert.ResolvedAt != nil {
+		embed.Fields = append(embed.Fields, EmbedField{Name: "✅ Resolved", Value: alert.ResolvedAt.Format("Jan 2, 15:04 MST")})
+	}
+
+	return embed
+}
+
+// shorten cuts s to at most n runes, ending it with an ellipsis if cut.
+func shorten(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-1]) + "…"
+}
+
+// getStatusInfo returns emoji, text, and color for the alert status.
+func (b *EmbedBuilder) getStatusInfo(alert *entity.Alert) (emoji, text string, color int) {
+	switch {
+	case alert.IsResolved():
+		return "✅", "RESOLVED", colorResolved
+	case alert.IsAcked():
+		return "👁️", "ACKNOWLEDGED", colorAcked
+	case alert.Severity == entity.SeverityCritical:
+		return "🚨", "CRITICAL", colorCritical
+	case alert.Severity == entity.SeverityWarning:
+		return "⚠️", "WARNING", colorWarning
+	default:
+		return "ℹ️", "INFO", colorInfo
+	}
+}
+
+// formatState formats the alert state for display.
+func (b *EmbedBuilder) formatState(state entity.AlertState) string {
+	switch state {
+	case entity.StateActive:
+		return "🔴 Firing"
+	case entity.StateAcked:
+		return "👁️ Acknowledged"
+	case entity.StateResolved:
+		return "✅ Resolved"
+	default:
+		return string(state)
+	}
+}
@@ -0,0 +1,341 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	domainerrors "github.com/qj0r9j0vc2/alert-bridge/internal/domain/errors"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/templates"
+)
+
+// TLS modes supported for SMTP connections.
+const (
+	TLSModeNone     = "none"     // Plain SMTP (not recommended outside local relays)
+	TLSModeStartTLS = "starttls" // Upgrade a plain connection via STARTTLS
+	TLSModeTLS      = "tls"      // Implicit TLS (SMTPS, usually port 465)
+)
+
+// Client sends alert notifications as emails over SMTP.
+// Implements the alert.Notifier interface.
+type Client struct {
+	host     string
+	port     int
+	username string
+	password string
+	from     string   // From header, e.g. "Alert Bridge <alerts@example.com>"
+	to       []string // To header entries
+	envelope envelope
+	tlsMode  string
+	renderer *Renderer
+	dialer   *net.Dialer
+}
+
+// envelope holds the bare SMTP envelope addresses.
+type envelope struct {
+	from string
+	to   []string
+}
+
+// NewClient creates a new SMTP email client. Sender and recipients may be
+// bare addresses or carry a display name, as in "Alert Bridge <alerts@example.com>".
+func NewClient(host string, port int, username, password, from string, to []string, tlsMode string) *Client {
+	if tlsMode == "" {
+		tlsMode = TLSModeStartTLS
+	}
+
+	env := envelope{from: envelopeAddress(from)}
+	for _, rcpt := range to {
+		env.to = append(env.to, envelopeAddress(rcpt))
+	}
+
+	return &Client{
+		host:     host,
+		port:     port,
+		username: username,
+		password: password,
+		from:     from,
+		to:       to,
+		envelope: env,
+		tlsMode:  tlsMode,
+		renderer: NewRenderer(),
+		dialer:   &net.Dialer{Timeout: 10 * time.Second},
+	}
+}
+
+// SetTemplates sets the configured email templates; see Renderer.SetTemplates.
+func (c *Client) SetTemplates(registry *templates.Registry) {
+	c.renderer.SetTemplates(registry)
+}
+
+// Notify sends an email for a newly firing alert.
+// Returns the generated Message-ID so follow-ups can thread onto it.
+func (c *Client) Notify(ctx context.Context, alert *entity.Alert) (string, error) {
+	body, err := c.renderer.Render(alert)
+	if err != nil {
+		return "", domainerrors.NewPermanentError("rendering email template", err)
+	}
+
+	messageID := c.newMessageID(alert)
+	headers := map[string]string{
+		"Message-ID": messageID,
+	}
+
+	if err := c.send(ctx, c.renderer.Subject(alert), body, headers); err != nil {
+		return "", categorizeSMTPError(err, "sending alert email")
+	}
+
+	return messageID, nil
+}
+
+// Preview returns the envelope, subject and HTML body that Notify would send for the alert.
+func (c *Client) Preview(alert *entity.Alert) (any, error) {
+	body, err := c.renderer.Render(alert)
+	if err != nil {
+		return nil, domainerrors.NewPermanentError("rendering email template", err)
+	}
+
+	return map[string]any{
+		"from":    c.from,
+		"to":      c.to,
+		"subject": c.renderer.Subject(alert),
+		"html":    body,
+	}, nil
+}
+
+// UpdateMessage sends a follow-up email (acknowledged/resolved) threaded onto
+// the original message, since sent emails cannot be edited.
+func (c *Client) UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error {
+	body, err := c.renderer.Render(alert)
+	if err != nil {
+		return domainerrors.NewPermanentError("rendering email template", err)
+	}
+
+	headers := map[string]string{
+		"Message-ID": c.newMessageID(alert),
+	}
+	if messageID != "" {
+		headers["In-Reply-To"] = messageID
+		headers["References"] = messageID
+	}
+
+	subject := "Re: " + c.renderer.Subject(alert)
+	if err := c.send(ctx, subject, body, headers); err != nil {
+		return categorizeSMTPError(err, "sending follow-up email")
+	}
+
+	return nil
+}
+
+// Name returns the notifier name.
+func (c *Client) Name() string {
+	return "email"
+}
+
+// newMessageID generates an RFC 5322 Message-ID for an alert email.
+func (c *Client) newMessageID(alert *entity.Alert) string {
+	buf := make([]byte, 8)
+	_, _ = rand.Read(buf)
+
+	domain := "alert-bridge"
+	if at := strings.LastIndex(c.envelope.from, "@"); at >= 0 && at < len(c.envelope.from)-1 {
+		domain = c.envelope.from[at+1:]
+	}
+
+	return fmt.Sprintf("<%s.%s@%s>", alert.ID, hex.EncodeToString(buf), domain)
+}
+
+// send delivers a single HTML email to all configured recipients.
+func (c *Client) send(ctx context.Context, subject, htmlBody string, headers map[string]string) error {
+	msg := c.buildMessage(subject, htmlBody, headers)
+
+	client, err := c.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if err := client.Mail(c.envelope.from); err != nil {
+		return fmt.Errorf("smtp MAIL FROM: %w", err)
+	}
+	for _, rcpt := range c.envelope.to {
+		if err := client.Rcpt(rcpt); err != nil {
+			return fmt.Errorf("smtp RCPT TO %s: %w", rcpt, err)
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("smtp DATA: %w", err)
+	}
+	if _, err := w.Write(msg); err != nil {
+		return fmt.Errorf("writing message: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("closing message: %w", err)
+	}
+
+	return client.Quit()
+}
+
+// SelfTest verifies that the SMTP server is reachable and, when credentials
+// are configured, accepts them, by opening a session and sending NOOP.
+func (c *Client) SelfTest(ctx context.Context) error {
+	client, err := c.connect(ctx)
+	if err != nil {
+		return categorizeSMTPError(err, "testing smtp server")
+	}
+	defer client.Close()
+
+	if err := client.Noop(); err != nil {
+		return categorizeSMTPError(fmt.Errorf("smtp NOOP: %w", err), "testing smtp server")
+	}
+	return categorizeSMTPError(client.Quit(), "testing smtp server")
+}
+
+// connect opens an SMTP session, upgrading it to TLS and authenticating as
+// configured. The whole session is bounded by the context deadline.
+func (c *Client) connect(ctx context.Context) (*smtp.Client, error) {
+	addr := net.JoinHostPort(c.host, strconv.Itoa(c.port))
+	conn, err := c.dial(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, c.host)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("creating smtp client: %w", err)
+	}
+
+	if c.tlsMode == TLSModeStartTLS {
+		if err := client.StartTLS(&tls.Config{ServerName: c.host}); err != nil {
+			client.Close()
+			return nil, fmt.Errorf("starttls: %w", err)
+		}
+	}
+
+	if c.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", c.username, c.password, c.host)); err != nil {
+			client.Close()
+			return nil, fmt.Errorf("smtp auth: %w", err)
+		}
+	}
+
+	return client, nil
+}
+
+// envelopeAddress returns the bare address of an email address that may
+// carry a display name. Addresses that do not parse are used as given.
+func envelopeAddress(address string) string {
+	parsed, err := mail.ParseAddress(address)
+	if err != nil {
+		return address
+	}
+	return parsed.Address
+}
+
+// dial opens the TCP (or implicit TLS) connection to the SMTP server.
+func (c *Client) dial(ctx context.Context, addr string) (net.Conn, error) {
+	if c.tlsMode == TLSModeTLS {
+		tlsDialer := &tls.Dialer{
+			NetDialer: c.dialer,
+			Config:    &tls.Config{ServerName: c.host},
+		}
+		conn, err := tlsDialer.DialContext(ctx, "tcp", addr)
+		if err != nil {
+			return nil, fmt.Errorf("dialing smtp server: %w", err)
+		}
+		return conn, nil
+	}
+
+	conn, err := c.dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("dialing smtp server: %w", err)
+	}
+	return conn, nil
+}
+
+// buildMessage assembles the raw RFC 5322 message.
+func (c *Client) buildMessage(subject, htmlBody string, headers map[string]string) []byte {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "From: %s\r\n", c.from)
+	fmt.Fprintf(&buf, "To: %s\r\n", strings.Join(c.to, ", "))
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	for _, key := range []string{"Message-ID", "In-Reply-To", "References"} {
+		if v, ok := headers[key]; ok {
+			fmt.Fprintf(&buf, "%s: %s\r\n", key, v)
+		}
+	}
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/html; charset=\"utf-8\"\r\n")
+	buf.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(htmlBody, "\n", "\r\n"))
+
+	return buf.Bytes()
+}
+
+// categorizeSMTPError wraps SMTP errors as transient or permanent domain errors.
+func categorizeSMTPError(err error, operation string) error {
+	if err == nil {
+		return nil
+	}
+
+	// Check for network errors (transient)
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return domainerrors.NewTransientError(
+			fmt.Sprintf("%s: network error", operation),
+			err,
+		)
+	}
+
+	// SMTP reply codes: 4xx are temporary failures, 5xx are permanent
+	var protoErr *textproto.Error
+	if errors.As(err, &protoErr) {
+		if protoErr.Code >= 400 && protoErr.Code < 500 {
+			return domainerrors.NewTransientError(
+				fmt.Sprintf("%s: temporary smtp failure (code %d)", operation, protoErr.Code),
+				err,
+			)
+		}
+		return domainerrors.NewPermanentError(
+			fmt.Sprintf("%s: smtp failure (code %d)", operation, protoErr.Code),
+			err,
+		)
+	}
+
+	// Check for context errors (transient)
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return domainerrors.NewTransientError(
+			fmt.Sprintf("%s: context timeout", operation),
+			err,
+		)
+	}
+
+	// Default to permanent error
+	return domainerrors.NewPermanentError(
+		fmt.Sprintf("%s: %v", operation, err),
+		err,
+	)
+}
@@ -0,0 +1,191 @@
+package email
+
+import (
+	"context"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainerrors "github.com/qj0r9j0vc2/alert-bridge/internal/domain/errors"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/notifiertest"
+)
+
+// smtpServer is a minimal SMTP server recording the envelope and message of
+// each delivery. rcptReply, if set, answers RCPT TO instead of accepting it.
+type smtpServer struct {
+	listener  net.Listener
+	rcptReply string
+
+	mu       sync.Mutex
+	from     []string
+	rcpts    []string
+	messages []string
+}
+
+func newSMTPServer(t *testing.T) *smtpServer {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	s := &smtpServer{listener: listener}
+	go s.serve()
+	return s
+}
+
+// client returns an email client delivering to the server without TLS.
+func (s *smtpServer) client(from string, to ...string) *Client {
+	addr := s.listener.Addr().(*net.TCPAddr)
+	return NewClient("127.0.0.1", addr.Port, "", "", from, to, TLSModeNone)
+}
+
+func (s *smtpServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(textproto.NewConn(conn))
+	}
+}
+
+func (s *smtpServer) handle(conn *textproto.Conn) {
+	defer conn.Close()
+
+	conn.PrintfLine("220 localhost ESMTP")
+	for {
+		line, err := conn.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+
+		s.mu.Lock()
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			conn.PrintfLine("250 localhost")
+		case "MAIL":
+			s.from = append(s.from, arg)
+			conn.PrintfLine("250 OK")
+		case "RCPT":
+			if s.rcptReply != "" {
+				conn.PrintfLine("%s", s.rcptReply)
+				break
+			}
+			s.rcpts = append(s.rcpts, arg)
+			conn.PrintfLine("250 OK")
+		case "DATA":
+			conn.PrintfLine("354 Go ahead")
+			s.mu.Unlock()
+			data, err := conn.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.messages = append(s.messages, string(data))
+			conn.PrintfLine("250 Queued")
+		case "NOOP", "RSET":
+			conn.PrintfLine("250 OK")
+		case "QUIT":
+			conn.PrintfLine("221 Bye")
+			s.mu.Unlock()
+			return
+		default:
+			conn.PrintfLine("502 Not implemented")
+		}
+		s.mu.Unlock()
+	}
+}
+
+func TestClient_NotifyWithDisplayNames(t *testing.T) {
+	server := newSMTPServer(t)
+	client := server.client("Alert Bridge <alerts@example.com>", "On-call <oncall@example.com>", "sre@example.com")
+
+	messageID, err := client.Notify(context.Background(), notifiertest.Alert())
+	require.NoError(t, err)
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	// The envelope carries bare addresses, the headers the display names
+	assert.Equal(t, []string{"FROM:<alerts@example.com>"}, server.from)
+	assert.Equal(t, []string{"TO:<oncall@example.com>", "TO:<sre@example.com>"}, server.rcpts)
+	require.Len(t, server.messages, 1)
+	message := server.messages[0]
+	assert.Contains(t, message, "From: Alert Bridge <alerts@example.com>\n")
+	assert.Contains(t, message, "Message-ID: "+messageID+"\n")
+	assert.True(t, strings.HasSuffix(messageID, "@example.com>"), messageID)
+}
+
+func TestClient_UpdateMessageThreads(t *testing.T) {
+	server := newSMTPServer(t)
+	client := server.client("alerts@example.com", "oncall@example.com")
+
+	require.NoError(t, client.UpdateMessage(context.Background(), "<original@example.com>", notifiertest.Alert()))
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	require.Len(t, server.messages, 1)
+	assert.Contains(t, server.messages[0], "In-Reply-To: <original@example.com>\n")
+	assert.Contains(t, server.messages[0], "References: <original@example.com>\n")
+	assert.Contains(t, server.messages[0], "Subject: Re: ")
+}
+
+func TestClient_ErrorClassification(t *testing.T) {
+	tests := []struct {
+		name      string
+		rcptReply string
+		transient bool
+	}{
+		{name: "temporary failure", rcptReply: "450 Mailbox busy", transient: true},
+		{name: "permanent failure", rcptReply: "550 No such user"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newSMTPServer(t)
+			server.rcptReply = tt.rcptReply
+			client := server.client("alerts@example.com", "oncall@example.com")
+
+			_, err := client.Notify(context.Background(), notifiertest.Alert())
+			require.Error(t, err)
+			assert.Equal(t, tt.transient, domainerrors.IsTransientError(err))
+		})
+	}
+}
+
+func TestClient_UnreachableServer(t *testing.T) {
+	server := newSMTPServer(t)
+	client := server.client("alerts@example.com", "oncall@example.com")
+	server.listener.Close()
+
+	_, err := client.Notify(context.Background(), notifiertest.Alert())
+	require.Error(t, err)
+	assert.True(t, domainerrors.IsTransientError(err))
+}
+
+func TestClient_SelfTest(t *testing.T) {
+	server := newSMTPServer(t)
+	client := server.client("alerts@example.com", "oncall@example.com")
+	require.NoError(t, client.SelfTest(context.Background()))
+
+	// Nothing is sent
+	server.mu.Lock()
+	assert.Empty(t, server.messages)
+	server.mu.Unlock()
+
+	// A server that does not accept the credentials fails the self-test
+	addr := server.listener.Addr().(*net.TCPAddr)
+	withAuth := NewClient("127.0.0.1", addr.Port, "user", "secret", "alerts@example.com", []string{"oncall@example.com"}, TLSModeNone)
+	assert.Error(t, withAuth.SelfTest(context.Background()))
+
+	server.listener.Close()
+	err := client.SelfTest(context.Background())
+	require.Error(t, err)
+	assert.True(t, domainerrors.IsTransientError(err))
+}
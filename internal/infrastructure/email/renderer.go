@@ -0,0 +1,159 @@
+package email
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"sort"
+	"strings"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/templates"
+)
+
+// Severity color codes used in the email header bar
+const (
+	colorCritical = "#E01E5A" // Red
+	colorWarning  = "#ECB22E" // Yellow/Orange
+	colorInfo     = "#36C5F0" // Blue
+	colorResolved = "#2EB67D" // Green
+	colorAcked    = "#9B59B6" // Purple
+)
+
+const alertTemplate = `<!DOCTYPE html>
+<html>
+<body style="font-family: -apple-system, Helvetica, Arial, sans-serif; color: #1d1c1d;">
+  <div style="border-left: 6px solid {{.Color}}; padding: 12px 16px; max-width: 640px;">
+    <div style="font-size: 13px; font-weight: bold; color: {{.Color}};">{{.Emoji}} {{.Status}} &middot; {{.Severity}}</div>
+    <h2 style="margin: 8px 0;">{{.Alert.Name}}</h2>
+    {{- if .Alert.Summary}}
+    <p style="font-style: italic;">{{.Alert.Summary}}</p>
+    {{- end}}
+    {{- if .Alert.Description}}
+    <p>{{.Alert.Description}}</p>
+    {{- end}}
+    <table style="border-collapse: collapse; font-size: 14px;">
+      {{- if .Alert.Instance}}
+      <tr><td style="padding: 2px 12px 2px 0;"><b>Instance</b></td><td><code>{{.Alert.Instance}}</code></td></tr>
+      {{- end}}
+      {{- if .Alert.Target}}
+      <tr><td style="padding: 2px 12px 2px 0;"><b>Target</b></td><td><code>{{.Alert.Target}}</code></td></tr>
+      {{- end}}
+      <tr><td style="padding: 2px 12px 2px 0;"><b>Fingerprint</b></td><td><code>{{.Alert.Fingerprint}}</code></td></tr>
+      <tr><td style="padding: 2px 12px 2px 0;"><b>Fired</b></td><td>{{.Alert.FiredAt.Format "Jan 2, 15:04 MST"}}</td></tr>
+      {{- if and .Alert.AckedAt .Alert.AckedBy}}
+      <tr><td style="padding: 2px 12px 2px 0;"><b>Acknowledged</b></td><td>{{.Alert.AckedBy}} at {{.Alert.AckedAt.Format "Jan 2, 15:04 MST"}}</td></tr>
+      {{- end}}
+      {{- if .Alert.ResolvedAt}}
+      <tr><td style="padding: 2px 12px 2px 0;"><b>Resolved</b></td><td>{{.Alert.ResolvedAt.Format "Jan 2, 15:04 MST"}}</td></tr>
+      {{- end}}
+    </table>
+    {{- if .Labels}}
+    <h4 style="margin: 16px 0 4px;">Labels</h4>
+    <table style="border-collapse: collapse; font-size: 13px;">
+      {{- range .Labels}}
+      <tr><td style="padding: 1px 12px 1px 0;"><code>{{.Key}}</code></td><td><code>{{.Value}}</code></td></tr>
+      {{- end}}
+    </table>
+    {{- end}}
+  </div>
+</body>
+</html>
+`
+
+// labelPair is a sorted label entry for template rendering.
+type labelPair struct {
+	Key   string
+	Value string
+}
+
+// templateData is the view model passed to the email template.
+type templateData struct {
+	Alert    *entity.Alert
+	Emoji    string
+	Status   string
+	Severity string
+	Color    string
+	Labels   []labelPair
+}
+
+// Renderer renders alert emails from an HTML template.
+type Renderer struct {
+	tmpl        *template.Template
+	subjectTmpl *templates.Template // Optional: custom subject line
+	bodyTmpl    *templates.Template // Optional: custom HTML body
+}
+
+// NewRenderer creates a renderer using the built-in alert template.
+func NewRenderer() *Renderer {
+	return &Renderer{
+		tmpl: template.Must(template.New("alert").Parse(alertTemplate)),
+	}
+}
+
+// SetTemplates sets the configured email templates of the subject and body.
+func (r *Renderer) SetTemplates(registry *templates.Registry) {
+	r.subjectTmpl = registry.Lookup(templates.Email, "subject")
+	r.bodyTmpl = registry.Lookup(templates.Email, "body")
+}
+
+// Render produces the HTML body for an alert in its current state, from the
+// body template if it renders one.
+func (r *Renderer) Render(alert *entity.Alert) (string, error) {
+	if body := r.bodyTmpl.Text(alert); body != "" {
+		return body, nil
+	}
+
+	emoji, status, color := getStatusInfo(alert)
+
+	keys := make([]string, 0, len(alert.Labels))
+	for k := range alert.Labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	labels := make([]labelPair, 0, len(keys))
+	for _, k := range keys {
+		labels = append(labels, labelPair{Key: k, Value: alert.Labels[k]})
+	}
+
+	data := templateData{
+		Alert:    alert,
+		Emoji:    emoji,
+		Status:   status,
+		Severity: strings.ToUpper(string(alert.Severity)),
+		Color:    color,
+		Labels:   labels,
+	}
+
+	var buf bytes.Buffer
+	if err := r.tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("executing template: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// Subject returns the email subject line for an alert.
+// The subject stays stable across state changes so mail clients keep the thread together.
+func (r *Renderer) Subject(alert *entity.Alert) string {
+	if subject := r.subjectTmpl.Text(alert); subject != "" {
+		return subject
+	}
+	return fmt.Sprintf("[%s] %s", strings.ToUpper(string(alert.Severity)), alert.Name)
+}
+
+// getStatusInfo returns emoji, text, and color for the alert status.
+func getStatusInfo(alert *entity.Alert) (emoji, text, color string) {
+	switch {
+	case alert.IsResolved():
+		return "✅", "RESOLVED", colorResolved
+	case alert.IsAcked():
+		return "👁️", "ACKNOWLEDGED", colorAcked
+	case alert.Severity == entity.SeverityCritical:
+		return "🚨", "CRITICAL", colorCritical
+	case alert.Severity == entity.SeverityWarning:
+		return "⚠️", "WARNING", colorWarning
+	default:
+		return "ℹ️", "INFO", colorInfo
+	}
+}
@@ -0,0 +1,35 @@
+// Package notifiertest provides fixtures shared by the notifier client tests,
+// so every integration is exercised with the same alert against a fake
+// service instead of each test file keeping its own copy.
+package notifiertest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+)
+
+// Alert returns a new critical alert with a summary, the alert the client
+// tests send.
+func Alert() *entity.Alert {
+	return entity.NewAlert("fp-1", "HighCPU", "host-1", "", "CPU above 90%", entity.SeverityCritical)
+}
+
+// NewServer serves requests with handler until the test ends and returns the
+// server's URL.
+func NewServer(t *testing.T, handler http.HandlerFunc) string {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+// UnreachableURL returns the URL of a server that was already closed, so
+// requests to it fail with a transport error.
+func UnreachableURL() string {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	return server.URL
+}
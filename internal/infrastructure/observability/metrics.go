@@ -19,9 +19,10 @@ type Metrics struct {
 	HTTPRequestsActive  metric.Int64UpDownCounter
 
 	// Alert processing metrics
-	AlertsProcessedTotal    metric.Int64Counter
-	AlertProcessingDuration metric.Float64Histogram
-	AlertsActiveGauge       metric.Int64UpDownCounter
+	AlertsProcessedTotal     metric.Int64Counter
+	AlertProcessingDuration  metric.Float64Histogram
+	AlertsActiveGauge        metric.Int64UpDownCounter
+	AlertsLabelsLimitedTotal metric.Int64Counter
 
 	// Notification metrics
 	NotificationsSentTotal   metric.Int64Counter
@@ -29,6 +30,10 @@ type Metrics struct {
 	NotificationRetriesTotal metric.Int64Counter
 	NotificationErrorsTotal  metric.Int64Counter
 
+	// Notifier circuit breaker metrics
+	NotifierCircuitTransitionsTotal metric.Int64Counter
+	NotificationsShortCircuitTotal  metric.Int64Counter
+
 	// Acknowledgment metrics
 	AcknowledgmentsSyncedTotal metric.Int64Counter
 	AcknowledgmentErrorsTotal  metric.Int64Counter
@@ -36,6 +41,9 @@ type Metrics struct {
 	// Repository metrics
 	RepositoryOperationsTotal   metric.Int64Counter
 	RepositoryOperationDuration metric.Float64Histogram
+
+	// Cache metrics
+	CacheLookupsTotal metric.Int64Counter
 }
 
 // NewMetrics creates and registers all application metrics.
@@ -100,6 +108,15 @@ func NewMetrics(meter metric.Meter) (*Metrics, error) {
 		return nil, fmt.Errorf("creating alerts_active: %w", err)
 	}
 
+	m.AlertsLabelsLimitedTotal, err = meter.Int64Counter(
+		"alerts.labels_limited.total",
+		metric.WithDescription("Total number of alerts rejected or truncated by the label limits"),
+		metric.WithUnit("{alerts}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating alerts_labels_limited_total: %w", err)
+	}
+
 	// Notification metrics
 	m.NotificationsSentTotal, err = meter.Int64Counter(
 		"notifications.sent.total",
@@ -137,6 +154,25 @@ func NewMetrics(meter metric.Meter) (*Metrics, error) {
 		return nil, fmt.Errorf("creating notification_errors_total: %w", err)
 	}
 
+	// Notifier circuit breaker metrics
+	m.NotifierCircuitTransitionsTotal, err = meter.Int64Counter(
+		"notifier.circuit.transitions.total",
+		metric.WithDescription("Total number of notifier circuit breaker state changes by new state"),
+		metric.WithUnit("{transitions}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating notifier_circuit_transitions_total: %w", err)
+	}
+
+	m.NotificationsShortCircuitTotal, err = meter.Int64Counter(
+		"notifications.short_circuited.total",
+		metric.WithDescription("Total number of notifications skipped because the notifier's circuit was open"),
+		metric.WithUnit("{notifications}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating notifications_short_circuited_total: %w", err)
+	}
+
 	// Acknowledgment metrics
 	m.AcknowledgmentsSyncedTotal, err = meter.Int64Counter(
 		"acknowledgments.synced.total",
@@ -175,6 +211,16 @@ func NewMetrics(meter metric.Meter) (*Metrics, error) {
 		return nil, fmt.Errorf("creating repository_operation_duration: %w", err)
 	}
 
+	// Cache metrics
+	m.CacheLookupsTotal, err = meter.Int64Counter(
+		"cache.lookups.total",
+		metric.WithDescription("Total number of cache lookups by cache and hit or miss"),
+		metric.WithUnit("{lookups}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating cache_lookups_total: %w", err)
+	}
+
 	return m, nil
 }
 
@@ -203,6 +249,14 @@ func (m *Metrics) RecordAlertProcessed(ctx context.Context, alertName, severity,
 	m.AlertProcessingDuration.Record(ctx, duration.Seconds(), metric.WithAttributes(attrs...))
 }
 
+// RecordAlertLabelsLimited records an alert rejected or truncated by the
+// label limits; action is "rejected" or "truncated".
+func (m *Metrics) RecordAlertLabelsLimited(ctx context.Context, action string) {
+	m.AlertsLabelsLimitedTotal.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("action", action),
+	))
+}
+
 // RecordNotificationSent records notification metrics.
 func (m *Metrics) RecordNotificationSent(ctx context.Context, notifier string, success bool, duration time.Duration, retries int) {
 	attrs := []attribute.KeyValue{
@@ -222,6 +276,22 @@ func (m *Metrics) RecordNotificationSent(ctx context.Context, notifier string, s
 	}
 }
 
+// RecordNotifierCircuitTransition records a notifier's circuit breaker changing state.
+func (m *Metrics) RecordNotifierCircuitTransition(ctx context.Context, notifier, from, to string) {
+	m.NotifierCircuitTransitionsTotal.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("notifier", notifier),
+		attribute.String("from", from),
+		attribute.String("to", to),
+	))
+}
+
+// RecordNotificationShortCircuited records a call skipped by an open circuit.
+func (m *Metrics) RecordNotificationShortCircuited(ctx context.Context, notifier string) {
+	m.NotificationsShortCircuitTotal.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("notifier", notifier),
+	))
+}
+
 // RecordAcknowledgmentSynced records acknowledgment sync metrics.
 func (m *Metrics) RecordAcknowledgmentSynced(ctx context.Context, source string, syncedSystems int, errors int) {
 	attrs := []attribute.KeyValue{
@@ -247,3 +317,11 @@ func (m *Metrics) RecordRepositoryOperation(ctx context.Context, operation, enti
 	m.RepositoryOperationsTotal.Add(ctx, 1, metric.WithAttributes(attrs...))
 	m.RepositoryOperationDuration.Record(ctx, duration.Seconds(), metric.WithAttributes(attrs...))
 }
+
+// RecordCacheLookup records a cache hit or miss.
+func (m *Metrics) RecordCacheLookup(ctx context.Context, cache string, hit bool) {
+	m.CacheLookupsTotal.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("cache", cache),
+		attribute.Bool("hit", hit),
+	))
+}
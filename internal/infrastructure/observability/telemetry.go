@@ -5,10 +5,13 @@ import (
 	"fmt"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
 	"go.opentelemetry.io/otel/exporters/prometheus"
 	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
 	"go.opentelemetry.io/otel/trace"
 	"go.opentelemetry.io/otel/trace/noop"
@@ -27,10 +30,10 @@ type Telemetry struct {
 }
 
 // NewTelemetry creates and initializes OpenTelemetry telemetry.
-// For now, we use:
-// - NoOp tracer (can be extended to OTLP exporter later)
+// We use:
+// - OTLP/HTTP trace exporter when otlpEndpoint is set, NoOp tracer otherwise
 // - Prometheus metrics exporter
-func NewTelemetry(serviceName, serviceVersion string) (*Telemetry, error) {
+func NewTelemetry(serviceName, serviceVersion, otlpEndpoint string) (*Telemetry, error) {
 	if serviceName == "" {
 		serviceName = ServiceName
 	}
@@ -67,11 +70,28 @@ func NewTelemetry(serviceName, serviceVersion string) (*Telemetry, error) {
 		return nil, fmt.Errorf("creating metrics: %w", err)
 	}
 
-	// For now, use NoOp tracer (can be extended to OTLP later)
-	tracerProvider := noop.NewTracerProvider()
+	// Setup tracing; without an endpoint spans are discarded by a NoOp tracer
+	var tracerProvider trace.TracerProvider = noop.NewTracerProvider()
+	if otlpEndpoint != "" {
+		exporter, err := otlptracehttp.New(context.Background(),
+			otlptracehttp.WithEndpointURL(otlpEndpoint),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("creating otlp trace exporter: %w", err)
+		}
+
+		tracerProvider = sdktrace.NewTracerProvider(
+			sdktrace.WithResource(res),
+			sdktrace.WithBatcher(exporter),
+		)
+	}
 
-	// Set global tracer provider
+	// Set global tracer provider and W3C trace context propagation
 	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
 
 	return &Telemetry{
 		TracerProvider: tracerProvider,
@@ -80,8 +100,19 @@ func NewTelemetry(serviceName, serviceVersion string) (*Telemetry, error) {
 	}, nil
 }
 
-// Shutdown cleanly shuts down the telemetry providers.
+// TracingEnabled returns true if spans are exported to an OTLP endpoint.
+func (t *Telemetry) TracingEnabled() bool {
+	_, ok := t.TracerProvider.(*sdktrace.TracerProvider)
+	return ok
+}
+
+// Shutdown cleanly shuts down the telemetry providers, flushing pending spans.
 func (t *Telemetry) Shutdown(ctx context.Context) error {
+	if tp, ok := t.TracerProvider.(*sdktrace.TracerProvider); ok {
+		if err := tp.Shutdown(ctx); err != nil {
+			return fmt.Errorf("shutting down tracer provider: %w", err)
+		}
+	}
 	if mp, ok := t.MeterProvider.(*sdkmetric.MeterProvider); ok {
 		if err := mp.Shutdown(ctx); err != nil {
 			return fmt.Errorf("shutting down meter provider: %w", err)
@@ -0,0 +1,40 @@
+package observability
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the instrumentation scope used for all alert-bridge spans.
+const TracerName = "github.com/qj0r9j0vc2/alert-bridge"
+
+// Span attribute keys shared across the alert pipeline.
+const (
+	AttrAlertID          = attribute.Key("alert.id")
+	AttrAlertFingerprint = attribute.Key("alert.fingerprint")
+	AttrAlertName        = attribute.Key("alert.name")
+	AttrAlertStatus      = attribute.Key("alert.status")
+	AttrNotifierName     = attribute.Key("notifier.name")
+	AttrNotifierAttempt  = attribute.Key("notifier.attempt")
+	AttrAckSource        = attribute.Key("ack.source")
+	AttrDBOperation      = attribute.Key("db.operation")
+)
+
+// StartSpan starts a child span using the globally registered tracer provider.
+// It is a no-op when tracing is not configured.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(TracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan records err on the span (if non-nil) and ends it.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
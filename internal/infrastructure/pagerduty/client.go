@@ -10,29 +10,50 @@ import (
 	"net"
 	"net/http"
 	"strings"
+	"sync"
+	"text/template"
 
 	"github.com/PagerDuty/go-pagerduty"
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
 	domainerrors "github.com/qj0r9j0vc2/alert-bridge/internal/domain/errors"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/resilience"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/templates"
 )
 
 // Client wraps the PagerDuty API client with domain-specific operations.
 // Implements both alert.Notifier and ack.AckSyncer interfaces.
 type Client struct {
 	eventsClient    *pagerduty.Client
+	httpClient      *http.Client
+	inflight        resilience.InFlight
 	routingKey      string
 	serviceID       string
 	fromEmail       string
 	defaultSeverity string
-	eventsAPIURL    string // Optional: for E2E testing with mock services
+	dedupKeyTmpl    *template.Template              // Optional: custom dedup key; nil uses fingerprint/ID
+	severityMap     entity.SeverityMap              // Optional: per-label PagerDuty severity overrides
+	priorityMap     entity.SeverityMap              // Optional: per-priority-annotation overrides, checked first
+	routingKeys     map[entity.AlertSeverity]string // Optional: per-severity routing keys
+	summaryTmpl     *templates.Template             // Optional: custom incident summary
+	detailsTmpl     *templates.Template             // Optional: custom "details" custom detail
+	eventsAPIURL    string                          // Optional: for E2E testing with mock services
+
+	onCallMu sync.Mutex
+	onCalls  map[string]onCallEntry // service ID -> cached GetOnCall result
 }
 
 // NewClient creates a new PagerDuty client.
-func NewClient(apiToken, routingKey, serviceID, fromEmail, defaultSeverity string, eventsAPIURL ...string) *Client {
+// dedupKeyTemplate is an optional Go template evaluated against the alert to
+// compute the dedup key (e.g. "{{ .Name }}/{{ .Instance }}"); empty keeps the
+// default of fingerprint, then alert ID.
+func NewClient(apiToken, routingKey, serviceID, fromEmail, defaultSeverity, dedupKeyTemplate string, eventsAPIURL ...string) (*Client, error) {
+	httpClient := &http.Client{}
+
 	var client *pagerduty.Client
 	if apiToken != "" {
 		client = pagerduty.NewClient(apiToken)
+		client.HTTPClient = httpClient
 	}
 
 	if defaultSeverity == "" {
@@ -44,32 +65,130 @@ func NewClient(apiToken, routingKey, serviceID, fromEmail, defaultSeverity strin
 		apiURL = eventsAPIURL[0]
 	}
 
+	var dedupKeyTmpl *template.Template
+	if dedupKeyTemplate != "" {
+		var err error
+		dedupKeyTmpl, err = ParseDedupKeyTemplate(dedupKeyTemplate)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &Client{
 		eventsClient:    client,
+		httpClient:      httpClient,
 		routingKey:      routingKey,
 		serviceID:       serviceID,
 		fromEmail:       fromEmail,
 		defaultSeverity: defaultSeverity,
+		dedupKeyTmpl:    dedupKeyTmpl,
 		eventsAPIURL:    apiURL,
+	}, nil
+}
+
+// SetSeverityMap sets the severity map whose PagerDuty severities override the
+// built-in mapping for alerts carrying a mapped severity label.
+func (c *Client) SetSeverityMap(severities entity.SeverityMap) {
+	c.severityMap = severities
+}
+
+// SetPriorityMap sets the map of priority annotation values whose handling
+// overrides that of the severity label.
+func (c *Client) SetPriorityMap(priorities entity.SeverityMap) {
+	c.priorityMap = priorities
+}
+
+// SetRoutingKeys sets per-severity Events API routing keys, so alerts of
+// each severity reach a service with its own urgency and escalation policy.
+// Severities without a key use the default routing key.
+func (c *Client) SetRoutingKeys(keys map[entity.AlertSeverity]string) {
+	c.routingKeys = keys
+}
+
+// SetTemplates sets the configured PagerDuty templates of the incident
+// summary and of its "details" custom detail.
+func (c *Client) SetTemplates(registry *templates.Registry) {
+	c.summaryTmpl = registry.Lookup(templates.PagerDuty, "summary")
+	c.detailsTmpl = registry.Lookup(templates.PagerDuty, "details")
+}
+
+// routingKeyFor returns the routing key for the alert's events: the key of
+// the severity its incident was triggered with, else of its current
+// urgency, else the default routing key.
+func (c *Client) routingKeyFor(alert *entity.Alert) string {
+	severity := entity.AlertSeverity(alert.GetExternalReference(entity.PagerDutyRoutingReference))
+	if severity == "" {
+		severity = c.urgency(alert)
+	}
+	if key := c.routingKeys[severity]; key != "" {
+		return key
+	}
+	return c.routingKey
+}
+
+// urgency returns the severity selecting the alert's routing key: that of its
+// priority map entry, if any, else its own.
+func (c *Client) urgency(alert *entity.Alert) entity.AlertSeverity {
+	if mapping, ok := c.priorityMap.ForPriority(alert); ok {
+		return mapping.Severity
+	}
+	return alert.Severity
+}
+
+// ParseDedupKeyTemplate parses a dedup key template evaluated against entity.Alert.
+// Missing label or annotation keys render as empty strings.
+func ParseDedupKeyTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("dedup_key").Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parsing dedup key template: %w", err)
 	}
+	return tmpl, nil
 }
 
 // Notify creates a PagerDuty incident for an alert.
 // Returns the incident/dedup key as message ID.
 func (c *Client) Notify(ctx context.Context, alert *entity.Alert) (string, error) {
-	if c.routingKey == "" {
+	defer c.inflight.Start()()
+
+	// Build the event
+	event := c.buildTriggerEvent(alert)
+	if event.RoutingKey == "" {
 		return "", fmt.Errorf("pagerduty routing key not configured")
 	}
 
-	// Build the event
+	// Send the event
+	resp, err := c.sendEvent(ctx, event)
+	if err != nil {
+		return "", categorizePagerDutyError(err, "sending pagerduty event")
+	}
+
+	// Later events must use the same routing key even if the severity changes
+	if severity := c.urgency(alert); c.routingKeys[severity] != "" && !alert.HasExternalReference(entity.PagerDutyRoutingReference) {
+		alert.SetExternalReference(entity.PagerDutyRoutingReference, string(severity))
+	}
+
+	// Return dedup key as the incident identifier
+	return resp.DedupKey, nil
+}
+
+// Preview returns the trigger event that Notify would send for the alert.
+// The routing key is redacted.
+func (c *Client) Preview(alert *entity.Alert) (any, error) {
+	event := c.buildTriggerEvent(alert)
+	event.RoutingKey = "<redacted>"
+	return event, nil
+}
+
+// buildTriggerEvent builds the Events API v2 trigger event for an alert.
+func (c *Client) buildTriggerEvent(alert *entity.Alert) *pagerduty.V2Event {
 	event := &pagerduty.V2Event{
-		RoutingKey: c.routingKey,
+		RoutingKey: c.routingKeyFor(alert),
 		Action:     "trigger",
 		DedupKey:   c.buildDedupKey(alert),
 		Payload: &pagerduty.V2Payload{
 			Summary:   c.buildSummary(alert),
 			Source:    alert.Instance,
-			Severity:  c.mapSeverity(alert.Severity),
+			Severity:  c.mapSeverity(alert),
 			Timestamp: alert.FiredAt.Format("2006-01-02T15:04:05.000Z"),
 			Component: alert.Target,
 			Group:     alert.GetLabel("job"),
@@ -83,33 +202,44 @@ func (c *Client) Notify(ctx context.Context, alert *entity.Alert) (string, error
 		event.Payload.Details = make(map[string]interface{})
 	}
 
-	// Send the event
-	var resp *pagerduty.V2EventResponse
-	var err error
+	return event
+}
 
-	if c.eventsAPIURL != "" {
-		// Use custom Events API endpoint (for E2E testing)
-		resp, err = c.sendEventHTTP(ctx, event)
-	} else {
-		// Use official PagerDuty library
-		resp, err = pagerduty.ManageEventWithContext(ctx, *event)
+// SelfTest verifies the REST API token and service ID by fetching the configured service.
+// Events API routing keys cannot be validated without creating an event, so
+// without an API token and service ID it returns entity.ErrSelfTestNotSupported.
+func (c *Client) SelfTest(ctx context.Context) error {
+	if c.routingKey == "" && len(c.routingKeys) == 0 {
+		return domainerrors.NewPermanentError("pagerduty routing key not configured", nil)
 	}
-
-	if err != nil {
-		return "", categorizePagerDutyError(err, "sending pagerduty event")
+	if c.eventsClient == nil || c.serviceID == "" {
+		return fmt.Errorf("%w: pagerduty api_token and service_id are needed to verify the configuration", entity.ErrSelfTestNotSupported)
 	}
 
-	// Return dedup key as the incident identifier
-	return resp.DedupKey, nil
+	if _, err := c.eventsClient.GetServiceWithContext(ctx, c.serviceID, &pagerduty.GetServiceOptions{}); err != nil {
+		return categorizePagerDutyError(err, "fetching pagerduty service")
+	}
+	return nil
 }
 
 // UpdateMessage updates an existing PagerDuty incident.
 // For resolved alerts, it sends a resolve event.
 func (c *Client) UpdateMessage(ctx context.Context, dedupKey string, alert *entity.Alert) error {
-	if c.routingKey == "" {
+	defer c.inflight.Start()()
+
+	routingKey := c.routingKeyFor(alert)
+	if routingKey == "" {
 		return fmt.Errorf("pagerduty routing key not configured")
 	}
 
+	// Triggering an acknowledged incident does not page anyone, so an alert
+	// whose acknowledgment expired gets a new incident instead
+	if reactivatedAt := alert.ReactivatedAt(); reactivatedAt != nil {
+		if reopened := entity.ReopenedDedupKey(c.buildDedupKey(alert), *reactivatedAt); reopened != dedupKey {
+			return c.reopen(ctx, alert, dedupKey, reopened)
+		}
+	}
+
 	action := "trigger"
 	if alert.IsResolved() {
 		action = "resolve"
@@ -118,7 +248,7 @@ func (c *Client) UpdateMessage(ctx context.Context, dedupKey string, alert *enti
 	}
 
 	event := &pagerduty.V2Event{
-		RoutingKey: c.routingKey,
+		RoutingKey: routingKey,
 		Action:     action,
 		DedupKey:   dedupKey,
 	}
@@ -128,65 +258,246 @@ func (c *Client) UpdateMessage(ctx context.Context, dedupKey string, alert *enti
 		event.Payload = &pagerduty.V2Payload{
 			Summary:  c.buildSummary(alert),
 			Source:   alert.Instance,
-			Severity: c.mapSeverity(alert.Severity),
+			Severity: c.mapSeverity(alert),
 		}
 	}
 
-	if c.eventsAPIURL != "" {
-		// Use custom Events API endpoint (for E2E testing)
-		_, err := c.sendEventHTTP(ctx, event)
-		if err != nil {
-			return categorizePagerDutyError(err, "updating pagerduty event")
-		}
-	} else {
-		// Use official PagerDuty library
-		_, err := pagerduty.ManageEventWithContext(ctx, *event)
-		if err != nil {
-			return categorizePagerDutyError(err, "updating pagerduty event")
-		}
+	if _, err := c.sendEvent(ctx, event); err != nil {
+		return categorizePagerDutyError(err, "updating pagerduty event")
+	}
+	return nil
+}
+
+// reopen resolves the acknowledged incident under dedupKey and triggers a
+// new one under reopened, which the alert references from then on. A retry
+// after a failed trigger resolves the old incident again, which PagerDuty
+// ignores.
+func (c *Client) reopen(ctx context.Context, alert *entity.Alert, dedupKey, reopened string) error {
+	resolve := &pagerduty.V2Event{
+		RoutingKey: c.routingKeyFor(alert),
+		Action:     "resolve",
+		DedupKey:   dedupKey,
+	}
+	if _, err := c.sendEvent(ctx, resolve); err != nil {
+		return categorizePagerDutyError(err, "resolving superseded pagerduty incident")
 	}
 
+	event := c.buildTriggerEvent(alert)
+	event.DedupKey = reopened
+	if _, err := c.sendEvent(ctx, event); err != nil {
+		return categorizePagerDutyError(err, "reopening pagerduty incident")
+	}
+
+	// The incident.triggered webhook links the new incident
+	alert.SetExternalReference("pagerduty", reopened)
+	alert.RemoveExternalReference(entity.PagerDutyIncidentReference)
+	alert.RemoveExternalReference(entity.PagerDutyURLReference)
 	return nil
 }
 
-// Acknowledge acknowledges an incident in PagerDuty via Events API v2.
+// sendEvent sends an Events API v2 event, to the custom endpoint if one is
+// configured (for E2E testing), else through the official library.
+func (c *Client) sendEvent(ctx context.Context, event *pagerduty.V2Event) (*pagerduty.V2EventResponse, error) {
+	if c.eventsAPIURL != "" {
+		return c.sendEventHTTP(ctx, event)
+	}
+	return pagerduty.ManageEventWithContext(ctx, *event)
+}
+
+// Acknowledge acknowledges an incident in PagerDuty.
+// Incidents we created through the Events API are acknowledged there by dedup
+// key. Other incidents (e.g. created by a different integration) are
+// acknowledged through the REST API by incident ID when an API token and
+// from_email are configured.
+//
+// An ack note is added to the incident as an incident note when the REST API
+// is configured; otherwise it is sent in the Events API payload details.
 func (c *Client) Acknowledge(ctx context.Context, alert *entity.Alert, ackEvent *entity.AckEvent) error {
-	if c.routingKey == "" {
-		return fmt.Errorf("pagerduty routing key not configured")
+	defer c.inflight.Start()()
+
+	if c.useRESTAck(alert) {
+		return c.acknowledgeIncident(ctx, alert, ackEvent)
 	}
 
-	dedupKey := alert.GetExternalReference("pagerduty")
-	if dedupKey == "" {
-		dedupKey = c.buildDedupKey(alert)
+	routingKey := c.routingKeyFor(alert)
+	if routingKey == "" {
+		return fmt.Errorf("pagerduty routing key not configured")
 	}
 
+	dedupKey := c.dedupKeyFor(alert)
+
 	event := &pagerduty.V2Event{
-		RoutingKey: c.routingKey,
+		RoutingKey: routingKey,
 		Action:     "acknowledge",
 		DedupKey:   dedupKey,
 	}
 
-	_, err := pagerduty.ManageEventWithContext(ctx, *event)
+	hasNote := ackEvent != nil && ackEvent.HasNote()
+	if hasNote && !c.restConfigured() {
+		event.Payload = &pagerduty.V2Payload{
+			Summary:  c.buildSummary(alert),
+			Source:   alert.Instance,
+			Severity: c.mapSeverity(alert),
+			Details: map[string]interface{}{
+				"ack_note": ackEvent.Note,
+				"acked_by": ackedBy(ackEvent),
+			},
+		}
+	}
+
+	var err error
+	if c.eventsAPIURL != "" {
+		// Use custom Events API endpoint (for E2E testing)
+		_, err = c.sendEventHTTP(ctx, event)
+	} else {
+		_, err = pagerduty.ManageEventWithContext(ctx, *event)
+	}
 	if err != nil {
 		return categorizePagerDutyError(err, "acknowledging pagerduty event")
 	}
 
+	if hasNote && c.restConfigured() {
+		incidentID, err := c.incidentIDFor(ctx, alert)
+		if err != nil {
+			return fmt.Errorf("%w: %w", entity.ErrAckNoteNotRecorded, err)
+		}
+		return c.addAckNote(ctx, incidentID, ackEvent)
+	}
+
+	return nil
+}
+
+// restConfigured reports whether the REST API can be used: it needs an API
+// token and a from_email identifying the PagerDuty user.
+func (c *Client) restConfigured() bool {
+	return c.eventsClient != nil && c.fromEmail != ""
+}
+
+// useRESTAck reports whether an ack should go through the REST API: it must be
+// configured, and the alert must either have a known incident ID or lack an
+// Events API dedup key (or a routing key to send it with).
+func (c *Client) useRESTAck(alert *entity.Alert) bool {
+	if !c.restConfigured() {
+		return false
+	}
+	if alert.HasExternalReference(entity.PagerDutyIncidentReference) {
+		return true
+	}
+	return c.routingKeyFor(alert) == "" || !alert.HasExternalReference("pagerduty")
+}
+
+// acknowledgeIncident sets the alert's incident to acknowledged via the REST
+// API and adds the ack note, if any, as an incident note.
+func (c *Client) acknowledgeIncident(ctx context.Context, alert *entity.Alert, ackEvent *entity.AckEvent) error {
+	incidentID, err := c.incidentIDFor(ctx, alert)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.eventsClient.ManageIncidentsWithContext(ctx, c.fromEmail, []pagerduty.ManageIncidentsOptions{{
+		ID:     incidentID,
+		Type:   "incident_reference",
+		Status: "acknowledged",
+	}})
+	if err != nil {
+		return categorizePagerDutyError(err, "acknowledging pagerduty incident")
+	}
+
+	if ackEvent != nil && ackEvent.HasNote() {
+		return c.addAckNote(ctx, incidentID, ackEvent)
+	}
+	return nil
+}
+
+// incidentIDFor returns the alert's PagerDuty incident ID. When it is unknown
+// it is looked up by incident key (the dedup key) and set on the alert, for the
+// caller to persist.
+func (c *Client) incidentIDFor(ctx context.Context, alert *entity.Alert) (string, error) {
+	if incidentID := alert.GetExternalReference(entity.PagerDutyIncidentReference); incidentID != "" {
+		return incidentID, nil
+	}
+
+	incidentID, err := c.findIncidentID(ctx, c.dedupKeyFor(alert))
+	if err != nil {
+		return "", err
+	}
+	alert.SetExternalReference(entity.PagerDutyIncidentReference, incidentID)
+	return incidentID, nil
+}
+
+// addAckNote adds the ack event's note to the incident via the REST API. It is
+// called once the incident is acknowledged, so failures wrap
+// entity.ErrAckNoteNotRecorded.
+func (c *Client) addAckNote(ctx context.Context, incidentID string, ackEvent *entity.AckEvent) error {
+	note := pagerduty.IncidentNote{
+		User:    pagerduty.APIObject{Summary: c.fromEmail},
+		Content: fmt.Sprintf("Acknowledged by %s via %s: %s", ackedBy(ackEvent), ackEvent.Source, ackEvent.Note),
+	}
+	if _, err := c.eventsClient.CreateIncidentNoteWithContext(ctx, incidentID, note); err != nil {
+		return fmt.Errorf("%w: %w", entity.ErrAckNoteNotRecorded, categorizePagerDutyError(err, "adding pagerduty incident note"))
+	}
 	return nil
 }
 
+// ackedBy returns the most readable identifier of the user behind an ack event.
+func ackedBy(ackEvent *entity.AckEvent) string {
+	switch {
+	case ackEvent.UserName != "":
+		return ackEvent.UserName
+	case ackEvent.UserEmail != "":
+		return ackEvent.UserEmail
+	default:
+		return ackEvent.UserID
+	}
+}
+
+// findIncidentID returns the ID of the open incident with the given incident key,
+// restricted to the configured service when one is set.
+func (c *Client) findIncidentID(ctx context.Context, incidentKey string) (string, error) {
+	opts := pagerduty.ListIncidentsOptions{
+		IncidentKey: incidentKey,
+		Statuses:    []string{"triggered", "acknowledged"},
+		Limit:       1,
+	}
+	if c.serviceID != "" {
+		opts.ServiceIDs = []string{c.serviceID}
+	}
+
+	resp, err := c.eventsClient.ListIncidentsWithContext(ctx, opts)
+	if err != nil {
+		return "", categorizePagerDutyError(err, "looking up pagerduty incident")
+	}
+	if len(resp.Incidents) == 0 {
+		return "", domainerrors.NewPermanentError(
+			fmt.Sprintf("no open pagerduty incident with key %q", incidentKey),
+			nil,
+		)
+	}
+
+	return resp.Incidents[0].ID, nil
+}
+
+// dedupKeyFor returns the dedup key stored for the alert, or the one Notify would build.
+func (c *Client) dedupKeyFor(alert *entity.Alert) string {
+	if dedupKey := alert.GetExternalReference("pagerduty"); dedupKey != "" {
+		return dedupKey
+	}
+	return c.buildDedupKey(alert)
+}
+
 // Resolve resolves an incident in PagerDuty.
 func (c *Client) Resolve(ctx context.Context, alert *entity.Alert) error {
-	if c.routingKey == "" {
+	defer c.inflight.Start()()
+
+	routingKey := c.routingKeyFor(alert)
+	if routingKey == "" {
 		return fmt.Errorf("pagerduty routing key not configured")
 	}
 
-	dedupKey := alert.GetExternalReference("pagerduty")
-	if dedupKey == "" {
-		dedupKey = c.buildDedupKey(alert)
-	}
+	dedupKey := c.dedupKeyFor(alert)
 
 	event := &pagerduty.V2Event{
-		RoutingKey: c.routingKey,
+		RoutingKey: routingKey,
 		Action:     "resolve",
 		DedupKey:   dedupKey,
 	}
@@ -204,6 +515,15 @@ func (c *Client) Name() string {
 	return "pagerduty"
 }
 
+// Close waits for in-flight PagerDuty requests to finish, then releases idle connections.
+func (c *Client) Close(ctx context.Context) error {
+	if err := c.inflight.Wait(ctx); err != nil {
+		return fmt.Errorf("waiting for in-flight pagerduty requests: %w", err)
+	}
+	c.httpClient.CloseIdleConnections()
+	return nil
+}
+
 // SupportsAck returns true as PagerDuty supports acknowledgment.
 func (c *Client) SupportsAck() bool {
 	return true
@@ -211,15 +531,35 @@ func (c *Client) SupportsAck() bool {
 
 // buildDedupKey creates a deduplication key for the alert.
 func (c *Client) buildDedupKey(alert *entity.Alert) string {
-	// Use fingerprint if available, otherwise use alert ID
+	key := alert.ID
 	if alert.Fingerprint != "" {
-		return alert.Fingerprint
+		key = alert.Fingerprint
+	}
+
+	// Use the configured template if it renders a non-empty key
+	if c.dedupKeyTmpl != nil {
+		var buf bytes.Buffer
+		if err := c.dedupKeyTmpl.Execute(&buf, alert); err == nil {
+			if rendered := strings.TrimSpace(buf.String()); rendered != "" {
+				key = rendered
+			}
+		}
+	}
+
+	// Tenants sharing a fingerprint must not share an incident
+	if alert.TenantID != "" {
+		key = alert.TenantID + "/" + key
 	}
-	return alert.ID
+	return key
 }
 
-// buildSummary creates the incident summary.
+// buildSummary creates the incident summary, from the summary template if
+// it renders one.
 func (c *Client) buildSummary(alert *entity.Alert) string {
+	if summary := c.summaryTmpl.Text(alert); summary != "" {
+		return summary
+	}
+
 	var parts []string
 
 	// Add severity prefix
@@ -278,11 +618,31 @@ func (c *Client) buildDetails(alert *entity.Alert) map[string]interface{} {
 		details["annotations"] = alert.Annotations
 	}
 
+	if text := c.detailsTmpl.Text(alert); text != "" {
+		details["details"] = text
+	}
+
 	return details
 }
 
-// mapSeverity maps alert severity to PagerDuty severity.
-func (c *Client) mapSeverity(severity entity.AlertSeverity) string {
+// mapSeverity maps an alert to its PagerDuty severity. The priority map
+// entry for the alert's priority annotation wins over the severity map entry
+// for its severity label, which wins over the built-in mapping.
+func (c *Client) mapSeverity(alert *entity.Alert) string {
+	if mapping, ok := c.priorityMap.ForPriority(alert); ok {
+		if mapping.PagerDutySeverity != "" {
+			return mapping.PagerDutySeverity
+		}
+		return c.builtinSeverity(mapping.Severity)
+	}
+	if mapping, ok := c.severityMap.ForAlert(alert); ok && mapping.PagerDutySeverity != "" {
+		return mapping.PagerDutySeverity
+	}
+	return c.builtinSeverity(alert.Severity)
+}
+
+// builtinSeverity returns the PagerDuty severity of an internal severity.
+func (c *Client) builtinSeverity(severity entity.AlertSeverity) string {
 	switch severity {
 	case entity.SeverityCritical:
 		return "critical"
@@ -311,8 +671,7 @@ func (c *Client) sendEventHTTP(ctx context.Context, event *pagerduty.V2Event) (*
 	req.Header.Set("Content-Type", "application/json")
 
 	// Send request
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("sending request: %w", err)
 	}
@@ -0,0 +1,431 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+)
+
+func TestBuildDedupKey_Template(t *testing.T) {
+	client, err := NewClient("", "routing-key", "", "", "", "{{ .Name }}/{{ .Instance }}")
+	require.NoError(t, err)
+
+	// Flapping alerts get new fingerprints but share name and instance
+	first := entity.NewAlert("fp-1", "HighCPU", "host-1", "", "", entity.SeverityCritical)
+	second := entity.NewAlert("fp-2", "HighCPU", "host-1", "", "", entity.SeverityCritical)
+	other := entity.NewAlert("fp-3", "HighCPU", "host-2", "", "", entity.SeverityCritical)
+
+	assert.Equal(t, "HighCPU/host-1", client.buildDedupKey(first))
+	assert.Equal(t, client.buildDedupKey(first), client.buildDedupKey(second))
+	assert.NotEqual(t, client.buildDedupKey(first), client.buildDedupKey(other))
+}
+
+func TestBuildDedupKey_Default(t *testing.T) {
+	client, err := NewClient("", "routing-key", "", "", "", "")
+	require.NoError(t, err)
+
+	a := entity.NewAlert("fp-1", "HighCPU", "host-1", "", "", entity.SeverityCritical)
+	assert.Equal(t, "fp-1", client.buildDedupKey(a))
+
+	// Template rendering an empty key falls back to the fingerprint
+	client, err = NewClient("", "routing-key", "", "", "", `{{ index .Labels "missing" }}`)
+	require.NoError(t, err)
+	assert.Equal(t, "fp-1", client.buildDedupKey(a))
+}
+
+func TestBuildDedupKey_Tenant(t *testing.T) {
+	client, err := NewClient("", "routing-key", "", "", "", "")
+	require.NoError(t, err)
+
+	acme := entity.NewAlert("fp-1", "HighCPU", "host-1", "", "", entity.SeverityCritical)
+	acme.TenantID = "acme"
+	globex := entity.NewAlert("fp-1", "HighCPU", "host-1", "", "", entity.SeverityCritical)
+	globex.TenantID = "globex"
+
+	assert.Equal(t, "acme/fp-1", client.buildDedupKey(acme))
+	assert.NotEqual(t, client.buildDedupKey(acme), client.buildDedupKey(globex))
+}
+
+func TestNewClient_InvalidDedupKeyTemplate(t *testing.T) {
+	_, err := NewClient("", "routing-key", "", "", "", "{{ .Name")
+	assert.Error(t, err)
+}
+
+func TestMapSeverity_SeverityMap(t *testing.T) {
+	client, err := NewClient("", "routing-key", "", "", "info", "")
+	require.NoError(t, err)
+	client.SetSeverityMap(entity.SeverityMap{
+		"ticket": {Severity: entity.SeverityWarning, PagerDutySeverity: "error"},
+		"page":   {Severity: entity.SeverityCritical},
+	})
+
+	ticket := entity.NewAlert("fp-1", "DiskFull", "host-1", "", "", entity.SeverityWarning)
+	ticket.AddLabel(entity.SeverityLabel, "ticket")
+	assert.Equal(t, "error", client.mapSeverity(ticket))
+
+	// Entries without a PagerDuty severity keep the built-in mapping
+	page := entity.NewAlert("fp-2", "HighCPU", "host-1", "", "", entity.SeverityCritical)
+	page.AddLabel(entity.SeverityLabel, "page")
+	assert.Equal(t, "critical", client.mapSeverity(page))
+
+	unmapped := entity.NewAlert("fp-3", "HighCPU", "host-1", "", "", entity.SeverityInfo)
+	assert.Equal(t, "info", client.mapSeverity(unmapped))
+}
+
+func TestMapSeverity_PriorityMap(t *testing.T) {
+	client, err := NewClient("", "routing-key", "", "", "info", "")
+	require.NoError(t, err)
+	client.SetSeverityMap(entity.SeverityMap{
+		"ticket": {Severity: entity.SeverityWarning, PagerDutySeverity: "error"},
+	})
+	client.SetPriorityMap(entity.SeverityMap{
+		"P1": {Severity: entity.SeverityCritical},
+		"P4": {Severity: entity.SeverityInfo, PagerDutySeverity: "info"},
+	})
+	client.SetRoutingKeys(map[entity.AlertSeverity]string{entity.SeverityCritical: "critical-key"})
+
+	// The priority annotation wins over the severity label
+	a := entity.NewAlert("fp-1", "DiskFull", "host-1", "", "", entity.SeverityWarning)
+	a.AddLabel(entity.SeverityLabel, "ticket")
+	a.AddAnnotation(entity.PriorityAnnotation, "P1")
+	assert.Equal(t, "critical", client.mapSeverity(a))
+	assert.Equal(t, "critical-key", client.routingKeyFor(a))
+
+	a.AddAnnotation(entity.PriorityAnnotation, "P4")
+	assert.Equal(t, "info", client.mapSeverity(a))
+	assert.Equal(t, "routing-key", client.routingKeyFor(a))
+
+	// Unmapped priorities fall back to the severity label
+	a.AddAnnotation(entity.PriorityAnnotation, "P9")
+	assert.Equal(t, "error", client.mapSeverity(a))
+}
+
+func TestAcknowledge_RESTByIncidentKey(t *testing.T) {
+	var managed []pagerduty.ManageIncidentsOptions
+	var from string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			assert.Equal(t, "fp-1", r.URL.Query().Get("incident_key"))
+			assert.Equal(t, []string{"PSERVICE"}, r.URL.Query()["service_ids[]"])
+			w.Write([]byte(`{"incidents":[{"id":"PINC1"}]}`))
+		case http.MethodPut:
+			from = r.Header.Get("From")
+			var body struct {
+				Incidents []pagerduty.ManageIncidentsOptions `json:"incidents"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			managed = body.Incidents
+			w.Write([]byte(`{"incidents":[]}`))
+		}
+	}))
+	defer server.Close()
+
+	// No routing key: the incident was not created by us
+	client, err := NewClient("token", "", "PSERVICE", "oncall@example.com", "", "")
+	require.NoError(t, err)
+	client.eventsClient = pagerduty.NewClient("token", pagerduty.WithAPIEndpoint(server.URL))
+
+	a := entity.NewAlert("fp-1", "HighCPU", "host-1", "", "", entity.SeverityCritical)
+	require.NoError(t, client.Acknowledge(context.Background(), a, nil))
+
+	require.Len(t, managed, 1)
+	assert.Equal(t, "PINC1", managed[0].ID)
+	assert.Equal(t, "acknowledged", managed[0].Status)
+	assert.Equal(t, "oncall@example.com", from)
+	assert.Equal(t, "PINC1", a.GetExternalReference(entity.PagerDutyIncidentReference))
+}
+
+func TestAcknowledge_NoteAsIncidentNote(t *testing.T) {
+	var note pagerduty.IncidentNote
+	var from string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPut:
+			w.Write([]byte(`{"incidents":[]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/incidents/PINC1/notes":
+			from = r.Header.Get("From")
+			var body struct {
+				Note pagerduty.IncidentNote `json:"note"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			note = body.Note
+			w.Write([]byte(`{"note":{"id":"PNOTE1"}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient("token", "", "", "oncall@example.com", "", "")
+	require.NoError(t, err)
+	client.eventsClient = pagerduty.NewClient("token", pagerduty.WithAPIEndpoint(server.URL))
+
+	a := entity.NewAlert("fp-1", "HighCPU", "host-1", "", "", entity.SeverityCritical)
+	a.SetExternalReference(entity.PagerDutyIncidentReference, "PINC1")
+	ackEvent := entity.NewAckEvent(a.ID, entity.AckSourceSlack, "U1", "alice@example.com", "Alice").
+		WithNote("restarting the exporter")
+	require.NoError(t, client.Acknowledge(context.Background(), a, ackEvent))
+
+	assert.Equal(t, "Acknowledged by Alice via slack: restarting the exporter", note.Content)
+	assert.Equal(t, "oncall@example.com", from)
+}
+
+func TestAcknowledge_NoteFailureAfterAck(t *testing.T) {
+	var acked bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPut:
+			acked = true
+			w.Write([]byte(`{"incidents":[]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/incidents/PINC1/notes":
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"error":{"message":"internal error"}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient("token", "", "", "oncall@example.com", "", "")
+	require.NoError(t, err)
+	client.eventsClient = pagerduty.NewClient("token", pagerduty.WithAPIEndpoint(server.URL))
+
+	a := entity.NewAlert("fp-1", "HighCPU", "host-1", "", "", entity.SeverityCritical)
+	a.SetExternalReference(entity.PagerDutyIncidentReference, "PINC1")
+	ackEvent := entity.NewAckEvent(a.ID, entity.AckSourceSlack, "U1", "alice@example.com", "Alice").
+		WithNote("restarting the exporter")
+	err = client.Acknowledge(context.Background(), a, ackEvent)
+
+	// The incident is acknowledged; only the note is reported missing
+	assert.True(t, acked)
+	assert.ErrorIs(t, err, entity.ErrAckNoteNotRecorded)
+}
+
+func TestAcknowledge_NoteInEventDetails(t *testing.T) {
+	var event pagerduty.V2Event
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"status":"success","dedup_key":"fp-1"}`))
+	}))
+	defer server.Close()
+
+	// Routing key only: the note can only travel with the event
+	client, err := NewClient("", "routing-key", "", "", "", "", server.URL)
+	require.NoError(t, err)
+
+	a := entity.NewAlert("fp-1", "HighCPU", "host-1", "", "", entity.SeverityCritical)
+	ackEvent := entity.NewAckEvent(a.ID, entity.AckSourceSlack, "U1", "alice@example.com", "").
+		WithNote("restarting the exporter")
+	require.NoError(t, client.Acknowledge(context.Background(), a, ackEvent))
+
+	assert.Equal(t, "acknowledge", event.Action)
+	require.NotNil(t, event.Payload)
+	assert.Equal(t, "restarting the exporter", event.Payload.Details.(map[string]any)["ack_note"])
+	assert.Equal(t, "alice@example.com", event.Payload.Details.(map[string]any)["acked_by"])
+
+	// Without a note the event carries no payload
+	event = pagerduty.V2Event{}
+	require.NoError(t, client.Acknowledge(context.Background(), a, nil))
+	assert.Nil(t, event.Payload)
+}
+
+func TestUseRESTAck(t *testing.T) {
+	client, err := NewClient("token", "routing-key", "", "oncall@example.com", "", "")
+	require.NoError(t, err)
+
+	created := entity.NewAlert("fp-1", "HighCPU", "host-1", "", "", entity.SeverityCritical)
+	created.SetExternalReference("pagerduty", "fp-1")
+	assert.False(t, client.useRESTAck(created), "incidents we created use the Events API")
+
+	created.SetExternalReference(entity.PagerDutyIncidentReference, "PINC1")
+	assert.True(t, client.useRESTAck(created), "known incident IDs use the REST API")
+
+	eventsOnly, err := NewClient("", "routing-key", "", "", "", "")
+	require.NoError(t, err)
+	assert.False(t, eventsOnly.useRESTAck(created), "REST API requires a token and from_email")
+}
+
+func TestRoutingKeys_PerSeverity(t *testing.T) {
+	var routingKeys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event pagerduty.V2Event
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		routingKeys = append(routingKeys, event.RoutingKey)
+		w.Write([]byte(`{"status":"success","dedup_key":"` + event.DedupKey + `"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", "default-key", "", "", "", "", server.URL)
+	require.NoError(t, err)
+	client.SetRoutingKeys(map[entity.AlertSeverity]string{entity.SeverityCritical: "page-key"})
+
+	critical := entity.NewAlert("fp-1", "HighCPU", "host-1", "", "", entity.SeverityCritical)
+	_, err = client.Notify(context.Background(), critical)
+	require.NoError(t, err)
+	assert.Equal(t, string(entity.SeverityCritical), critical.GetExternalReference(entity.PagerDutyRoutingReference))
+
+	warning := entity.NewAlert("fp-2", "DiskFull", "host-1", "", "", entity.SeverityWarning)
+	_, err = client.Notify(context.Background(), warning)
+	require.NoError(t, err)
+	assert.False(t, warning.HasExternalReference(entity.PagerDutyRoutingReference))
+
+	// A downgraded alert keeps reaching the service its incident was opened in
+	critical.ChangeSeverity(entity.SeverityWarning, critical.FiredAt)
+	critical.Resolve("alertmanager", critical.FiredAt)
+	require.NoError(t, client.UpdateMessage(context.Background(), "fp-1", critical))
+
+	assert.Equal(t, []string{"page-key", "default-key", "page-key"}, routingKeys)
+}
+
+func TestUpdateMessage_ReopensReactivatedIncident(t *testing.T) {
+	var events []pagerduty.V2Event
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event pagerduty.V2Event
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		events = append(events, event)
+		w.Write([]byte(`{"status":"success","dedup_key":"` + event.DedupKey + `"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", "routing-key", "", "", "", "", server.URL)
+	require.NoError(t, err)
+
+	at := time.Date(2025, 1, 2, 3, 0, 0, 0, time.UTC)
+	alert := entity.NewAlert("fp-1", "HighCPU", "host-1", "", "", entity.SeverityCritical)
+	alert.SetExternalReference("pagerduty", "fp-1")
+	alert.SetExternalReference(entity.PagerDutyIncidentReference, "PINC1")
+	alert.SetExternalReference(entity.PagerDutyURLReference, "https://acme.pagerduty.com/incidents/PINC1")
+	require.NoError(t, alert.Acknowledge("alice@example.com", at))
+	require.NoError(t, alert.Reactivate("ack-expiry", at.Add(time.Hour)))
+
+	// The acknowledged incident is resolved and a new one triggered
+	require.NoError(t, client.UpdateMessage(context.Background(), "fp-1", alert))
+	reopened := entity.ReopenedDedupKey("fp-1", at.Add(time.Hour))
+	require.Len(t, events, 2)
+	assert.Equal(t, "resolve", events[0].Action)
+	assert.Equal(t, "fp-1", events[0].DedupKey)
+	assert.Equal(t, "trigger", events[1].Action)
+	assert.Equal(t, reopened, events[1].DedupKey)
+	require.NotNil(t, events[1].Payload)
+	assert.Equal(t, reopened, alert.GetExternalReference("pagerduty"))
+
+	// The old incident's links are dropped until the new one's webhook arrives
+	assert.False(t, alert.HasExternalReference(entity.PagerDutyIncidentReference))
+	assert.False(t, alert.HasExternalReference(entity.PagerDutyURLReference))
+
+	// Later updates go to the new incident
+	require.NoError(t, client.UpdateMessage(context.Background(), reopened, alert))
+	require.Len(t, events, 3)
+	assert.Equal(t, "trigger", events[2].Action)
+	assert.Equal(t, reopened, events[2].DedupKey)
+}
+
+func TestGetOnCall(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/services/PSERVICE":
+			w.Write([]byte(`{"service":{"id":"PSERVICE","escalation_policy":{"id":"PPOLICY"}}}`))
+		case "/oncalls":
+			assert.Equal(t, []string{"PPOLICY"}, r.URL.Query()["escalation_policy_ids[]"])
+			w.Write([]byte(`{"oncalls":[
+				{"escalation_level":2,"user":{"id":"PUSER2","summary":"Bob Backup"}},
+				{"escalation_level":1,"user":{"id":"PUSER1","summary":"Alice Primary"}}
+			]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient("token", "routing-key", "PSERVICE", "", "", "")
+	require.NoError(t, err)
+	client.eventsClient = pagerduty.NewClient("token", pagerduty.WithAPIEndpoint(server.URL))
+
+	name, err := client.GetOnCall(context.Background(), "PSERVICE")
+	require.NoError(t, err)
+	assert.Equal(t, "Alice Primary", name)
+
+	// Cached: no further API calls
+	name, err = client.GetOnCall(context.Background(), "PSERVICE")
+	require.NoError(t, err)
+	assert.Equal(t, "Alice Primary", name)
+	assert.Equal(t, 2, requests)
+}
+
+func TestGetOnCall_NoAPIToken(t *testing.T) {
+	client, err := NewClient("", "routing-key", "PSERVICE", "", "", "")
+	require.NoError(t, err)
+
+	_, err = client.GetOnCall(context.Background(), "PSERVICE")
+	assert.Error(t, err)
+}
+
+func TestNotify_LeavesIncidentLinkToWebhook(t *testing.T) {
+	// PagerDuty creates incidents asynchronously; only the event is sent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/enqueue", r.URL.Path)
+		w.Write([]byte(`{"status":"success","dedup_key":"fp-1"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("token", "routing-key", "PSERVICE", "", "", "", server.URL)
+	require.NoError(t, err)
+	client.eventsClient = pagerduty.NewClient("token", pagerduty.WithAPIEndpoint(server.URL))
+
+	alert := entity.NewAlert("fp-1", "HighCPU", "host-1", "", "", entity.SeverityCritical)
+	dedupKey, err := client.Notify(context.Background(), alert)
+	require.NoError(t, err)
+	assert.Equal(t, "fp-1", dedupKey)
+	assert.False(t, alert.HasExternalReference(entity.PagerDutyURLReference))
+}
+
+func TestSelfTest(t *testing.T) {
+	var fetched string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetched = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"service":{"id":"PSVC1"}}`))
+	}))
+	defer server.Close()
+
+	// Routing key only: nothing can be verified without creating an event
+	client, err := NewClient("", "routing-key", "", "", "", "")
+	require.NoError(t, err)
+	assert.ErrorIs(t, client.SelfTest(context.Background()), entity.ErrSelfTestNotSupported)
+
+	// An API token without a service ID has nothing to fetch either
+	client, err = NewClient("token", "routing-key", "", "", "", "")
+	require.NoError(t, err)
+	assert.ErrorIs(t, client.SelfTest(context.Background()), entity.ErrSelfTestNotSupported)
+
+	client, err = NewClient("token", "routing-key", "PSVC1", "", "", "")
+	require.NoError(t, err)
+	client.eventsClient = pagerduty.NewClient("token", pagerduty.WithAPIEndpoint(server.URL))
+	require.NoError(t, client.SelfTest(context.Background()))
+	assert.Equal(t, "/services/PSVC1", fetched)
+
+	// Without a routing key the notifier cannot work at all
+	client, err = NewClient("token", "", "PSVC1", "", "", "")
+	require.NoError(t, err)
+	err = client.SelfTest(context.Background())
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, entity.ErrSelfTestNotSupported)
+}
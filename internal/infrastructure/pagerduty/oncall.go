@@ -0,0 +1,83 @@
+package pagerduty
+
+import (
+	"context"
+	"time"
+
+	"github.com/PagerDuty/go-pagerduty"
+
+	domainerrors "github.com/qj0r9j0vc2/alert-bridge/internal/domain/errors"
+)
+
+// OnCallCacheTTL is how long an on-call lookup, successful or not, is reused.
+const OnCallCacheTTL = time.Minute
+
+// onCallEntry is a cached on-call lookup.
+type onCallEntry struct {
+	name    string
+	err     error
+	expires time.Time
+}
+
+// GetOnCall returns the name of the user currently on call at the lowest
+// escalation level of the service's escalation policy. Lookups are cached
+// for OnCallCacheTTL so an alert storm does not run into the REST API rate
+// limit. Requires the REST API token.
+func (c *Client) GetOnCall(ctx context.Context, serviceID string) (string, error) {
+	if c.eventsClient == nil {
+		return "", domainerrors.NewPermanentError("pagerduty api token not configured", nil)
+	}
+
+	c.onCallMu.Lock()
+	entry, ok := c.onCalls[serviceID]
+	c.onCallMu.Unlock()
+	if ok && time.Now().Before(entry.expires) {
+		return entry.name, entry.err
+	}
+
+	name, err := c.lookupOnCall(ctx, serviceID)
+	if ctx.Err() != nil {
+		// Our caller gave up; that says nothing about PagerDuty
+		return name, err
+	}
+
+	c.onCallMu.Lock()
+	if c.onCalls == nil {
+		c.onCalls = make(map[string]onCallEntry)
+	}
+	c.onCalls[serviceID] = onCallEntry{name: name, err: err, expires: time.Now().Add(OnCallCacheTTL)}
+	c.onCallMu.Unlock()
+
+	return name, err
+}
+
+// lookupOnCall asks the REST API who is on call for the service.
+func (c *Client) lookupOnCall(ctx context.Context, serviceID string) (string, error) {
+	service, err := c.eventsClient.GetServiceWithContext(ctx, serviceID, &pagerduty.GetServiceOptions{})
+	if err != nil {
+		return "", categorizePagerDutyError(err, "fetching pagerduty service")
+	}
+
+	resp, err := c.eventsClient.ListOnCallsWithContext(ctx, pagerduty.ListOnCallOptions{
+		EscalationPolicyIDs: []string{service.EscalationPolicy.ID},
+		Earliest:            true,
+	})
+	if err != nil {
+		return "", categorizePagerDutyError(err, "listing pagerduty on-calls")
+	}
+
+	var name string
+	var level uint
+	for _, onCall := range resp.OnCalls {
+		if onCall.User.Summary == "" {
+			continue
+		}
+		if name == "" || onCall.EscalationLevel < level {
+			name, level = onCall.User.Summary, onCall.EscalationLevel
+		}
+	}
+	if name == "" {
+		return "", domainerrors.NewNotFoundError("pagerduty on-call user")
+	}
+	return name, nil
+}
@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
 )
 
 // AckEventRepository provides an in-memory implementation of repository.AckEventRepository.
@@ -24,11 +25,16 @@ func NewAckEventRepository() *AckEventRepository {
 	}
 }
 
-// Save persists a new ack event.
+// Save persists a new ack event. The event is removed again if the unit of
+// work ctx runs in fails.
 func (r *AckEventRepository) Save(ctx context.Context, event *entity.AckEvent) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	if _, exists := r.events[event.ID]; exists {
+		return repository.ErrAlreadyExists
+	}
+
 	// Store a copy to prevent external mutations
 	eventCopy := *event
 	r.events[event.ID] = &eventCopy
@@ -36,9 +42,34 @@ func (r *AckEventRepository) Save(ctx context.Context, event *entity.AckEvent) e
 	// Index by alert ID
 	r.byAlertID[event.AlertID] = append(r.byAlertID[event.AlertID], event.ID)
 
+	onRollback(ctx, func() { r.remove(event.ID) })
+
 	return nil
 }
 
+// remove deletes an ack event and its index entry.
+func (r *AckEventRepository) remove(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	event, ok := r.events[id]
+	if !ok {
+		return
+	}
+	delete(r.events, id)
+
+	ids := r.byAlertID[event.AlertID]
+	for i, eventID := range ids {
+		if eventID == id {
+			r.byAlertID[event.AlertID] = append(ids[:i], ids[i+1:]...)
+			break
+		}
+	}
+	if len(r.byAlertID[event.AlertID]) == 0 {
+		delete(r.byAlertID, event.AlertID)
+	}
+}
+
 // FindByAlertID retrieves all ack events for an alert.
 func (r *AckEventRepository) FindByAlertID(ctx context.Context, alertID string) ([]*entity.AckEvent, error) {
 	r.mu.RLock()
@@ -47,7 +78,7 @@ func (r *AckEventRepository) FindByAlertID(ctx context.Context, alertID string)
 	ids := r.byAlertID[alertID]
 	events := make([]*entity.AckEvent, 0, len(ids))
 	for _, id := range ids {
-		if event, ok := r.events[id]; ok {
+		if event, ok := r.events[id]; ok && repository.InTenant(ctx, event.TenantID) {
 			eventCopy := *event
 			events = append(events, &eventCopy)
 		}
@@ -67,7 +98,7 @@ func (r *AckEventRepository) FindByID(ctx context.Context, id string) (*entity.A
 	defer r.mu.RUnlock()
 
 	event, ok := r.events[id]
-	if !ok {
+	if !ok || !repository.InTenant(ctx, event.TenantID) {
 		return nil, nil
 	}
 
@@ -87,7 +118,7 @@ func (r *AckEventRepository) FindLatestByAlertID(ctx context.Context, alertID st
 
 	var latest *entity.AckEvent
 	for _, id := range ids {
-		if event, ok := r.events[id]; ok {
+		if event, ok := r.events[id]; ok && repository.InTenant(ctx, event.TenantID) {
 			if latest == nil || event.CreatedAt.After(latest.CreatedAt) {
 				latest = event
 			}
@@ -115,6 +146,9 @@ func (r *AckEventRepository) GetTopAcknowledgers(ctx context.Context, limit int)
 	// Count acknowledgments per user (by email)
 	userCounts := make(map[string]*entity.UserAckCount)
 	for _, event := range r.events {
+		if !repository.InTenant(ctx, event.TenantID) {
+			continue
+		}
 		email := event.UserEmail
 		if email == "" {
 			email = event.UserID // fallback to user ID
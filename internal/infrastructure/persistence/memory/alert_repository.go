@@ -2,9 +2,12 @@ package memory
 
 import (
 	"context"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
 )
 
 // AlertRepository provides an in-memory implementation of repository.AlertRepository.
@@ -14,6 +17,10 @@ type AlertRepository struct {
 	alerts        map[string]*entity.Alert     // id -> alert
 	byFingerprint map[string][]string          // fingerprint -> alert IDs
 	byExternalRef map[string]map[string]string // system -> (referenceID -> alert ID)
+
+	// ttl is how long resolved alerts are kept, zero to keep them forever.
+	ttl time.Duration
+	now func() time.Time
 }
 
 // NewAlertRepository creates a new in-memory alert repository.
@@ -22,6 +29,7 @@ func NewAlertRepository() *AlertRepository {
 		alerts:        make(map[string]*entity.Alert),
 		byFingerprint: make(map[string][]string),
 		byExternalRef: make(map[string]map[string]string),
+		now:           time.Now,
 	}
 }
 
@@ -30,26 +38,53 @@ func (r *AlertRepository) Save(ctx context.Context, alert *entity.Alert) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	return r.saveLocked(alert)
+}
+
+// UpsertByFingerprint saves the alert unless a firing alert of the same
+// tenant with the same fingerprint already exists, in which case the existing
+// alert is returned.
+func (r *AlertRepository) UpsertByFingerprint(ctx context.Context, alert *entity.Alert) (*entity.Alert, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, id := range r.byFingerprint[alert.Fingerprint] {
+		if existing, ok := r.alerts[id]; ok && existing.IsFiring() && existing.TenantID == alert.TenantID {
+			return existing.Clone(), false, nil
+		}
+	}
+
+	if err := r.saveLocked(alert); err != nil {
+		return nil, false, err
+	}
+
+	return alert.Clone(), true, nil
+}
+
+// saveLocked stores the alert and updates the indexes. Caller must hold r.mu.
+func (r *AlertRepository) saveLocked(alert *entity.Alert) error {
 	if _, exists := r.alerts[alert.ID]; exists {
-		return entity.ErrDuplicateAlert
+		return repository.ErrDuplicateAlert
+	}
+
+	// At most one firing alert per tenant and fingerprint, like the SQL unique index
+	if alert.IsFiring() {
+		for _, id := range r.byFingerprint[alert.Fingerprint] {
+			if existing, ok := r.alerts[id]; ok && existing.IsFiring() && existing.TenantID == alert.TenantID {
+				return repository.ErrDuplicateAlert
+			}
+		}
 	}
 
 	// Store a copy to prevent external mutations
-	alertCopy := *alert
-	r.alerts[alert.ID] = &alertCopy
+	alert.Version = 1
+	r.alerts[alert.ID] = alert.Clone()
 
 	// Index by fingerprint
 	r.byFingerprint[alert.Fingerprint] = append(r.byFingerprint[alert.Fingerprint], alert.ID)
 
 	// Index by external references
-	for system, refID := range alert.ExternalReferences {
-		if refID != "" {
-			if r.byExternalRef[system] == nil {
-				r.byExternalRef[system] = make(map[string]string)
-			}
-			r.byExternalRef[system][refID] = alert.ID
-		}
-	}
+	r.indexReferences(alert)
 
 	return nil
 }
@@ -60,16 +95,15 @@ func (r *AlertRepository) FindByID(ctx context.Context, id string) (*entity.Aler
 	defer r.mu.RUnlock()
 
 	alert, ok := r.alerts[id]
-	if !ok {
+	if !ok || !repository.InTenant(ctx, alert.TenantID) {
 		return nil, nil
 	}
 
 	// Return a copy to prevent external mutations
-	alertCopy := *alert
-	return &alertCopy, nil
+	return alert.Clone(), nil
 }
 
-// FindByFingerprint finds alerts matching the Alertmanager fingerprint.
+// FindByFingerprint finds alerts matching the Alertmanager fingerprint, newest first.
 func (r *AlertRepository) FindByFingerprint(ctx context.Context, fingerprint string) ([]*entity.Alert, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
@@ -77,11 +111,13 @@ func (r *AlertRepository) FindByFingerprint(ctx context.Context, fingerprint str
 	ids := r.byFingerprint[fingerprint]
 	alerts := make([]*entity.Alert, 0, len(ids))
 	for _, id := range ids {
-		if alert, ok := r.alerts[id]; ok {
-			alertCopy := *alert
-			alerts = append(alerts, &alertCopy)
+		if alert, ok := r.alerts[id]; ok && repository.InTenant(ctx, alert.TenantID) {
+			alerts = append(alerts, alert.Clone())
 		}
 	}
+	sort.Slice(alerts, func(i, j int) bool {
+		return alerts[i].CreatedAt.After(alerts[j].CreatedAt)
+	})
 	return alerts, nil
 }
 
@@ -101,103 +137,173 @@ func (r *AlertRepository) FindByExternalReference(ctx context.Context, system, r
 	}
 
 	alert, ok := r.alerts[id]
-	if !ok {
+	if !ok || !repository.InTenant(ctx, alert.TenantID) {
 		return nil, nil
 	}
 
-	alertCopy := *alert
-	return &alertCopy, nil
+	return alert.Clone(), nil
 }
 
 // Update modifies an existing alert.
+// Returns ErrConcurrentUpdate if the alert was modified since it was read.
 func (r *AlertRepository) Update(ctx context.Context, alert *entity.Alert) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	existing, exists := r.alerts[alert.ID]
 	if !exists {
-		return entity.ErrAlertNotFound
+		return repository.ErrAlertNotFound
 	}
-
-	// Update external reference indexes if changed
-	// Remove old references
-	for system, oldRefID := range existing.ExternalReferences {
-		newRefID := alert.GetExternalReference(system)
-		if oldRefID != newRefID && oldRefID != "" {
-			if r.byExternalRef[system] != nil {
-				delete(r.byExternalRef[system], oldRefID)
-			}
-		}
+	if alert.Version != 0 && alert.Version != existing.Version {
+		return repository.ErrConcurrentUpdate
 	}
+	alert.Version = existing.Version + 1
 
-	// Add new references
-	for system, newRefID := range alert.ExternalReferences {
-		oldRefID := existing.GetExternalReference(system)
-		if newRefID != oldRefID && newRefID != "" {
-			if r.byExternalRef[system] == nil {
-				r.byExternalRef[system] = make(map[string]string)
-			}
-			r.byExternalRef[system][newRefID] = alert.ID
-		}
-	}
+	// Re-index external references in case they changed
+	r.unindexReferences(existing)
+	r.indexReferences(alert)
 
 	// Store updated copy
-	alertCopy := *alert
-	r.alerts[alert.ID] = &alertCopy
+	r.alerts[alert.ID] = alert.Clone()
 
 	return nil
 }
 
-// FindActive returns all currently active (non-resolved) alerts.
+// FindActive returns all currently active (non-resolved) alerts, most recently fired first.
 func (r *AlertRepository) FindActive(ctx context.Context) ([]*entity.Alert, error) {
+	return r.GetActiveAlerts(ctx, "")
+}
+
+// FindFiring returns all firing alerts (active or acknowledged), most recently fired first.
+func (r *AlertRepository) FindFiring(ctx context.Context) ([]*entity.Alert, error) {
+	return r.GetActiveAlerts(ctx, "")
+}
+
+// FindNotSeenSince returns the firing alerts last seen before the given time,
+// least recently seen first.
+func (r *AlertRepository) FindNotSeenSince(ctx context.Context, before time.Time) ([]*entity.Alert, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	var active []*entity.Alert
+	stale := make([]*entity.Alert, 0)
 	for _, alert := range r.alerts {
-		if alert.IsActive() {
-			alertCopy := *alert
-			active = append(active, &alertCopy)
+		if alert.IsFiring() && alert.LastSeenAt.Before(before) && repository.InTenant(ctx, alert.TenantID) {
+			stale = append(stale, alert.Clone())
 		}
 	}
-	return active, nil
+	sort.Slice(stale, func(i, j int) bool {
+		return stale[i].LastSeenAt.Before(stale[j].LastSeenAt)
+	})
+	return stale, nil
 }
 
-// FindFiring returns all firing alerts (active or acknowledged).
-func (r *AlertRepository) FindFiring(ctx context.Context) ([]*entity.Alert, error) {
+// FindFiringByCorrelationID returns the firing alerts sharing a correlation ID, oldest first.
+func (r *AlertRepository) FindFiringByCorrelationID(ctx context.Context, correlationID string) ([]*entity.Alert, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	correlated := make([]*entity.Alert, 0)
+	for _, alert := range r.alerts {
+		if alert.IsFiring() && alert.CorrelationID == correlationID && repository.InTenant(ctx, alert.TenantID) {
+			correlated = append(correlated, alert.Clone())
+		}
+	}
+	sort.Slice(correlated, func(i, j int) bool {
+		if !correlated[i].FiredAt.Equal(correlated[j].FiredAt) {
+			return correlated[i].FiredAt.Before(correlated[j].FiredAt)
+		}
+		return correlated[i].CreatedAt.Before(correlated[j].CreatedAt)
+	})
+	return correlated, nil
+}
+
+// FindByLabels returns the firing alerts carrying every matcher label,
+// most recently fired first.
+func (r *AlertRepository) FindByLabels(ctx context.Context, matchers map[string]string) ([]*entity.Alert, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	var firing []*entity.Alert
+	matched := make([]*entity.Alert, 0)
 	for _, alert := range r.alerts {
-		if alert.IsFiring() {
-			alertCopy := *alert
-			firing = append(firing, &alertCopy)
+		if alert.IsFiring() && alert.HasLabels(matchers) && repository.InTenant(ctx, alert.TenantID) {
+			matched = append(matched, alert.Clone())
 		}
 	}
-	return firing, nil
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].FiredAt.After(matched[j].FiredAt)
+	})
+	return matched, nil
 }
 
-// GetActiveAlerts returns active alerts, optionally filtered by severity.
-// Pass empty string for severity to get all active alerts.
+// GetActiveAlerts returns non-resolved alerts, optionally filtered by severity,
+// most recently fired first. Pass empty string for severity to get all active alerts.
 func (r *AlertRepository) GetActiveAlerts(ctx context.Context, severity string) ([]*entity.Alert, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	var active []*entity.Alert
+	active := make([]*entity.Alert, 0)
 	for _, alert := range r.alerts {
-		if alert.IsActive() {
+		if alert.IsFiring() && repository.InTenant(ctx, alert.TenantID) {
 			// Filter by severity if specified
 			if severity != "" && string(alert.Severity) != severity {
 				continue
 			}
-			alertCopy := *alert
-			active = append(active, &alertCopy)
+			active = append(active, alert.Clone())
 		}
 	}
+	sort.Slice(active, func(i, j int) bool {
+		return active[i].FiredAt.After(active[j].FiredAt)
+	})
 	return active, nil
 }
 
+// GetActiveAlertsByPriority returns non-resolved alerts, optionally filtered
+// by severity, most urgent first.
+func (r *AlertRepository) GetActiveAlertsByPriority(ctx context.Context, severity string, weights entity.SeverityWeights) ([]*entity.Alert, error) {
+	active, err := r.GetActiveAlerts(ctx, severity)
+	if err != nil {
+		return nil, err
+	}
+	weights.SortByPriority(active)
+	return active, nil
+}
+
+// CountByStateSeverity aggregates stored alerts by state and severity.
+func (r *AlertRepository) CountByStateSeverity(ctx context.Context) ([]*entity.StateSeverityCount, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	type key struct {
+		state    entity.AlertState
+		severity entity.AlertSeverity
+	}
+	groups := make(map[key]*entity.StateSeverityCount)
+	counts := make([]*entity.StateSeverityCount, 0)
+	for _, alert := range r.alerts {
+		if !repository.InTenant(ctx, alert.TenantID) {
+			continue
+		}
+		k := key{alert.State, alert.Severity}
+		count, ok := groups[k]
+		if !ok {
+			count = &entity.StateSeverityCount{State: alert.State, Severity: alert.Severity, OldestFiredAt: alert.FiredAt}
+			groups[k] = count
+			counts = append(counts, count)
+		}
+		count.Count++
+		if alert.FiredAt.Before(count.OldestFiredAt) {
+			count.OldestFiredAt = alert.FiredAt
+		}
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].State != counts[j].State {
+			return counts[i].State < counts[j].State
+		}
+		return counts[i].Severity < counts[j].Severity
+	})
+	return counts, nil
+}
+
 // Delete removes an alert by ID.
 func (r *AlertRepository) Delete(ctx context.Context, id string) error {
 	r.mu.Lock()
@@ -205,15 +311,19 @@ func (r *AlertRepository) Delete(ctx context.Context, id string) error {
 
 	alert, exists := r.alerts[id]
 	if !exists {
-		return entity.ErrAlertNotFound
+		return repository.ErrAlertNotFound
 	}
 
+	r.deleteLocked(alert)
+	return nil
+}
+
+// deleteLocked removes the alert and its index entries. Caller must hold r.mu.
+func (r *AlertRepository) deleteLocked(alert *entity.Alert) {
+	id := alert.ID
+
 	// Remove from external reference indexes
-	for system, refID := range alert.ExternalReferences {
-		if refID != "" && r.byExternalRef[system] != nil {
-			delete(r.byExternalRef[system], refID)
-		}
-	}
+	r.unindexReferences(alert)
 
 	// Remove from fingerprint index
 	fps := r.byFingerprint[alert.Fingerprint]
@@ -223,7 +333,35 @@ func (r *AlertRepository) Delete(ctx context.Context, id string) error {
 			break
 		}
 	}
+	if len(r.byFingerprint[alert.Fingerprint]) == 0 {
+		delete(r.byFingerprint, alert.Fingerprint)
+	}
 
 	delete(r.alerts, id)
-	return nil
+}
+
+// indexReferences adds every external reference ID of the alert to the index.
+// The full stored value is indexed too so IDs containing the separator still match.
+// Must be called with the lock held.
+func (r *AlertRepository) indexReferences(alert *entity.Alert) {
+	for system, value := range alert.ExternalReferences {
+		for _, refID := range entity.ReferenceLookupIDs(value) {
+			if r.byExternalRef[system] == nil {
+				r.byExternalRef[system] = make(map[string]string)
+			}
+			r.byExternalRef[system][refID] = alert.ID
+		}
+	}
+}
+
+// unindexReferences removes every external reference ID of the alert from the index.
+// Must be called with the lock held.
+func (r *AlertRepository) unindexReferences(alert *entity.Alert) {
+	for system, value := range alert.ExternalReferences {
+		for _, refID := range entity.ReferenceLookupIDs(value) {
+			if r.byExternalRef[system] != nil {
+				delete(r.byExternalRef[system], refID)
+			}
+		}
+	}
 }
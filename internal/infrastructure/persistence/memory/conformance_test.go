@@ -0,0 +1,18 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository/repositorytest"
+)
+
+func TestRepositoryConformance(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) repositorytest.Repositories {
+		return repositorytest.Repositories{
+			Alert:    NewAlertRepository(),
+			AckEvent: NewAckEventRepository(),
+			Silence:  NewSilenceRepository(),
+			Delivery: NewDeliveryRepository(),
+		}
+	})
+}
@@ -0,0 +1,58 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+)
+
+// DeliveryRepository provides an in-memory implementation of repository.DeliveryRepository.
+// Thread-safe for concurrent access.
+type DeliveryRepository struct {
+	mu        sync.RWMutex
+	byAlertID map[string][]*entity.NotificationDelivery // alertID -> deliveries
+	ids       map[string]bool
+}
+
+// NewDeliveryRepository creates a new in-memory delivery repository.
+func NewDeliveryRepository() *DeliveryRepository {
+	return &DeliveryRepository{
+		byAlertID: make(map[string][]*entity.NotificationDelivery),
+		ids:       make(map[string]bool),
+	}
+}
+
+// Save records a delivery.
+func (r *DeliveryRepository) Save(ctx context.Context, delivery *entity.NotificationDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ids[delivery.ID] {
+		return repository.ErrAlreadyExists
+	}
+
+	// Store a copy to prevent external mutations
+	deliveryCopy := *delivery
+	r.byAlertID[delivery.AlertID] = append(r.byAlertID[delivery.AlertID], &deliveryCopy)
+	r.ids[delivery.ID] = true
+	return nil
+}
+
+// FindByAlertID returns the deliveries of an alert, oldest first.
+func (r *DeliveryRepository) FindByAlertID(ctx context.Context, alertID string) ([]*entity.NotificationDelivery, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	deliveries := make([]*entity.NotificationDelivery, 0, len(r.byAlertID[alertID]))
+	for _, delivery := range r.byAlertID[alertID] {
+		deliveryCopy := *delivery
+		deliveries = append(deliveries, &deliveryCopy)
+	}
+	sort.SliceStable(deliveries, func(i, j int) bool {
+		return deliveries[i].CreatedAt.Before(deliveries[j].CreatedAt)
+	})
+	return deliveries, nil
+}
@@ -0,0 +1,92 @@
+package memory
+
+import (
+	"context"
+	"time"
+)
+
+// SetTTL sets how long resolved alerts are kept before EvictExpired drops
+// them. Zero, the default, keeps them forever.
+func (r *AlertRepository) SetTTL(ttl time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.ttl = ttl
+}
+
+// EvictExpired removes alerts resolved longer than the TTL ago and returns
+// their IDs. Firing alerts are never evicted.
+func (r *AlertRepository) EvictExpired() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ttl <= 0 {
+		return nil
+	}
+
+	cutoff := r.now().Add(-r.ttl)
+	var evicted []string
+	for id, alert := range r.alerts {
+		if !alert.IsResolved() {
+			continue
+		}
+		resolvedAt := alert.UpdatedAt
+		if alert.ResolvedAt != nil {
+			resolvedAt = *alert.ResolvedAt
+		}
+		if resolvedAt.Before(cutoff) {
+			r.deleteLocked(alert)
+			evicted = append(evicted, id)
+		}
+	}
+	return evicted
+}
+
+// StartEviction runs EvictExpired every interval until ctx is done, passing
+// the IDs of evicted alerts to onEvict, e.g. to drop their ack events.
+// It returns immediately; the sweep runs in a background goroutine.
+func (r *AlertRepository) StartEviction(ctx context.Context, interval time.Duration, onEvict func(alertIDs []string)) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if evicted := r.EvictExpired(); len(evicted) > 0 && onEvict != nil {
+					onEvict(evicted)
+				}
+			}
+		}
+	}()
+}
+
+// DeleteByAlertIDs removes the ack events of the given alerts, e.g. after
+// AlertRepository.EvictExpired dropped them.
+func (r *AckEventRepository) DeleteByAlertIDs(alertIDs []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, alertID := range alertIDs {
+		for _, id := range r.byAlertID[alertID] {
+			delete(r.events, id)
+		}
+		delete(r.byAlertID, alertID)
+	}
+}
+
+// DeleteByAlertIDs removes the deliveries of the given alerts, e.g. after
+// AlertRepository.EvictExpired dropped them.
+func (r *DeliveryRepository) DeleteByAlertIDs(alertIDs []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, alertID := range alertIDs {
+		for _, delivery := range r.byAlertID[alertID] {
+			delete(r.ids, delivery.ID)
+		}
+		delete(r.byAlertID, alertID)
+	}
+}
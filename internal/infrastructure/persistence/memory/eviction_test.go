@@ -0,0 +1,105 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+)
+
+func TestAlertRepository_EvictExpired(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	now := start
+
+	alerts := NewAlertRepository()
+	alerts.now = func() time.Time { return now }
+	alerts.SetTTL(time.Hour)
+	ackEvents := NewAckEventRepository()
+
+	newAlert := func(fingerprint string) *entity.Alert {
+		alert := entity.NewAlert(fingerprint, "High CPU", "host-1", "", "", entity.SeverityCritical)
+		alert.SetExternalReference("slack", "C1:"+fingerprint)
+		require.NoError(t, alerts.Save(ctx, alert))
+		require.NoError(t, ackEvents.Save(ctx, entity.NewAckEvent(alert.ID, entity.AckSourceSlack, "U1", "", "")))
+		return alert
+	}
+
+	expired := newAlert("fp-expired")
+	expired.Resolve("alertmanager", start)
+	require.NoError(t, alerts.Update(ctx, expired))
+
+	recent := newAlert("fp-recent")
+	recent.Resolve("alertmanager", start.Add(45*time.Minute))
+	require.NoError(t, alerts.Update(ctx, recent))
+
+	firing := newAlert("fp-firing")
+
+	// Nothing is older than the TTL yet.
+	now = start.Add(59 * time.Minute)
+	assert.Empty(t, alerts.EvictExpired())
+
+	now = start.Add(90 * time.Minute)
+	evicted := alerts.EvictExpired()
+	require.Equal(t, []string{expired.ID}, evicted)
+	ackEvents.DeleteByAlertIDs(evicted)
+
+	found, err := alerts.FindByID(ctx, expired.ID)
+	require.NoError(t, err)
+	assert.Nil(t, found)
+
+	byFingerprint, err := alerts.FindByFingerprint(ctx, "fp-expired")
+	require.NoError(t, err)
+	assert.Empty(t, byFingerprint)
+
+	byRef, err := alerts.FindByExternalReference(ctx, "slack", "C1:fp-expired")
+	require.NoError(t, err)
+	assert.Nil(t, byRef)
+
+	events, err := ackEvents.FindByAlertID(ctx, expired.ID)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	for _, kept := range []*entity.Alert{recent, firing} {
+		found, err := alerts.FindByID(ctx, kept.ID)
+		require.NoError(t, err)
+		assert.NotNil(t, found, kept.Fingerprint)
+
+		events, err := ackEvents.FindByAlertID(ctx, kept.ID)
+		require.NoError(t, err)
+		assert.Len(t, events, 1, kept.Fingerprint)
+	}
+
+	// Firing alerts are kept however old they get.
+	now = start.Add(24 * time.Hour)
+	assert.Equal(t, []string{recent.ID}, alerts.EvictExpired())
+}
+
+func TestAlertRepository_StartEviction(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	alerts := NewAlertRepository()
+	alerts.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	alerts.SetTTL(time.Hour)
+
+	alert := entity.NewAlert("fp-1", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	alert.Resolve("alertmanager", time.Now())
+	require.NoError(t, alerts.Save(ctx, alert))
+
+	evicted := make(chan []string, 1)
+	alerts.StartEviction(ctx, 10*time.Millisecond, func(alertIDs []string) {
+		evicted <- alertIDs
+	})
+
+	select {
+	case ids := <-evicted:
+		assert.Equal(t, []string{alert.ID}, ids)
+	case <-time.After(time.Second):
+		t.Fatal("alert was not evicted")
+	}
+}
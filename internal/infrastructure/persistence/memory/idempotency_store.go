@@ -0,0 +1,77 @@
+package memory
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultIdempotencyCapacity is the default maximum number of keys kept in memory.
+const DefaultIdempotencyCapacity = 10000
+
+// IdempotencyStore provides an in-memory LRU implementation of repository.IdempotencyStore.
+// When full, the least recently recorded key is evicted. Thread-safe for concurrent access.
+type IdempotencyStore struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List               // front = most recently recorded
+	entries  map[string]*list.Element // key -> element holding *idempotencyEntry
+}
+
+type idempotencyEntry struct {
+	key       string
+	expiresAt time.Time
+}
+
+// NewIdempotencyStore creates an in-memory idempotency store holding at most capacity keys.
+// A non-positive capacity uses DefaultIdempotencyCapacity.
+func NewIdempotencyStore(capacity int) *IdempotencyStore {
+	if capacity <= 0 {
+		capacity = DefaultIdempotencyCapacity
+	}
+	return &IdempotencyStore{
+		capacity: capacity,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+}
+
+// MarkProcessed records the key for ttl. Returns false if it was already recorded and unexpired.
+func (s *IdempotencyStore) MarkProcessed(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if elem, ok := s.entries[key]; ok {
+		entry := elem.Value.(*idempotencyEntry)
+		if now.Before(entry.expiresAt) {
+			return false, nil
+		}
+		entry.expiresAt = now.Add(ttl)
+		s.order.MoveToFront(elem)
+		return true, nil
+	}
+
+	s.entries[key] = s.order.PushFront(&idempotencyEntry{key: key, expiresAt: now.Add(ttl)})
+
+	for s.order.Len() > s.capacity {
+		oldest := s.order.Back()
+		s.order.Remove(oldest)
+		delete(s.entries, oldest.Value.(*idempotencyEntry).key)
+	}
+
+	return true, nil
+}
+
+// Release removes the key, so it can be recorded again.
+func (s *IdempotencyStore) Release(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if elem, ok := s.entries[key]; ok {
+		s.order.Remove(elem)
+		delete(s.entries, key)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+)
+
+// OutboxRepository provides an in-memory implementation of repository.OutboxRepository.
+// Entries do not survive a restart, so it only exercises the outbox flow.
+// Thread-safe for concurrent access.
+type OutboxRepository struct {
+	mu      sync.RWMutex
+	entries map[string]*entity.OutboxEntry // id -> entry
+	order   []string                       // entry IDs in insertion order
+	claims  map[string]time.Time           // id -> end of its claim
+}
+
+// NewOutboxRepository creates a new in-memory outbox repository.
+func NewOutboxRepository() *OutboxRepository {
+	return &OutboxRepository{
+		entries: make(map[string]*entity.OutboxEntry),
+		claims:  make(map[string]time.Time),
+	}
+}
+
+// Save records a new pending entry. The entry is removed again if the unit of
+// work ctx runs in fails.
+func (r *OutboxRepository) Save(ctx context.Context, entry *entity.OutboxEntry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.entries[entry.ID]; exists {
+		return repository.ErrAlreadyExists
+	}
+
+	entryCopy := *entry
+	r.entries[entry.ID] = &entryCopy
+	r.order = append(r.order, entry.ID)
+
+	onRollback(ctx, func() { r.remove(entry.ID) })
+
+	return nil
+}
+
+// remove deletes an entry.
+func (r *OutboxRepository) remove(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.entries, id)
+	delete(r.claims, id)
+	for i, entryID := range r.order {
+		if entryID == id {
+			r.order = append(r.order[:i], r.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// ClaimPending claims up to limit undelivered, unclaimed entries below
+// maxAttempts, oldest first, for lease.
+func (r *OutboxRepository) ClaimPending(ctx context.Context, maxAttempts, limit int, lease time.Duration) ([]*entity.OutboxEntry, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	var pending []*entity.OutboxEntry
+	for _, id := range r.order {
+		if len(pending) >= limit {
+			break
+		}
+		entry := r.entries[id]
+		if !entry.IsPending() || entry.Attempts >= maxAttempts || now.Before(r.claims[id]) {
+			continue
+		}
+		r.claims[id] = now.Add(lease)
+		entryCopy := *entry
+		pending = append(pending, &entryCopy)
+	}
+
+	return pending, nil
+}
+
+// MarkSent records the entry as delivered.
+func (r *OutboxRepository) MarkSent(ctx context.Context, id string, sentAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, ok := r.entries[id]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	entry.SentAt = &sentAt
+
+	return nil
+}
+
+// MarkFailed increments the entry's attempt count, records the error and
+// keeps the entry claimed until retryAt.
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id string, lastError string, retryAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, ok := r.entries[id]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	entry.Attempts++
+	entry.LastError = lastError
+	r.claims[id] = retryAt
+
+	return nil
+}
@@ -2,9 +2,11 @@ package memory
 
 import (
 	"context"
+	"sort"
 	"sync"
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
 )
 
 // SilenceRepository provides an in-memory implementation of repository.SilenceRepository.
@@ -32,7 +34,12 @@ func (r *SilenceRepository) Save(ctx context.Context, silence *entity.SilenceMar
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	if _, exists := r.silences[silence.ID]; exists {
+		return repository.ErrAlreadyExists
+	}
+
 	// Store a copy to prevent external mutations
+	silence.Version = 1
 	silenceCopy := *silence
 	if silence.Labels != nil {
 		silenceCopy.Labels = make(map[string]string)
@@ -41,7 +48,13 @@ func (r *SilenceRepository) Save(ctx context.Context, silence *entity.SilenceMar
 		}
 	}
 	r.silences[silence.ID] = &silenceCopy
+	r.index(silence)
 
+	return nil
+}
+
+// index adds the silence to the alert ID, instance and fingerprint indexes.
+func (r *SilenceRepository) index(silence *entity.SilenceMark) {
 	// Index by alert ID if set
 	if silence.AlertID != "" {
 		r.byAlertID[silence.AlertID] = append(r.byAlertID[silence.AlertID], silence.ID)
@@ -56,8 +69,6 @@ func (r *SilenceRepository) Save(ctx context.Context, silence *entity.SilenceMar
 	if silence.Fingerprint != "" {
 		r.byFingerprint[silence.Fingerprint] = append(r.byFingerprint[silence.Fingerprint], silence.ID)
 	}
-
-	return nil
 }
 
 // FindByID retrieves a silence by its ID.
@@ -66,7 +77,7 @@ func (r *SilenceRepository) FindByID(ctx context.Context, id string) (*entity.Si
 	defer r.mu.RUnlock()
 
 	silence, ok := r.silences[id]
-	if !ok {
+	if !ok || !repository.InTenant(ctx, silence.TenantID) {
 		return nil, nil
 	}
 
@@ -78,12 +89,13 @@ func (r *SilenceRepository) FindActive(ctx context.Context) ([]*entity.SilenceMa
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	var active []*entity.SilenceMark
+	active := make([]*entity.SilenceMark, 0)
 	for _, silence := range r.silences {
-		if silence.IsActive() {
+		if silence.IsActive() && repository.InTenant(ctx, silence.TenantID) {
 			active = append(active, r.copySilence(silence))
 		}
 	}
+	sortNewestFirst(active)
 	return active, nil
 }
 
@@ -93,12 +105,13 @@ func (r *SilenceRepository) FindByAlertID(ctx context.Context, alertID string) (
 	defer r.mu.RUnlock()
 
 	ids := r.byAlertID[alertID]
-	var active []*entity.SilenceMark
+	active := make([]*entity.SilenceMark, 0)
 	for _, id := range ids {
-		if silence, ok := r.silences[id]; ok && silence.IsActive() {
+		if silence, ok := r.silences[id]; ok && silence.IsActive() && repository.InTenant(ctx, silence.TenantID) {
 			active = append(active, r.copySilence(silence))
 		}
 	}
+	sortNewestFirst(active)
 	return active, nil
 }
 
@@ -108,12 +121,13 @@ func (r *SilenceRepository) FindByInstance(ctx context.Context, instance string)
 	defer r.mu.RUnlock()
 
 	ids := r.byInstance[instance]
-	var active []*entity.SilenceMark
+	active := make([]*entity.SilenceMark, 0)
 	for _, id := range ids {
-		if silence, ok := r.silences[id]; ok && silence.IsActive() {
+		if silence, ok := r.silences[id]; ok && silence.IsActive() && repository.InTenant(ctx, silence.TenantID) {
 			active = append(active, r.copySilence(silence))
 		}
 	}
+	sortNewestFirst(active)
 	return active, nil
 }
 
@@ -123,74 +137,67 @@ func (r *SilenceRepository) FindByFingerprint(ctx context.Context, fingerprint s
 	defer r.mu.RUnlock()
 
 	ids := r.byFingerprint[fingerprint]
-	var active []*entity.SilenceMark
+	active := make([]*entity.SilenceMark, 0)
 	for _, id := range ids {
-		if silence, ok := r.silences[id]; ok && silence.IsActive() {
+		if silence, ok := r.silences[id]; ok && silence.IsActive() && repository.InTenant(ctx, silence.TenantID) {
 			active = append(active, r.copySilence(silence))
 		}
 	}
+	sortNewestFirst(active)
 	return active, nil
 }
 
-// FindMatchingAlert returns all active silences that match the given alert.
+// FindMatchingAlert returns all active silences that match the given alert,
+// which only includes silences of the alert's tenant and global ones. Like the SQL backends it applies entity.SilenceMark.MatchesAlert to every
+// silence rather than relying on the indexes.
 func (r *SilenceRepository) FindMatchingAlert(ctx context.Context, alert *entity.Alert) ([]*entity.SilenceMark, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	var matches []*entity.SilenceMark
-	seen := make(map[string]bool)
-
-	// Check silences by alert ID
-	for _, id := range r.byAlertID[alert.ID] {
-		if silence, ok := r.silences[id]; ok && silence.MatchesAlert(alert) {
-			if !seen[id] {
-				matches = append(matches, r.copySilence(silence))
-				seen[id] = true
-			}
+	matches := make([]*entity.SilenceMark, 0)
+	for _, silence := range r.silences {
+		if silence.MatchesAlert(alert) {
+			matches = append(matches, r.copySilence(silence))
 		}
 	}
 
-	// Check silences by fingerprint
-	for _, id := range r.byFingerprint[alert.Fingerprint] {
-		if silence, ok := r.silences[id]; ok && silence.MatchesAlert(alert) {
-			if !seen[id] {
-				matches = append(matches, r.copySilence(silence))
-				seen[id] = true
-			}
-		}
-	}
+	return matches, nil
+}
 
-	// Check silences by instance
-	for _, id := range r.byInstance[alert.Instance] {
-		if silence, ok := r.silences[id]; ok && silence.MatchesAlert(alert) {
-			if !seen[id] {
-				matches = append(matches, r.copySilence(silence))
-				seen[id] = true
-			}
-		}
-	}
+// CountActive returns the number of currently active silences.
+func (r *SilenceRepository) CountActive(ctx context.Context) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
 
-	// Check label-only silences (no specific alert/fingerprint/instance)
-	for id, silence := range r.silences {
-		if silence.AlertID == "" && silence.Fingerprint == "" && silence.Instance == "" {
-			if silence.MatchesAlert(alert) && !seen[id] {
-				matches = append(matches, r.copySilence(silence))
-				seen[id] = true
-			}
+	count := 0
+	for _, silence := range r.silences {
+		if silence.IsActive() && repository.InTenant(ctx, silence.TenantID) {
+			count++
 		}
 	}
-
-	return matches, nil
+	return count, nil
 }
 
 // Update modifies an existing silence.
+// Returns ErrConcurrentUpdate if the silence was modified since it was read.
 func (r *SilenceRepository) Update(ctx context.Context, silence *entity.SilenceMark) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	if _, exists := r.silences[silence.ID]; !exists {
-		return entity.ErrSilenceNotFound
+	existing, exists := r.silences[silence.ID]
+	if !exists {
+		return repository.ErrSilenceNotFound
+	}
+	if silence.Version != 0 && silence.Version != existing.Version {
+		return repository.ErrConcurrentUpdate
 	}
+	silence.Version = existing.Version + 1
+
+	// Re-index in case the silence was retargeted
+	r.removeFromIndex(r.byAlertID, existing.AlertID, silence.ID)
+	r.removeFromIndex(r.byInstance, existing.Instance, silence.ID)
+	r.removeFromIndex(r.byFingerprint, existing.Fingerprint, silence.ID)
+	r.index(silence)
 
 	silenceCopy := *silence
 	if silence.Labels != nil {
@@ -211,7 +218,7 @@ func (r *SilenceRepository) Delete(ctx context.Context, id string) error {
 
 	silence, exists := r.silences[id]
 	if !exists {
-		return entity.ErrSilenceNotFound
+		return repository.ErrSilenceNotFound
 	}
 
 	// Remove from indexes
@@ -258,6 +265,13 @@ func (r *SilenceRepository) copySilence(silence *entity.SilenceMark) *entity.Sil
 	return &silenceCopy
 }
 
+// sortNewestFirst orders silences by creation time, newest first.
+func sortNewestFirst(silences []*entity.SilenceMark) {
+	sort.Slice(silences, func(i, j int) bool {
+		return silences[i].CreatedAt.After(silences[j].CreatedAt)
+	})
+}
+
 // removeFromIndex removes an ID from a slice index.
 func (r *SilenceRepository) removeFromIndex(index map[string][]string, key, id string) {
 	if key == "" {
@@ -0,0 +1,73 @@
+package memory
+
+import (
+	"context"
+	"sync"
+)
+
+// TransactionManager implements repository.TransactionManager for in-memory
+// storage. Units of work are serialized so concurrent ones cannot interleave.
+// When fn fails, the ack events and outbox entries it saved are removed
+// again; alert writes are kept, so a unit of work should update its alert
+// last.
+type TransactionManager struct {
+	mu sync.Mutex
+}
+
+// txKey carries the txLog of the unit of work a context runs in.
+type txKey struct{}
+
+// txLog collects the functions undoing the writes of a unit of work.
+type txLog struct {
+	manager *TransactionManager
+
+	mu   sync.Mutex
+	undo []func()
+}
+
+// NewTransactionManager creates a new in-memory transaction manager.
+func NewTransactionManager() *TransactionManager {
+	return &TransactionManager{}
+}
+
+// WithTransaction executes fn while holding the manager's lock and undoes
+// the supported writes of fn if it fails.
+// Nested calls run fn directly instead of deadlocking.
+func (m *TransactionManager) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if log, ok := ctx.Value(txKey{}).(*txLog); ok && log.manager == m {
+		return fn(ctx)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	log := &txLog{manager: m}
+	err := fn(context.WithValue(ctx, txKey{}, log))
+	if err != nil {
+		log.rollback()
+	}
+	return err
+}
+
+// rollback runs the undo functions, most recent first.
+func (l *txLog) rollback() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for i := len(l.undo) - 1; i >= 0; i-- {
+		l.undo[i]()
+	}
+}
+
+// onRollback registers undo to run if the unit of work ctx runs in fails.
+// Outside a unit of work it does nothing.
+func onRollback(ctx context.Context, undo func()) {
+	log, ok := ctx.Value(txKey{}).(*txLog)
+	if !ok {
+		return
+	}
+
+	log.mu.Lock()
+	defer log.mu.Unlock()
+	log.undo = append(log.undo, undo)
+}
@@ -20,23 +20,23 @@ func NewAckEventRepository(db *DB) *AckEventRepository {
 }
 
 // Save persists a new ack event.
-// Returns ErrNotFound if the referenced alert doesn't exist (FK constraint).
+// Returns ErrAlertNotFound if the referenced alert doesn't exist (FK constraint).
 func (r *AckEventRepository) Save(ctx context.Context, event *entity.AckEvent) error {
 	query := `
 		INSERT INTO ack_events (
 			id, alert_id, source,
 			user_id, user_email, user_name,
 			note, duration_seconds,
-			created_at
+			created_at, tenant_id
 		) VALUES (
 			?, ?, ?,
 			?, ?, ?,
 			?, ?,
-			?
+			?, ?
 		)
 	`
 
-	_, err := r.db.Primary().ExecContext(ctx, query,
+	_, err := r.db.getExecutor(ctx).ExecContext(ctx, query,
 		event.ID,
 		event.AlertID,
 		string(event.Source),
@@ -46,11 +46,12 @@ func (r *AckEventRepository) Save(ctx context.Context, event *entity.AckEvent) e
 		nullString(event.Note),
 		durationToSeconds(event.Duration),
 		timeToTimestamp(event.CreatedAt),
+		event.TenantID,
 	)
 
 	if err != nil {
 		if isForeignKeyError(err) {
-			return repository.ErrNotFound // Alert doesn't exist
+			return repository.ErrAlertNotFound // Alert doesn't exist
 		}
 		if isDuplicateError(err) {
 			return repository.ErrAlreadyExists
@@ -58,6 +59,7 @@ func (r *AckEventRepository) Save(ctx context.Context, event *entity.AckEvent) e
 		return fmt.Errorf("inserting ack event: %w", err)
 	}
 
+	r.db.wrote(ackEventKey(event.ID), ackEventsKey(event.AlertID))
 	return nil
 }
 
@@ -69,16 +71,16 @@ func (r *AckEventRepository) FindByID(ctx context.Context, id string) (*entity.A
 			id, alert_id, source,
 			user_id, user_email, user_name,
 			note, duration_seconds,
-			created_at
+			created_at, tenant_id
 		FROM ack_events
-		WHERE id = ?
-	`
+		WHERE id = ?`
+	query, args := withTenant(ctx, query, id)
 
 	var event entity.AckEvent
 	var userID, userEmail, userName, note sql.NullString
 	var durationSeconds sql.NullInt64
 
-	err := r.db.Replica().QueryRowContext(ctx, query, id).Scan(
+	err := r.db.readerFor(ctx, ackEventKey(id)).QueryRowContext(ctx, query, args...).Scan(
 		&event.ID,
 		&event.AlertID,
 		&event.Source,
@@ -88,6 +90,7 @@ func (r *AckEventRepository) FindByID(ctx context.Context, id string) (*entity.A
 		&note,
 		&durationSeconds,
 		&event.CreatedAt,
+		&event.TenantID,
 	)
 
 	if err != nil {
@@ -116,13 +119,12 @@ func (r *AckEventRepository) FindByAlertID(ctx context.Context, alertID string)
 			id, alert_id, source,
 			user_id, user_email, user_name,
 			note, duration_seconds,
-			created_at
+			created_at, tenant_id
 		FROM ack_events
-		WHERE alert_id = ?
-		ORDER BY created_at ASC
-	`
+		WHERE alert_id = ?`
+	query, args := withTenant(ctx, query, alertID)
 
-	rows, err := r.db.Replica().QueryContext(ctx, query, alertID)
+	rows, err := r.db.readerFor(ctx, ackEventsKey(alertID)).QueryContext(ctx, query+" ORDER BY created_at ASC", args...)
 	if err != nil {
 		return nil, fmt.Errorf("querying ack events by alert ID: %w", err)
 	}
@@ -139,18 +141,16 @@ func (r *AckEventRepository) FindLatestByAlertID(ctx context.Context, alertID st
 			id, alert_id, source,
 			user_id, user_email, user_name,
 			note, duration_seconds,
-			created_at
+			created_at, tenant_id
 		FROM ack_events
-		WHERE alert_id = ?
-		ORDER BY created_at DESC
-		LIMIT 1
-	`
+		WHERE alert_id = ?`
+	query, args := withTenant(ctx, query, alertID)
 
 	var event entity.AckEvent
 	var userID, userEmail, userName, note sql.NullString
 	var durationSeconds sql.NullInt64
 
-	err := r.db.Replica().QueryRowContext(ctx, query, alertID).Scan(
+	err := r.db.readerFor(ctx, ackEventsKey(alertID)).QueryRowContext(ctx, query+" ORDER BY created_at DESC LIMIT 1", args...).Scan(
 		&event.ID,
 		&event.AlertID,
 		&event.Source,
@@ -160,6 +160,7 @@ func (r *AckEventRepository) FindLatestByAlertID(ctx context.Context, alertID st
 		&note,
 		&durationSeconds,
 		&event.CreatedAt,
+		&event.TenantID,
 	)
 
 	if err != nil {
@@ -189,12 +190,11 @@ func (r *AckEventRepository) GetTopAcknowledgers(ctx context.Context, limit int)
 	query := `
 		SELECT user_name, user_email, COUNT(*) as ack_count
 		FROM ack_events
-		GROUP BY user_email
-		ORDER BY ack_count DESC
-		LIMIT ?
-	`
+		WHERE 1 = 1`
+	query, args := withTenant(ctx, query)
 
-	rows, err := r.db.Replica().QueryContext(ctx, query, limit)
+	rows, err := r.db.getReader(ctx).QueryContext(ctx,
+		query+" GROUP BY user_email ORDER BY ack_count DESC LIMIT ?", append(args, limit)...)
 	if err != nil {
 		return nil, fmt.Errorf("querying top acknowledgers: %w", err)
 	}
@@ -240,6 +240,7 @@ func (r *AckEventRepository) scanAckEvents(rows *sql.Rows) ([]*entity.AckEvent,
 			&note,
 			&durationSeconds,
 			&event.CreatedAt,
+			&event.TenantID,
 		)
 
 		if err != nil {
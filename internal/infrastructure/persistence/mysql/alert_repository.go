@@ -3,7 +3,9 @@ package mysql
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
@@ -20,8 +22,18 @@ func NewAlertRepository(db *DB) *AlertRepository {
 }
 
 // Save persists a new alert.
-// Returns ErrAlreadyExists if an alert with the same ID already exists.
+// Returns ErrDuplicateAlert if an alert with the same ID already exists.
 func (r *AlertRepository) Save(ctx context.Context, alert *entity.Alert) error {
+	return r.db.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := r.save(ctx, alert); err != nil {
+			return err
+		}
+		return r.saveReferences(ctx, alert)
+	})
+}
+
+// save inserts the alert row.
+func (r *AlertRepository) save(ctx context.Context, alert *entity.Alert) error {
 	// Serialize JSON fields
 	labelsJSON, err := marshalJSON(alert.Labels)
 	if err != nil {
@@ -38,23 +50,34 @@ func (r *AlertRepository) Save(ctx context.Context, alert *entity.Alert) error {
 		return fmt.Errorf("marshaling external_references: %w", err)
 	}
 
+	transitionState, transitionAt, transitionBy := transitionColumns(alert.LastTransition)
+
+	assignmentsJSON, err := assignmentsColumn(alert.Assignments)
+	if err != nil {
+		return fmt.Errorf("marshaling assignments: %w", err)
+	}
+
 	query := `
 		INSERT INTO alerts (
 			id, fingerprint, name, instance, target, summary, description,
 			severity, state, labels, annotations,
 			external_references,
 			fired_at, acked_at, acked_by, resolved_at,
-			version, created_at, updated_at
+			version, created_at, updated_at,
+			updated_by, last_transition_state, last_transition_at, last_transition_by,
+			correlation_id, tenant_id, last_seen_at, assigned_to, assignments
 		) VALUES (
 			?, ?, ?, ?, ?, ?, ?,
 			?, ?, ?, ?,
 			?,
 			?, ?, ?, ?,
-			1, ?, ?
+			1, ?, ?,
+			?, ?, ?, ?,
+			?, ?, ?, ?, ?
 		)
 	`
 
-	_, err = r.db.Primary().ExecContext(ctx, query,
+	_, err = r.db.getExecutor(ctx).ExecContext(ctx, query,
 		alert.ID,
 		alert.Fingerprint,
 		alert.Name,
@@ -73,18 +96,170 @@ func (r *AlertRepository) Save(ctx context.Context, alert *entity.Alert) error {
 		nullTime(alert.ResolvedAt),
 		timeToTimestamp(alert.CreatedAt),
 		timeToTimestamp(alert.UpdatedAt),
+		nullString(alert.UpdatedBy),
+		transitionState,
+		transitionAt,
+		transitionBy,
+		nullString(alert.CorrelationID),
+		alert.TenantID,
+		nullTimeValue(alert.LastSeenAt),
+		nullString(alert.AssignedTo),
+		assignmentsJSON,
 	)
 
 	if err != nil {
 		if isDuplicateError(err) {
-			return repository.ErrAlreadyExists
+			return repository.ErrDuplicateAlert
 		}
 		return fmt.Errorf("inserting alert: %w", err)
 	}
 
+	alert.Version = 1
+	r.db.wrote(alertKeys(alert)...)
 	return nil
 }
 
+// UpsertByFingerprint inserts the alert unless a firing alert with the same
+// fingerprint already exists. The unique index on firing_fingerprint makes the
+// check atomic across concurrent webhook deliveries and replicas.
+func (r *AlertRepository) UpsertByFingerprint(ctx context.Context, alert *entity.Alert) (*entity.Alert, bool, error) {
+	var existing *entity.Alert
+	var inserted bool
+	err := r.db.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		existing, inserted, err = r.upsertByFingerprint(ctx, alert)
+		if err != nil || !inserted {
+			return err
+		}
+		return r.saveReferences(ctx, alert)
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return existing, inserted, nil
+}
+
+// upsertByFingerprint inserts the alert row unless a firing alert with the
+// same fingerprint exists, which it returns instead.
+func (r *AlertRepository) upsertByFingerprint(ctx context.Context, alert *entity.Alert) (*entity.Alert, bool, error) {
+	// Serialize JSON fields
+	labelsJSON, err := marshalJSON(alert.Labels)
+	if err != nil {
+		return nil, false, fmt.Errorf("marshaling labels: %w", err)
+	}
+
+	annotationsJSON, err := marshalJSON(alert.Annotations)
+	if err != nil {
+		return nil, false, fmt.Errorf("marshaling annotations: %w", err)
+	}
+
+	externalReferencesJSON, err := marshalJSON(alert.ExternalReferences)
+	if err != nil {
+		return nil, false, fmt.Errorf("marshaling external_references: %w", err)
+	}
+
+	transitionState, transitionAt, transitionBy := transitionColumns(alert.LastTransition)
+
+	assignmentsJSON, err := assignmentsColumn(alert.Assignments)
+	if err != nil {
+		return nil, false, fmt.Errorf("marshaling assignments: %w", err)
+	}
+
+	// "id = id" turns a duplicate into a no-op so RowsAffected reports 0
+	query := `
+		INSERT INTO alerts (
+			id, fingerprint, name, instance, target, summary, description,
+			severity, state, labels, annotations,
+			external_references,
+			fired_at, acked_at, acked_by, resolved_at,
+			version, created_at, updated_at,
+			updated_by, last_transition_state, last_transition_at, last_transition_by,
+			correlation_id, tenant_id, last_seen_at, assigned_to, assignments
+		) VALUES (
+			?, ?, ?, ?, ?, ?, ?,
+			?, ?, ?, ?,
+			?,
+			?, ?, ?, ?,
+			1, ?, ?,
+			?, ?, ?, ?,
+			?, ?, ?, ?, ?
+		)
+		ON DUPLICATE KEY UPDATE id = id
+	`
+
+	result, err := r.db.getExecutor(ctx).ExecContext(ctx, query,
+		alert.ID,
+		alert.Fingerprint,
+		alert.Name,
+		alert.Instance,
+		alert.Target,
+		alert.Summary,
+		alert.Description,
+		string(alert.Severity),
+		string(alert.State),
+		labelsJSON,
+		annotationsJSON,
+		externalReferencesJSON,
+		timeToTimestamp(alert.FiredAt),
+		nullTime(alert.AckedAt),
+		nullString(alert.AckedBy),
+		nullTime(alert.ResolvedAt),
+		timeToTimestamp(alert.CreatedAt),
+		timeToTimestamp(alert.UpdatedAt),
+		nullString(alert.UpdatedBy),
+		transitionState,
+		transitionAt,
+		transitionBy,
+		nullString(alert.CorrelationID),
+		alert.TenantID,
+		nullTimeValue(alert.LastSeenAt),
+		nullString(alert.AssignedTo),
+		assignmentsJSON,
+	)
+	if err != nil {
+		return nil, false, fmt.Errorf("upserting alert: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, false, fmt.Errorf("getting rows affected: %w", err)
+	}
+	if rowsAffected == 1 {
+		alert.Version = 1
+		r.db.wrote(alertKeys(alert)...)
+		return alert, true, nil
+	}
+
+	// Read from primary: the conflicting row may not have replicated yet
+	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, `
+		SELECT
+			id, fingerprint, name, instance, target, summary, description,
+			severity, state, labels, annotations,
+			external_references,
+			fired_at, acked_at, acked_by, resolved_at,
+			version, created_at, updated_at,
+			updated_by, last_transition_state, last_transition_at, last_transition_by,
+			correlation_id, tenant_id, last_seen_at, assigned_to, assignments
+		FROM alerts
+		WHERE tenant_id = ? AND fingerprint = ? AND state IN ('active', 'acknowledged')
+		LIMIT 1
+	`, alert.TenantID, alert.Fingerprint)
+	if err != nil {
+		return nil, false, fmt.Errorf("querying firing alert: %w", err)
+	}
+	defer rows.Close()
+
+	existing, err := r.scanAlerts(rows)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(existing) == 0 {
+		// The conflict was on the primary key rather than the fingerprint
+		return nil, false, repository.ErrDuplicateAlert
+	}
+	return existing[0], false, nil
+}
+
 // FindByID retrieves an alert by its unique identifier.
 // Returns nil, nil if not found.
 func (r *AlertRepository) FindByID(ctx context.Context, id string) (*entity.Alert, error) {
@@ -94,18 +269,21 @@ func (r *AlertRepository) FindByID(ctx context.Context, id string) (*entity.Aler
 			severity, state, labels, annotations,
 			external_references,
 			fired_at, acked_at, acked_by, resolved_at,
-			version, created_at, updated_at
+			version, created_at, updated_at,
+			updated_by, last_transition_state, last_transition_at, last_transition_by,
+			correlation_id, tenant_id, last_seen_at, assigned_to, assignments
 		FROM alerts
-		WHERE id = ?
-	`
+		WHERE id = ?`
+	query, args := withTenant(ctx, query, id)
 
 	var alert entity.Alert
 	var labelsJSON, annotationsJSON, externalReferencesJSON string
 	var ackedBy sql.NullString
 	var ackedAt, resolvedAt sql.NullTime
-	var version int
+	var updatedBy, transitionState, transitionBy, correlationID, assignedTo, assignments sql.NullString
+	var transitionAt, lastSeenAt sql.NullTime
 
-	err := r.db.Replica().QueryRowContext(ctx, query, id).Scan(
+	err := r.db.readerFor(ctx, alertKey(id)).QueryRowContext(ctx, query, args...).Scan(
 		&alert.ID,
 		&alert.Fingerprint,
 		&alert.Name,
@@ -122,9 +300,18 @@ func (r *AlertRepository) FindByID(ctx context.Context, id string) (*entity.Aler
 		&ackedAt,
 		&ackedBy,
 		&resolvedAt,
-		&version,
+		&alert.Version,
 		&alert.CreatedAt,
 		&alert.UpdatedAt,
+		&updatedBy,
+		&transitionState,
+		&transitionAt,
+		&transitionBy,
+		&correlationID,
+		&alert.TenantID,
+		&lastSeenAt,
+		&assignedTo,
+		&assignments,
 	)
 
 	if err != nil {
@@ -149,6 +336,16 @@ func (r *AlertRepository) FindByID(ctx context.Context, id string) (*entity.Aler
 	alert.AckedBy = stringValue(ackedBy)
 	alert.AckedAt = timePtr(ackedAt)
 	alert.ResolvedAt = timePtr(resolvedAt)
+	alert.UpdatedBy = stringValue(updatedBy)
+	alert.LastTransition = transitionFromColumns(transitionState, transitionAt, transitionBy)
+	alert.CorrelationID = stringValue(correlationID)
+	if seen := timePtr(lastSeenAt); seen != nil {
+		alert.LastSeenAt = *seen
+	}
+	alert.AssignedTo = stringValue(assignedTo)
+	if alert.Assignments, err = assignmentsFromColumn(assignments); err != nil {
+		return nil, fmt.Errorf("unmarshaling assignments: %w", err)
+	}
 
 	return &alert, nil
 }
@@ -162,13 +359,14 @@ func (r *AlertRepository) FindByFingerprint(ctx context.Context, fingerprint str
 			severity, state, labels, annotations,
 			external_references,
 			fired_at, acked_at, acked_by, resolved_at,
-			version, created_at, updated_at
+			version, created_at, updated_at,
+			updated_by, last_transition_state, last_transition_at, last_transition_by,
+			correlation_id, tenant_id, last_seen_at, assigned_to, assignments
 		FROM alerts
-		WHERE fingerprint = ?
-		ORDER BY created_at DESC
-	`
+		WHERE fingerprint = ?`
+	query, args := withTenant(ctx, query, fingerprint)
 
-	rows, err := r.db.Replica().QueryContext(ctx, query, fingerprint)
+	rows, err := r.db.readerFor(ctx, alertFingerprintKey(fingerprint)).QueryContext(ctx, query+" ORDER BY created_at DESC", args...)
 	if err != nil {
 		return nil, fmt.Errorf("querying alerts by fingerprint: %w", err)
 	}
@@ -178,6 +376,8 @@ func (r *AlertRepository) FindByFingerprint(ctx context.Context, fingerprint str
 }
 
 // FindByExternalReference finds an alert by a specific external reference key and value.
+// Matches any of the reference IDs stored under the key, through the
+// alert_references index.
 // Returns nil, nil if not found.
 func (r *AlertRepository) FindByExternalReference(ctx context.Context, key, value string) (*entity.Alert, error) {
 	query := `
@@ -186,18 +386,24 @@ func (r *AlertRepository) FindByExternalReference(ctx context.Context, key, valu
 			severity, state, labels, annotations,
 			external_references,
 			fired_at, acked_at, acked_by, resolved_at,
-			version, created_at, updated_at
+			version, created_at, updated_at,
+			updated_by, last_transition_state, last_transition_at, last_transition_by,
+			correlation_id, tenant_id, last_seen_at, assigned_to, assignments
 		FROM alerts
-		WHERE JSON_EXTRACT(external_references, CONCAT('$.', ?)) = ?
-	`
+		WHERE id IN (
+			SELECT alert_id FROM alert_references
+			WHERE reference_system = ? AND reference_hash = SHA2(?, 256)
+		)`
+	query, args := withTenant(ctx, query, key, value)
 
 	var alert entity.Alert
 	var labelsJSON, annotationsJSON, externalReferencesJSON string
 	var ackedBy sql.NullString
 	var ackedAt, resolvedAt sql.NullTime
-	var version int
+	var updatedBy, transitionState, transitionBy, correlationID, assignedTo, assignments sql.NullString
+	var transitionAt, lastSeenAt sql.NullTime
 
-	err := r.db.Replica().QueryRowContext(ctx, query, key, value).Scan(
+	err := r.db.readerFor(ctx, alertReferenceKey(key, value)).QueryRowContext(ctx, query, args...).Scan(
 		&alert.ID,
 		&alert.Fingerprint,
 		&alert.Name,
@@ -214,9 +420,18 @@ func (r *AlertRepository) FindByExternalReference(ctx context.Context, key, valu
 		&ackedAt,
 		&ackedBy,
 		&resolvedAt,
-		&version,
+		&alert.Version,
 		&alert.CreatedAt,
 		&alert.UpdatedAt,
+		&updatedBy,
+		&transitionState,
+		&transitionAt,
+		&transitionBy,
+		&correlationID,
+		&alert.TenantID,
+		&lastSeenAt,
+		&assignedTo,
+		&assignments,
 	)
 
 	if err != nil {
@@ -241,23 +456,69 @@ func (r *AlertRepository) FindByExternalReference(ctx context.Context, key, valu
 	alert.AckedBy = stringValue(ackedBy)
 	alert.AckedAt = timePtr(ackedAt)
 	alert.ResolvedAt = timePtr(resolvedAt)
+	alert.UpdatedBy = stringValue(updatedBy)
+	alert.LastTransition = transitionFromColumns(transitionState, transitionAt, transitionBy)
+	alert.CorrelationID = stringValue(correlationID)
+	if seen := timePtr(lastSeenAt); seen != nil {
+		alert.LastSeenAt = *seen
+	}
+	alert.AssignedTo = stringValue(assignedTo)
+	if alert.Assignments, err = assignmentsFromColumn(assignments); err != nil {
+		return nil, fmt.Errorf("unmarshaling assignments: %w", err)
+	}
 
 	return &alert, nil
 }
 
+// saveReferences replaces the alert's rows in alert_references, the index of
+// FindByExternalReference. It runs in the transaction writing the alert.
+func (r *AlertRepository) saveReferences(ctx context.Context, alert *entity.Alert) error {
+	exec := r.db.getExecutor(ctx)
+	if _, err := exec.ExecContext(ctx, `DELETE FROM alert_references WHERE alert_id = ?`, alert.ID); err != nil {
+		return fmt.Errorf("deleting alert references: %w", err)
+	}
+
+	for system, value := range alert.ExternalReferences {
+		for _, referenceID := range entity.ReferenceLookupIDs(value) {
+			_, err := exec.ExecContext(ctx, `
+				INSERT INTO alert_references (alert_id, reference_system, reference_hash)
+				VALUES (?, ?, SHA2(?, 256))
+			`, alert.ID, system, referenceID)
+			if err != nil {
+				return fmt.Errorf("inserting alert reference: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
 // Update modifies an existing alert with optimistic locking.
-// Returns ErrNotFound if the alert doesn't exist.
+// Returns ErrAlertNotFound if the alert doesn't exist.
 // Returns ErrConcurrentUpdate if the alert was modified by another instance.
+// Deadlocks and lock wait timeouts are retried; if they persist the error matches ErrDeadlock.
 func (r *AlertRepository) Update(ctx context.Context, alert *entity.Alert) error {
-	// First, get the current version
-	var currentVersion int
-	versionQuery := `SELECT version FROM alerts WHERE id = ?`
-	err := r.db.Primary().QueryRowContext(ctx, versionQuery, alert.ID).Scan(&currentVersion)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return repository.ErrNotFound
+	return r.db.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := r.update(ctx, alert); err != nil {
+			return err
+		}
+		return r.saveReferences(ctx, alert)
+	})
+}
+
+// update runs one read-version-then-update cycle of Update.
+func (r *AlertRepository) update(ctx context.Context, alert *entity.Alert) error {
+	// Expect the version the alert was read at; alerts that carry no
+	// version are checked against the stored one
+	currentVersion := alert.Version
+	if currentVersion == 0 {
+		versionQuery := `SELECT version FROM alerts WHERE id = ?`
+		err := r.db.getExecutor(ctx).QueryRowContext(ctx, versionQuery, alert.ID).Scan(&currentVersion)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return repository.ErrAlertNotFound
+			}
+			return fmt.Errorf("checking alert version: %w", err)
 		}
-		return fmt.Errorf("checking alert version: %w", err)
 	}
 
 	// Serialize JSON fields
@@ -276,6 +537,13 @@ func (r *AlertRepository) Update(ctx context.Context, alert *entity.Alert) error
 		return fmt.Errorf("marshaling external_references: %w", err)
 	}
 
+	transitionState, transitionAt, transitionBy := transitionColumns(alert.LastTransition)
+
+	assignmentsJSON, err := assignmentsColumn(alert.Assignments)
+	if err != nil {
+		return fmt.Errorf("marshaling assignments: %w", err)
+	}
+
 	// Update with optimistic locking (increment version)
 	query := `
 		UPDATE alerts SET
@@ -295,11 +563,19 @@ func (r *AlertRepository) Update(ctx context.Context, alert *entity.Alert) error
 			acked_by = ?,
 			resolved_at = ?,
 			updated_at = ?,
+			updated_by = ?,
+			last_transition_state = ?,
+			last_transition_at = ?,
+			last_transition_by = ?,
+			correlation_id = ?,
+			last_seen_at = ?,
+			assigned_to = ?,
+			assignments = ?,
 			version = version + 1
 		WHERE id = ? AND version = ?
 	`
 
-	result, err := r.db.Primary().ExecContext(ctx, query,
+	result, err := r.db.getExecutor(ctx).ExecContext(ctx, query,
 		alert.Fingerprint,
 		alert.Name,
 		alert.Instance,
@@ -316,6 +592,14 @@ func (r *AlertRepository) Update(ctx context.Context, alert *entity.Alert) error
 		nullString(alert.AckedBy),
 		nullTime(alert.ResolvedAt),
 		timeToTimestamp(alert.UpdatedAt),
+		nullString(alert.UpdatedBy),
+		transitionState,
+		transitionAt,
+		transitionBy,
+		nullString(alert.CorrelationID),
+		nullTimeValue(alert.LastSeenAt),
+		nullString(alert.AssignedTo),
+		assignmentsJSON,
 		alert.ID,
 		currentVersion,
 	)
@@ -334,19 +618,21 @@ func (r *AlertRepository) Update(ctx context.Context, alert *entity.Alert) error
 		// Check if alert exists
 		var exists bool
 		existsQuery := `SELECT COUNT(*) > 0 FROM alerts WHERE id = ?`
-		err := r.db.Primary().QueryRowContext(ctx, existsQuery, alert.ID).Scan(&exists)
+		err := r.db.getExecutor(ctx).QueryRowContext(ctx, existsQuery, alert.ID).Scan(&exists)
 		if err != nil {
 			return fmt.Errorf("checking alert existence: %w", err)
 		}
 
 		if !exists {
-			return repository.ErrNotFound
+			return repository.ErrAlertNotFound
 		}
 
 		// Alert exists but version mismatch - concurrent update detected
 		return repository.ErrConcurrentUpdate
 	}
 
+	alert.Version = currentVersion + 1
+	r.db.wrote(alertKeys(alert)...)
 	return nil
 }
 
@@ -358,13 +644,14 @@ func (r *AlertRepository) FindActive(ctx context.Context) ([]*entity.Alert, erro
 			severity, state, labels, annotations,
 			external_references,
 			fired_at, acked_at, acked_by, resolved_at,
-			version, created_at, updated_at
+			version, created_at, updated_at,
+			updated_by, last_transition_state, last_transition_at, last_transition_by,
+			correlation_id, tenant_id, last_seen_at, assigned_to, assignments
 		FROM alerts
-		WHERE state != 'resolved'
-		ORDER BY fired_at DESC
-	`
+		WHERE state != 'resolved'`
+	query, args := withTenant(ctx, query)
 
-	rows, err := r.db.Replica().QueryContext(ctx, query)
+	rows, err := r.db.getReader(ctx).QueryContext(ctx, query+" ORDER BY fired_at DESC", args...)
 	if err != nil {
 		return nil, fmt.Errorf("querying active alerts: %w", err)
 	}
@@ -381,13 +668,14 @@ func (r *AlertRepository) FindFiring(ctx context.Context) ([]*entity.Alert, erro
 			severity, state, labels, annotations,
 			external_references,
 			fired_at, acked_at, acked_by, resolved_at,
-			version, created_at, updated_at
+			version, created_at, updated_at,
+			updated_by, last_transition_state, last_transition_at, last_transition_by,
+			correlation_id, tenant_id, last_seen_at, assigned_to, assignments
 		FROM alerts
-		WHERE state IN ('active', 'acknowledged')
-		ORDER BY fired_at DESC
-	`
+		WHERE state IN ('active', 'acknowledged')`
+	query, args := withTenant(ctx, query)
 
-	rows, err := r.db.Replica().QueryContext(ctx, query)
+	rows, err := r.db.getReader(ctx).QueryContext(ctx, query+" ORDER BY fired_at DESC", args...)
 	if err != nil {
 		return nil, fmt.Errorf("querying firing alerts: %w", err)
 	}
@@ -396,9 +684,107 @@ func (r *AlertRepository) FindFiring(ctx context.Context) ([]*entity.Alert, erro
 	return r.scanAlerts(rows)
 }
 
+// FindFiringByCorrelationID returns the firing alerts sharing a correlation ID, oldest first.
+func (r *AlertRepository) FindFiringByCorrelationID(ctx context.Context, correlationID string) ([]*entity.Alert, error) {
+	// Read from primary: a correlated alert may arrive right after its root
+	query := `
+		SELECT
+			id, fingerprint, name, instance, target, summary, description,
+			severity, state, labels, annotations,
+			external_references,
+			fired_at, acked_at, acked_by, resolved_at,
+			version, created_at, updated_at,
+			updated_by, last_transition_state, last_transition_at, last_transition_by,
+			correlation_id, tenant_id, last_seen_at, assigned_to, assignments
+		FROM alerts
+		WHERE correlation_id = ? AND state IN ('active', 'acknowledged')`
+	query, args := withTenant(ctx, query, correlationID)
+
+	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, query+" ORDER BY fired_at ASC, created_at ASC", args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying alerts by correlation id: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanAlerts(rows)
+}
+
+// FindNotSeenSince returns the firing alerts last seen before the given
+// time, least recently seen first.
+func (r *AlertRepository) FindNotSeenSince(ctx context.Context, before time.Time) ([]*entity.Alert, error) {
+	query := `
+		SELECT
+			id, fingerprint, name, instance, target, summary, description,
+			severity, state, labels, annotations,
+			external_references,
+			fired_at, acked_at, acked_by, resolved_at,
+			version, created_at, updated_at,
+			updated_by, last_transition_state, last_transition_at, last_transition_by,
+			correlation_id, tenant_id, last_seen_at, assigned_to, assignments
+		FROM alerts
+		WHERE state IN ('active', 'acknowledged') AND last_seen_at < ?`
+	query, args := withTenant(ctx, query, timeToTimestamp(before))
+
+	rows, err := r.db.getReader(ctx).QueryContext(ctx, query+" ORDER BY last_seen_at ASC", args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying alerts not seen since: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanAlerts(rows)
+}
+
+// FindByLabels returns the firing alerts carrying every matcher label, most
+// recently fired first. The matchers are checked in the query as a single
+// JSON_CONTAINS, which handles any label name.
+func (r *AlertRepository) FindByLabels(ctx context.Context, matchers map[string]string) ([]*entity.Alert, error) {
+	query := `
+		SELECT
+			id, fingerprint, name, instance, target, summary, description,
+			severity, state, labels, annotations,
+			external_references,
+			fired_at, acked_at, acked_by, resolved_at,
+			version, created_at, updated_at,
+			updated_by, last_transition_state, last_transition_at, last_transition_by,
+			correlation_id, tenant_id, last_seen_at, assigned_to, assignments
+		FROM alerts
+		WHERE state IN ('active', 'acknowledged')`
+	var args []interface{}
+	if len(matchers) > 0 {
+		candidate, err := json.Marshal(matchers)
+		if err != nil {
+			return nil, fmt.Errorf("marshaling label matchers: %w", err)
+		}
+		query += " AND JSON_CONTAINS(labels, ?)"
+		args = append(args, string(candidate))
+	}
+	query, args = withTenant(ctx, query, args...)
+
+	rows, err := r.db.getReader(ctx).QueryContext(ctx, query+" ORDER BY fired_at DESC", args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying alerts by labels: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanAlerts(rows)
+}
+
 // GetActiveAlerts returns active alerts, optionally filtered by severity.
 // Pass empty string for severity to get all active alerts.
 func (r *AlertRepository) GetActiveAlerts(ctx context.Context, severity string) ([]*entity.Alert, error) {
+	return r.getActiveAlerts(ctx, severity, " ORDER BY fired_at DESC")
+}
+
+// GetActiveAlertsByPriority returns active alerts, optionally filtered by
+// severity, most urgent first.
+func (r *AlertRepository) GetActiveAlertsByPriority(ctx context.Context, severity string, weights entity.SeverityWeights) ([]*entity.Alert, error) {
+	orderBy, orderArgs := priorityOrder(weights)
+	return r.getActiveAlerts(ctx, severity, orderBy, orderArgs...)
+}
+
+// getActiveAlerts returns active alerts, optionally filtered by severity, in
+// the order of the given ORDER BY clause.
+func (r *AlertRepository) getActiveAlerts(ctx context.Context, severity, orderBy string, orderArgs ...interface{}) ([]*entity.Alert, error) {
 	var query string
 	var args []interface{}
 
@@ -410,11 +796,11 @@ func (r *AlertRepository) GetActiveAlerts(ctx context.Context, severity string)
 				severity, state, labels, annotations,
 				external_references,
 				fired_at, acked_at, acked_by, resolved_at,
-				version, created_at, updated_at
+				version, created_at, updated_at,
+				updated_by, last_transition_state, last_transition_at, last_transition_by,
+				correlation_id, tenant_id, last_seen_at, assigned_to, assignments
 			FROM alerts
-			WHERE state != 'resolved'
-			ORDER BY fired_at DESC
-		`
+			WHERE state != 'resolved'`
 	} else {
 		// Filter by severity
 		query = `
@@ -423,15 +809,16 @@ func (r *AlertRepository) GetActiveAlerts(ctx context.Context, severity string)
 				severity, state, labels, annotations,
 				external_references,
 				fired_at, acked_at, acked_by, resolved_at,
-				version, created_at, updated_at
+				version, created_at, updated_at,
+				updated_by, last_transition_state, last_transition_at, last_transition_by,
+				correlation_id, tenant_id, last_seen_at, assigned_to, assignments
 			FROM alerts
-			WHERE state != 'resolved' AND severity = ?
-			ORDER BY fired_at DESC
-		`
+			WHERE state != 'resolved' AND severity = ?`
 		args = append(args, severity)
 	}
+	query, args = withTenant(ctx, query, args...)
 
-	rows, err := r.db.Replica().QueryContext(ctx, query, args...)
+	rows, err := r.db.getReader(ctx).QueryContext(ctx, query+orderBy, append(args, orderArgs...)...)
 	if err != nil {
 		return nil, fmt.Errorf("querying active alerts: %w", err)
 	}
@@ -440,12 +827,52 @@ func (r *AlertRepository) GetActiveAlerts(ctx context.Context, severity string)
 	return r.scanAlerts(rows)
 }
 
+// CountByStateSeverity aggregates alerts by state and severity in a single query.
+func (r *AlertRepository) CountByStateSeverity(ctx context.Context) ([]*entity.StateSeverityCount, error) {
+	query := `
+		SELECT state, severity, COUNT(*), MIN(fired_at)
+		FROM alerts
+		WHERE 1 = 1`
+	query, args := withTenant(ctx, query)
+
+	rows, err := r.db.getReader(ctx).QueryContext(ctx, query+" GROUP BY state, severity ORDER BY state, severity", args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying alert counts: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make([]*entity.StateSeverityCount, 0)
+	for rows.Next() {
+		var (
+			state    string
+			severity string
+			count    int
+			oldest   time.Time
+		)
+		if err := rows.Scan(&state, &severity, &count, &oldest); err != nil {
+			return nil, fmt.Errorf("scanning alert count: %w", err)
+		}
+		counts = append(counts, &entity.StateSeverityCount{
+			State:         entity.AlertState(state),
+			Severity:      entity.AlertSeverity(severity),
+			Count:         count,
+			OldestFiredAt: oldest,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating alert counts: %w", err)
+	}
+
+	return counts, nil
+}
+
 // Delete removes an alert by ID.
-// Returns ErrNotFound if the alert doesn't exist.
+// Returns ErrAlertNotFound if the alert doesn't exist.
 func (r *AlertRepository) Delete(ctx context.Context, id string) error {
 	query := `DELETE FROM alerts WHERE id = ?`
 
-	result, err := r.db.Primary().ExecContext(ctx, query, id)
+	result, err := r.db.getExecutor(ctx).ExecContext(ctx, query, id)
 	if err != nil {
 		return fmt.Errorf("deleting alert: %w", err)
 	}
@@ -456,9 +883,10 @@ func (r *AlertRepository) Delete(ctx context.Context, id string) error {
 	}
 
 	if rowsAffected == 0 {
-		return repository.ErrNotFound
+		return repository.ErrAlertNotFound
 	}
 
+	r.db.wrote(alertKey(id))
 	return nil
 }
 
@@ -471,7 +899,8 @@ func (r *AlertRepository) scanAlerts(rows *sql.Rows) ([]*entity.Alert, error) {
 		var labelsJSON, annotationsJSON, externalReferencesJSON string
 		var ackedBy sql.NullString
 		var ackedAt, resolvedAt sql.NullTime
-		var version int
+		var updatedBy, transitionState, transitionBy, correlationID, assignedTo, assignments sql.NullString
+		var transitionAt, lastSeenAt sql.NullTime
 
 		err := rows.Scan(
 			&alert.ID,
@@ -490,9 +919,18 @@ func (r *AlertRepository) scanAlerts(rows *sql.Rows) ([]*entity.Alert, error) {
 			&ackedAt,
 			&ackedBy,
 			&resolvedAt,
-			&version,
+			&alert.Version,
 			&alert.CreatedAt,
 			&alert.UpdatedAt,
+			&updatedBy,
+			&transitionState,
+			&transitionAt,
+			&transitionBy,
+			&correlationID,
+			&alert.TenantID,
+			&lastSeenAt,
+			&assignedTo,
+			&assignments,
 		)
 
 		if err != nil {
@@ -514,6 +952,16 @@ func (r *AlertRepository) scanAlerts(rows *sql.Rows) ([]*entity.Alert, error) {
 		alert.AckedBy = stringValue(ackedBy)
 		alert.AckedAt = timePtr(ackedAt)
 		alert.ResolvedAt = timePtr(resolvedAt)
+		alert.UpdatedBy = stringValue(updatedBy)
+		alert.LastTransition = transitionFromColumns(transitionState, transitionAt, transitionBy)
+		alert.CorrelationID = stringValue(correlationID)
+		if seen := timePtr(lastSeenAt); seen != nil {
+			alert.LastSeenAt = *seen
+		}
+		alert.AssignedTo = stringValue(assignedTo)
+		if alert.Assignments, err = assignmentsFromColumn(assignments); err != nil {
+			return nil, fmt.Errorf("unmarshaling assignments: %w", err)
+		}
 
 		alerts = append(alerts, &alert)
 	}
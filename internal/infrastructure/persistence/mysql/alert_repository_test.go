@@ -316,7 +316,7 @@ func TestAlertRepository_FindActive(t *testing.T) {
 	// Create resolved alert
 	resolvedAlert := createTestAlert()
 	resolvedAlert.ID = "resolved-alert"
-	resolvedAlert.Resolve(now)
+	resolvedAlert.Resolve("alertmanager", now)
 	err = repo.Save(ctx, resolvedAlert)
 	require.NoError(t, err)
 
@@ -354,7 +354,7 @@ func TestAlertRepository_FindFiring(t *testing.T) {
 	// Create resolved alert
 	resolvedAlert := createTestAlert()
 	resolvedAlert.ID = "resolved-alert"
-	resolvedAlert.Resolve(now)
+	resolvedAlert.Resolve("alertmanager", now)
 	err = repo.Save(ctx, resolvedAlert)
 	require.NoError(t, err)
 
@@ -0,0 +1,23 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository/repositorytest"
+)
+
+// TestRepositoryConformance skips in short mode or without a MySQL server,
+// like the other integration tests.
+func TestRepositoryConformance(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) repositorytest.Repositories {
+		db := setupTestDB(t)
+		t.Cleanup(func() { db.Close() })
+
+		return repositorytest.Repositories{
+			Alert:    NewAlertRepository(db),
+			AckEvent: NewAckEventRepository(db),
+			Silence:  NewSilenceRepository(db),
+			Delivery: NewDeliveryRepository(db),
+		}
+	})
+}
@@ -3,6 +3,7 @@ package mysql
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
@@ -17,29 +18,21 @@ type DB struct {
 	primary *sql.DB
 	replica *sql.DB
 	config  *config.MySQLConfig
+	retry   *retrier
+	recent  *recentWrites // nil without a replica or read-your-writes window
 }
 
 // NewDB creates a new MySQL database connection with connection pooling.
 // It establishes connections to both primary and optional replica instances.
+// The primary is reached through cfg.DSN if set, else through a DSN built
+// from the primary fields.
 func NewDB(cfg *config.MySQLConfig) (*DB, error) {
 	if cfg == nil {
 		return nil, fmt.Errorf("mysql config is required")
 	}
 
-	// Build primary DSN
-	primaryDSN := buildDSN(
-		cfg.Primary.Host,
-		cfg.Primary.Port,
-		cfg.Primary.Database,
-		cfg.Primary.Username,
-		cfg.Primary.Password,
-		cfg.Charset,
-		cfg.ParseTime,
-		cfg.Timeout,
-	)
-
 	// Open primary connection
-	primary, err := sql.Open("mysql", primaryDSN)
+	primary, err := sql.Open("mysql", primaryDSN(cfg))
 	if err != nil {
 		return nil, fmt.Errorf("opening primary connection: %w", err)
 	}
@@ -62,6 +55,7 @@ func NewDB(cfg *config.MySQLConfig) (*DB, error) {
 	db := &DB{
 		primary: primary,
 		config:  cfg,
+		retry:   newRetrier(cfg.Pool.RetryAttempts),
 	}
 
 	// Set up replica if enabled
@@ -100,11 +94,32 @@ func NewDB(cfg *config.MySQLConfig) (*DB, error) {
 		}
 
 		db.replica = replica
+		if cfg.Replica.MaxStaleness > 0 {
+			db.recent = newRecentWrites(cfg.Replica.MaxStaleness)
+		}
 	}
 
 	return db, nil
 }
 
+// primaryDSN returns the DSN of the primary: the configured one, or one
+// built from the primary fields.
+func primaryDSN(cfg *config.MySQLConfig) string {
+	if cfg.DSN != "" {
+		return cfg.DSN
+	}
+	return buildDSN(
+		cfg.Primary.Host,
+		cfg.Primary.Port,
+		cfg.Primary.Database,
+		cfg.Primary.Username,
+		cfg.Primary.Password,
+		cfg.Charset,
+		cfg.ParseTime,
+		cfg.Timeout,
+	)
+}
+
 // buildDSN constructs a MySQL DSN string.
 // Format: user:password@tcp(host:port)/database?params
 func buildDSN(host string, port int, database, username, password, charset string, parseTime bool, timeout time.Duration) string {
@@ -238,10 +253,28 @@ func (db *DB) BeginTx(ctx context.Context) (repository.Transaction, error) {
 	return &mysqlTx{Tx: tx}, nil
 }
 
-// WithTransaction executes a function within a transaction.
-// If the function returns an error, the transaction is rolled back.
-// Otherwise, the transaction is committed.
+// WithTransaction implements repository.TransactionManager. If fn returns an
+// error, the transaction is rolled back; otherwise it is committed. A call
+// nested in another WithTransaction joins the outer transaction instead of
+// starting a new one. MySQL rolls back the whole transaction on a deadlock or
+// lock wait timeout, so the outermost call runs fn again in a new
+// transaction; a deadlock that persists is returned wrapped in ErrDeadlock.
 func (db *DB) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := repository.TxFromContext(ctx).(*mysqlTx); ok {
+		return fn(ctx)
+	}
+
+	err := db.retry.doIf(ctx, isDeadlock, func() error {
+		return db.runTx(ctx, fn)
+	})
+	if isDeadlock(err) && !errors.Is(err, ErrDeadlock) {
+		return fmt.Errorf("%w: %w", ErrDeadlock, err)
+	}
+	return err
+}
+
+// runTx executes fn in a new transaction.
+func (db *DB) runTx(ctx context.Context, fn func(ctx context.Context) error) error {
 	tx, err := db.BeginTx(ctx)
 	if err != nil {
 		return err
@@ -265,3 +298,53 @@ func (db *DB) WithTransaction(ctx context.Context, fn func(ctx context.Context)
 
 	return nil
 }
+
+// getExecutor returns the transaction from context, or the primary database.
+// Writes that must commit together use it so they join an ongoing transaction.
+// Outside a transaction, statements failing with a transient error are retried.
+func (db *DB) getExecutor(ctx context.Context) interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+} {
+	if tx := repository.TxFromContext(ctx); tx != nil {
+		if sqlTx, ok := tx.(*mysqlTx); ok {
+			return sqlTx.Tx
+		}
+	}
+	return retryingDB{db: db.primary, retry: db.retry}
+}
+
+// getReader returns the transaction from context, or the replica database.
+// Reads inside a transaction must see its uncommitted writes.
+func (db *DB) getReader(ctx context.Context) interface {
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+} {
+	if tx := repository.TxFromContext(ctx); tx != nil {
+		if sqlTx, ok := tx.(*mysqlTx); ok {
+			return sqlTx.Tx
+		}
+	}
+	return retryingDB{db: db.Replica(), retry: db.retry}
+}
+
+// readerFor is getReader for a read of the row identified by key. Rows
+// written within the replica's max staleness are read from the primary, so
+// callers see their own writes despite replication lag.
+func (db *DB) readerFor(ctx context.Context, key string) interface {
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+} {
+	if db.recent != nil && repository.TxFromContext(ctx) == nil && db.recent.contains(key) {
+		return retryingDB{db: db.primary, retry: db.retry}
+	}
+	return db.getReader(ctx)
+}
+
+// wrote records that the rows identified by keys were written, for readerFor.
+func (db *DB) wrote(keys ...string) {
+	if db.recent != nil {
+		db.recent.mark(keys...)
+	}
+}
@@ -116,6 +116,26 @@ func TestBuildDSN(t *testing.T) {
 	}
 }
 
+func TestPrimaryDSN(t *testing.T) {
+	cfg := &config.MySQLConfig{
+		Primary: config.MySQLInstanceConfig{
+			Host:     "localhost",
+			Port:     3306,
+			Database: "test_db",
+			Username: "root",
+			Password: "password",
+		},
+		Timeout:   5 * time.Second,
+		ParseTime: true,
+		Charset:   "utf8mb4",
+	}
+	assert.Equal(t, "root:password@tcp(localhost:3306)/test_db?charset=utf8mb4&parseTime=true&timeout=5s", primaryDSN(cfg))
+
+	// A configured DSN is used as is
+	cfg.DSN = "app:secret@unix(/var/run/mysqld/mysqld.sock)/alerts?parseTime=true&tls=preferred"
+	assert.Equal(t, cfg.DSN, primaryDSN(cfg))
+}
+
 func TestDB_Replica(t *testing.T) {
 	db := &DB{
 		primary: nil, // Mock for testing
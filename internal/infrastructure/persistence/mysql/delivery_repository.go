@@ -0,0 +1,104 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+)
+
+// DeliveryRepository provides MySQL implementation of repository.DeliveryRepository.
+type DeliveryRepository struct {
+	db *DB
+}
+
+// NewDeliveryRepository creates a new MySQL-backed delivery repository.
+func NewDeliveryRepository(db *DB) *DeliveryRepository {
+	return &DeliveryRepository{db: db}
+}
+
+// Save records a delivery.
+// Returns ErrAlertNotFound if the referenced alert doesn't exist (FK constraint).
+func (r *DeliveryRepository) Save(ctx context.Context, delivery *entity.NotificationDelivery) error {
+	query := `
+		INSERT INTO notification_deliveries (
+			id, alert_id, notifier, action, status,
+			external_reference, error, created_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	_, err := r.db.getExecutor(ctx).ExecContext(ctx, query,
+		delivery.ID,
+		delivery.AlertID,
+		delivery.Notifier,
+		string(delivery.Action),
+		string(delivery.Status),
+		nullString(delivery.ExternalReference),
+		nullString(delivery.Error),
+		timeToTimestamp(delivery.CreatedAt),
+	)
+
+	if err != nil {
+		if isForeignKeyError(err) {
+			return repository.ErrAlertNotFound
+		}
+		if isDuplicateError(err) {
+			return repository.ErrAlreadyExists
+		}
+		return fmt.Errorf("inserting notification delivery: %w", err)
+	}
+
+	r.db.wrote(deliveriesKey(delivery.AlertID))
+	return nil
+}
+
+// FindByAlertID retrieves the deliveries of an alert, oldest first.
+// Returns empty slice if none found.
+func (r *DeliveryRepository) FindByAlertID(ctx context.Context, alertID string) ([]*entity.NotificationDelivery, error) {
+	query := `
+		SELECT
+			id, alert_id, notifier, action, status,
+			external_reference, error, created_at
+		FROM notification_deliveries
+		WHERE alert_id = ?
+		ORDER BY created_at ASC`
+
+	rows, err := r.db.readerFor(ctx, deliveriesKey(alertID)).QueryContext(ctx, query, alertID)
+	if err != nil {
+		return nil, fmt.Errorf("querying notification deliveries by alert ID: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := make([]*entity.NotificationDelivery, 0)
+	for rows.Next() {
+		var delivery entity.NotificationDelivery
+		var externalReference, deliveryErr sql.NullString
+
+		err := rows.Scan(
+			&delivery.ID,
+			&delivery.AlertID,
+			&delivery.Notifier,
+			&delivery.Action,
+			&delivery.Status,
+			&externalReference,
+			&deliveryErr,
+			&delivery.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scanning notification delivery row: %w", err)
+		}
+
+		delivery.ExternalReference = stringValue(externalReference)
+		delivery.Error = stringValue(deliveryErr)
+
+		deliveries = append(deliveries, &delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating notification delivery rows: %w", err)
+	}
+
+	return deliveries, nil
+}
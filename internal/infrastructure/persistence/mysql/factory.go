@@ -10,14 +10,18 @@ import (
 
 // Repositories holds all MySQL repository implementations.
 type Repositories struct {
-	Alert    repository.AlertRepository
-	AckEvent repository.AckEventRepository
-	Silence  repository.SilenceRepository
+	Alert       repository.AlertRepository
+	AckEvent    repository.AckEventRepository
+	Silence     repository.SilenceRepository
+	Idempotency repository.IdempotencyStore
+	Outbox      repository.OutboxRepository
+	Delivery    repository.DeliveryRepository
 }
 
 // NewRepositories creates all MySQL repository implementations.
-// It establishes a database connection, runs migrations, and returns all repositories.
-func NewRepositories(cfg *config.MySQLConfig) (*Repositories, *DB, error) {
+// It establishes a database connection, runs pending migrations if autoMigrate
+// is set, and returns all repositories.
+func NewRepositories(cfg *config.MySQLConfig, autoMigrate bool) (*Repositories, *DB, error) {
 	if cfg == nil {
 		return nil, nil, fmt.Errorf("mysql config is required")
 	}
@@ -29,18 +33,22 @@ func NewRepositories(cfg *config.MySQLConfig) (*Repositories, *DB, error) {
 	}
 
 	// Run migrations
-	migrator := NewMigrator(db.Primary())
-	ctx := context.Background()
-	if err := migrator.Up(ctx); err != nil {
-		db.Close()
-		return nil, nil, fmt.Errorf("running migrations: %w", err)
+	if autoMigrate {
+		migrator := NewMigrator(db.Primary())
+		if err := migrator.Up(context.Background()); err != nil {
+			db.Close()
+			return nil, nil, fmt.Errorf("running migrations: %w", err)
+		}
 	}
 
 	// Create repositories
 	repos := &Repositories{
-		Alert:    NewAlertRepository(db),
-		AckEvent: NewAckEventRepository(db),
-		Silence:  NewSilenceRepository(db),
+		Alert:       NewAlertRepository(db),
+		AckEvent:    NewAckEventRepository(db),
+		Silence:     NewSilenceRepository(db),
+		Idempotency: NewIdempotencyStore(db),
+		Outbox:      NewOutboxRepository(db),
+		Delivery:    NewDeliveryRepository(db),
 	}
 
 	return repos, db, nil
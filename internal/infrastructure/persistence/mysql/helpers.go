@@ -1,14 +1,17 @@
 package mysql
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/go-sql-driver/mysql"
 
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
 )
 
@@ -33,6 +36,18 @@ func nullTime(t *time.Time) sql.NullTime {
 	}
 }
 
+// nullTimeValue converts a time.Time to sql.NullTime.
+// Returns NULL if the time is zero.
+func nullTimeValue(t time.Time) sql.NullTime {
+	if t.IsZero() {
+		return sql.NullTime{Valid: false}
+	}
+	return sql.NullTime{
+		Time:  t.UTC(),
+		Valid: true,
+	}
+}
+
 // timePtr converts sql.NullTime to *time.Time.
 // Returns nil if the value is NULL.
 func timePtr(nt sql.NullTime) *time.Time {
@@ -51,6 +66,96 @@ func stringValue(ns sql.NullString) string {
 	return ns.String
 }
 
+// transitionColumns splits a state transition into nullable columns for storage.
+func transitionColumns(t *entity.StateTransition) (state sql.NullString, at sql.NullTime, by sql.NullString) {
+	if t == nil {
+		return sql.NullString{}, sql.NullTime{}, sql.NullString{}
+	}
+	return nullString(string(t.State)), nullTime(&t.At), nullString(t.By)
+}
+
+// transitionFromColumns rebuilds a state transition from nullable columns.
+// Returns nil if no transition was recorded.
+func transitionFromColumns(state sql.NullString, at sql.NullTime, by sql.NullString) *entity.StateTransition {
+	if !state.Valid || !at.Valid {
+		return nil
+	}
+	return &entity.StateTransition{
+		State: entity.AlertState(state.String),
+		At:    at.Time,
+		By:    stringValue(by),
+	}
+}
+
+// storedAssignment is the JSON form of an assignment change.
+type storedAssignment struct {
+	To string    `json:"to,omitempty"`
+	At time.Time `json:"at"`
+	By string    `json:"by,omitempty"`
+}
+
+// assignmentsColumn serializes an assignment history for storage.
+// Returns NULL for an empty history.
+func assignmentsColumn(assignments []entity.Assignment) (sql.NullString, error) {
+	if len(assignments) == 0 {
+		return sql.NullString{}, nil
+	}
+	stored := make([]storedAssignment, len(assignments))
+	for i, a := range assignments {
+		stored[i] = storedAssignment{To: a.To, At: a.At.UTC(), By: a.By}
+	}
+	data, err := json.Marshal(stored)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: string(data), Valid: true}, nil
+}
+
+// assignmentsFromColumn rebuilds an assignment history from its column.
+// Returns nil if no assignment was recorded.
+func assignmentsFromColumn(column sql.NullString) ([]entity.Assignment, error) {
+	if !column.Valid || column.String == "" {
+		return nil, nil
+	}
+	var stored []storedAssignment
+	if err := json.Unmarshal([]byte(column.String), &stored); err != nil {
+		return nil, err
+	}
+	assignments := make([]entity.Assignment, len(stored))
+	for i, a := range stored {
+		assignments[i] = entity.Assignment{To: a.To, At: a.At, By: a.By}
+	}
+	return assignments, nil
+}
+
+// withTenant restricts a query ending in a WHERE clause to the tenant the
+// context is scoped to, if any, and returns it with its arguments.
+func withTenant(ctx context.Context, query string, args ...interface{}) (string, []interface{}) {
+	tenantID, ok := repository.TenantFromContext(ctx)
+	if !ok {
+		return query, args
+	}
+	return query + " AND tenant_id = ?", append(args, tenantID)
+}
+
+// priorityOrder builds the ORDER BY clause matching
+// entity.SeverityWeights.SortByPriority and returns it with its arguments.
+func priorityOrder(weights entity.SeverityWeights) (string, []interface{}) {
+	var b strings.Builder
+	var args []interface{}
+	b.WriteString(" ORDER BY (")
+	if len(weights) > 0 {
+		b.WriteString("CASE severity")
+		for _, severity := range weights.Severities() {
+			b.WriteString(" WHEN ? THEN ?")
+			args = append(args, string(severity), weights[severity])
+		}
+		b.WriteString(" ELSE 0 END + ")
+	}
+	b.WriteString("CASE WHEN state = 'active' THEN ? ELSE 0 END) DESC, fired_at ASC")
+	return b.String(), append(args, entity.UnackedPriorityBoost)
+}
+
 // timeToTimestamp converts time.Time to MySQL TIMESTAMP format.
 // MySQL TIMESTAMP is stored in UTC and converted to local timezone on retrieval.
 func timeToTimestamp(t time.Time) time.Time {
@@ -127,10 +232,8 @@ func mapError(err error) error {
 			return repository.ErrAlreadyExists
 		case 1452: // ER_NO_REFERENCED_ROW_2 - Foreign key constraint fails
 			return repository.ErrNotFound // Referenced entity doesn't exist
-		case 1213: // ER_LOCK_DEADLOCK - Deadlock found
-			return repository.ErrConcurrentUpdate // Retry needed
-		case 1205: // ER_LOCK_WAIT_TIMEOUT - Lock wait timeout exceeded
-			return repository.ErrConcurrentUpdate // Retry needed
+		case 1213, 1205: // ER_LOCK_DEADLOCK, ER_LOCK_WAIT_TIMEOUT
+			return fmt.Errorf("%w: %w", ErrDeadlock, err) // Safe to retry, unlike a version mismatch
 		}
 	}
 
@@ -138,15 +241,35 @@ func mapError(err error) error {
 	return err
 }
 
+// ErrDeadlock classifies MySQL deadlocks (1213) and lock wait timeouts (1205).
+// The losing statement was rolled back, so unlike repository.ErrConcurrentUpdate
+// it is safe to retry.
+var ErrDeadlock = errors.New("mysql deadlock or lock wait timeout")
+
+// isDeadlock checks if an error is a deadlock or lock wait timeout.
+func isDeadlock(err error) bool {
+	if errors.Is(err, ErrDeadlock) {
+		return true
+	}
+
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		return mysqlErr.Number == 1213 || mysqlErr.Number == 1205
+	}
+	return false
+}
+
 // isRetryable checks if an error is retryable (transient failure).
 // Returns true for deadlocks, lock timeouts, and connection errors.
+// ErrConcurrentUpdate is a real conflict between writers and is never retryable.
 func isRetryable(err error) bool {
-	if err == nil {
+	if err == nil || errors.Is(err, repository.ErrConcurrentUpdate) {
 		return false
 	}
 
 	// Check for MySQL-specific retryable errors
-	if mysqlErr, ok := err.(*mysql.MySQLError); ok {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
 		switch mysqlErr.Number {
 		case 1213: // ER_LOCK_DEADLOCK
 			return true
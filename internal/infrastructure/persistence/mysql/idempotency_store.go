@@ -0,0 +1,56 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// IdempotencyStore provides MySQL implementation of repository.IdempotencyStore
+// backed by the processed_webhooks table.
+type IdempotencyStore struct {
+	db *DB
+}
+
+// NewIdempotencyStore creates a new MySQL-backed idempotency store.
+func NewIdempotencyStore(db *DB) *IdempotencyStore {
+	return &IdempotencyStore{db: db}
+}
+
+// MarkProcessed records the key for ttl. Returns false if it was already recorded and unexpired.
+// The primary key makes the check atomic across replicas of alert-bridge.
+func (s *IdempotencyStore) MarkProcessed(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	now := time.Now().UTC()
+
+	// Purge expired keys so they can be recorded again
+	if _, err := s.db.getExecutor(ctx).ExecContext(ctx,
+		`DELETE FROM processed_webhooks WHERE expires_at <= ?`,
+		timeToTimestamp(now),
+	); err != nil {
+		return false, fmt.Errorf("deleting expired idempotency keys: %w", err)
+	}
+
+	result, err := s.db.getExecutor(ctx).ExecContext(ctx, `
+		INSERT IGNORE INTO processed_webhooks (idempotency_key, expires_at, created_at)
+		VALUES (?, ?, ?)
+	`, key, timeToTimestamp(now.Add(ttl)), timeToTimestamp(now))
+	if err != nil {
+		return false, fmt.Errorf("inserting idempotency key: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("getting rows affected: %w", err)
+	}
+	return rowsAffected == 1, nil
+}
+
+// Release removes the key, so it can be recorded again.
+func (s *IdempotencyStore) Release(ctx context.Context, key string) error {
+	if _, err := s.db.getExecutor(ctx).ExecContext(ctx,
+		`DELETE FROM processed_webhooks WHERE idempotency_key = ?`, key,
+	); err != nil {
+		return fmt.Errorf("deleting idempotency key: %w", err)
+	}
+	return nil
+}
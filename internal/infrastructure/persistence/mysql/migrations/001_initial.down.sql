@@ -0,0 +1,8 @@
+-- MySQL Schema Rollback: Initial Schema
+-- Version: 1
+-- Description: Drop the application tables. schema_migrations is kept so the
+-- migrator can still record versions; child tables go first for foreign keys.
+
+DROP TABLE IF EXISTS silences;
+DROP TABLE IF EXISTS ack_events;
+DROP TABLE IF EXISTS alerts;
@@ -149,8 +149,10 @@ CREATE TABLE IF NOT EXISTS silences (
 -- Initial Migration Record
 -- ============================================================================
 
+-- The alerts table above already includes external_references, so version 2
+-- is recorded as well and its ALTER is skipped on new databases
 INSERT INTO schema_migrations (version, applied_at)
-VALUES (1, CURRENT_TIMESTAMP)
+VALUES (1, CURRENT_TIMESTAMP), (2, CURRENT_TIMESTAMP)
 ON DUPLICATE KEY UPDATE applied_at = CURRENT_TIMESTAMP;
 
 -- ============================================================================
@@ -0,0 +1,6 @@
+-- MySQL Schema Rollback: External References
+-- Version: 2
+-- Description: Nothing to revert. The initial schema already creates
+-- external_references, and upgraded databases kept the old columns.
+
+DO 0;
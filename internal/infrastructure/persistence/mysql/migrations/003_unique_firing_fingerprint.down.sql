@@ -0,0 +1,7 @@
+-- MySQL Schema Rollback: Unique Firing Fingerprint
+-- Version: 3
+-- Description: Drop the one-firing-alert-per-fingerprint constraint
+
+ALTER TABLE alerts
+DROP INDEX idx_alerts_firing_fingerprint,
+DROP COLUMN firing_fingerprint;
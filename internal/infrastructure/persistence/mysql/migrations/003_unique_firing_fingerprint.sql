@@ -0,0 +1,22 @@
+-- MySQL Schema Migration: Unique Firing Fingerprint
+-- Version: 3
+-- Description: Allow at most one firing (active or acknowledged) alert per fingerprint
+-- so concurrent webhook deliveries cannot create duplicate alerts and notifications.
+-- MySQL has no partial indexes, so a generated column holds the fingerprint only while
+-- the alert is firing; NULLs are not considered duplicates by the unique index.
+
+-- Resolve older duplicates that would violate the new index, keeping the newest alert
+UPDATE alerts older
+JOIN alerts newer
+    ON newer.fingerprint = older.fingerprint
+    AND newer.state IN ('active', 'acknowledged')
+    AND (newer.created_at > older.created_at
+         OR (newer.created_at = older.created_at AND newer.id > older.id))
+SET older.state = 'resolved',
+    older.resolved_at = COALESCE(older.resolved_at, older.updated_at)
+WHERE older.state IN ('active', 'acknowledged');
+
+ALTER TABLE alerts
+ADD COLUMN firing_fingerprint VARCHAR(255)
+    GENERATED ALWAYS AS (CASE WHEN state IN ('active', 'acknowledged') THEN fingerprint ELSE NULL END) STORED,
+ADD UNIQUE INDEX idx_alerts_firing_fingerprint (firing_fingerprint);
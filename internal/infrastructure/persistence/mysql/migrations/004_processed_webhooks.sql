@@ -0,0 +1,11 @@
+-- MySQL Schema Migration: Processed Webhooks
+-- Version: 4
+-- Description: Track processed webhook idempotency keys to skip redelivered payloads
+
+CREATE TABLE IF NOT EXISTS processed_webhooks (
+    idempotency_key VARCHAR(128) NOT NULL PRIMARY KEY,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    INDEX idx_processed_webhooks_expires_at (expires_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,9 @@
+-- MySQL Schema Rollback: Alert Transitions
+-- Version: 5
+-- Description: Drop the last state change columns
+
+ALTER TABLE alerts
+DROP COLUMN last_transition_by,
+DROP COLUMN last_transition_at,
+DROP COLUMN last_transition_state,
+DROP COLUMN updated_by;
@@ -0,0 +1,9 @@
+-- MySQL Schema Migration: Alert Transitions
+-- Version: 5
+-- Description: Record who made the last state change of an alert and when
+
+ALTER TABLE alerts
+ADD COLUMN updated_by VARCHAR(255) DEFAULT NULL AFTER updated_at,
+ADD COLUMN last_transition_state VARCHAR(20) DEFAULT NULL AFTER updated_by,
+ADD COLUMN last_transition_at TIMESTAMP NULL DEFAULT NULL AFTER last_transition_state,
+ADD COLUMN last_transition_by VARCHAR(255) DEFAULT NULL AFTER last_transition_at;
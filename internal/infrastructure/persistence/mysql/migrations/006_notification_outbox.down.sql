@@ -0,0 +1,5 @@
+-- MySQL Schema Rollback: Notification Outbox
+-- Version: 6
+-- Description: Drop the notification outbox table
+
+DROP TABLE IF EXISTS notification_outbox;
@@ -0,0 +1,15 @@
+-- MySQL Schema Migration: Notification Outbox
+-- Version: 6
+-- Description: Record pending notifications in the same transaction as the alert change
+
+CREATE TABLE IF NOT EXISTS notification_outbox (
+    id VARCHAR(255) NOT NULL PRIMARY KEY,
+    alert_id VARCHAR(255) NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL,
+    sent_at TIMESTAMP NULL DEFAULT NULL,
+
+    INDEX idx_notification_outbox_pending (sent_at, created_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,7 @@
+-- MySQL Schema Rollback: Alert Correlation
+-- Version: 7
+-- Description: Drop the correlation column
+
+ALTER TABLE alerts
+DROP INDEX idx_alerts_correlation_id,
+DROP COLUMN correlation_id;
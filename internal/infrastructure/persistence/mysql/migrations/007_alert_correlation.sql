@@ -0,0 +1,7 @@
+-- MySQL Schema Migration: Alert Correlation
+-- Version: 7
+-- Description: Group alerts that share the configured correlation labels
+
+ALTER TABLE alerts
+ADD COLUMN correlation_id VARCHAR(64) DEFAULT NULL AFTER last_transition_by,
+ADD INDEX idx_alerts_correlation_id (correlation_id);
@@ -0,0 +1,8 @@
+-- MySQL Schema Rollback: Alertmanager Silences
+-- Version: 8
+-- Description: Drop imported silences and restore the original source values
+
+DELETE FROM silences WHERE source = 'alertmanager';
+
+ALTER TABLE silences
+MODIFY COLUMN source ENUM('slack', 'pagerduty', 'api') NOT NULL;
@@ -0,0 +1,6 @@
+-- MySQL Schema Migration: Alertmanager Silences
+-- Version: 8
+-- Description: Allow silences imported from Alertmanager
+
+ALTER TABLE silences
+MODIFY COLUMN source ENUM('slack', 'pagerduty', 'api', 'alertmanager') NOT NULL;
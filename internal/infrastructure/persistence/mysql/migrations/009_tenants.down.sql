@@ -0,0 +1,17 @@
+-- MySQL Schema Rollback: Tenants
+-- Version: 9
+-- Description: Drop the tenant columns and restore the global firing fingerprint index.
+-- Fails if firing alerts of different tenants share a fingerprint.
+
+ALTER TABLE ack_events
+DROP COLUMN tenant_id;
+
+ALTER TABLE silences
+DROP INDEX idx_silences_tenant_id,
+DROP COLUMN tenant_id;
+
+ALTER TABLE alerts
+DROP INDEX idx_alerts_tenant_id,
+DROP INDEX idx_alerts_firing_fingerprint,
+ADD UNIQUE INDEX idx_alerts_firing_fingerprint (firing_fingerprint),
+DROP COLUMN tenant_id;
@@ -0,0 +1,18 @@
+-- MySQL Schema Migration: Tenants
+-- Version: 9
+-- Description: Scope alerts, silences and ack events to a tenant. The empty
+-- tenant is the default, so existing rows keep single-tenant behavior.
+-- Firing fingerprints only need to be unique within a tenant.
+
+ALTER TABLE alerts
+ADD COLUMN tenant_id VARCHAR(255) NOT NULL DEFAULT '' AFTER correlation_id,
+DROP INDEX idx_alerts_firing_fingerprint,
+ADD UNIQUE INDEX idx_alerts_firing_fingerprint (tenant_id, firing_fingerprint),
+ADD INDEX idx_alerts_tenant_id (tenant_id, state);
+
+ALTER TABLE silences
+ADD COLUMN tenant_id VARCHAR(255) NOT NULL DEFAULT '',
+ADD INDEX idx_silences_tenant_id (tenant_id);
+
+ALTER TABLE ack_events
+ADD COLUMN tenant_id VARCHAR(255) NOT NULL DEFAULT '';
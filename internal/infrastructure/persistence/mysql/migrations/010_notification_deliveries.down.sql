@@ -0,0 +1,5 @@
+-- MySQL Schema Rollback: Notification Deliveries
+-- Version: 10
+-- Description: Drop the notification deliveries table
+
+DROP TABLE IF EXISTS notification_deliveries;
@@ -0,0 +1,17 @@
+-- MySQL Schema Migration: Notification Deliveries
+-- Version: 10
+-- Description: Record whether each notification reached its notifier
+
+CREATE TABLE IF NOT EXISTS notification_deliveries (
+    id VARCHAR(255) NOT NULL PRIMARY KEY,
+    alert_id VARCHAR(255) NOT NULL,
+    notifier VARCHAR(100) NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    external_reference VARCHAR(1024) DEFAULT NULL,
+    error TEXT DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL,
+
+    FOREIGN KEY (alert_id) REFERENCES alerts(id) ON DELETE CASCADE,
+    INDEX idx_notification_deliveries_alert_created (alert_id, created_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
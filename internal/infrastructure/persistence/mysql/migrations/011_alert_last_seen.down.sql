@@ -0,0 +1,7 @@
+-- MySQL Schema Rollback: Alert Last Seen
+-- Version: 11
+-- Description: Drop the last seen column
+
+ALTER TABLE alerts
+DROP INDEX idx_alerts_state_last_seen,
+DROP COLUMN last_seen_at;
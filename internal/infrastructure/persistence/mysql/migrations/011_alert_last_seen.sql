@@ -0,0 +1,9 @@
+-- MySQL Schema Migration: Alert Last Seen
+-- Version: 11
+-- Description: Track when each alert was last re-fired so stale alerts can be auto-resolved
+
+ALTER TABLE alerts
+ADD COLUMN last_seen_at TIMESTAMP NULL DEFAULT NULL AFTER tenant_id,
+ADD INDEX idx_alerts_state_last_seen (state, last_seen_at);
+
+UPDATE alerts SET last_seen_at = updated_at, updated_at = updated_at WHERE last_seen_at IS NULL;
@@ -0,0 +1,8 @@
+-- MySQL Schema Rollback: Alert Assignment
+-- Version: 12
+-- Description: Drop the assignment columns
+
+ALTER TABLE alerts
+DROP INDEX idx_alerts_assigned_to,
+DROP COLUMN assignments,
+DROP COLUMN assigned_to;
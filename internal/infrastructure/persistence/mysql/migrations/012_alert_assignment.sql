@@ -0,0 +1,8 @@
+-- MySQL Schema Migration: Alert Assignment
+-- Version: 12
+-- Description: Track the responder each alert is assigned to and the history of assignment changes
+
+ALTER TABLE alerts
+ADD COLUMN assigned_to VARCHAR(255) DEFAULT NULL AFTER last_seen_at,
+ADD COLUMN assignments JSON DEFAULT NULL AFTER assigned_to,
+ADD INDEX idx_alerts_assigned_to (assigned_to);
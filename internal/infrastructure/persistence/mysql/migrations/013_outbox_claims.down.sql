@@ -0,0 +1,6 @@
+-- MySQL Schema Rollback: Outbox Claims
+-- Version: 13
+-- Description: Drop the outbox claim column
+
+ALTER TABLE notification_outbox
+DROP COLUMN claimed_until;
@@ -0,0 +1,6 @@
+-- MySQL Schema Migration: Outbox Claims
+-- Version: 13
+-- Description: Lease pending outbox entries to one dispatcher so instances do not deliver them twice
+
+ALTER TABLE notification_outbox
+ADD COLUMN claimed_until TIMESTAMP NULL DEFAULT NULL AFTER sent_at;
@@ -0,0 +1,5 @@
+-- MySQL Schema Rollback: Alert References
+-- Version: 14
+-- Description: Drop the external reference index table
+
+DROP TABLE IF EXISTS alert_references;
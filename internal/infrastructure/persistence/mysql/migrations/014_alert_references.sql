@@ -0,0 +1,38 @@
+-- MySQL Schema Migration: Alert References
+-- Version: 14
+-- Description: Index external reference IDs so FindByExternalReference does
+-- not scan the external_references JSON of every alert. IDs are indexed by
+-- SHA-256 so values of any length fit the index.
+
+CREATE TABLE IF NOT EXISTS alert_references (
+    alert_id VARCHAR(255) NOT NULL,
+    reference_system VARCHAR(100) NOT NULL,
+    reference_hash CHAR(64) NOT NULL,
+
+    FOREIGN KEY (alert_id) REFERENCES alerts(id) ON DELETE CASCADE,
+    INDEX idx_alert_references_lookup (reference_system, reference_hash)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Index the references of existing alerts: every ID of a comma-separated
+-- value, and the whole value when it holds several
+INSERT INTO alert_references (alert_id, reference_system, reference_hash)
+WITH RECURSIVE refs (alert_id, reference_system, rest, reference_id) AS (
+    SELECT alerts.id, stored.reference_system,
+        CAST(CONCAT(JSON_UNQUOTE(JSON_EXTRACT(alerts.external_references,
+            CONCAT('$."', stored.reference_system, '"'))), ',') AS CHAR(4096)),
+        CAST(IF(LOCATE(',', JSON_UNQUOTE(JSON_EXTRACT(alerts.external_references,
+            CONCAT('$."', stored.reference_system, '"')))) > 0,
+            JSON_UNQUOTE(JSON_EXTRACT(alerts.external_references,
+            CONCAT('$."', stored.reference_system, '"'))), NULL) AS CHAR(4096))
+    FROM alerts,
+        JSON_TABLE(JSON_KEYS(alerts.external_references), '$[*]'
+            COLUMNS (reference_system VARCHAR(100) PATH '$')) AS stored
+    UNION ALL
+    SELECT alert_id, reference_system, SUBSTRING(rest, LOCATE(',', rest) + 1),
+        SUBSTRING_INDEX(rest, ',', 1)
+    FROM refs
+    WHERE rest != ''
+)
+SELECT DISTINCT alert_id, reference_system, SHA2(reference_id, 256)
+FROM refs
+WHERE reference_id != '';
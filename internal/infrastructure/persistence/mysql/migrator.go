@@ -29,37 +29,31 @@ type Migration struct {
 	Version int
 	Name    string
 	SQL     string
+
+	// DownSQL reverts the migration. Empty if the migration cannot be rolled back.
+	DownSQL string
 }
 
-// Up runs all pending migrations to bring the database schema up to date.
-// It tracks applied migrations in the schema_migrations table.
+// Up applies every migration whose version is not yet recorded in the
+// schema_migrations table, in version order. Running it again is a no-op.
 func (m *Migrator) Up(ctx context.Context) error {
-	// Get current version
-	currentVersion, err := m.currentVersion(ctx)
-	if err != nil {
-		return fmt.Errorf("getting current version: %w", err)
-	}
-
 	// Load all migrations
 	migrations, err := m.loadMigrations()
 	if err != nil {
 		return fmt.Errorf("loading migrations: %w", err)
 	}
 
-	// Filter pending migrations
-	pending := make([]Migration, 0)
 	for _, migration := range migrations {
-		if migration.Version > currentVersion {
-			pending = append(pending, migration)
+		// Re-read after each step: a migration may record more than its own
+		// version, e.g. 001_initial already contains 002
+		applied, err := m.appliedVersions(ctx)
+		if err != nil {
+			return fmt.Errorf("getting applied versions: %w", err)
+		}
+		if applied[migration.Version] {
+			continue
 		}
-	}
-
-	if len(pending) == 0 {
-		return nil // No pending migrations
-	}
 
-	// Apply each pending migration in a transaction
-	for _, migration := range pending {
 		if err := m.applyMigration(ctx, migration); err != nil {
 			return fmt.Errorf("applying migration %d (%s): %w", migration.Version, migration.Name, err)
 		}
@@ -68,34 +62,78 @@ func (m *Migrator) Up(ctx context.Context) error {
 	return nil
 }
 
-// Down rolls back the last applied migration.
-// This is not commonly used in production but useful for development.
-func (m *Migrator) Down(ctx context.Context) error {
-	// Get current version
-	currentVersion, err := m.currentVersion(ctx)
+// Down rolls back applied migrations above toVersion, newest first, running
+// each migration's down file and removing its version record. Down(ctx, 0)
+// reverts the whole schema except the schema_migrations table itself.
+func (m *Migrator) Down(ctx context.Context, toVersion int) error {
+	migrations, err := m.loadMigrations()
 	if err != nil {
-		return fmt.Errorf("getting current version: %w", err)
+		return fmt.Errorf("loading migrations: %w", err)
 	}
 
-	if currentVersion == 0 {
-		return nil // No migrations to roll back
+	applied, err := m.appliedVersions(ctx)
+	if err != nil {
+		return fmt.Errorf("getting applied versions: %w", err)
 	}
 
-	// For down migrations, we would need separate down migration files
-	// For now, we'll just remove the version record
-	// In production, down migrations are rarely used
-	query := `DELETE FROM schema_migrations WHERE version = ?`
-	if _, err := m.db.ExecContext(ctx, query, currentVersion); err != nil {
-		return fmt.Errorf("removing migration version: %w", err)
+	for i := len(migrations) - 1; i >= 0; i-- {
+		migration := migrations[i]
+		if migration.Version <= toVersion || !applied[migration.Version] {
+			continue
+		}
+
+		if err := m.revertMigration(ctx, migration); err != nil {
+			return fmt.Errorf("reverting migration %d (%s): %w", migration.Version, migration.Name, err)
+		}
 	}
 
 	return nil
 }
 
-// currentVersion returns the latest applied migration version.
-// Returns 0 if no migrations have been applied.
-func (m *Migrator) currentVersion(ctx context.Context) (int, error) {
-	// First, check if schema_migrations table exists
+// AppliedVersions returns the recorded migration versions in ascending order.
+func (m *Migrator) AppliedVersions(ctx context.Context) ([]int, error) {
+	applied, err := m.appliedVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]int, 0, len(applied))
+	for version := range applied {
+		versions = append(versions, version)
+	}
+	sort.Ints(versions)
+	return versions, nil
+}
+
+// appliedVersions returns the set of recorded migration versions.
+// Returns an empty set if the schema_migrations table doesn't exist yet.
+func (m *Migrator) appliedVersions(ctx context.Context) (map[int]bool, error) {
+	applied := make(map[int]bool)
+
+	exists, err := m.migrationsTableExists(ctx)
+	if err != nil || !exists {
+		return applied, err
+	}
+
+	rows, err := m.db.QueryContext(ctx, `SELECT version FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("querying applied versions: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var version int
+		if err := rows.Scan(&version); err != nil {
+			return nil, fmt.Errorf("scanning applied version: %w", err)
+		}
+		applied[version] = true
+	}
+
+	return applied, rows.Err()
+}
+
+// migrationsTableExists reports whether the schema_migrations table exists.
+func (m *Migrator) migrationsTableExists(ctx context.Context) (bool, error) {
 	var tableExists bool
 	checkTableQuery := `
 		SELECT COUNT(*) > 0
@@ -104,7 +142,18 @@ func (m *Migrator) currentVersion(ctx context.Context) (int, error) {
 		AND table_name = 'schema_migrations'
 	`
 	if err := m.db.QueryRowContext(ctx, checkTableQuery).Scan(&tableExists); err != nil {
-		return 0, fmt.Errorf("checking schema_migrations table: %w", err)
+		return false, fmt.Errorf("checking schema_migrations table: %w", err)
+	}
+	return tableExists, nil
+}
+
+// currentVersion returns the latest applied migration version.
+// Returns 0 if no migrations have been applied.
+func (m *Migrator) currentVersion(ctx context.Context) (int, error) {
+	// First, check if schema_migrations table exists
+	tableExists, err := m.migrationsTableExists(ctx)
+	if err != nil {
+		return 0, err
 	}
 
 	if !tableExists {
@@ -136,9 +185,11 @@ func (m *Migrator) applyMigration(ctx context.Context, migration Migration) erro
 	}
 	defer tx.Rollback()
 
-	// Execute migration SQL
-	if _, err := tx.ExecContext(ctx, migration.SQL); err != nil {
-		return fmt.Errorf("executing migration SQL: %w", err)
+	// Execute migration SQL one statement at a time; the DSN does not enable multiStatements
+	for _, stmt := range splitStatements(migration.SQL) {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("executing migration SQL: %w", err)
+		}
 	}
 
 	// Record migration version (if not already recorded by the migration itself)
@@ -159,8 +210,67 @@ func (m *Migrator) applyMigration(ctx context.Context, migration Migration) erro
 	return nil
 }
 
+// revertMigration runs a migration's down SQL and removes its version record.
+// MySQL commits DDL implicitly, so a failure midway can leave the step partly reverted.
+func (m *Migrator) revertMigration(ctx context.Context, migration Migration) error {
+	if migration.DownSQL == "" {
+		return fmt.Errorf("no down migration")
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, stmt := range splitStatements(migration.DownSQL) {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("executing down migration SQL: %w", err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM schema_migrations WHERE version = ?`, migration.Version); err != nil {
+		return fmt.Errorf("removing migration version: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// splitStatements splits a migration file into statements, dropping "--"
+// comment lines. Statements end with a semicolon at the end of a line.
+func splitStatements(sqlText string) []string {
+	var (
+		statements []string
+		current    strings.Builder
+	)
+	for _, line := range strings.Split(sqlText, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+
+		current.WriteString(line)
+		current.WriteString("\n")
+		if strings.HasSuffix(trimmed, ";") {
+			if stmt := strings.TrimSuffix(strings.TrimSpace(current.String()), ";"); stmt != "" {
+				statements = append(statements, stmt)
+			}
+			current.Reset()
+		}
+	}
+	if stmt := strings.TrimSpace(current.String()); stmt != "" {
+		statements = append(statements, stmt)
+	}
+	return statements
+}
+
 // loadMigrations loads all migration files from the embedded filesystem.
-// It parses filenames like "001_initial.sql" to extract version numbers.
+// It parses filenames like "001_initial.sql" to extract version numbers and
+// pairs each with its optional "001_initial.down.sql" rollback.
 func (m *Migrator) loadMigrations() ([]Migration, error) {
 	entries, err := migrationFiles.ReadDir("migrations")
 	if err != nil {
@@ -168,6 +278,7 @@ func (m *Migrator) loadMigrations() ([]Migration, error) {
 	}
 
 	migrations := make([]Migration, 0, len(entries))
+	downSQL := make(map[int]string)
 
 	for _, entry := range entries {
 		if entry.IsDir() {
@@ -190,15 +301,20 @@ func (m *Migrator) loadMigrations() ([]Migration, error) {
 			return nil, fmt.Errorf("parsing version from filename %s: %w", filename, err)
 		}
 
-		// Extract name (remove .sql extension)
-		name := strings.TrimSuffix(parts[1], ".sql")
-
 		// Read migration SQL
 		content, err := migrationFiles.ReadFile("migrations/" + filename)
 		if err != nil {
 			return nil, fmt.Errorf("reading migration file %s: %w", filename, err)
 		}
 
+		if strings.HasSuffix(filename, ".down.sql") {
+			downSQL[version] = string(content)
+			continue
+		}
+
+		// Extract name (remove .sql extension)
+		name := strings.TrimSuffix(parts[1], ".sql")
+
 		migrations = append(migrations, Migration{
 			Version: version,
 			Name:    name,
@@ -206,6 +322,10 @@ func (m *Migrator) loadMigrations() ([]Migration, error) {
 		})
 	}
 
+	for i := range migrations {
+		migrations[i].DownSQL = downSQL[migrations[i].Version]
+	}
+
 	// Sort by version
 	sort.Slice(migrations, func(i, j int) bool {
 		return migrations[i].Version < migrations[j].Version
@@ -214,6 +334,11 @@ func (m *Migrator) loadMigrations() ([]Migration, error) {
 	return migrations, nil
 }
 
+// Migrations returns all available migrations, ordered by version.
+func (m *Migrator) Migrations() ([]Migration, error) {
+	return m.loadMigrations()
+}
+
 // Version returns the current migration version.
 func (m *Migrator) Version(ctx context.Context) (int, error) {
 	return m.currentVersion(ctx)
@@ -272,7 +272,7 @@ func TestMigrator_ApplyMigration(t *testing.T) {
 	_, _ = db.Primary().Exec("DROP TABLE IF EXISTS test_table")
 }
 
-func TestMigrator_Down(t *testing.T) {
+func TestMigrator_DownAndReapply(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration test in short mode")
 	}
@@ -301,40 +301,78 @@ func TestMigrator_Down(t *testing.T) {
 	}
 	defer db.Close()
 
-	// Create schema_migrations table and insert a version
-	_, _ = db.Primary().Exec("DROP TABLE IF EXISTS schema_migrations")
-	_, err = db.Primary().Exec(`
-		CREATE TABLE schema_migrations (
-			version INT PRIMARY KEY NOT NULL,
-			applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
-		)
-	`)
-	require.NoError(t, err)
+	m := NewMigrator(db.Primary())
+	ctx := context.Background()
 
-	_, err = db.Primary().Exec("INSERT INTO schema_migrations (version) VALUES (1)")
-	require.NoError(t, err)
+	// Start from an empty schema
+	require.NoError(t, m.Up(ctx))
+	require.NoError(t, m.Down(ctx, 0))
 
-	m := NewMigrator(db.Primary())
+	migrations, err := m.Migrations()
+	require.NoError(t, err)
+	latest := migrations[len(migrations)-1].Version
 
-	ctx := context.Background()
+	require.NoError(t, m.Up(ctx))
+	applied, err := m.AppliedVersions(ctx)
+	require.NoError(t, err)
+	assert.Len(t, applied, len(migrations))
+	assert.Equal(t, latest, applied[len(applied)-1])
 
-	// Current version should be 1
+	// Roll back the newest migration only
+	require.NoError(t, m.Down(ctx, latest-1))
 	version, err := m.Version(ctx)
 	require.NoError(t, err)
-	assert.Equal(t, 1, version)
+	assert.Equal(t, latest-1, version)
+
+	// Re-applying restores it and is idempotent
+	require.NoError(t, m.Up(ctx))
+	require.NoError(t, m.Up(ctx))
+	version, err = m.Version(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, latest, version)
 
-	// Run down migration
-	err = m.Down(ctx)
+	// Rolling back everything leaves an empty version table
+	require.NoError(t, m.Down(ctx, 0))
+	applied, err = m.AppliedVersions(ctx)
 	require.NoError(t, err)
+	assert.Empty(t, applied)
 
-	// Version should now be 0
-	version, err = m.Version(ctx)
+	var tableExists bool
+	err = db.Primary().QueryRow(`
+		SELECT COUNT(*) > 0
+		FROM information_schema.tables
+		WHERE table_schema = DATABASE()
+		AND table_name = 'alerts'
+	`).Scan(&tableExists)
 	require.NoError(t, err)
-	assert.Equal(t, 0, version)
+	assert.False(t, tableExists)
+}
 
-	// Running down again should be safe (no error)
-	err = m.Down(ctx)
+func TestMigrator_EveryMigrationHasDown(t *testing.T) {
+	migrations, err := (&Migrator{}).loadMigrations()
 	require.NoError(t, err)
+
+	for _, migration := range migrations {
+		assert.NotEmpty(t, migration.DownSQL, "migration %d (%s) has no down file", migration.Version, migration.Name)
+		assert.NotContains(t, migration.Name, ".down")
+	}
+}
+
+func TestSplitStatements(t *testing.T) {
+	statements := splitStatements(`-- comment; with a semicolon
+CREATE TABLE t (
+    id INT
+);
+
+-- another comment
+INSERT INTO t VALUES (1);
+DO 0`)
+
+	assert.Equal(t, []string{
+		"CREATE TABLE t (\n    id INT\n)",
+		"INSERT INTO t VALUES (1)",
+		"DO 0",
+	}, statements)
 }
 
 func TestNewMigrator(t *testing.T) {
@@ -0,0 +1,158 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+)
+
+// OutboxRepository implements repository.OutboxRepository for MySQL.
+// All queries go to the primary so the dispatcher never sees stale entries.
+type OutboxRepository struct {
+	db *DB
+}
+
+// NewOutboxRepository creates a new MySQL outbox repository.
+func NewOutboxRepository(db *DB) *OutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+// Save records a new pending entry, joining the transaction in ctx if any.
+func (r *OutboxRepository) Save(ctx context.Context, entry *entity.OutboxEntry) error {
+	query := `
+		INSERT INTO notification_outbox (
+			id, alert_id, action, attempts, last_error, created_at, sent_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+
+	_, err := r.db.getExecutor(ctx).ExecContext(ctx, query,
+		entry.ID,
+		entry.AlertID,
+		string(entry.Action),
+		entry.Attempts,
+		nullString(entry.LastError),
+		timeToTimestamp(entry.CreatedAt),
+		nullTime(entry.SentAt),
+	)
+	if err != nil {
+		if isDuplicateError(err) {
+			return repository.ErrAlreadyExists
+		}
+		return fmt.Errorf("inserting outbox entry: %w", err)
+	}
+
+	return nil
+}
+
+// ClaimPending claims up to limit undelivered, unclaimed entries below
+// maxAttempts, oldest first, for lease. Rows are locked with SKIP LOCKED
+// while they are claimed, so concurrent instances claim disjoint entries.
+func (r *OutboxRepository) ClaimPending(ctx context.Context, maxAttempts, limit int, lease time.Duration) ([]*entity.OutboxEntry, error) {
+	var entries []*entity.OutboxEntry
+	err := r.db.WithTransaction(ctx, func(ctx context.Context) error {
+		now := time.Now().UTC()
+
+		var err error
+		entries, err = r.findClaimable(ctx, maxAttempts, limit, now)
+		if err != nil || len(entries) == 0 {
+			return err
+		}
+
+		query := `UPDATE notification_outbox SET claimed_until = ? WHERE id = ?`
+		claimedUntil := timeToTimestamp(now.Add(lease))
+		for _, entry := range entries {
+			if _, err := r.db.getExecutor(ctx).ExecContext(ctx, query, claimedUntil, entry.ID); err != nil {
+				return fmt.Errorf("claiming outbox entry: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// findClaimable locks and returns the entries ClaimPending claims.
+func (r *OutboxRepository) findClaimable(ctx context.Context, maxAttempts, limit int, now time.Time) ([]*entity.OutboxEntry, error) {
+	query := `
+		SELECT id, alert_id, action, attempts, last_error, created_at, sent_at
+		FROM notification_outbox
+		WHERE sent_at IS NULL AND attempts < ?
+			AND (claimed_until IS NULL OR claimed_until <= ?)
+		ORDER BY created_at ASC, id ASC
+		LIMIT ?
+		FOR UPDATE SKIP LOCKED
+	`
+
+	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, query, maxAttempts, timeToTimestamp(now), limit)
+	if err != nil {
+		return nil, fmt.Errorf("querying pending outbox entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*entity.OutboxEntry
+	for rows.Next() {
+		var (
+			entry     entity.OutboxEntry
+			action    string
+			lastError sql.NullString
+			sentAt    sql.NullTime
+		)
+		if err := rows.Scan(&entry.ID, &entry.AlertID, &action, &entry.Attempts, &lastError, &entry.CreatedAt, &sentAt); err != nil {
+			return nil, fmt.Errorf("scanning outbox entry: %w", err)
+		}
+
+		entry.Action = entity.OutboxAction(action)
+		entry.LastError = stringValue(lastError)
+		entry.SentAt = timePtr(sentAt)
+		entries = append(entries, &entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating outbox entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+// MarkSent records the entry as delivered.
+func (r *OutboxRepository) MarkSent(ctx context.Context, id string, sentAt time.Time) error {
+	query := `UPDATE notification_outbox SET sent_at = ? WHERE id = ?`
+
+	result, err := r.db.getExecutor(ctx).ExecContext(ctx, query, timeToTimestamp(sentAt), id)
+	if err != nil {
+		return fmt.Errorf("marking outbox entry sent: %w", err)
+	}
+
+	return checkOutboxRowsAffected(result)
+}
+
+// MarkFailed increments the entry's attempt count, records the error and
+// keeps the entry claimed until retryAt, so polls before then skip it.
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id string, lastError string, retryAt time.Time) error {
+	query := `UPDATE notification_outbox SET attempts = attempts + 1, last_error = ?, claimed_until = ? WHERE id = ?`
+
+	result, err := r.db.getExecutor(ctx).ExecContext(ctx, query, nullString(lastError), timeToTimestamp(retryAt.UTC()), id)
+	if err != nil {
+		return fmt.Errorf("marking outbox entry failed: %w", err)
+	}
+
+	return checkOutboxRowsAffected(result)
+}
+
+// checkOutboxRowsAffected returns ErrNotFound if an update matched no entry.
+func checkOutboxRowsAffected(result sql.Result) error {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("checking rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
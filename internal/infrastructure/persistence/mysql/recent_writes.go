@@ -0,0 +1,110 @@
+package mysql
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+)
+
+// recentWrites remembers the keys of rows written within the last ttl, so
+// reads of them go to the primary instead of a replica that may not have
+// caught up yet.
+type recentWrites struct {
+	mu        sync.Mutex
+	ttl       time.Duration
+	written   map[string]time.Time // key -> when its read-your-writes window ends
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// newRecentWrites creates a recentWrites keeping keys for ttl.
+func newRecentWrites(ttl time.Duration) *recentWrites {
+	return &recentWrites{
+		ttl:     ttl,
+		written: make(map[string]time.Time),
+		now:     time.Now,
+	}
+}
+
+// mark records that the rows identified by keys were just written.
+func (w *recentWrites) mark(keys ...string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	now := w.now()
+	until := now.Add(w.ttl)
+	for _, key := range keys {
+		w.written[key] = until
+	}
+
+	// Drop expired keys at most once per window, so the set stays small
+	if now.Sub(w.lastSweep) < w.ttl {
+		return
+	}
+	for key, expires := range w.written {
+		if !now.Before(expires) {
+			delete(w.written, key)
+		}
+	}
+	w.lastSweep = now
+}
+
+// contains reports whether key was written within the last ttl.
+func (w *recentWrites) contains(key string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	expires, ok := w.written[key]
+	if !ok {
+		return false
+	}
+	if !w.now().Before(expires) {
+		delete(w.written, key)
+		return false
+	}
+	return true
+}
+
+// alertKeys returns the recentWrites keys under which an alert is read: its
+// ID, fingerprint and each of its external reference IDs.
+func alertKeys(alert *entity.Alert) []string {
+	keys := []string{alertKey(alert.ID), alertFingerprintKey(alert.Fingerprint)}
+	for key, value := range alert.ExternalReferences {
+		for _, id := range strings.Split(value, ",") {
+			keys = append(keys, alertReferenceKey(key, id))
+		}
+	}
+	return keys
+}
+
+func alertKey(id string) string {
+	return "alert:" + id
+}
+
+func alertFingerprintKey(fingerprint string) string {
+	return "alert_fingerprint:" + fingerprint
+}
+
+func alertReferenceKey(key, value string) string {
+	return "alert_reference:" + key + "=" + value
+}
+
+func silenceKey(id string) string {
+	return "silence:" + id
+}
+
+func ackEventKey(id string) string {
+	return "ack_event:" + id
+}
+
+// ackEventsKey is the key of the ack events of an alert.
+func ackEventsKey(alertID string) string {
+	return "ack_events:" + alertID
+}
+
+// deliveriesKey is the key of the notification deliveries of an alert.
+func deliveriesKey(alertID string) string {
+	return "deliveries:" + alertID
+}
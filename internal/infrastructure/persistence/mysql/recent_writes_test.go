@@ -0,0 +1,64 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+)
+
+func TestRecentWrites_ExpireAfterTTL(t *testing.T) {
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	w := newRecentWrites(time.Second)
+	w.now = func() time.Time { return now }
+
+	w.mark(alertKey("a1"))
+	assert.True(t, w.contains(alertKey("a1")))
+	assert.False(t, w.contains(alertKey("a2")))
+
+	now = now.Add(999 * time.Millisecond)
+	assert.True(t, w.contains(alertKey("a1")))
+
+	now = now.Add(time.Millisecond)
+	assert.False(t, w.contains(alertKey("a1")))
+
+	// Marks sweep expired keys so the set does not grow unbounded
+	w.mark(alertKey("a2"))
+	now = now.Add(2 * time.Second)
+	w.mark(alertKey("a3"))
+	assert.Len(t, w.written, 1)
+}
+
+func TestAlertRepository_ReadsOwnWritesFromPrimary(t *testing.T) {
+	conn := &fakeConn{matched: true}
+	db := newFakeDB(t, conn)
+	replica := sql.OpenDB(&fakeConn{})
+	t.Cleanup(func() { replica.Close() })
+	db.replica = replica
+	db.recent = newRecentWrites(time.Minute)
+
+	readsFrom := func(key string) *sql.DB {
+		return db.readerFor(context.Background(), key).(retryingDB).db
+	}
+
+	alert := entity.NewAlert("fp-1", "HighCPU", "host-1", "", "", entity.SeverityCritical)
+	alert.SetExternalReference("slack", "C1:1.0,C2:2.0")
+	assert.Same(t, replica, readsFrom(alertKey(alert.ID)))
+
+	repo := NewAlertRepository(db)
+	require.NoError(t, repo.Update(context.Background(), alert))
+
+	assert.Same(t, db.primary, readsFrom(alertKey(alert.ID)))
+	assert.Same(t, db.primary, readsFrom(alertFingerprintKey("fp-1")))
+	assert.Same(t, db.primary, readsFrom(alertReferenceKey("slack", "C2:2.0")))
+	assert.Same(t, replica, readsFrom(alertKey("other")))
+
+	// Without a window every read goes to the replica
+	db.recent = nil
+	assert.Same(t, replica, readsFrom(alertKey(alert.ID)))
+}
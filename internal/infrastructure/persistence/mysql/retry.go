@@ -0,0 +1,152 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+)
+
+// Backoff between retries of a transient query failure.
+const (
+	retryInitialInterval = 50 * time.Millisecond
+	retryMaxInterval     = time.Second
+	retryMultiplier      = 2.0
+	retryJitterFactor    = 0.2
+)
+
+// retrier re-runs database calls that failed with a transient driver error,
+// such as a deadlock, a lock wait timeout or a refused connection, with
+// exponential backoff and jitter.
+type retrier struct {
+	maxAttempts int // including the first try
+	sleep       func(ctx context.Context, d time.Duration) error
+}
+
+// newRetrier creates a retrier making at most maxAttempts calls.
+// Values below 1 are treated as a single attempt.
+func newRetrier(maxAttempts int) *retrier {
+	return &retrier{
+		maxAttempts: max(maxAttempts, 1),
+		sleep:       sleepContext,
+	}
+}
+
+// do calls fn until it succeeds, fails with an error that is not retryable,
+// the attempts are used up or ctx is done, and returns fn's last error.
+// Inside retryDeadlocks fn is called once, since the whole cycle is retried.
+func (r *retrier) do(ctx context.Context, fn func() error) error {
+	if ctx.Value(cycleRetryKey{}) != nil {
+		return fn()
+	}
+	return r.doIf(ctx, isRetryable, fn)
+}
+
+// doIf is do with the given classification of retryable errors.
+func (r *retrier) doIf(ctx context.Context, retryable func(error) bool, fn func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || !retryable(err) || attempt >= r.maxAttempts {
+			return err
+		}
+		if r.sleep(ctx, retryBackoff(attempt)) != nil {
+			return err
+		}
+	}
+}
+
+// retryBackoff calculates the delay after the given failed attempt.
+// Formula: min(retryInitialInterval * retryMultiplier^(attempt-1) * (1 ± jitter), retryMaxInterval)
+func retryBackoff(attempt int) time.Duration {
+	backoff := float64(retryInitialInterval) * math.Pow(retryMultiplier, float64(attempt-1))
+	backoff *= 1.0 + (rand.Float64()*2.0-1.0)*retryJitterFactor
+	if backoff > float64(retryMaxInterval) {
+		backoff = float64(retryMaxInterval)
+	}
+	return time.Duration(backoff)
+}
+
+// sleepContext waits for d, returning early with ctx's error if it is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// cycleRetryKey marks a context whose statements belong to a cycle retried as a whole.
+type cycleRetryKey struct{}
+
+// retryDeadlocks runs fn, running it again from the start if it fails with a
+// deadlock or lock wait timeout. MySQL rolls back the statement that lost,
+// so re-reading and re-applying it is safe; a version mismatch is returned
+// as ErrConcurrentUpdate and never retried. Inside a transaction fn runs
+// once, since the deadlock rolled back the whole transaction, which
+// WithTransaction retries instead.
+// A deadlock that persists is returned wrapped in ErrDeadlock.
+func (db *DB) retryDeadlocks(ctx context.Context, fn func(ctx context.Context) error) error {
+	var err error
+	if repository.TxFromContext(ctx) != nil {
+		err = fn(ctx)
+	} else {
+		cycleCtx := context.WithValue(ctx, cycleRetryKey{}, true)
+		err = db.retry.doIf(ctx, isDeadlock, func() error {
+			return fn(cycleCtx)
+		})
+	}
+
+	if isDeadlock(err) && !errors.Is(err, ErrDeadlock) {
+		return fmt.Errorf("%w: %w", ErrDeadlock, err)
+	}
+	return err
+}
+
+// retryingDB runs statements outside a transaction through a retrier.
+// Statements inside a transaction are never retried this way: MySQL rolls
+// back the whole transaction on a deadlock, so WithTransaction retries it as
+// a whole.
+type retryingDB struct {
+	db    *sql.DB
+	retry *retrier
+}
+
+func (r retryingDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	var result sql.Result
+	err := r.retry.do(ctx, func() error {
+		var err error
+		result, err = r.db.ExecContext(ctx, query, args...)
+		return err
+	})
+	return result, err
+}
+
+func (r retryingDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	var rows *sql.Rows
+	err := r.retry.do(ctx, func() error {
+		var err error
+		rows, err = r.db.QueryContext(ctx, query, args...)
+		return err
+	})
+	return rows, err
+}
+
+// QueryRowContext retries on the query error reported by Row.Err; errors
+// while scanning, including sql.ErrNoRows, surface from Scan as usual.
+func (r retryingDB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	var row *sql.Row
+	_ = r.retry.do(ctx, func() error {
+		row = r.db.QueryRowContext(ctx, query, args...)
+		return row.Err()
+	})
+	return row
+}
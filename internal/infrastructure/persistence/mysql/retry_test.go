@@ -0,0 +1,298 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+)
+
+var (
+	errDeadlock        = &mysql.MySQLError{Number: 1213, Message: "Deadlock found when trying to get lock; try restarting transaction"}
+	errLockWaitTimeout = &mysql.MySQLError{Number: 1205, Message: "Lock wait timeout exceeded; try restarting transaction"}
+)
+
+// newTestRetrier returns a retrier that records its backoffs instead of sleeping.
+func newTestRetrier(maxAttempts int) (*retrier, *[]time.Duration) {
+	var slept []time.Duration
+	r := newRetrier(maxAttempts)
+	r.sleep = func(ctx context.Context, d time.Duration) error {
+		slept = append(slept, d)
+		return ctx.Err()
+	}
+	return r, &slept
+}
+
+// failing returns a call that fails with the given errors in order, then succeeds.
+func failing(calls *int, errs ...error) func() error {
+	return func() error {
+		*calls++
+		if *calls <= len(errs) {
+			return errs[*calls-1]
+		}
+		return nil
+	}
+}
+
+func TestRetrier_RetriesTransientErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		errs      []error
+		wantCalls int
+		wantErr   error
+	}{
+		{name: "deadlock", errs: []error{errDeadlock}, wantCalls: 2},
+		{name: "lock wait timeout", errs: []error{errLockWaitTimeout, errLockWaitTimeout}, wantCalls: 3},
+		{name: "wrapped deadlock", errs: []error{fmt.Errorf("updating alert: %w", errDeadlock)}, wantCalls: 2},
+		{name: "connection refused", errs: []error{errors.New("dial tcp 127.0.0.1:3306: connect: connection refused")}, wantCalls: 2},
+		{name: "gives up after max attempts", errs: []error{errDeadlock, errDeadlock, errDeadlock, errDeadlock}, wantCalls: 3, wantErr: errDeadlock},
+		{name: "concurrent update is a real conflict", errs: []error{repository.ErrConcurrentUpdate}, wantCalls: 1, wantErr: repository.ErrConcurrentUpdate},
+		{name: "duplicate entry", errs: []error{&mysql.MySQLError{Number: 1062}}, wantCalls: 1, wantErr: &mysql.MySQLError{Number: 1062}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, slept := newTestRetrier(3)
+
+			calls := 0
+			err := r.do(context.Background(), failing(&calls, tt.errs...))
+
+			assert.Equal(t, tt.wantCalls, calls)
+			assert.Len(t, *slept, tt.wantCalls-1)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRetrier_SingleAttemptDisablesRetries(t *testing.T) {
+	r, slept := newTestRetrier(1)
+
+	calls := 0
+	err := r.do(context.Background(), failing(&calls, errDeadlock))
+
+	assert.ErrorIs(t, err, errDeadlock)
+	assert.Equal(t, 1, calls)
+	assert.Empty(t, *slept)
+}
+
+func TestRetrier_StopsWhenContextDone(t *testing.T) {
+	r, _ := newTestRetrier(5)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	calls := 0
+	err := r.do(ctx, failing(&calls, errDeadlock, errDeadlock))
+
+	assert.ErrorIs(t, err, errDeadlock)
+	assert.Equal(t, 1, calls)
+}
+
+func TestRetryBackoff(t *testing.T) {
+	for attempt := 1; attempt <= 10; attempt++ {
+		nominal := min(retryInitialInterval<<(attempt-1), retryMaxInterval)
+		backoff := retryBackoff(attempt)
+
+		assert.GreaterOrEqual(t, backoff, time.Duration(float64(nominal)*(1-retryJitterFactor)), "attempt %d", attempt)
+		assert.LessOrEqual(t, backoff, min(time.Duration(float64(nominal)*(1+retryJitterFactor)), retryMaxInterval), "attempt %d", attempt)
+	}
+}
+
+// fakeConn is a database/sql driver connection that fails UPDATE statements
+// with queued errors and reports whether the updated row exists.
+type fakeConn struct {
+	mu         sync.Mutex
+	updateErrs []error
+	updates    int
+	matched    bool // whether the UPDATE matched the expected version
+	commits    int
+	rollbacks  int
+}
+
+func (c *fakeConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Other statements, such as the reference index writes, succeed
+	if !strings.HasPrefix(strings.TrimSpace(query), "UPDATE") {
+		return driver.RowsAffected(1), nil
+	}
+
+	c.updates++
+	if len(c.updateErrs) > 0 {
+		err := c.updateErrs[0]
+		c.updateErrs = c.updateErrs[1:]
+		return nil, err
+	}
+	if c.matched {
+		return driver.RowsAffected(1), nil
+	}
+	return driver.RowsAffected(0), nil
+}
+
+// QueryContext answers the existence check run after an UPDATE matched no rows.
+func (c *fakeConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return &fakeRows{values: []driver.Value{int64(1)}}, nil
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("prepare not supported")
+}
+func (c *fakeConn) Close() error              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) { return fakeTx{c}, nil }
+
+func (c *fakeConn) Connect(context.Context) (driver.Conn, error) { return c, nil }
+func (c *fakeConn) Driver() driver.Driver                        { return nil }
+
+// fakeTx counts how transactions on a fakeConn end.
+type fakeTx struct{ conn *fakeConn }
+
+func (tx fakeTx) Commit() error {
+	tx.conn.mu.Lock()
+	defer tx.conn.mu.Unlock()
+	tx.conn.commits++
+	return nil
+}
+
+func (tx fakeTx) Rollback() error {
+	tx.conn.mu.Lock()
+	defer tx.conn.mu.Unlock()
+	tx.conn.rollbacks++
+	return nil
+}
+
+// fakeRows returns a single row.
+type fakeRows struct {
+	values []driver.Value
+	done   bool
+}
+
+func (r *fakeRows) Columns() []string { return []string{"exists"} }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	copy(dest, r.values)
+	return nil
+}
+
+func newFakeDB(t *testing.T, conn *fakeConn) *DB {
+	t.Helper()
+
+	primary := sql.OpenDB(conn)
+	t.Cleanup(func() { primary.Close() })
+
+	retry, _ := newTestRetrier(3)
+	return &DB{primary: primary, retry: retry}
+}
+
+func TestAlertRepository_UpdateRetriesDeadlocks(t *testing.T) {
+	tests := []struct {
+		name        string
+		updateErrs  []error
+		matched     bool
+		wantUpdates int
+		wantErr     error
+	}{
+		{name: "deadlock then success", updateErrs: []error{errDeadlock}, matched: true, wantUpdates: 2},
+		{name: "lock wait timeout then success", updateErrs: []error{errLockWaitTimeout, errDeadlock}, matched: true, wantUpdates: 3},
+		{name: "persistent deadlock", updateErrs: []error{errDeadlock, errDeadlock, errDeadlock}, matched: true, wantUpdates: 3, wantErr: ErrDeadlock},
+		{name: "version conflict is not retried", matched: false, wantUpdates: 1, wantErr: repository.ErrConcurrentUpdate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := &fakeConn{updateErrs: tt.updateErrs, matched: tt.matched}
+			repo := NewAlertRepository(newFakeDB(t, conn))
+
+			alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+			alert.Version = 1
+			err := repo.Update(context.Background(), alert)
+
+			assert.Equal(t, tt.wantUpdates, conn.updates)
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				assert.Equal(t, 2, alert.Version)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, 1, alert.Version)
+		})
+	}
+}
+
+func TestDB_WithTransactionRetriesDeadlocks(t *testing.T) {
+	tests := []struct {
+		name          string
+		updateErrs    []error
+		wantUpdates   int
+		wantRollbacks int
+		wantCommits   int
+		wantErr       error
+	}{
+		{name: "deadlock then success", updateErrs: []error{errDeadlock}, wantUpdates: 2, wantRollbacks: 1, wantCommits: 1},
+		{name: "lock wait timeout then success", updateErrs: []error{errLockWaitTimeout}, wantUpdates: 2, wantRollbacks: 1, wantCommits: 1},
+		{name: "persistent deadlock", updateErrs: []error{errDeadlock, errDeadlock, errDeadlock}, wantUpdates: 3, wantRollbacks: 3, wantErr: ErrDeadlock},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := &fakeConn{updateErrs: tt.updateErrs, matched: true}
+			db := newFakeDB(t, conn)
+			repo := NewAlertRepository(db)
+
+			runs := 0
+			err := db.WithTransaction(context.Background(), func(ctx context.Context) error {
+				runs++
+				// Each run starts from the stored version, as a re-read would
+				alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+				alert.Version = 1
+				return repo.Update(ctx, alert)
+			})
+
+			assert.Equal(t, tt.wantUpdates, runs)
+			assert.Equal(t, tt.wantUpdates, conn.updates)
+			assert.Equal(t, tt.wantRollbacks, conn.rollbacks)
+			assert.Equal(t, tt.wantCommits, conn.commits)
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestDB_WithTransactionDoesNotRetryVersionConflicts(t *testing.T) {
+	conn := &fakeConn{matched: false}
+	db := newFakeDB(t, conn)
+	repo := NewAlertRepository(db)
+
+	err := db.WithTransaction(context.Background(), func(ctx context.Context) error {
+		alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+		alert.Version = 1
+		return repo.Update(ctx, alert)
+	})
+
+	assert.ErrorIs(t, err, repository.ErrConcurrentUpdate)
+	assert.Equal(t, 1, conn.updates)
+	assert.Equal(t, 1, conn.rollbacks)
+}
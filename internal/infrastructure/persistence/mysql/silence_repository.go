@@ -32,16 +32,16 @@ func (r *SilenceRepository) Save(ctx context.Context, silence *entity.SilenceMar
 			id, alert_id, instance, fingerprint, labels,
 			start_at, end_at,
 			created_by, created_by_email, reason, source,
-			version, created_at
+			version, created_at, tenant_id
 		) VALUES (
 			?, ?, ?, ?, ?,
 			?, ?,
 			?, ?, ?, ?,
-			1, ?
+			1, ?, ?
 		)
 	`
 
-	_, err = r.db.Primary().ExecContext(ctx, query,
+	_, err = r.db.getExecutor(ctx).ExecContext(ctx, query,
 		silence.ID,
 		nullString(silence.AlertID),
 		nullString(silence.Instance),
@@ -54,6 +54,7 @@ func (r *SilenceRepository) Save(ctx context.Context, silence *entity.SilenceMar
 		silence.Reason,
 		string(silence.Source),
 		timeToTimestamp(silence.CreatedAt),
+		silence.TenantID,
 	)
 
 	if err != nil {
@@ -63,6 +64,8 @@ func (r *SilenceRepository) Save(ctx context.Context, silence *entity.SilenceMar
 		return fmt.Errorf("inserting silence: %w", err)
 	}
 
+	silence.Version = 1
+	r.db.wrote(silenceKey(silence.ID))
 	return nil
 }
 
@@ -74,17 +77,16 @@ func (r *SilenceRepository) FindByID(ctx context.Context, id string) (*entity.Si
 			id, alert_id, instance, fingerprint, labels,
 			start_at, end_at,
 			created_by, created_by_email, reason, source,
-			version, created_at
+			version, created_at, tenant_id
 		FROM silences
-		WHERE id = ?
-	`
+		WHERE id = ?`
+	query, args := withTenant(ctx, query, id)
 
 	var silence entity.SilenceMark
 	var alertID, instance, fingerprint, createdBy, createdByEmail sql.NullString
 	var labelsJSON string
-	var version int
 
-	err := r.db.Replica().QueryRowContext(ctx, query, id).Scan(
+	err := r.db.readerFor(ctx, silenceKey(id)).QueryRowContext(ctx, query, args...).Scan(
 		&silence.ID,
 		&alertID,
 		&instance,
@@ -96,8 +98,9 @@ func (r *SilenceRepository) FindByID(ctx context.Context, id string) (*entity.Si
 		&createdByEmail,
 		&silence.Reason,
 		&silence.Source,
-		&version,
+		&silence.Version,
 		&silence.CreatedAt,
+		&silence.TenantID,
 	)
 
 	if err != nil {
@@ -130,13 +133,12 @@ func (r *SilenceRepository) FindActive(ctx context.Context) ([]*entity.SilenceMa
 			id, alert_id, instance, fingerprint, labels,
 			start_at, end_at,
 			created_by, created_by_email, reason, source,
-			version, created_at
+			version, created_at, tenant_id
 		FROM silences
-		WHERE start_at <= NOW() AND end_at > NOW()
-		ORDER BY created_at DESC
-	`
+		WHERE start_at <= NOW() AND end_at > NOW()`
+	query, args := withTenant(ctx, query)
 
-	rows, err := r.db.Replica().QueryContext(ctx, query)
+	rows, err := r.db.getReader(ctx).QueryContext(ctx, query+" ORDER BY created_at DESC", args...)
 	if err != nil {
 		return nil, fmt.Errorf("querying active silences: %w", err)
 	}
@@ -152,14 +154,13 @@ func (r *SilenceRepository) FindByAlertID(ctx context.Context, alertID string) (
 			id, alert_id, instance, fingerprint, labels,
 			start_at, end_at,
 			created_by, created_by_email, reason, source,
-			version, created_at
+			version, created_at, tenant_id
 		FROM silences
 		WHERE alert_id = ?
-		  AND start_at <= NOW() AND end_at > NOW()
-		ORDER BY created_at DESC
-	`
+		  AND start_at <= NOW() AND end_at > NOW()`
+	query, args := withTenant(ctx, query, alertID)
 
-	rows, err := r.db.Replica().QueryContext(ctx, query, alertID)
+	rows, err := r.db.getReader(ctx).QueryContext(ctx, query+" ORDER BY created_at DESC", args...)
 	if err != nil {
 		return nil, fmt.Errorf("querying silences by alert ID: %w", err)
 	}
@@ -175,14 +176,13 @@ func (r *SilenceRepository) FindByInstance(ctx context.Context, instance string)
 			id, alert_id, instance, fingerprint, labels,
 			start_at, end_at,
 			created_by, created_by_email, reason, source,
-			version, created_at
+			version, created_at, tenant_id
 		FROM silences
 		WHERE instance = ?
-		  AND start_at <= NOW() AND end_at > NOW()
-		ORDER BY created_at DESC
-	`
+		  AND start_at <= NOW() AND end_at > NOW()`
+	query, args := withTenant(ctx, query, instance)
 
-	rows, err := r.db.Replica().QueryContext(ctx, query, instance)
+	rows, err := r.db.getReader(ctx).QueryContext(ctx, query+" ORDER BY created_at DESC", args...)
 	if err != nil {
 		return nil, fmt.Errorf("querying silences by instance: %w", err)
 	}
@@ -198,14 +198,13 @@ func (r *SilenceRepository) FindByFingerprint(ctx context.Context, fingerprint s
 			id, alert_id, instance, fingerprint, labels,
 			start_at, end_at,
 			created_by, created_by_email, reason, source,
-			version, created_at
+			version, created_at, tenant_id
 		FROM silences
 		WHERE fingerprint = ?
-		  AND start_at <= NOW() AND end_at > NOW()
-		ORDER BY created_at DESC
-	`
+		  AND start_at <= NOW() AND end_at > NOW()`
+	query, args := withTenant(ctx, query, fingerprint)
 
-	rows, err := r.db.Replica().QueryContext(ctx, query, fingerprint)
+	rows, err := r.db.getReader(ctx).QueryContext(ctx, query+" ORDER BY created_at DESC", args...)
 	if err != nil {
 		return nil, fmt.Errorf("querying silences by fingerprint: %w", err)
 	}
@@ -221,18 +220,18 @@ func (r *SilenceRepository) FindByFingerprint(ctx context.Context, fingerprint s
 // 3. Match by instance (with optional label matching)
 // 4. Match by labels only (all silence labels must be present in alert)
 func (r *SilenceRepository) FindMatchingAlert(ctx context.Context, alert *entity.Alert) ([]*entity.SilenceMark, error) {
-	// Get all active silences
+	// Get all active silences of the alert's tenant, and the global ones
 	query := `
 		SELECT
 			id, alert_id, instance, fingerprint, labels,
 			start_at, end_at,
 			created_by, created_by_email, reason, source,
-			version, created_at
+			version, created_at, tenant_id
 		FROM silences
-		WHERE start_at <= NOW() AND end_at > NOW()
+		WHERE start_at <= NOW() AND end_at > NOW() AND tenant_id IN ('', ?)
 	`
 
-	rows, err := r.db.Replica().QueryContext(ctx, query)
+	rows, err := r.db.getReader(ctx).QueryContext(ctx, query, alert.TenantID)
 	if err != nil {
 		return nil, fmt.Errorf("querying active silences: %w", err)
 	}
@@ -254,19 +253,42 @@ func (r *SilenceRepository) FindMatchingAlert(ctx context.Context, alert *entity
 	return matching, nil
 }
 
+// CountActive returns the number of currently active silences.
+func (r *SilenceRepository) CountActive(ctx context.Context) (int, error) {
+	query, args := withTenant(ctx, `SELECT COUNT(*) FROM silences WHERE start_at <= NOW() AND end_at > NOW()`)
+
+	var count int
+	if err := r.db.getReader(ctx).QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("counting active silences: %w", err)
+	}
+
+	return count, nil
+}
+
 // Update modifies an existing silence with optimistic locking.
-// Returns ErrNotFound if the silence doesn't exist.
+// Returns ErrSilenceNotFound if the silence doesn't exist.
 // Returns ErrConcurrentUpdate if the silence was modified by another instance.
+// Deadlocks and lock wait timeouts are retried; if they persist the error matches ErrDeadlock.
 func (r *SilenceRepository) Update(ctx context.Context, silence *entity.SilenceMark) error {
-	// First, get the current version
-	var currentVersion int
-	versionQuery := `SELECT version FROM silences WHERE id = ?`
-	err := r.db.Primary().QueryRowContext(ctx, versionQuery, silence.ID).Scan(&currentVersion)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return repository.ErrNotFound
+	return r.db.retryDeadlocks(ctx, func(ctx context.Context) error {
+		return r.update(ctx, silence)
+	})
+}
+
+// update runs one read-version-then-update cycle of Update.
+func (r *SilenceRepository) update(ctx context.Context, silence *entity.SilenceMark) error {
+	// Expect the version the silence was read at; silences that carry no
+	// version are checked against the stored one
+	currentVersion := silence.Version
+	if currentVersion == 0 {
+		versionQuery := `SELECT version FROM silences WHERE id = ?`
+		err := r.db.getExecutor(ctx).QueryRowContext(ctx, versionQuery, silence.ID).Scan(&currentVersion)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return repository.ErrSilenceNotFound
+			}
+			return fmt.Errorf("checking silence version: %w", err)
 		}
-		return fmt.Errorf("checking silence version: %w", err)
 	}
 
 	// Serialize JSON fields
@@ -292,7 +314,7 @@ func (r *SilenceRepository) Update(ctx context.Context, silence *entity.SilenceM
 		WHERE id = ? AND version = ?
 	`
 
-	result, err := r.db.Primary().ExecContext(ctx, query,
+	result, err := r.db.getExecutor(ctx).ExecContext(ctx, query,
 		nullString(silence.AlertID),
 		nullString(silence.Instance),
 		nullString(silence.Fingerprint),
@@ -320,28 +342,30 @@ func (r *SilenceRepository) Update(ctx context.Context, silence *entity.SilenceM
 		// Either the silence doesn't exist or version mismatch
 		var exists bool
 		existsQuery := `SELECT COUNT(*) > 0 FROM silences WHERE id = ?`
-		err := r.db.Primary().QueryRowContext(ctx, existsQuery, silence.ID).Scan(&exists)
+		err := r.db.getExecutor(ctx).QueryRowContext(ctx, existsQuery, silence.ID).Scan(&exists)
 		if err != nil {
 			return fmt.Errorf("checking silence existence: %w", err)
 		}
 
 		if !exists {
-			return repository.ErrNotFound
+			return repository.ErrSilenceNotFound
 		}
 
 		// Silence exists but version mismatch - concurrent update detected
 		return repository.ErrConcurrentUpdate
 	}
 
+	silence.Version = currentVersion + 1
+	r.db.wrote(silenceKey(silence.ID))
 	return nil
 }
 
 // Delete removes a silence by ID.
-// Returns ErrNotFound if the silence doesn't exist.
+// Returns ErrSilenceNotFound if the silence doesn't exist.
 func (r *SilenceRepository) Delete(ctx context.Context, id string) error {
 	query := `DELETE FROM silences WHERE id = ?`
 
-	result, err := r.db.Primary().ExecContext(ctx, query, id)
+	result, err := r.db.getExecutor(ctx).ExecContext(ctx, query, id)
 	if err != nil {
 		return fmt.Errorf("deleting silence: %w", err)
 	}
@@ -352,9 +376,10 @@ func (r *SilenceRepository) Delete(ctx context.Context, id string) error {
 	}
 
 	if rowsAffected == 0 {
-		return repository.ErrNotFound
+		return repository.ErrSilenceNotFound
 	}
 
+	r.db.wrote(silenceKey(id))
 	return nil
 }
 
@@ -363,7 +388,7 @@ func (r *SilenceRepository) Delete(ctx context.Context, id string) error {
 func (r *SilenceRepository) DeleteExpired(ctx context.Context) (int, error) {
 	query := `DELETE FROM silences WHERE end_at < NOW()`
 
-	result, err := r.db.Primary().ExecContext(ctx, query)
+	result, err := r.db.getExecutor(ctx).ExecContext(ctx, query)
 	if err != nil {
 		return 0, fmt.Errorf("deleting expired silences: %w", err)
 	}
@@ -384,7 +409,6 @@ func (r *SilenceRepository) scanSilences(rows *sql.Rows) ([]*entity.SilenceMark,
 		var silence entity.SilenceMark
 		var alertID, instance, fingerprint, createdBy, createdByEmail sql.NullString
 		var labelsJSON string
-		var version int
 
 		err := rows.Scan(
 			&silence.ID,
@@ -398,8 +422,9 @@ func (r *SilenceRepository) scanSilences(rows *sql.Rows) ([]*entity.SilenceMark,
 			&createdByEmail,
 			&silence.Reason,
 			&silence.Source,
-			&version,
+			&silence.Version,
 			&silence.CreatedAt,
+			&silence.TenantID,
 		)
 
 		if err != nil {
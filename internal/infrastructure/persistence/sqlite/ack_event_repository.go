@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
 )
 
 // AckEventRepository provides SQLite implementation of repository.AckEventRepository.
@@ -19,23 +20,27 @@ func NewAckEventRepository(db *DB) *AckEventRepository {
 }
 
 // Save persists a new ack event.
-// Returns error if the referenced alert doesn't exist (foreign key constraint).
+// Returns ErrAlertNotFound if the referenced alert doesn't exist (foreign key constraint)
+// and ErrAlreadyExists if an event with the same ID exists.
 func (r *AckEventRepository) Save(ctx context.Context, event *entity.AckEvent) error {
 	_, err := r.db.getExecutor(ctx).ExecContext(ctx, `
 		INSERT INTO ack_events (
 			id, alert_id, source, user_id, user_email, user_name,
-			note, duration_seconds, created_at
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+			note, duration_seconds, created_at, tenant_id
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`,
 		event.ID, event.AlertID, string(event.Source),
 		event.UserID, event.UserEmail, event.UserName,
 		nullString(event.Note), durationToSeconds(event.Duration),
-		timeToString(event.CreatedAt),
+		timeToString(event.CreatedAt), event.TenantID,
 	)
 
 	if err != nil {
 		if isForeignKeyError(err) {
-			return fmt.Errorf("alert not found: %w", err)
+			return repository.ErrAlertNotFound
+		}
+		if isUniqueConstraintError(err) {
+			return repository.ErrAlreadyExists
 		}
 		return fmt.Errorf("insert ack event: %w", err)
 	}
@@ -46,11 +51,11 @@ func (r *AckEventRepository) Save(ctx context.Context, event *entity.AckEvent) e
 // FindByID retrieves an ack event by its unique identifier.
 // Returns nil, nil if not found.
 func (r *AckEventRepository) FindByID(ctx context.Context, id string) (*entity.AckEvent, error) {
-	row := r.db.getExecutor(ctx).QueryRowContext(ctx, `
+	query, args := withTenant(ctx, `
 		SELECT id, alert_id, source, user_id, user_email, user_name,
-			note, duration_seconds, created_at
-		FROM ack_events WHERE id = ?
-	`, id)
+			note, duration_seconds, created_at, tenant_id
+		FROM ack_events WHERE id = ?`, id)
+	row := r.db.getExecutor(ctx).QueryRowContext(ctx, query, args...)
 
 	return scanAckEvent(row)
 }
@@ -58,12 +63,11 @@ func (r *AckEventRepository) FindByID(ctx context.Context, id string) (*entity.A
 // FindByAlertID retrieves all ack events for an alert, ordered by creation time (oldest first).
 // Returns empty slice if none found.
 func (r *AckEventRepository) FindByAlertID(ctx context.Context, alertID string) ([]*entity.AckEvent, error) {
-	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, `
+	query, args := withTenant(ctx, `
 		SELECT id, alert_id, source, user_id, user_email, user_name,
-			note, duration_seconds, created_at
-		FROM ack_events WHERE alert_id = ?
-		ORDER BY created_at ASC
-	`, alertID)
+			note, duration_seconds, created_at, tenant_id
+		FROM ack_events WHERE alert_id = ?`, alertID)
+	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, query+" ORDER BY created_at ASC", args...)
 	if err != nil {
 		return nil, fmt.Errorf("query ack events by alert ID: %w", err)
 	}
@@ -75,13 +79,11 @@ func (r *AckEventRepository) FindByAlertID(ctx context.Context, alertID string)
 // FindLatestByAlertID retrieves the most recent ack event for an alert.
 // Returns nil, nil if not found.
 func (r *AckEventRepository) FindLatestByAlertID(ctx context.Context, alertID string) (*entity.AckEvent, error) {
-	row := r.db.getExecutor(ctx).QueryRowContext(ctx, `
+	query, args := withTenant(ctx, `
 		SELECT id, alert_id, source, user_id, user_email, user_name,
-			note, duration_seconds, created_at
-		FROM ack_events WHERE alert_id = ?
-		ORDER BY created_at DESC
-		LIMIT 1
-	`, alertID)
+			note, duration_seconds, created_at, tenant_id
+		FROM ack_events WHERE alert_id = ?`, alertID)
+	row := r.db.getExecutor(ctx).QueryRowContext(ctx, query+" ORDER BY created_at DESC LIMIT 1", args...)
 
 	return scanAckEvent(row)
 }
@@ -93,19 +95,17 @@ func (r *AckEventRepository) GetTopAcknowledgers(ctx context.Context, limit int)
 		limit = 10
 	}
 
-	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, `
+	query, args := withTenant(ctx, `
 		SELECT user_name, user_email, COUNT(*) as ack_count
-		FROM ack_events
-		GROUP BY user_email
-		ORDER BY ack_count DESC
-		LIMIT ?
-	`, limit)
+		FROM ack_events WHERE 1 = 1`)
+	rows, err := r.db.getExecutor(ctx).QueryContext(ctx,
+		query+" GROUP BY user_email ORDER BY ack_count DESC LIMIT ?", append(args, limit)...)
 	if err != nil {
 		return nil, fmt.Errorf("query top acknowledgers: %w", err)
 	}
 	defer rows.Close()
 
-	var results []*entity.UserAckCount
+	results := make([]*entity.UserAckCount, 0)
 	for rows.Next() {
 		var (
 			userName  string
@@ -146,7 +146,7 @@ func scanAckEvent(row *sql.Row) (*entity.AckEvent, error) {
 	err := row.Scan(
 		&event.ID, &event.AlertID, &source,
 		&event.UserID, &event.UserEmail, &event.UserName,
-		&note, &durationSeconds, &createdAt,
+		&note, &durationSeconds, &createdAt, &event.TenantID,
 	)
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -168,7 +168,7 @@ func scanAckEvent(row *sql.Row) (*entity.AckEvent, error) {
 
 // scanAckEvents scans multiple rows into AckEvent entities.
 func scanAckEvents(rows *sql.Rows) ([]*entity.AckEvent, error) {
-	var events []*entity.AckEvent
+	events := make([]*entity.AckEvent, 0)
 
 	for rows.Next() {
 		var (
@@ -182,7 +182,7 @@ func scanAckEvents(rows *sql.Rows) ([]*entity.AckEvent, error) {
 		err := rows.Scan(
 			&event.ID, &event.AlertID, &source,
 			&event.UserID, &event.UserEmail, &event.UserName,
-			&note, &durationSeconds, &createdAt,
+			&note, &durationSeconds, &createdAt, &event.TenantID,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("scan ack event row: %w", err)
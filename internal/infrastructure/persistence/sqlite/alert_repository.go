@@ -4,8 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
 )
 
 // AlertRepository provides SQLite implementation of repository.AlertRepository.
@@ -21,6 +23,16 @@ func NewAlertRepository(db *DB) *AlertRepository {
 // Save persists a new alert.
 // Returns ErrDuplicateAlert if an alert with the same ID already exists.
 func (r *AlertRepository) Save(ctx context.Context, alert *entity.Alert) error {
+	return r.db.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := r.save(ctx, alert); err != nil {
+			return err
+		}
+		return r.saveReferences(ctx, alert)
+	})
+}
+
+// save inserts the alert row.
+func (r *AlertRepository) save(ctx context.Context, alert *entity.Alert) error {
 	labels, err := marshalJSON(alert.Labels)
 	if err != nil {
 		return fmt.Errorf("marshal labels: %w", err)
@@ -36,13 +48,22 @@ func (r *AlertRepository) Save(ctx context.Context, alert *entity.Alert) error {
 		return fmt.Errorf("marshal external references: %w", err)
 	}
 
+	transitionState, transitionAt, transitionBy := transitionColumns(alert.LastTransition)
+
+	assignments, err := assignmentsColumn(alert.Assignments)
+	if err != nil {
+		return fmt.Errorf("marshal assignments: %w", err)
+	}
+
 	_, err = r.db.getExecutor(ctx).ExecContext(ctx, `
 		INSERT INTO alerts (
 			id, fingerprint, name, instance, target, summary, description,
 			severity, state, labels, annotations,
 			external_references,
-			fired_at, acked_at, acked_by, resolved_at, created_at, updated_at
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			fired_at, acked_at, acked_by, resolved_at, created_at, updated_at,
+			updated_by, last_transition_state, last_transition_at, last_transition_by,
+			correlation_id, tenant_id, last_seen_at, assigned_to, assignments
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`,
 		alert.ID, alert.Fingerprint, alert.Name, alert.Instance, alert.Target,
 		alert.Summary, alert.Description, string(alert.Severity), string(alert.State),
@@ -51,28 +72,140 @@ func (r *AlertRepository) Save(ctx context.Context, alert *entity.Alert) error {
 		timeToString(alert.FiredAt),
 		nullTime(alert.AckedAt), nullString(alert.AckedBy), nullTime(alert.ResolvedAt),
 		timeToString(alert.CreatedAt), timeToString(alert.UpdatedAt),
+		nullString(alert.UpdatedBy), transitionState, transitionAt, transitionBy,
+		nullString(alert.CorrelationID), alert.TenantID, timeToString(alert.LastSeenAt),
+		nullString(alert.AssignedTo), assignments,
 	)
 
 	if err != nil {
 		if isUniqueConstraintError(err) {
-			return entity.ErrDuplicateAlert
+			return repository.ErrDuplicateAlert
 		}
 		return fmt.Errorf("insert alert: %w", err)
 	}
 
+	alert.Version = 1
 	return nil
 }
 
+// UpsertByFingerprint inserts the alert unless a firing alert of the same
+// tenant with the same fingerprint already exists. The partial unique index
+// on firing fingerprints makes the check atomic across concurrent webhook
+// deliveries.
+func (r *AlertRepository) UpsertByFingerprint(ctx context.Context, alert *entity.Alert) (*entity.Alert, bool, error) {
+	var existing *entity.Alert
+	var inserted bool
+	err := r.db.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		existing, inserted, err = r.upsertByFingerprint(ctx, alert)
+		if err != nil || !inserted {
+			return err
+		}
+		return r.saveReferences(ctx, alert)
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return existing, inserted, nil
+}
+
+// upsertByFingerprint inserts the alert row unless a firing alert of the same
+// tenant with the same fingerprint exists, which it returns instead.
+func (r *AlertRepository) upsertByFingerprint(ctx context.Context, alert *entity.Alert) (*entity.Alert, bool, error) {
+	labels, err := marshalJSON(alert.Labels)
+	if err != nil {
+		return nil, false, fmt.Errorf("marshal labels: %w", err)
+	}
+
+	annotations, err := marshalJSON(alert.Annotations)
+	if err != nil {
+		return nil, false, fmt.Errorf("marshal annotations: %w", err)
+	}
+
+	externalRefs, err := marshalJSON(alert.ExternalReferences)
+	if err != nil {
+		return nil, false, fmt.Errorf("marshal external references: %w", err)
+	}
+
+	transitionState, transitionAt, transitionBy := transitionColumns(alert.LastTransition)
+
+	assignments, err := assignmentsColumn(alert.Assignments)
+	if err != nil {
+		return nil, false, fmt.Errorf("marshal assignments: %w", err)
+	}
+
+	exec := r.db.getExecutor(ctx)
+	result, err := exec.ExecContext(ctx, `
+		INSERT INTO alerts (
+			id, fingerprint, name, instance, target, summary, description,
+			severity, state, labels, annotations,
+			external_references,
+			fired_at, acked_at, acked_by, resolved_at, created_at, updated_at,
+			updated_by, last_transition_state, last_transition_at, last_transition_by,
+			correlation_id, tenant_id, last_seen_at, assigned_to, assignments
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(tenant_id, fingerprint) WHERE state IN ('active', 'acknowledged') DO NOTHING
+	`,
+		alert.ID, alert.Fingerprint, alert.Name, alert.Instance, alert.Target,
+		alert.Summary, alert.Description, string(alert.Severity), string(alert.State),
+		labels, annotations,
+		externalRefs,
+		timeToString(alert.FiredAt),
+		nullTime(alert.AckedAt), nullString(alert.AckedBy), nullTime(alert.ResolvedAt),
+		timeToString(alert.CreatedAt), timeToString(alert.UpdatedAt),
+		nullString(alert.UpdatedBy), transitionState, transitionAt, transitionBy,
+		nullString(alert.CorrelationID), alert.TenantID, timeToString(alert.LastSeenAt),
+		nullString(alert.AssignedTo), assignments,
+	)
+	if err != nil {
+		if isUniqueConstraintError(err) {
+			return nil, false, repository.ErrDuplicateAlert
+		}
+		return nil, false, fmt.Errorf("upsert alert: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, false, fmt.Errorf("get rows affected: %w", err)
+	}
+	if rowsAffected == 1 {
+		alert.Version = 1
+		return alert, true, nil
+	}
+
+	row := exec.QueryRowContext(ctx, `
+		SELECT id, fingerprint, name, instance, target, summary, description,
+			severity, state, labels, annotations,
+			external_references,
+			fired_at, acked_at, acked_by, resolved_at, created_at, updated_at,
+			updated_by, last_transition_state, last_transition_at, last_transition_by,
+			correlation_id, tenant_id, last_seen_at, assigned_to, assignments, version
+		FROM alerts
+		WHERE tenant_id = ? AND fingerprint = ? AND state IN ('active', 'acknowledged')
+	`, alert.TenantID, alert.Fingerprint)
+
+	existing, err := scanAlert(row)
+	if err != nil {
+		return nil, false, err
+	}
+	if existing == nil {
+		return nil, false, fmt.Errorf("firing alert for fingerprint %s not found after conflict", alert.Fingerprint)
+	}
+	return existing, false, nil
+}
+
 // FindByID retrieves an alert by its unique identifier.
 // Returns nil, nil if not found.
 func (r *AlertRepository) FindByID(ctx context.Context, id string) (*entity.Alert, error) {
-	row := r.db.getExecutor(ctx).QueryRowContext(ctx, `
+	query, args := withTenant(ctx, `
 		SELECT id, fingerprint, name, instance, target, summary, description,
 			severity, state, labels, annotations,
 			external_references,
-			fired_at, acked_at, acked_by, resolved_at, created_at, updated_at
-		FROM alerts WHERE id = ?
-	`, id)
+			fired_at, acked_at, acked_by, resolved_at, created_at, updated_at,
+			updated_by, last_transition_state, last_transition_at, last_transition_by,
+			correlation_id, tenant_id, last_seen_at, assigned_to, assignments, version
+		FROM alerts WHERE id = ?`, id)
+	row := r.db.getExecutor(ctx).QueryRowContext(ctx, query, args...)
 
 	return scanAlert(row)
 }
@@ -80,13 +213,15 @@ func (r *AlertRepository) FindByID(ctx context.Context, id string) (*entity.Aler
 // FindByFingerprint finds alerts matching the Alertmanager fingerprint.
 // Returns empty slice if none found.
 func (r *AlertRepository) FindByFingerprint(ctx context.Context, fingerprint string) ([]*entity.Alert, error) {
-	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, `
+	query, args := withTenant(ctx, `
 		SELECT id, fingerprint, name, instance, target, summary, description,
 			severity, state, labels, annotations,
 			external_references,
-			fired_at, acked_at, acked_by, resolved_at, created_at, updated_at
-		FROM alerts WHERE fingerprint = ?
-	`, fingerprint)
+			fired_at, acked_at, acked_by, resolved_at, created_at, updated_at,
+			updated_by, last_transition_state, last_transition_at, last_transition_by,
+			correlation_id, tenant_id, last_seen_at, assigned_to, assignments, version
+		FROM alerts WHERE fingerprint = ?`, fingerprint)
+	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, query+" ORDER BY created_at DESC", args...)
 	if err != nil {
 		return nil, fmt.Errorf("query by fingerprint: %w", err)
 	}
@@ -96,23 +231,77 @@ func (r *AlertRepository) FindByFingerprint(ctx context.Context, fingerprint str
 }
 
 // FindByExternalReference finds an alert by its external integration reference.
+// Matches any of the reference IDs stored for the system, through the
+// alert_references index.
 // Returns nil, nil if not found.
 func (r *AlertRepository) FindByExternalReference(ctx context.Context, system, referenceID string) (*entity.Alert, error) {
-	row := r.db.getExecutor(ctx).QueryRowContext(ctx, `
+	query, args := withTenant(ctx, `
 		SELECT id, fingerprint, name, instance, target, summary, description,
 			severity, state, labels, annotations,
 			external_references,
-			fired_at, acked_at, acked_by, resolved_at, created_at, updated_at
+			fired_at, acked_at, acked_by, resolved_at, created_at, updated_at,
+			updated_by, last_transition_state, last_transition_at, last_transition_by,
+			correlation_id, tenant_id, last_seen_at, assigned_to, assignments, version
 		FROM alerts
-		WHERE json_extract(external_references, '$.' || ?) = ?
-	`, system, referenceID)
+		WHERE id IN (
+			SELECT alert_id FROM alert_references
+			WHERE reference_system = ? AND reference_id = ?
+		)`, system, referenceID)
+	row := r.db.getExecutor(ctx).QueryRowContext(ctx, query, args...)
 
 	return scanAlert(row)
 }
 
-// Update modifies an existing alert.
+// saveReferences replaces the alert's rows in alert_references, the index of
+// FindByExternalReference. It runs in the transaction writing the alert.
+func (r *AlertRepository) saveReferences(ctx context.Context, alert *entity.Alert) error {
+	exec := r.db.getExecutor(ctx)
+	if _, err := exec.ExecContext(ctx, `DELETE FROM alert_references WHERE alert_id = ?`, alert.ID); err != nil {
+		return fmt.Errorf("delete alert references: %w", err)
+	}
+
+	for system, value := range alert.ExternalReferences {
+		for _, referenceID := range entity.ReferenceLookupIDs(value) {
+			_, err := exec.ExecContext(ctx, `
+				INSERT INTO alert_references (alert_id, reference_system, reference_id)
+				VALUES (?, ?, ?)
+			`, alert.ID, system, referenceID)
+			if err != nil {
+				return fmt.Errorf("insert alert reference: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// Update modifies an existing alert with optimistic locking.
 // Returns ErrAlertNotFound if the alert doesn't exist.
+// Returns ErrConcurrentUpdate if the alert was modified since it was read.
 func (r *AlertRepository) Update(ctx context.Context, alert *entity.Alert) error {
+	return r.db.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := r.update(ctx, alert); err != nil {
+			return err
+		}
+		return r.saveReferences(ctx, alert)
+	})
+}
+
+// update writes the alert row if its version is unchanged.
+func (r *AlertRepository) update(ctx context.Context, alert *entity.Alert) error {
+	exec := r.db.getExecutor(ctx)
+
+	// Alerts that carry no version are checked against the stored one
+	expectedVersion := alert.Version
+	if expectedVersion == 0 {
+		err := exec.QueryRowContext(ctx, `SELECT version FROM alerts WHERE id = ?`, alert.ID).Scan(&expectedVersion)
+		if err == sql.ErrNoRows {
+			return repository.ErrAlertNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("check alert version: %w", err)
+		}
+	}
+
 	labels, err := marshalJSON(alert.Labels)
 	if err != nil {
 		return fmt.Errorf("marshal labels: %w", err)
@@ -128,13 +317,23 @@ func (r *AlertRepository) Update(ctx context.Context, alert *entity.Alert) error
 		return fmt.Errorf("marshal external references: %w", err)
 	}
 
-	result, err := r.db.getExecutor(ctx).ExecContext(ctx, `
+	transitionState, transitionAt, transitionBy := transitionColumns(alert.LastTransition)
+
+	assignments, err := assignmentsColumn(alert.Assignments)
+	if err != nil {
+		return fmt.Errorf("marshal assignments: %w", err)
+	}
+
+	result, err := exec.ExecContext(ctx, `
 		UPDATE alerts SET
 			fingerprint = ?, name = ?, instance = ?, target = ?, summary = ?, description = ?,
 			severity = ?, state = ?, labels = ?, annotations = ?,
 			external_references = ?,
-			fired_at = ?, acked_at = ?, acked_by = ?, resolved_at = ?, updated_at = ?
-		WHERE id = ?
+			fired_at = ?, acked_at = ?, acked_by = ?, resolved_at = ?, updated_at = ?,
+			updated_by = ?, last_transition_state = ?, last_transition_at = ?, last_transition_by = ?,
+			correlation_id = ?, last_seen_at = ?, assigned_to = ?, assignments = ?,
+			version = version + 1
+		WHERE id = ? AND version = ?
 	`,
 		alert.Fingerprint, alert.Name, alert.Instance, alert.Target,
 		alert.Summary, alert.Description, string(alert.Severity), string(alert.State),
@@ -143,7 +342,10 @@ func (r *AlertRepository) Update(ctx context.Context, alert *entity.Alert) error
 		timeToString(alert.FiredAt),
 		nullTime(alert.AckedAt), nullString(alert.AckedBy), nullTime(alert.ResolvedAt),
 		timeToString(alert.UpdatedAt),
-		alert.ID,
+		nullString(alert.UpdatedBy), transitionState, transitionAt, transitionBy,
+		nullString(alert.CorrelationID), timeToString(alert.LastSeenAt),
+		nullString(alert.AssignedTo), assignments,
+		alert.ID, expectedVersion,
 	)
 	if err != nil {
 		return fmt.Errorf("update alert: %w", err)
@@ -154,21 +356,33 @@ func (r *AlertRepository) Update(ctx context.Context, alert *entity.Alert) error
 		return fmt.Errorf("get rows affected: %w", err)
 	}
 	if rowsAffected == 0 {
-		return entity.ErrAlertNotFound
+		// Either the alert doesn't exist or it changed since it was read
+		var exists bool
+		err := exec.QueryRowContext(ctx, `SELECT COUNT(*) > 0 FROM alerts WHERE id = ?`, alert.ID).Scan(&exists)
+		if err != nil {
+			return fmt.Errorf("check alert existence: %w", err)
+		}
+		if !exists {
+			return repository.ErrAlertNotFound
+		}
+		return repository.ErrConcurrentUpdate
 	}
 
+	alert.Version = expectedVersion + 1
 	return nil
 }
 
 // FindActive returns all currently active (non-resolved) alerts.
 func (r *AlertRepository) FindActive(ctx context.Context) ([]*entity.Alert, error) {
-	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, `
+	query, args := withTenant(ctx, `
 		SELECT id, fingerprint, name, instance, target, summary, description,
 			severity, state, labels, annotations,
 			external_references,
-			fired_at, acked_at, acked_by, resolved_at, created_at, updated_at
-		FROM alerts WHERE state != 'resolved'
-	`)
+			fired_at, acked_at, acked_by, resolved_at, created_at, updated_at,
+			updated_by, last_transition_state, last_transition_at, last_transition_by,
+			correlation_id, tenant_id, last_seen_at, assigned_to, assignments, version
+		FROM alerts WHERE state != 'resolved'`)
+	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, query+" ORDER BY fired_at DESC", args...)
 	if err != nil {
 		return nil, fmt.Errorf("query active alerts: %w", err)
 	}
@@ -179,13 +393,15 @@ func (r *AlertRepository) FindActive(ctx context.Context) ([]*entity.Alert, erro
 
 // FindFiring returns all firing alerts (active or acknowledged).
 func (r *AlertRepository) FindFiring(ctx context.Context) ([]*entity.Alert, error) {
-	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, `
+	query, args := withTenant(ctx, `
 		SELECT id, fingerprint, name, instance, target, summary, description,
 			severity, state, labels, annotations,
 			external_references,
-			fired_at, acked_at, acked_by, resolved_at, created_at, updated_at
-		FROM alerts WHERE state IN ('active', 'acknowledged')
-	`)
+			fired_at, acked_at, acked_by, resolved_at, created_at, updated_at,
+			updated_by, last_transition_state, last_transition_at, last_transition_by,
+			correlation_id, tenant_id, last_seen_at, assigned_to, assignments, version
+		FROM alerts WHERE state IN ('active', 'acknowledged')`)
+	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, query+" ORDER BY fired_at DESC", args...)
 	if err != nil {
 		return nil, fmt.Errorf("query firing alerts: %w", err)
 	}
@@ -194,9 +410,93 @@ func (r *AlertRepository) FindFiring(ctx context.Context) ([]*entity.Alert, erro
 	return scanAlerts(rows)
 }
 
+// FindFiringByCorrelationID returns the firing alerts sharing a correlation ID, oldest first.
+func (r *AlertRepository) FindFiringByCorrelationID(ctx context.Context, correlationID string) ([]*entity.Alert, error) {
+	query, args := withTenant(ctx, `
+		SELECT id, fingerprint, name, instance, target, summary, description,
+			severity, state, labels, annotations,
+			external_references,
+			fired_at, acked_at, acked_by, resolved_at, created_at, updated_at,
+			updated_by, last_transition_state, last_transition_at, last_transition_by,
+			correlation_id, tenant_id, last_seen_at, assigned_to, assignments, version
+		FROM alerts WHERE correlation_id = ? AND state IN ('active', 'acknowledged')`, correlationID)
+	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, query+" ORDER BY fired_at ASC, created_at ASC", args...)
+	if err != nil {
+		return nil, fmt.Errorf("query alerts by correlation id: %w", err)
+	}
+	defer rows.Close()
+
+	return scanAlerts(rows)
+}
+
+// FindNotSeenSince returns the firing alerts last seen before the given
+// time, least recently seen first.
+func (r *AlertRepository) FindNotSeenSince(ctx context.Context, before time.Time) ([]*entity.Alert, error) {
+	query, args := withTenant(ctx, `
+		SELECT id, fingerprint, name, instance, target, summary, description,
+			severity, state, labels, annotations,
+			external_references,
+			fired_at, acked_at, acked_by, resolved_at, created_at, updated_at,
+			updated_by, last_transition_state, last_transition_at, last_transition_by,
+			correlation_id, tenant_id, last_seen_at, assigned_to, assignments, version
+		FROM alerts WHERE state IN ('active', 'acknowledged') AND last_seen_at < ?`, timeToString(before))
+	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, query+" ORDER BY last_seen_at ASC", args...)
+	if err != nil {
+		return nil, fmt.Errorf("query alerts not seen since: %w", err)
+	}
+	defer rows.Close()
+
+	return scanAlerts(rows)
+}
+
+// FindByLabels returns the firing alerts carrying every matcher label, most
+// recently fired first. Labels are matched with json_extract in the query.
+func (r *AlertRepository) FindByLabels(ctx context.Context, matchers map[string]string) ([]*entity.Alert, error) {
+	query, args, unmatched := withLabels(`
+		SELECT id, fingerprint, name, instance, target, summary, description,
+			severity, state, labels, annotations,
+			external_references,
+			fired_at, acked_at, acked_by, resolved_at, created_at, updated_at,
+			updated_by, last_transition_state, last_transition_at, last_transition_by,
+			correlation_id, tenant_id, last_seen_at, assigned_to, assignments, version
+		FROM alerts WHERE state IN ('active', 'acknowledged')`, matchers)
+	query, args = withTenant(ctx, query, args...)
+	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, query+" ORDER BY fired_at DESC", args...)
+	if err != nil {
+		return nil, fmt.Errorf("query alerts by labels: %w", err)
+	}
+	defer rows.Close()
+
+	alerts, err := scanAlerts(rows)
+	if err != nil || len(unmatched) == 0 {
+		return alerts, err
+	}
+
+	matched := make([]*entity.Alert, 0, len(alerts))
+	for _, alert := range alerts {
+		if alert.HasLabels(unmatched) {
+			matched = append(matched, alert)
+		}
+	}
+	return matched, nil
+}
+
 // GetActiveAlerts returns active alerts, optionally filtered by severity.
 // Pass empty string for severity to get all active alerts.
 func (r *AlertRepository) GetActiveAlerts(ctx context.Context, severity string) ([]*entity.Alert, error) {
+	return r.getActiveAlerts(ctx, severity, " ORDER BY fired_at DESC")
+}
+
+// GetActiveAlertsByPriority returns active alerts, optionally filtered by
+// severity, most urgent first.
+func (r *AlertRepository) GetActiveAlertsByPriority(ctx context.Context, severity string, weights entity.SeverityWeights) ([]*entity.Alert, error) {
+	orderBy, orderArgs := priorityOrder(weights)
+	return r.getActiveAlerts(ctx, severity, orderBy, orderArgs...)
+}
+
+// getActiveAlerts returns active alerts, optionally filtered by severity, in
+// the order of the given ORDER BY clause.
+func (r *AlertRepository) getActiveAlerts(ctx context.Context, severity, orderBy string, orderArgs ...interface{}) ([]*entity.Alert, error) {
 	var query string
 	var args []interface{}
 
@@ -205,23 +505,24 @@ func (r *AlertRepository) GetActiveAlerts(ctx context.Context, severity string)
 			SELECT id, fingerprint, name, instance, target, summary, description,
 				severity, state, labels, annotations,
 				external_references,
-				fired_at, acked_at, acked_by, resolved_at, created_at, updated_at
-			FROM alerts WHERE state != 'resolved'
-			ORDER BY fired_at DESC
-		`
+				fired_at, acked_at, acked_by, resolved_at, created_at, updated_at,
+				updated_by, last_transition_state, last_transition_at, last_transition_by,
+				correlation_id, tenant_id, last_seen_at, assigned_to, assignments, version
+			FROM alerts WHERE state != 'resolved'`
 	} else {
 		query = `
 			SELECT id, fingerprint, name, instance, target, summary, description,
 				severity, state, labels, annotations,
 				external_references,
-				fired_at, acked_at, acked_by, resolved_at, created_at, updated_at
-			FROM alerts WHERE state != 'resolved' AND severity = ?
-			ORDER BY fired_at DESC
-		`
+				fired_at, acked_at, acked_by, resolved_at, created_at, updated_at,
+				updated_by, last_transition_state, last_transition_at, last_transition_by,
+				correlation_id, tenant_id, last_seen_at, assigned_to, assignments, version
+			FROM alerts WHERE state != 'resolved' AND severity = ?`
 		args = append(args, severity)
 	}
 
-	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, query, args...)
+	query, args = withTenant(ctx, query, args...)
+	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, query+orderBy, append(args, orderArgs...)...)
 	if err != nil {
 		return nil, fmt.Errorf("query active alerts: %w", err)
 	}
@@ -230,6 +531,44 @@ func (r *AlertRepository) GetActiveAlerts(ctx context.Context, severity string)
 	return scanAlerts(rows)
 }
 
+// CountByStateSeverity aggregates alerts by state and severity in a single query.
+func (r *AlertRepository) CountByStateSeverity(ctx context.Context) ([]*entity.StateSeverityCount, error) {
+	query, args := withTenant(ctx, `
+		SELECT state, severity, COUNT(*), MIN(fired_at)
+		FROM alerts WHERE 1 = 1`)
+	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, query+" GROUP BY state, severity ORDER BY state, severity", args...)
+	if err != nil {
+		return nil, fmt.Errorf("query alert counts: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make([]*entity.StateSeverityCount, 0)
+	for rows.Next() {
+		var (
+			state    string
+			severity string
+			count    int
+			oldest   string
+		)
+		if err := rows.Scan(&state, &severity, &count, &oldest); err != nil {
+			return nil, fmt.Errorf("scan alert count row: %w", err)
+		}
+		oldestFiredAt, _ := parseTime(oldest)
+		counts = append(counts, &entity.StateSeverityCount{
+			State:         entity.AlertState(state),
+			Severity:      entity.AlertSeverity(severity),
+			Count:         count,
+			OldestFiredAt: oldestFiredAt,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	return counts, nil
+}
+
 // Delete removes an alert by ID.
 // Returns ErrAlertNotFound if the alert doesn't exist.
 func (r *AlertRepository) Delete(ctx context.Context, id string) error {
@@ -243,7 +582,7 @@ func (r *AlertRepository) Delete(ctx context.Context, id string) error {
 		return fmt.Errorf("get rows affected: %w", err)
 	}
 	if rowsAffected == 0 {
-		return entity.ErrAlertNotFound
+		return repository.ErrAlertNotFound
 	}
 
 	return nil
@@ -252,24 +591,34 @@ func (r *AlertRepository) Delete(ctx context.Context, id string) error {
 // scanAlert scans a single row into an Alert entity.
 func scanAlert(row *sql.Row) (*entity.Alert, error) {
 	var (
-		alert        entity.Alert
-		severity     string
-		state        string
-		labels       string
-		annotations  string
-		externalRefs string
-		firedAt      string
-		ackedAt      sql.NullString
-		ackedBy      sql.NullString
-		resolvedAt   sql.NullString
-		createdAt    string
-		updatedAt    string
+		alert           entity.Alert
+		severity        string
+		state           string
+		labels          string
+		annotations     string
+		externalRefs    string
+		firedAt         string
+		ackedAt         sql.NullString
+		ackedBy         sql.NullString
+		resolvedAt      sql.NullString
+		createdAt       string
+		updatedAt       string
+		updatedBy       sql.NullString
+		transitionState sql.NullString
+		transitionAt    sql.NullString
+		transitionBy    sql.NullString
+		correlationID   sql.NullString
+		lastSeenAt      sql.NullString
+		assignedTo      sql.NullString
+		assignments     sql.NullString
 	)
 
 	err := row.Scan(
 		&alert.ID, &alert.Fingerprint, &alert.Name, &alert.Instance, &alert.Target,
 		&alert.Summary, &alert.Description, &severity, &state, &labels, &annotations,
 		&externalRefs, &firedAt, &ackedAt, &ackedBy, &resolvedAt, &createdAt, &updatedAt,
+		&updatedBy, &transitionState, &transitionAt, &transitionBy,
+		&correlationID, &alert.TenantID, &lastSeenAt, &assignedTo, &assignments, &alert.Version,
 	)
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -294,34 +643,52 @@ func scanAlert(row *sql.Row) (*entity.Alert, error) {
 	alert.ResolvedAt = scanNullTime(resolvedAt)
 	alert.CreatedAt, _ = parseTime(createdAt)
 	alert.UpdatedAt, _ = parseTime(updatedAt)
+	alert.UpdatedBy = stringFromNull(updatedBy)
+	alert.LastTransition = transitionFromColumns(transitionState, transitionAt, transitionBy)
+	alert.CorrelationID = stringFromNull(correlationID)
+	if seen := scanNullTime(lastSeenAt); seen != nil {
+		alert.LastSeenAt = *seen
+	}
+	alert.AssignedTo = stringFromNull(assignedTo)
+	alert.Assignments, _ = assignmentsFromColumn(assignments)
 
 	return &alert, nil
 }
 
 // scanAlerts scans multiple rows into Alert entities.
 func scanAlerts(rows *sql.Rows) ([]*entity.Alert, error) {
-	var alerts []*entity.Alert
+	alerts := make([]*entity.Alert, 0)
 
 	for rows.Next() {
 		var (
-			alert        entity.Alert
-			severity     string
-			state        string
-			labels       string
-			annotations  string
-			externalRefs string
-			firedAt      string
-			ackedAt      sql.NullString
-			ackedBy      sql.NullString
-			resolvedAt   sql.NullString
-			createdAt    string
-			updatedAt    string
+			alert           entity.Alert
+			severity        string
+			state           string
+			labels          string
+			annotations     string
+			externalRefs    string
+			firedAt         string
+			ackedAt         sql.NullString
+			ackedBy         sql.NullString
+			resolvedAt      sql.NullString
+			createdAt       string
+			updatedAt       string
+			updatedBy       sql.NullString
+			transitionState sql.NullString
+			transitionAt    sql.NullString
+			transitionBy    sql.NullString
+			correlationID   sql.NullString
+			lastSeenAt      sql.NullString
+			assignedTo      sql.NullString
+			assignments     sql.NullString
 		)
 
 		err := rows.Scan(
 			&alert.ID, &alert.Fingerprint, &alert.Name, &alert.Instance, &alert.Target,
 			&alert.Summary, &alert.Description, &severity, &state, &labels, &annotations,
 			&externalRefs, &firedAt, &ackedAt, &ackedBy, &resolvedAt, &createdAt, &updatedAt,
+			&updatedBy, &transitionState, &transitionAt, &transitionBy,
+			&correlationID, &alert.TenantID, &lastSeenAt, &assignedTo, &assignments, &alert.Version,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("scan alert row: %w", err)
@@ -343,6 +710,14 @@ func scanAlerts(rows *sql.Rows) ([]*entity.Alert, error) {
 		alert.ResolvedAt = scanNullTime(resolvedAt)
 		alert.CreatedAt, _ = parseTime(createdAt)
 		alert.UpdatedAt, _ = parseTime(updatedAt)
+		alert.UpdatedBy = stringFromNull(updatedBy)
+		alert.LastTransition = transitionFromColumns(transitionState, transitionAt, transitionBy)
+		alert.CorrelationID = stringFromNull(correlationID)
+		if seen := scanNullTime(lastSeenAt); seen != nil {
+			alert.LastSeenAt = *seen
+		}
+		alert.AssignedTo = stringFromNull(assignedTo)
+		alert.Assignments, _ = assignmentsFromColumn(assignments)
 
 		alerts = append(alerts, &alert)
 	}
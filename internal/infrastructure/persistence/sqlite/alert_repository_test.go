@@ -2,10 +2,13 @@ package sqlite
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
 )
 
 func setupAlertRepo(t *testing.T) (*AlertRepository, func()) {
@@ -69,9 +72,55 @@ func TestAlertRepository_Save_Duplicate(t *testing.T) {
 
 	// Try to save again
 	err = repo.Save(ctx, alert)
-	if err != entity.ErrDuplicateAlert {
+	if !errors.Is(err, entity.ErrDuplicateAlert) {
 		t.Errorf("expected ErrDuplicateAlert, got %v", err)
 	}
+	if !errors.Is(err, repository.ErrAlreadyExists) {
+		t.Errorf("expected ErrAlreadyExists, got %v", err)
+	}
+}
+
+func TestAlertRepository_UpsertByFingerprint(t *testing.T) {
+	repo, cleanup := setupAlertRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	first := entity.NewAlert("fp-upsert", "TestAlert", "instance1", "target1", "Test summary", entity.SeverityWarning)
+
+	stored, created, err := repo.UpsertByFingerprint(ctx, first)
+	if err != nil {
+		t.Fatalf("failed to upsert alert: %v", err)
+	}
+	if !created || stored.ID != first.ID {
+		t.Fatalf("expected first alert to be created, got created=%v id=%s", created, stored.ID)
+	}
+
+	// A second firing alert with the same fingerprint returns the existing one
+	second := entity.NewAlert("fp-upsert", "TestAlert", "instance1", "target1", "Test summary", entity.SeverityWarning)
+	stored, created, err = repo.UpsertByFingerprint(ctx, second)
+	if err != nil {
+		t.Fatalf("failed to upsert duplicate alert: %v", err)
+	}
+	if created {
+		t.Error("expected duplicate alert not to be created")
+	}
+	if stored.ID != first.ID {
+		t.Errorf("expected existing alert %s, got %s", first.ID, stored.ID)
+	}
+
+	// Once resolved, the fingerprint can fire again
+	first.Resolve("alertmanager", time.Now().UTC())
+	if err := repo.Update(ctx, first); err != nil {
+		t.Fatalf("failed to resolve alert: %v", err)
+	}
+
+	stored, created, err = repo.UpsertByFingerprint(ctx, second)
+	if err != nil {
+		t.Fatalf("failed to upsert after resolve: %v", err)
+	}
+	if !created || stored.ID != second.ID {
+		t.Errorf("expected new alert after resolve, got created=%v id=%s", created, stored.ID)
+	}
 }
 
 func TestAlertRepository_FindByID_NotFound(t *testing.T) {
@@ -99,6 +148,9 @@ func TestAlertRepository_FindByFingerprint(t *testing.T) {
 	alert2 := entity.NewAlert("fp-same", "Alert2", "instance2", "target2", "Summary2", entity.SeverityCritical)
 	alert3 := entity.NewAlert("fp-other", "Alert3", "instance3", "target3", "Summary3", entity.SeverityInfo)
 
+	// Only one alert per fingerprint may be firing at a time
+	alert1.Resolve("alertmanager", time.Now().UTC())
+
 	for _, a := range []*entity.Alert{alert1, alert2, alert3} {
 		if err := repo.Save(ctx, a); err != nil {
 			t.Fatalf("failed to save alert: %v", err)
@@ -139,6 +191,145 @@ func TestAlertRepository_FindBySlackMessageID(t *testing.T) {
 	}
 }
 
+func TestAlertRepository_FindByAnySlackChannelMessage(t *testing.T) {
+	repo, cleanup := setupAlertRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	alert := entity.NewAlert("fp1", "TestAlert", "instance1", "target1", "Summary", entity.SeverityWarning)
+	alert.SetExternalReference("slack", entity.JoinReferenceIDs([]string{"C1:1.1", "C2:2.2"}))
+
+	if err := repo.Save(ctx, alert); err != nil {
+		t.Fatalf("failed to save alert: %v", err)
+	}
+
+	for _, messageID := range []string{"C1:1.1", "C2:2.2"} {
+		found, err := repo.FindByExternalReference(ctx, "slack", messageID)
+		if err != nil {
+			t.Fatalf("failed to find by slack message ID %s: %v", messageID, err)
+		}
+		if found == nil || found.ID != alert.ID {
+			t.Fatalf("expected alert %s for message %s, got %v", alert.ID, messageID, found)
+		}
+	}
+
+	found, err := repo.FindByExternalReference(ctx, "slack", "C2:2")
+	if err != nil {
+		t.Fatalf("failed to query partial message ID: %v", err)
+	}
+	if found != nil {
+		t.Errorf("expected no match for partial message ID, got %s", found.ID)
+	}
+}
+
+func TestAlertRepository_FindByExternalReferenceAfterUpdate(t *testing.T) {
+	repo, cleanup := setupAlertRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	alert := entity.NewAlert("fp1", "TestAlert", "instance1", "target1", "Summary", entity.SeverityWarning)
+	alert.SetExternalReference("slack", "C1:1.1")
+	if err := repo.Save(ctx, alert); err != nil {
+		t.Fatalf("failed to save alert: %v", err)
+	}
+
+	alert.SetExternalReference("slack", entity.JoinReferenceIDs([]string{"C2:2.2", "C3:3.3"}))
+	if err := repo.Update(ctx, alert); err != nil {
+		t.Fatalf("failed to update alert: %v", err)
+	}
+
+	for messageID, want := range map[string]bool{"C1:1.1": false, "C2:2.2": true, "C3:3.3": true} {
+		found, err := repo.FindByExternalReference(ctx, "slack", messageID)
+		if err != nil {
+			t.Fatalf("failed to find by slack message ID %s: %v", messageID, err)
+		}
+		if (found != nil) != want {
+			t.Errorf("message %s: expected found=%v, got %v", messageID, want, found)
+		}
+	}
+
+	// Deleting the alert removes its references
+	if err := repo.Delete(ctx, alert.ID); err != nil {
+		t.Fatalf("failed to delete alert: %v", err)
+	}
+	var count int
+	if err := repo.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM alert_references`).Scan(&count); err != nil {
+		t.Fatalf("failed to count references: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("expected no references after delete, got %d", count)
+	}
+}
+
+func TestAlertRepository_FindByExternalReferenceUsesIndex(t *testing.T) {
+	repo, cleanup := setupAlertRepo(t)
+	defer cleanup()
+
+	rows, err := repo.db.QueryContext(context.Background(), `
+		EXPLAIN QUERY PLAN
+		SELECT id FROM alerts
+		WHERE id IN (
+			SELECT alert_id FROM alert_references
+			WHERE reference_system = ? AND reference_id = ?
+		)`, "slack", "C1:1.1")
+	if err != nil {
+		t.Fatalf("failed to explain query: %v", err)
+	}
+	defer rows.Close()
+
+	var plan []string
+	for rows.Next() {
+		var id, parent, notUsed int
+		var detail string
+		if err := rows.Scan(&id, &parent, &notUsed, &detail); err != nil {
+			t.Fatalf("failed to scan plan: %v", err)
+		}
+		plan = append(plan, detail)
+	}
+	for _, step := range plan {
+		if strings.HasPrefix(step, "SCAN") {
+			t.Errorf("expected indexed lookups only, got plan %q", plan)
+		}
+	}
+}
+
+func TestAlertRepository_MigrationIndexesExistingReferences(t *testing.T) {
+	repo, cleanup := setupAlertRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	alert := entity.NewAlert("fp1", "TestAlert", "instance1", "target1", "Summary", entity.SeverityWarning)
+	alert.SetExternalReference("slack", entity.JoinReferenceIDs([]string{"C1:1.1", "C2:2.2"}))
+	alert.SetExternalReference("pagerduty", "PD123456")
+	if err := repo.Save(ctx, alert); err != nil {
+		t.Fatalf("failed to save alert: %v", err)
+	}
+
+	// Alerts stored before the index existed are indexed by the migration
+	if err := repo.db.MigrateDown(ctx, 14); err != nil {
+		t.Fatalf("failed to roll back: %v", err)
+	}
+	if err := repo.db.Migrate(ctx); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+
+	lookups := map[string]string{
+		"C1:1.1":        "slack",
+		"C2:2.2":        "slack",
+		"C1:1.1,C2:2.2": "slack",
+		"PD123456":      "pagerduty",
+	}
+	for referenceID, system := range lookups {
+		found, err := repo.FindByExternalReference(ctx, system, referenceID)
+		if err != nil {
+			t.Fatalf("failed to find by %s reference %s: %v", system, referenceID, err)
+		}
+		if found == nil || found.ID != alert.ID {
+			t.Errorf("expected alert %s for %s reference %s, got %v", alert.ID, system, referenceID, found)
+		}
+	}
+}
+
 func TestAlertRepository_FindByPagerDutyIncidentID(t *testing.T) {
 	repo, cleanup := setupAlertRepo(t)
 	defer cleanup()
@@ -203,9 +394,35 @@ func TestAlertRepository_Update_NotFound(t *testing.T) {
 	alert := entity.NewAlert("fp1", "TestAlert", "instance1", "target1", "Summary", entity.SeverityWarning)
 
 	err := repo.Update(ctx, alert)
-	if err != entity.ErrAlertNotFound {
+	if !errors.Is(err, entity.ErrAlertNotFound) {
 		t.Errorf("expected ErrAlertNotFound, got %v", err)
 	}
+	if !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestAlertRepository_Update_ConcurrentUpdate(t *testing.T) {
+	repo, cleanup := setupAlertRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	alert := entity.NewAlert("fp1", "TestAlert", "instance1", "target1", "Summary", entity.SeverityWarning)
+	if err := repo.Save(ctx, alert); err != nil {
+		t.Fatalf("failed to save alert: %v", err)
+	}
+
+	// Simulate concurrent update by updating directly in database
+	if _, err := repo.db.ExecContext(ctx, "UPDATE alerts SET version = version + 1 WHERE id = ?", alert.ID); err != nil {
+		t.Fatalf("failed to bump version: %v", err)
+	}
+
+	// Now try to update with stale version - should fail with ErrConcurrentUpdate
+	alert.Summary = "Modified summary"
+	err := repo.Update(ctx, alert)
+	if !errors.Is(err, repository.ErrConcurrentUpdate) {
+		t.Errorf("expected ErrConcurrentUpdate, got %v", err)
+	}
 }
 
 func TestAlertRepository_FindActive(t *testing.T) {
@@ -219,7 +436,7 @@ func TestAlertRepository_FindActive(t *testing.T) {
 	acked := entity.NewAlert("fp2", "Acked", "instance2", "target2", "Summary", entity.SeverityWarning)
 	acked.Acknowledge("user", time.Now())
 	resolved := entity.NewAlert("fp3", "Resolved", "instance3", "target3", "Summary", entity.SeverityWarning)
-	resolved.Resolve(time.Now())
+	resolved.Resolve("alertmanager", time.Now())
 
 	for _, a := range []*entity.Alert{active, acked, resolved} {
 		if err := repo.Save(ctx, a); err != nil {
@@ -248,7 +465,7 @@ func TestAlertRepository_FindFiring(t *testing.T) {
 	acked := entity.NewAlert("fp2", "Acked", "instance2", "target2", "Summary", entity.SeverityWarning)
 	acked.Acknowledge("user", time.Now())
 	resolved := entity.NewAlert("fp3", "Resolved", "instance3", "target3", "Summary", entity.SeverityWarning)
-	resolved.Resolve(time.Now())
+	resolved.Resolve("alertmanager", time.Now())
 
 	for _, a := range []*entity.Alert{active, acked, resolved} {
 		if err := repo.Save(ctx, a); err != nil {
@@ -298,9 +515,12 @@ func TestAlertRepository_Delete_NotFound(t *testing.T) {
 
 	ctx := context.Background()
 	err := repo.Delete(ctx, "non-existent")
-	if err != entity.ErrAlertNotFound {
+	if !errors.Is(err, entity.ErrAlertNotFound) {
 		t.Errorf("expected ErrAlertNotFound, got %v", err)
 	}
+	if !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
 }
 
 func TestAlertRepository_EmptySliceNotNil(t *testing.T) {
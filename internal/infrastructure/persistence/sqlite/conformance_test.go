@@ -0,0 +1,27 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository/repositorytest"
+)
+
+func TestRepositoryConformance(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) repositorytest.Repositories {
+		db, err := NewDB(":memory:")
+		require.NoError(t, err)
+		t.Cleanup(func() { db.Close() })
+		require.NoError(t, db.Migrate(context.Background()))
+
+		repos := NewRepositories(db)
+		return repositorytest.Repositories{
+			Alert:    repos.Alert,
+			AckEvent: repos.AckEvent,
+			Silence:  repos.Silence,
+			Delivery: repos.Delivery,
+		}
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"embed"
 	"fmt"
 	"path/filepath"
+	"sort"
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
 	_ "modernc.org/sqlite"
@@ -56,33 +57,149 @@ func NewDB(path string) (*DB, error) {
 	return &DB{DB: db, path: path}, nil
 }
 
-// Migrate runs all pending database migrations.
+// Migrate applies every migration whose version is not yet recorded in the
+// schema_version table, in order. Each migration file records its own version.
 func (db *DB) Migrate(ctx context.Context) error {
-	// Check current schema version
-	var currentVersion int
-	err := db.QueryRowContext(ctx, "SELECT COALESCE(MAX(version), 0) FROM schema_version").Scan(&currentVersion)
+	applied, err := db.appliedVersions(ctx)
 	if err != nil {
-		// Table doesn't exist yet, that's fine
-		currentVersion = 0
+		return err
 	}
 
-	// Read and execute migration SQL
-	data, err := migrations.ReadFile("migrations/001_initial.sql")
+	for _, m := range schemaMigrations {
+		if applied[m.version] {
+			continue
+		}
+
+		data, err := migrations.ReadFile(m.file)
+		if err != nil {
+			return fmt.Errorf("read migration %d: %w", m.version, err)
+		}
+		if _, err := db.ExecContext(ctx, string(data)); err != nil {
+			return fmt.Errorf("execute migration %d: %w", m.version, err)
+		}
+	}
+
+	return nil
+}
+
+// MigrateDown reverts applied migrations above toVersion, newest first. Each
+// step runs its down file and removes its schema_version row in one
+// transaction. MigrateDown(ctx, 0) drops everything except schema_version.
+func (db *DB) MigrateDown(ctx context.Context, toVersion int) error {
+	applied, err := db.appliedVersions(ctx)
 	if err != nil {
-		return fmt.Errorf("read migration: %w", err)
+		return err
 	}
 
-	// Only run if not already applied
-	if currentVersion < 1 {
-		_, err = db.ExecContext(ctx, string(data))
+	for i := len(schemaMigrations) - 1; i >= 0; i-- {
+		m := schemaMigrations[i]
+		if m.version <= toVersion || !applied[m.version] {
+			continue
+		}
+
+		data, err := migrations.ReadFile(m.downFile)
+		if err != nil {
+			return fmt.Errorf("read down migration %d: %w", m.version, err)
+		}
+
+		err = db.WithTransaction(ctx, func(ctx context.Context) error {
+			exec := db.getExecutor(ctx)
+			if _, err := exec.ExecContext(ctx, string(data)); err != nil {
+				return fmt.Errorf("execute down migration %d: %w", m.version, err)
+			}
+			if _, err := exec.ExecContext(ctx, "DELETE FROM schema_version WHERE version = ?", m.version); err != nil {
+				return fmt.Errorf("remove schema version %d: %w", m.version, err)
+			}
+			return nil
+		})
 		if err != nil {
-			return fmt.Errorf("execute migration: %w", err)
+			return err
 		}
 	}
 
 	return nil
 }
 
+// AppliedVersions returns the recorded migration versions in ascending order.
+func (db *DB) AppliedVersions(ctx context.Context) ([]int, error) {
+	applied, err := db.appliedVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]int, 0, len(applied))
+	for version := range applied {
+		versions = append(versions, version)
+	}
+	sort.Ints(versions)
+	return versions, nil
+}
+
+// appliedVersions returns the set of recorded migration versions.
+// Returns an empty set if the schema_version table doesn't exist yet.
+func (db *DB) appliedVersions(ctx context.Context) (map[int]bool, error) {
+	applied := make(map[int]bool)
+
+	var exists bool
+	if err := db.QueryRowContext(ctx,
+		"SELECT COUNT(*) > 0 FROM sqlite_master WHERE type = 'table' AND name = 'schema_version'",
+	).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("check schema_version table: %w", err)
+	}
+	if !exists {
+		return applied, nil
+	}
+
+	rows, err := db.QueryContext(ctx, "SELECT version FROM schema_version")
+	if err != nil {
+		return nil, fmt.Errorf("query schema versions: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var version int
+		if err := rows.Scan(&version); err != nil {
+			return nil, fmt.Errorf("scan schema version: %w", err)
+		}
+		applied[version] = true
+	}
+
+	return applied, rows.Err()
+}
+
+// MigrationVersions returns the versions Migrate can apply, in order.
+func MigrationVersions() []int {
+	versions := make([]int, 0, len(schemaMigrations))
+	for _, m := range schemaMigrations {
+		versions = append(versions, m.version)
+	}
+	return versions
+}
+
+// schemaMigrations lists the migrations and their rollbacks, in order. The
+// initial schema already includes external_references (002), so there is no
+// separate version 2 step.
+var schemaMigrations = []struct {
+	version  int
+	file     string
+	downFile string
+}{
+	{version: 1, file: "migrations/001_initial.sql", downFile: "migrations/001_initial.down.sql"},
+	{version: 3, file: "migrations/003_unique_firing_fingerprint.sql", downFile: "migrations/003_unique_firing_fingerprint.down.sql"},
+	{version: 4, file: "migrations/004_processed_webhooks.sql", downFile: "migrations/004_processed_webhooks.down.sql"},
+	{version: 5, file: "migrations/005_alert_transitions.sql", downFile: "migrations/005_alert_transitions.down.sql"},
+	{version: 6, file: "migrations/006_notification_outbox.sql", downFile: "migrations/006_notification_outbox.down.sql"},
+	{version: 7, file: "migrations/007_optimistic_locking.sql", downFile: "migrations/007_optimistic_locking.down.sql"},
+	{version: 8, file: "migrations/008_alert_correlation.sql", downFile: "migrations/008_alert_correlation.down.sql"},
+	{version: 9, file: "migrations/009_alertmanager_silences.sql", downFile: "migrations/009_alertmanager_silences.down.sql"},
+	{version: 10, file: "migrations/010_tenants.sql", downFile: "migrations/010_tenants.down.sql"},
+	{version: 11, file: "migrations/011_notification_deliveries.sql", downFile: "migrations/011_notification_deliveries.down.sql"},
+	{version: 12, file: "migrations/012_alert_last_seen.sql", downFile: "migrations/012_alert_last_seen.down.sql"},
+	{version: 13, file: "migrations/013_alert_assignment.sql", downFile: "migrations/013_alert_assignment.down.sql"},
+	{version: 14, file: "migrations/014_outbox_claims.sql", downFile: "migrations/014_outbox_claims.down.sql"},
+	{version: 15, file: "migrations/015_alert_references.sql", downFile: "migrations/015_alert_references.down.sql"},
+}
+
 // Close closes the database connection with proper cleanup.
 func (db *DB) Close() error {
 	// Force WAL checkpoint before close (only for file-based databases)
@@ -124,10 +241,15 @@ func (db *DB) BeginTx(ctx context.Context) (repository.Transaction, error) {
 	return &sqliteTx{Tx: tx}, nil
 }
 
-// WithTransaction executes a function within a transaction.
-// If the function returns an error, the transaction is rolled back.
-// Otherwise, the transaction is committed.
+// WithTransaction implements repository.TransactionManager. If fn returns an
+// error, the transaction is rolled back; otherwise it is committed. A call
+// nested in another WithTransaction joins the outer transaction instead of
+// starting a new one.
 func (db *DB) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := repository.TxFromContext(ctx).(*sqliteTx); ok {
+		return fn(ctx)
+	}
+
 	tx, err := db.BeginTx(ctx)
 	if err != nil {
 		return err
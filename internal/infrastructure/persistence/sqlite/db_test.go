@@ -2,9 +2,13 @@ package sqlite
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
 )
 
 func TestNewDB_InMemory(t *testing.T) {
@@ -69,8 +73,8 @@ func TestDB_Migrate(t *testing.T) {
 	if err != nil {
 		t.Fatalf("failed to query schema version: %v", err)
 	}
-	if version != 1 {
-		t.Errorf("expected schema version 1, got %d", version)
+	if version != 15 {
+		t.Errorf("expected schema version 15, got %d", version)
 	}
 }
 
@@ -91,14 +95,14 @@ func TestDB_MigrateIdempotent(t *testing.T) {
 		t.Fatalf("failed to run second migration: %v", err)
 	}
 
-	// Verify schema version is still 1
+	// Verify schema version is unchanged
 	var version int
 	err = db.QueryRowContext(ctx, "SELECT MAX(version) FROM schema_version").Scan(&version)
 	if err != nil {
 		t.Fatalf("failed to query schema version: %v", err)
 	}
-	if version != 1 {
-		t.Errorf("expected schema version 1, got %d", version)
+	if version != 15 {
+		t.Errorf("expected schema version 15, got %d", version)
 	}
 }
 
@@ -156,3 +160,122 @@ func TestDB_ForeignKeysEnabled(t *testing.T) {
 		t.Error("expected foreign key constraint error, got nil")
 	}
 }
+
+func TestDB_WithTransaction(t *testing.T) {
+	db, err := NewDB(":memory:")
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	if err := db.Migrate(ctx); err != nil {
+		t.Fatalf("failed to run migration: %v", err)
+	}
+
+	repos := NewRepositories(db)
+	alert := entity.NewAlert("fp-tx", "TxAlert", "host-1", "", "", entity.SeverityWarning)
+	ackEvent := entity.NewAckEvent(alert.ID, entity.AckSourceAPI, "u1", "u1@example.com", "User One")
+
+	// A failure after both writes rolls back both, including the nested call
+	errBoom := errors.New("boom")
+	err = db.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := repos.Alert.Save(ctx, alert); err != nil {
+			return err
+		}
+		if err := db.WithTransaction(ctx, func(ctx context.Context) error {
+			return repos.AckEvent.Save(ctx, ackEvent)
+		}); err != nil {
+			return err
+		}
+		return errBoom
+	})
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("expected errBoom, got %v", err)
+	}
+
+	if found, err := repos.Alert.FindByID(ctx, alert.ID); err != nil || found != nil {
+		t.Errorf("expected alert to be rolled back, got %v (err %v)", found, err)
+	}
+	if found, err := repos.AckEvent.FindByID(ctx, ackEvent.ID); err != nil || found != nil {
+		t.Errorf("expected ack event to be rolled back, got %v (err %v)", found, err)
+	}
+
+	// Without an error both writes commit
+	err = db.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := repos.Alert.Save(ctx, alert); err != nil {
+			return err
+		}
+		return repos.AckEvent.Save(ctx, ackEvent)
+	})
+	if err != nil {
+		t.Fatalf("failed to commit: %v", err)
+	}
+	if found, err := repos.AckEvent.FindByID(ctx, ackEvent.ID); err != nil || found == nil {
+		t.Errorf("expected committed ack event, got %v (err %v)", found, err)
+	}
+}
+
+func TestDB_MigrateDownAndReapply(t *testing.T) {
+	db, err := NewDB(":memory:")
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	if err := db.Migrate(ctx); err != nil {
+		t.Fatalf("failed to run migration: %v", err)
+	}
+
+	assertVersions := func(want ...int) {
+		t.Helper()
+		got, err := db.AppliedVersions(ctx)
+		if err != nil {
+			t.Fatalf("failed to read applied versions: %v", err)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("expected applied versions %v, got %v", want, got)
+		}
+	}
+	tableExists := func(name string) bool {
+		t.Helper()
+		var count int
+		err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?", name).Scan(&count)
+		if err != nil {
+			t.Fatalf("failed to query table %s: %v", name, err)
+		}
+		return count > 0
+	}
+
+	assertVersions(1, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15)
+
+	if err := db.MigrateDown(ctx, 5); err != nil {
+		t.Fatalf("failed to roll back to version 5: %v", err)
+	}
+	assertVersions(1, 3, 4, 5)
+	if tableExists("notification_outbox") {
+		t.Error("expected notification_outbox to be dropped")
+	}
+
+	if err := db.Migrate(ctx); err != nil {
+		t.Fatalf("failed to re-apply migrations: %v", err)
+	}
+	assertVersions(1, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15)
+	if !tableExists("notification_outbox") {
+		t.Error("expected notification_outbox to be re-created")
+	}
+
+	if err := db.MigrateDown(ctx, 0); err != nil {
+		t.Fatalf("failed to roll back everything: %v", err)
+	}
+	assertVersions()
+	if tableExists("alerts") {
+		t.Error("expected alerts to be dropped")
+	}
+
+	if err := db.Migrate(ctx); err != nil {
+		t.Fatalf("failed to re-apply migrations: %v", err)
+	}
+	assertVersions(1, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15)
+}
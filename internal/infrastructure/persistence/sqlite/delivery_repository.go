@@ -0,0 +1,97 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+)
+
+// DeliveryRepository provides SQLite implementation of repository.DeliveryRepository.
+type DeliveryRepository struct {
+	db *DB
+}
+
+// NewDeliveryRepository creates a new SQLite-backed delivery repository.
+func NewDeliveryRepository(db *DB) *DeliveryRepository {
+	return &DeliveryRepository{db: db}
+}
+
+// Save records a delivery.
+// Returns ErrAlertNotFound if the referenced alert doesn't exist (foreign key constraint)
+// and ErrAlreadyExists if a delivery with the same ID exists.
+func (r *DeliveryRepository) Save(ctx context.Context, delivery *entity.NotificationDelivery) error {
+	_, err := r.db.getExecutor(ctx).ExecContext(ctx, `
+		INSERT INTO notification_deliveries (
+			id, alert_id, notifier, action, status,
+			external_reference, error, created_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`,
+		delivery.ID, delivery.AlertID, delivery.Notifier,
+		string(delivery.Action), string(delivery.Status),
+		nullString(delivery.ExternalReference), nullString(delivery.Error),
+		timeToString(delivery.CreatedAt),
+	)
+
+	if err != nil {
+		if isForeignKeyError(err) {
+			return repository.ErrAlertNotFound
+		}
+		if isUniqueConstraintError(err) {
+			return repository.ErrAlreadyExists
+		}
+		return fmt.Errorf("insert notification delivery: %w", err)
+	}
+
+	return nil
+}
+
+// FindByAlertID retrieves the deliveries of an alert, oldest first.
+// Returns empty slice if none found.
+func (r *DeliveryRepository) FindByAlertID(ctx context.Context, alertID string) ([]*entity.NotificationDelivery, error) {
+	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, `
+		SELECT id, alert_id, notifier, action, status,
+			external_reference, error, created_at
+		FROM notification_deliveries WHERE alert_id = ?
+		ORDER BY created_at ASC, rowid ASC`, alertID)
+	if err != nil {
+		return nil, fmt.Errorf("query notification deliveries by alert ID: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := make([]*entity.NotificationDelivery, 0)
+	for rows.Next() {
+		var (
+			delivery          entity.NotificationDelivery
+			action            string
+			status            string
+			externalReference sql.NullString
+			deliveryErr       sql.NullString
+			createdAt         string
+		)
+
+		err := rows.Scan(
+			&delivery.ID, &delivery.AlertID, &delivery.Notifier, &action, &status,
+			&externalReference, &deliveryErr, &createdAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan notification delivery row: %w", err)
+		}
+
+		delivery.Action = entity.OutboxAction(action)
+		delivery.Status = entity.DeliveryStatus(status)
+		delivery.ExternalReference = stringFromNull(externalReference)
+		delivery.Error = stringFromNull(deliveryErr)
+		delivery.CreatedAt, _ = parseTime(createdAt)
+
+		deliveries = append(deliveries, &delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	return deliveries, nil
+}
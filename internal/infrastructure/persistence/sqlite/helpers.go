@@ -1,10 +1,15 @@
 package sqlite
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
+	"sort"
 	"strings"
 	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
 )
 
 // nullString converts a string to sql.NullString.
@@ -60,6 +65,126 @@ func scanNullTime(ns sql.NullString) *time.Time {
 	return &t
 }
 
+// transitionColumns splits a state transition into nullable columns for storage.
+func transitionColumns(t *entity.StateTransition) (state, at, by sql.NullString) {
+	if t == nil {
+		return sql.NullString{}, sql.NullString{}, sql.NullString{}
+	}
+	return nullString(string(t.State)), nullTime(&t.At), nullString(t.By)
+}
+
+// transitionFromColumns rebuilds a state transition from nullable columns.
+// Returns nil if no transition was recorded.
+func transitionFromColumns(state, at, by sql.NullString) *entity.StateTransition {
+	transitionAt := scanNullTime(at)
+	if !state.Valid || transitionAt == nil {
+		return nil
+	}
+	return &entity.StateTransition{
+		State: entity.AlertState(state.String),
+		At:    *transitionAt,
+		By:    stringFromNull(by),
+	}
+}
+
+// storedAssignment is the JSON form of an assignment change.
+type storedAssignment struct {
+	To string `json:"to,omitempty"`
+	At string `json:"at"`
+	By string `json:"by,omitempty"`
+}
+
+// assignmentsColumn serializes an assignment history for storage.
+// Returns NULL for an empty history.
+func assignmentsColumn(assignments []entity.Assignment) (sql.NullString, error) {
+	if len(assignments) == 0 {
+		return sql.NullString{}, nil
+	}
+	stored := make([]storedAssignment, len(assignments))
+	for i, a := range assignments {
+		stored[i] = storedAssignment{To: a.To, At: timeToString(a.At), By: a.By}
+	}
+	data, err := json.Marshal(stored)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: string(data), Valid: true}, nil
+}
+
+// assignmentsFromColumn rebuilds an assignment history from its column.
+// Returns nil if no assignment was recorded.
+func assignmentsFromColumn(column sql.NullString) ([]entity.Assignment, error) {
+	if !column.Valid || column.String == "" {
+		return nil, nil
+	}
+	var stored []storedAssignment
+	if err := json.Unmarshal([]byte(column.String), &stored); err != nil {
+		return nil, err
+	}
+	assignments := make([]entity.Assignment, len(stored))
+	for i, a := range stored {
+		at, _ := parseTime(a.At)
+		assignments[i] = entity.Assignment{To: a.To, At: at, By: a.By}
+	}
+	return assignments, nil
+}
+
+// withTenant restricts a query ending in a WHERE clause to the tenant the
+// context is scoped to, if any, and returns it with its arguments.
+func withTenant(ctx context.Context, query string, args ...interface{}) (string, []interface{}) {
+	tenantID, ok := repository.TenantFromContext(ctx)
+	if !ok {
+		return query, args
+	}
+	return query + " AND tenant_id = ?", append(args, tenantID)
+}
+
+// withLabels restricts a query ending in a WHERE clause to alerts whose
+// labels include every matcher, and returns it with its arguments. Matchers
+// whose label name cannot be written as a JSON path are returned separately
+// for the caller to filter in process.
+func withLabels(query string, matchers map[string]string, args ...interface{}) (string, []interface{}, map[string]string) {
+	keys := make([]string, 0, len(matchers))
+	for key := range matchers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString(query)
+	var unmatched map[string]string
+	for _, key := range keys {
+		if strings.ContainsAny(key, `"\`) {
+			if unmatched == nil {
+				unmatched = make(map[string]string)
+			}
+			unmatched[key] = matchers[key]
+			continue
+		}
+		b.WriteString(" AND json_extract(labels, ?) = ?")
+		args = append(args, `$."`+key+`"`, matchers[key])
+	}
+	return b.String(), args, unmatched
+}
+
+// priorityOrder builds the ORDER BY clause matching
+// entity.SeverityWeights.SortByPriority and returns it with its arguments.
+func priorityOrder(weights entity.SeverityWeights) (string, []interface{}) {
+	var b strings.Builder
+	var args []interface{}
+	b.WriteString(" ORDER BY (")
+	if len(weights) > 0 {
+		b.WriteString("CASE severity")
+		for _, severity := range weights.Severities() {
+			b.WriteString(" WHEN ? THEN ?")
+			args = append(args, string(severity), weights[severity])
+		}
+		b.WriteString(" ELSE 0 END + ")
+	}
+	b.WriteString("CASE WHEN state = 'active' THEN ? ELSE 0 END) DESC, fired_at ASC")
+	return b.String(), append(args, entity.UnackedPriorityBoost)
+}
+
 // parseTime parses an RFC3339 string to time.Time.
 func parseTime(s string) (time.Time, error) {
 	return time.Parse(time.RFC3339, s)
@@ -0,0 +1,53 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// IdempotencyStore provides SQLite implementation of repository.IdempotencyStore
+// backed by the processed_webhooks table.
+type IdempotencyStore struct {
+	db *DB
+}
+
+// NewIdempotencyStore creates a new SQLite-backed idempotency store.
+func NewIdempotencyStore(db *DB) *IdempotencyStore {
+	return &IdempotencyStore{db: db}
+}
+
+// MarkProcessed records the key for ttl. Returns false if it was already recorded and unexpired.
+func (s *IdempotencyStore) MarkProcessed(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	now := time.Now().UTC()
+	exec := s.db.getExecutor(ctx)
+
+	// Purge expired keys so they can be recorded again
+	if _, err := exec.ExecContext(ctx, `DELETE FROM processed_webhooks WHERE expires_at <= ?`, timeToString(now)); err != nil {
+		return false, fmt.Errorf("delete expired keys: %w", err)
+	}
+
+	result, err := exec.ExecContext(ctx, `
+		INSERT OR IGNORE INTO processed_webhooks (idempotency_key, expires_at, created_at)
+		VALUES (?, ?, ?)
+	`, key, timeToString(now.Add(ttl)), timeToString(now))
+	if err != nil {
+		return false, fmt.Errorf("insert idempotency key: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("get rows affected: %w", err)
+	}
+	return rowsAffected == 1, nil
+}
+
+// Release removes the key, so it can be recorded again.
+func (s *IdempotencyStore) Release(ctx context.Context, key string) error {
+	if _, err := s.db.getExecutor(ctx).ExecContext(ctx,
+		`DELETE FROM processed_webhooks WHERE idempotency_key = ?`, key,
+	); err != nil {
+		return fmt.Errorf("delete idempotency key: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestIdempotencyStore_MarkProcessed(t *testing.T) {
+	db, err := NewDB(":memory:")
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	if err := db.Migrate(ctx); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+
+	store := NewIdempotencyStore(db)
+
+	first, err := store.MarkProcessed(ctx, "key-1", time.Minute)
+	if err != nil {
+		t.Fatalf("failed to mark key: %v", err)
+	}
+	if !first {
+		t.Error("expected first delivery to be recorded")
+	}
+
+	again, err := store.MarkProcessed(ctx, "key-1", time.Minute)
+	if err != nil {
+		t.Fatalf("failed to mark duplicate key: %v", err)
+	}
+	if again {
+		t.Error("expected duplicate delivery to be rejected")
+	}
+
+	// Expired keys can be recorded again
+	if _, err := store.MarkProcessed(ctx, "key-2", -time.Second); err != nil {
+		t.Fatalf("failed to mark expiring key: %v", err)
+	}
+	renewed, err := store.MarkProcessed(ctx, "key-2", time.Minute)
+	if err != nil {
+		t.Fatalf("failed to re-mark expired key: %v", err)
+	}
+	if !renewed {
+		t.Error("expected expired key to be recorded again")
+	}
+}
+
+func TestIdempotencyStore_Release(t *testing.T) {
+	db, err := NewDB(":memory:")
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	if err := db.Migrate(ctx); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+
+	store := NewIdempotencyStore(db)
+
+	if _, err := store.MarkProcessed(ctx, "key-1", time.Minute); err != nil {
+		t.Fatalf("failed to mark key: %v", err)
+	}
+	if err := store.Release(ctx, "key-1"); err != nil {
+		t.Fatalf("failed to release key: %v", err)
+	}
+	again, err := store.MarkProcessed(ctx, "key-1", time.Minute)
+	if err != nil {
+		t.Fatalf("failed to re-mark released key: %v", err)
+	}
+	if !again {
+		t.Error("expected released key to be recorded again")
+	}
+
+	if err := store.Release(ctx, "unknown"); err != nil {
+		t.Errorf("expected releasing an unknown key to succeed, got %v", err)
+	}
+}
@@ -0,0 +1,8 @@
+-- SQLite Schema Rollback: Initial Schema
+-- Version: 1
+-- Description: Drop the application tables. schema_version is kept so the
+-- version history stays readable; child tables go first for foreign keys.
+
+DROP TABLE IF EXISTS silences;
+DROP TABLE IF EXISTS ack_events;
+DROP TABLE IF EXISTS alerts;
@@ -0,0 +1,5 @@
+-- SQLite Schema Rollback: Unique Firing Fingerprint
+-- Version: 3
+-- Description: Drop the one-firing-alert-per-fingerprint constraint
+
+DROP INDEX IF EXISTS idx_alerts_firing_fingerprint;
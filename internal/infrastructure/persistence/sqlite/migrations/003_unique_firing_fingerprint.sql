@@ -0,0 +1,25 @@
+-- SQLite Schema Migration: Unique Firing Fingerprint
+-- Version: 3
+-- Description: Allow at most one firing (active or acknowledged) alert per fingerprint
+-- so concurrent webhook deliveries cannot create duplicate alerts and notifications.
+
+-- Resolve older duplicates that would violate the new index, keeping the newest alert
+UPDATE alerts
+SET state = 'resolved',
+    resolved_at = COALESCE(resolved_at, updated_at)
+WHERE state IN ('active', 'acknowledged')
+  AND EXISTS (
+      SELECT 1 FROM alerts newer
+      WHERE newer.fingerprint = alerts.fingerprint
+        AND newer.state IN ('active', 'acknowledged')
+        AND (newer.created_at > alerts.created_at
+             OR (newer.created_at = alerts.created_at AND newer.id > alerts.id))
+  );
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_alerts_firing_fingerprint
+    ON alerts(fingerprint)
+    WHERE state IN ('active', 'acknowledged');
+
+-- Insert version 3
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (3, datetime('now'));
@@ -0,0 +1,5 @@
+-- SQLite Schema Rollback: Processed Webhooks
+-- Version: 4
+-- Description: Drop the webhook idempotency table
+
+DROP TABLE IF EXISTS processed_webhooks;
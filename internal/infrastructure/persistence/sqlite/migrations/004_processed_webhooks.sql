@@ -0,0 +1,16 @@
+-- SQLite Schema Migration: Processed Webhooks
+-- Version: 4
+-- Description: Track processed webhook idempotency keys to skip redelivered payloads
+
+CREATE TABLE IF NOT EXISTS processed_webhooks (
+    idempotency_key TEXT PRIMARY KEY NOT NULL,
+    expires_at TEXT NOT NULL,
+    created_at TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_processed_webhooks_expires_at
+    ON processed_webhooks(expires_at);
+
+-- Insert version 4
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (4, datetime('now'));
@@ -0,0 +1,8 @@
+-- SQLite Schema Rollback: Alert Transitions
+-- Version: 5
+-- Description: Drop the last state change columns
+
+ALTER TABLE alerts DROP COLUMN last_transition_by;
+ALTER TABLE alerts DROP COLUMN last_transition_at;
+ALTER TABLE alerts DROP COLUMN last_transition_state;
+ALTER TABLE alerts DROP COLUMN updated_by;
@@ -0,0 +1,12 @@
+-- SQLite Schema Migration: Alert Transitions
+-- Version: 5
+-- Description: Record who made the last state change of an alert and when
+
+ALTER TABLE alerts ADD COLUMN updated_by TEXT DEFAULT NULL;
+ALTER TABLE alerts ADD COLUMN last_transition_state TEXT DEFAULT NULL;
+ALTER TABLE alerts ADD COLUMN last_transition_at TEXT DEFAULT NULL;
+ALTER TABLE alerts ADD COLUMN last_transition_by TEXT DEFAULT NULL;
+
+-- Insert version 5
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (5, datetime('now'));
@@ -0,0 +1,5 @@
+-- SQLite Schema Rollback: Notification Outbox
+-- Version: 6
+-- Description: Drop the notification outbox table
+
+DROP TABLE IF EXISTS notification_outbox;
@@ -0,0 +1,20 @@
+-- SQLite Schema Migration: Notification Outbox
+-- Version: 6
+-- Description: Record pending notifications in the same transaction as the alert change
+
+CREATE TABLE IF NOT EXISTS notification_outbox (
+    id TEXT PRIMARY KEY NOT NULL,
+    alert_id TEXT NOT NULL,
+    action TEXT NOT NULL CHECK(action IN ('notify', 'update')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT DEFAULT NULL,
+    created_at TEXT NOT NULL,
+    sent_at TEXT DEFAULT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_outbox_pending
+    ON notification_outbox(sent_at, created_at);
+
+-- Insert version 6
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (6, datetime('now'));
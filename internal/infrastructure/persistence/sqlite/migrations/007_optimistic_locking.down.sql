@@ -0,0 +1,6 @@
+-- SQLite Schema Rollback: Optimistic Locking
+-- Version: 7
+-- Description: Drop the version columns
+
+ALTER TABLE silences DROP COLUMN version;
+ALTER TABLE alerts DROP COLUMN version;
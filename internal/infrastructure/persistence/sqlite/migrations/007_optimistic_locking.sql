@@ -0,0 +1,11 @@
+-- SQLite Schema Migration: Optimistic Locking
+-- Version: 7
+-- Description: Add version columns so updates based on a stale read fail
+-- instead of overwriting a concurrent change, matching the MySQL schema.
+
+ALTER TABLE alerts ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE silences ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+
+-- Insert version 7
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (7, datetime('now'));
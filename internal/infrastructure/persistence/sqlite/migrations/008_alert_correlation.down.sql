@@ -0,0 +1,6 @@
+-- SQLite Schema Rollback: Alert Correlation
+-- Version: 8
+-- Description: Drop the correlation column
+
+DROP INDEX IF EXISTS idx_alerts_correlation_id;
+ALTER TABLE alerts DROP COLUMN correlation_id;
@@ -0,0 +1,13 @@
+-- SQLite Schema Migration: Alert Correlation
+-- Version: 8
+-- Description: Group alerts that share the configured correlation labels
+
+ALTER TABLE alerts ADD COLUMN correlation_id TEXT DEFAULT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_alerts_correlation_id
+    ON alerts(correlation_id)
+    WHERE correlation_id IS NOT NULL;
+
+-- Insert version 8
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (8, datetime('now'));
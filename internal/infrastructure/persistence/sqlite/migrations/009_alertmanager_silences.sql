@@ -0,0 +1,54 @@
+-- SQLite Schema Migration: Alertmanager Silences
+-- Version: 9
+-- Description: Allow silences imported from Alertmanager. SQLite cannot alter
+-- a CHECK constraint, so the silences table is rebuilt.
+
+CREATE TABLE silences_new (
+    id TEXT PRIMARY KEY NOT NULL,
+    alert_id TEXT DEFAULT NULL,
+    instance TEXT DEFAULT NULL,
+    fingerprint TEXT DEFAULT NULL,
+    labels TEXT NOT NULL DEFAULT '{}',
+    start_at TEXT NOT NULL,
+    end_at TEXT NOT NULL,
+    created_by TEXT NOT NULL DEFAULT '',
+    created_by_email TEXT NOT NULL DEFAULT '',
+    reason TEXT NOT NULL DEFAULT '',
+    source TEXT NOT NULL CHECK (source IN ('slack', 'pagerduty', 'api', 'alertmanager')),
+    created_at TEXT NOT NULL,
+    version INTEGER NOT NULL DEFAULT 1
+);
+
+INSERT INTO silences_new (
+    id, alert_id, instance, fingerprint, labels, start_at, end_at,
+    created_by, created_by_email, reason, source, created_at, version
+)
+SELECT
+    id, alert_id, instance, fingerprint, labels, start_at, end_at,
+    created_by, created_by_email, reason, source, created_at, version
+FROM silences;
+
+DROP TABLE silences;
+ALTER TABLE silences_new RENAME TO silences;
+
+CREATE INDEX IF NOT EXISTS idx_silences_alert_id
+    ON silences(alert_id)
+    WHERE alert_id IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_silences_instance
+    ON silences(instance)
+    WHERE instance IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_silences_fingerprint
+    ON silences(fingerprint)
+    WHERE fingerprint IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_silences_end_at
+    ON silences(end_at);
+
+CREATE INDEX IF NOT EXISTS idx_silences_active
+    ON silences(start_at, end_at);
+
+-- Insert version 9
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (9, datetime('now'));
@@ -0,0 +1,15 @@
+-- SQLite Schema Rollback: Tenants
+-- Version: 10
+-- Description: Drop the tenant columns and restore the global firing fingerprint index
+
+DROP INDEX IF EXISTS idx_silences_tenant_id;
+DROP INDEX IF EXISTS idx_alerts_tenant_id;
+DROP INDEX IF EXISTS idx_alerts_firing_fingerprint;
+
+ALTER TABLE ack_events DROP COLUMN tenant_id;
+ALTER TABLE silences DROP COLUMN tenant_id;
+ALTER TABLE alerts DROP COLUMN tenant_id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_alerts_firing_fingerprint
+    ON alerts(fingerprint)
+    WHERE state IN ('active', 'acknowledged');
@@ -0,0 +1,25 @@
+-- SQLite Schema Migration: Tenants
+-- Version: 10
+-- Description: Scope alerts, silences and ack events to a tenant. Existing
+-- rows belong to the default tenant (empty string), and firing fingerprints
+-- only need to be unique within a tenant.
+
+ALTER TABLE alerts ADD COLUMN tenant_id TEXT NOT NULL DEFAULT '';
+ALTER TABLE silences ADD COLUMN tenant_id TEXT NOT NULL DEFAULT '';
+ALTER TABLE ack_events ADD COLUMN tenant_id TEXT NOT NULL DEFAULT '';
+
+DROP INDEX IF EXISTS idx_alerts_firing_fingerprint;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_alerts_firing_fingerprint
+    ON alerts(tenant_id, fingerprint)
+    WHERE state IN ('active', 'acknowledged');
+
+CREATE INDEX IF NOT EXISTS idx_alerts_tenant_id
+    ON alerts(tenant_id, state);
+
+CREATE INDEX IF NOT EXISTS idx_silences_tenant_id
+    ON silences(tenant_id);
+
+-- Insert version 10
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (10, datetime('now'));
@@ -0,0 +1,5 @@
+-- SQLite Schema Rollback: Notification Deliveries
+-- Version: 11
+-- Description: Drop the notification deliveries table
+
+DROP TABLE IF EXISTS notification_deliveries;
@@ -0,0 +1,23 @@
+-- SQLite Schema Migration: Notification Deliveries
+-- Version: 11
+-- Description: Record whether each notification reached its notifier
+
+CREATE TABLE IF NOT EXISTS notification_deliveries (
+    id TEXT PRIMARY KEY NOT NULL,
+    alert_id TEXT NOT NULL,
+    notifier TEXT NOT NULL,
+    action TEXT NOT NULL CHECK(action IN ('notify', 'update')),
+    status TEXT NOT NULL CHECK(status IN ('sent', 'failed')),
+    external_reference TEXT DEFAULT NULL,
+    error TEXT DEFAULT NULL,
+    created_at TEXT NOT NULL,
+
+    FOREIGN KEY (alert_id) REFERENCES alerts(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_deliveries_alert_created
+    ON notification_deliveries(alert_id, created_at);
+
+-- Insert version 11
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (11, datetime('now'));
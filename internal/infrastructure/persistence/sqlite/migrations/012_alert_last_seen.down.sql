@@ -0,0 +1,6 @@
+-- SQLite Schema Rollback: Alert Last Seen
+-- Version: 12
+-- Description: Drop the last seen column
+
+DROP INDEX IF EXISTS idx_alerts_last_seen_at;
+ALTER TABLE alerts DROP COLUMN last_seen_at;
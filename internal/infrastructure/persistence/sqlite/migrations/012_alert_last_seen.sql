@@ -0,0 +1,15 @@
+-- SQLite Schema Migration: Alert Last Seen
+-- Version: 12
+-- Description: Track when each alert was last re-fired so stale alerts can be auto-resolved
+
+ALTER TABLE alerts ADD COLUMN last_seen_at TEXT DEFAULT NULL;
+
+UPDATE alerts SET last_seen_at = updated_at WHERE last_seen_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_alerts_last_seen_at
+    ON alerts(last_seen_at)
+    WHERE state IN ('active', 'acknowledged');
+
+-- Insert version 12
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (12, datetime('now'));
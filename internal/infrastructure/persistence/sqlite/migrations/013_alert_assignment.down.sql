@@ -0,0 +1,7 @@
+-- SQLite Schema Rollback: Alert Assignment
+-- Version: 13
+-- Description: Drop the assignment columns
+
+DROP INDEX IF EXISTS idx_alerts_assigned_to;
+ALTER TABLE alerts DROP COLUMN assignments;
+ALTER TABLE alerts DROP COLUMN assigned_to;
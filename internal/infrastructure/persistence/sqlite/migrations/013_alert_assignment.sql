@@ -0,0 +1,14 @@
+-- SQLite Schema Migration: Alert Assignment
+-- Version: 13
+-- Description: Track the responder each alert is assigned to and the history of assignment changes
+
+ALTER TABLE alerts ADD COLUMN assigned_to TEXT DEFAULT NULL;
+ALTER TABLE alerts ADD COLUMN assignments TEXT DEFAULT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_alerts_assigned_to
+    ON alerts(assigned_to)
+    WHERE assigned_to IS NOT NULL;
+
+-- Insert version 13
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (13, datetime('now'));
@@ -0,0 +1,5 @@
+-- SQLite Schema Rollback: Outbox Claims
+-- Version: 14
+-- Description: Drop the outbox claim column
+
+ALTER TABLE notification_outbox DROP COLUMN claimed_until;
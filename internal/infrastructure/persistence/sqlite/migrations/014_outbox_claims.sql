@@ -0,0 +1,9 @@
+-- SQLite Schema Migration: Outbox Claims
+-- Version: 14
+-- Description: Lease pending outbox entries to one dispatcher so instances do not deliver them twice
+
+ALTER TABLE notification_outbox ADD COLUMN claimed_until TEXT DEFAULT NULL;
+
+-- Insert version 14
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (14, datetime('now'));
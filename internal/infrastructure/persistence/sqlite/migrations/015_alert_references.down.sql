@@ -0,0 +1,5 @@
+-- SQLite Schema Rollback: Alert References
+-- Version: 15
+-- Description: Drop the external reference index table
+
+DROP TABLE IF EXISTS alert_references;
@@ -0,0 +1,40 @@
+-- SQLite Schema Migration: Alert References
+-- Version: 15
+-- Description: Index external reference IDs so FindByExternalReference does
+-- not scan the external_references JSON of every alert
+
+CREATE TABLE IF NOT EXISTS alert_references (
+    alert_id TEXT NOT NULL,
+    reference_system TEXT NOT NULL,
+    reference_id TEXT NOT NULL,
+    FOREIGN KEY (alert_id) REFERENCES alerts(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_alert_references_lookup
+    ON alert_references(reference_system, reference_id);
+
+CREATE INDEX IF NOT EXISTS idx_alert_references_alert_id
+    ON alert_references(alert_id);
+
+-- Index the references of existing alerts: every ID of a comma-separated
+-- value, and the whole value when it holds several
+INSERT INTO alert_references (alert_id, reference_system, reference_id)
+WITH RECURSIVE refs (alert_id, reference_system, rest, reference_id) AS (
+    SELECT alerts.id, stored.key, stored.value || ',',
+        CASE WHEN instr(stored.value, ',') > 0 THEN stored.value END
+    FROM alerts, json_each(CASE WHEN json_valid(alerts.external_references)
+        THEN alerts.external_references ELSE '{}' END) AS stored
+    WHERE stored.type = 'text'
+    UNION ALL
+    SELECT alert_id, reference_system, substr(rest, instr(rest, ',') + 1),
+        substr(rest, 1, instr(rest, ',') - 1)
+    FROM refs
+    WHERE rest != ''
+)
+SELECT DISTINCT alert_id, reference_system, reference_id
+FROM refs
+WHERE reference_id != '';
+
+-- Insert version 15
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (15, datetime('now'));
@@ -0,0 +1,152 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+)
+
+// OutboxRepository provides SQLite implementation of repository.OutboxRepository.
+type OutboxRepository struct {
+	db *DB
+}
+
+// NewOutboxRepository creates a new SQLite-backed outbox repository.
+func NewOutboxRepository(db *DB) *OutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+// Save records a new pending entry, joining the transaction in ctx if any.
+func (r *OutboxRepository) Save(ctx context.Context, entry *entity.OutboxEntry) error {
+	_, err := r.db.getExecutor(ctx).ExecContext(ctx, `
+		INSERT INTO notification_outbox (
+			id, alert_id, action, attempts, last_error, created_at, sent_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?)
+	`,
+		entry.ID, entry.AlertID, string(entry.Action), entry.Attempts,
+		nullString(entry.LastError), timeToString(entry.CreatedAt), nullTime(entry.SentAt),
+	)
+	if err != nil {
+		if isUniqueConstraintError(err) {
+			return repository.ErrAlreadyExists
+		}
+		return fmt.Errorf("insert outbox entry: %w", err)
+	}
+
+	return nil
+}
+
+// ClaimPending claims up to limit undelivered, unclaimed entries below
+// maxAttempts, oldest first, for lease. Each entry is claimed with a
+// conditional update, so an entry another connection claimed first is
+// skipped.
+func (r *OutboxRepository) ClaimPending(ctx context.Context, maxAttempts, limit int, lease time.Duration) ([]*entity.OutboxEntry, error) {
+	now := time.Now().UTC()
+	candidates, err := r.findClaimable(ctx, maxAttempts, limit, now)
+	if err != nil {
+		return nil, err
+	}
+
+	claimedUntil := timeToString(now.Add(lease))
+	var entries []*entity.OutboxEntry
+	for _, entry := range candidates {
+		result, err := r.db.getExecutor(ctx).ExecContext(ctx, `
+			UPDATE notification_outbox SET claimed_until = ?
+			WHERE id = ? AND sent_at IS NULL AND (claimed_until IS NULL OR claimed_until <= ?)
+		`, claimedUntil, entry.ID, timeToString(now))
+		if err != nil {
+			return nil, fmt.Errorf("claim outbox entry: %w", err)
+		}
+		if err := checkOutboxRowsAffected(result); err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// findClaimable returns the entries ClaimPending tries to claim.
+func (r *OutboxRepository) findClaimable(ctx context.Context, maxAttempts, limit int, now time.Time) ([]*entity.OutboxEntry, error) {
+	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, `
+		SELECT id, alert_id, action, attempts, last_error, created_at, sent_at
+		FROM notification_outbox
+		WHERE sent_at IS NULL AND attempts < ?
+			AND (claimed_until IS NULL OR claimed_until <= ?)
+		ORDER BY created_at ASC, rowid ASC
+		LIMIT ?
+	`, maxAttempts, timeToString(now), limit)
+	if err != nil {
+		return nil, fmt.Errorf("query pending outbox entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*entity.OutboxEntry
+	for rows.Next() {
+		var (
+			entry     entity.OutboxEntry
+			action    string
+			lastError sql.NullString
+			createdAt string
+			sentAt    sql.NullString
+		)
+		if err := rows.Scan(&entry.ID, &entry.AlertID, &action, &entry.Attempts, &lastError, &createdAt, &sentAt); err != nil {
+			return nil, fmt.Errorf("scan outbox entry: %w", err)
+		}
+
+		entry.Action = entity.OutboxAction(action)
+		entry.LastError = stringFromNull(lastError)
+		entry.SentAt = scanNullTime(sentAt)
+		if entry.CreatedAt, err = parseTime(createdAt); err != nil {
+			return nil, fmt.Errorf("parse created_at: %w", err)
+		}
+		entries = append(entries, &entry)
+	}
+
+	return entries, rows.Err()
+}
+
+// MarkSent records the entry as delivered.
+func (r *OutboxRepository) MarkSent(ctx context.Context, id string, sentAt time.Time) error {
+	result, err := r.db.getExecutor(ctx).ExecContext(ctx, `
+		UPDATE notification_outbox SET sent_at = ? WHERE id = ?
+	`, timeToString(sentAt), id)
+	if err != nil {
+		return fmt.Errorf("mark outbox entry sent: %w", err)
+	}
+
+	return checkOutboxRowsAffected(result)
+}
+
+// MarkFailed increments the entry's attempt count, records the error and
+// keeps the entry claimed until retryAt, so polls before then skip it.
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id string, lastError string, retryAt time.Time) error {
+	result, err := r.db.getExecutor(ctx).ExecContext(ctx, `
+		UPDATE notification_outbox SET attempts = attempts + 1, last_error = ?, claimed_until = ? WHERE id = ?
+	`, nullString(lastError), timeToString(retryAt.UTC()), id)
+	if err != nil {
+		return fmt.Errorf("mark outbox entry failed: %w", err)
+	}
+
+	return checkOutboxRowsAffected(result)
+}
+
+// checkOutboxRowsAffected returns ErrNotFound if an update matched no entry.
+func checkOutboxRowsAffected(result sql.Result) error {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
 )
 
 // SilenceRepository provides SQLite implementation of repository.SilenceRepository.
@@ -29,8 +30,9 @@ func (r *SilenceRepository) Save(ctx context.Context, silence *entity.SilenceMar
 	_, err = r.db.getExecutor(ctx).ExecContext(ctx, `
 		INSERT INTO silences (
 			id, alert_id, instance, fingerprint, labels,
-			start_at, end_at, created_by, created_by_email, reason, source, created_at
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			start_at, end_at, created_by, created_by_email, reason, source, created_at,
+			tenant_id
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`,
 		silence.ID,
 		nullString(silence.AlertID),
@@ -44,23 +46,29 @@ func (r *SilenceRepository) Save(ctx context.Context, silence *entity.SilenceMar
 		silence.Reason,
 		string(silence.Source),
 		timeToString(silence.CreatedAt),
+		silence.TenantID,
 	)
 
 	if err != nil {
+		if isUniqueConstraintError(err) {
+			return repository.ErrAlreadyExists
+		}
 		return fmt.Errorf("insert silence: %w", err)
 	}
 
+	silence.Version = 1
 	return nil
 }
 
 // FindByID retrieves a silence by its unique identifier.
 // Returns nil, nil if not found.
 func (r *SilenceRepository) FindByID(ctx context.Context, id string) (*entity.SilenceMark, error) {
-	row := r.db.getExecutor(ctx).QueryRowContext(ctx, `
+	query, args := withTenant(ctx, `
 		SELECT id, alert_id, instance, fingerprint, labels,
-			start_at, end_at, created_by, created_by_email, reason, source, created_at
-		FROM silences WHERE id = ?
-	`, id)
+			start_at, end_at, created_by, created_by_email, reason, source, created_at,
+			version, tenant_id
+		FROM silences WHERE id = ?`, id)
+	row := r.db.getExecutor(ctx).QueryRowContext(ctx, query, args...)
 
 	return scanSilence(row)
 }
@@ -70,12 +78,13 @@ func (r *SilenceRepository) FindByID(ctx context.Context, id string) (*entity.Si
 func (r *SilenceRepository) FindActive(ctx context.Context) ([]*entity.SilenceMark, error) {
 	now := timeToString(time.Now().UTC())
 
-	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, `
+	query, args := withTenant(ctx, `
 		SELECT id, alert_id, instance, fingerprint, labels,
-			start_at, end_at, created_by, created_by_email, reason, source, created_at
+			start_at, end_at, created_by, created_by_email, reason, source, created_at,
+			version, tenant_id
 		FROM silences
-		WHERE start_at <= ? AND end_at > ?
-	`, now, now)
+		WHERE start_at <= ? AND end_at > ?`, now, now)
+	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, query+" ORDER BY created_at DESC", args...)
 	if err != nil {
 		return nil, fmt.Errorf("query active silences: %w", err)
 	}
@@ -89,12 +98,13 @@ func (r *SilenceRepository) FindActive(ctx context.Context) ([]*entity.SilenceMa
 func (r *SilenceRepository) FindByAlertID(ctx context.Context, alertID string) ([]*entity.SilenceMark, error) {
 	now := timeToString(time.Now().UTC())
 
-	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, `
+	query, args := withTenant(ctx, `
 		SELECT id, alert_id, instance, fingerprint, labels,
-			start_at, end_at, created_by, created_by_email, reason, source, created_at
+			start_at, end_at, created_by, created_by_email, reason, source, created_at,
+			version, tenant_id
 		FROM silences
-		WHERE alert_id = ? AND start_at <= ? AND end_at > ?
-	`, alertID, now, now)
+		WHERE alert_id = ? AND start_at <= ? AND end_at > ?`, alertID, now, now)
+	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, query+" ORDER BY created_at DESC", args...)
 	if err != nil {
 		return nil, fmt.Errorf("query silences by alert ID: %w", err)
 	}
@@ -108,12 +118,13 @@ func (r *SilenceRepository) FindByAlertID(ctx context.Context, alertID string) (
 func (r *SilenceRepository) FindByInstance(ctx context.Context, instance string) ([]*entity.SilenceMark, error) {
 	now := timeToString(time.Now().UTC())
 
-	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, `
+	query, args := withTenant(ctx, `
 		SELECT id, alert_id, instance, fingerprint, labels,
-			start_at, end_at, created_by, created_by_email, reason, source, created_at
+			start_at, end_at, created_by, created_by_email, reason, source, created_at,
+			version, tenant_id
 		FROM silences
-		WHERE instance = ? AND start_at <= ? AND end_at > ?
-	`, instance, now, now)
+		WHERE instance = ? AND start_at <= ? AND end_at > ?`, instance, now, now)
+	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, query+" ORDER BY created_at DESC", args...)
 	if err != nil {
 		return nil, fmt.Errorf("query silences by instance: %w", err)
 	}
@@ -127,12 +138,13 @@ func (r *SilenceRepository) FindByInstance(ctx context.Context, instance string)
 func (r *SilenceRepository) FindByFingerprint(ctx context.Context, fingerprint string) ([]*entity.SilenceMark, error) {
 	now := timeToString(time.Now().UTC())
 
-	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, `
+	query, args := withTenant(ctx, `
 		SELECT id, alert_id, instance, fingerprint, labels,
-			start_at, end_at, created_by, created_by_email, reason, source, created_at
+			start_at, end_at, created_by, created_by_email, reason, source, created_at,
+			version, tenant_id
 		FROM silences
-		WHERE fingerprint = ? AND start_at <= ? AND end_at > ?
-	`, fingerprint, now, now)
+		WHERE fingerprint = ? AND start_at <= ? AND end_at > ?`, fingerprint, now, now)
+	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, query+" ORDER BY created_at DESC", args...)
 	if err != nil {
 		return nil, fmt.Errorf("query silences by fingerprint: %w", err)
 	}
@@ -147,13 +159,14 @@ func (r *SilenceRepository) FindByFingerprint(ctx context.Context, fingerprint s
 func (r *SilenceRepository) FindMatchingAlert(ctx context.Context, alert *entity.Alert) ([]*entity.SilenceMark, error) {
 	now := timeToString(time.Now().UTC())
 
-	// Query all active silences
+	// Query all active silences of the alert's tenant, and the global ones
 	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, `
 		SELECT id, alert_id, instance, fingerprint, labels,
-			start_at, end_at, created_by, created_by_email, reason, source, created_at
+			start_at, end_at, created_by, created_by_email, reason, source, created_at,
+			version, tenant_id
 		FROM silences
-		WHERE start_at <= ? AND end_at > ?
-	`, now, now)
+		WHERE start_at <= ? AND end_at > ? AND tenant_id IN ('', ?)
+	`, now, now, alert.TenantID)
 	if err != nil {
 		return nil, fmt.Errorf("query active silences: %w", err)
 	}
@@ -165,7 +178,7 @@ func (r *SilenceRepository) FindMatchingAlert(ctx context.Context, alert *entity
 	}
 
 	// Filter silences that match the alert
-	var matches []*entity.SilenceMark
+	matches := make([]*entity.SilenceMark, 0)
 	for _, silence := range silences {
 		if silence.MatchesAlert(alert) {
 			matches = append(matches, silence)
@@ -175,20 +188,50 @@ func (r *SilenceRepository) FindMatchingAlert(ctx context.Context, alert *entity
 	return matches, nil
 }
 
-// Update modifies an existing silence.
+// CountActive returns the number of currently active silences.
+func (r *SilenceRepository) CountActive(ctx context.Context) (int, error) {
+	now := timeToString(time.Now().UTC())
+
+	query, args := withTenant(ctx, `SELECT COUNT(*) FROM silences WHERE start_at <= ? AND end_at > ?`, now, now)
+
+	var count int
+	err := r.db.getExecutor(ctx).QueryRowContext(ctx, query, args...).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("count active silences: %w", err)
+	}
+
+	return count, nil
+}
+
+// Update modifies an existing silence with optimistic locking.
 // Returns ErrSilenceNotFound if the silence doesn't exist.
+// Returns ErrConcurrentUpdate if the silence was modified since it was read.
 func (r *SilenceRepository) Update(ctx context.Context, silence *entity.SilenceMark) error {
+	exec := r.db.getExecutor(ctx)
+
+	// Silences that carry no version are checked against the stored one
+	expectedVersion := silence.Version
+	if expectedVersion == 0 {
+		err := exec.QueryRowContext(ctx, `SELECT version FROM silences WHERE id = ?`, silence.ID).Scan(&expectedVersion)
+		if err == sql.ErrNoRows {
+			return repository.ErrSilenceNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("check silence version: %w", err)
+		}
+	}
+
 	labels, err := marshalJSON(silence.Labels)
 	if err != nil {
 		return fmt.Errorf("marshal labels: %w", err)
 	}
 
-	result, err := r.db.getExecutor(ctx).ExecContext(ctx, `
+	result, err := exec.ExecContext(ctx, `
 		UPDATE silences SET
 			alert_id = ?, instance = ?, fingerprint = ?, labels = ?,
 			start_at = ?, end_at = ?, created_by = ?, created_by_email = ?,
-			reason = ?, source = ?
-		WHERE id = ?
+			reason = ?, source = ?, version = version + 1
+		WHERE id = ? AND version = ?
 	`,
 		nullString(silence.AlertID),
 		nullString(silence.Instance),
@@ -200,7 +243,7 @@ func (r *SilenceRepository) Update(ctx context.Context, silence *entity.SilenceM
 		silence.CreatedByEmail,
 		silence.Reason,
 		string(silence.Source),
-		silence.ID,
+		silence.ID, expectedVersion,
 	)
 	if err != nil {
 		return fmt.Errorf("update silence: %w", err)
@@ -211,9 +254,19 @@ func (r *SilenceRepository) Update(ctx context.Context, silence *entity.SilenceM
 		return fmt.Errorf("get rows affected: %w", err)
 	}
 	if rowsAffected == 0 {
-		return entity.ErrSilenceNotFound
+		// Either the silence doesn't exist or it changed since it was read
+		var exists bool
+		err := exec.QueryRowContext(ctx, `SELECT COUNT(*) > 0 FROM silences WHERE id = ?`, silence.ID).Scan(&exists)
+		if err != nil {
+			return fmt.Errorf("check silence existence: %w", err)
+		}
+		if !exists {
+			return repository.ErrSilenceNotFound
+		}
+		return repository.ErrConcurrentUpdate
 	}
 
+	silence.Version = expectedVersion + 1
 	return nil
 }
 
@@ -230,7 +283,7 @@ func (r *SilenceRepository) Delete(ctx context.Context, id string) error {
 		return fmt.Errorf("get rows affected: %w", err)
 	}
 	if rowsAffected == 0 {
-		return entity.ErrSilenceNotFound
+		return repository.ErrSilenceNotFound
 	}
 
 	return nil
@@ -271,7 +324,7 @@ func scanSilence(row *sql.Row) (*entity.SilenceMark, error) {
 	err := row.Scan(
 		&silence.ID, &alertID, &instance, &fingerprint, &labels,
 		&startAt, &endAt, &silence.CreatedBy, &silence.CreatedByEmail,
-		&silence.Reason, &source, &createdAt,
+		&silence.Reason, &source, &createdAt, &silence.Version, &silence.TenantID,
 	)
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -299,7 +352,7 @@ func scanSilence(row *sql.Row) (*entity.SilenceMark, error) {
 
 // scanSilences scans multiple rows into SilenceMark entities.
 func scanSilences(rows *sql.Rows) ([]*entity.SilenceMark, error) {
-	var silences []*entity.SilenceMark
+	silences := make([]*entity.SilenceMark, 0)
 
 	for rows.Next() {
 		var (
@@ -317,7 +370,7 @@ func scanSilences(rows *sql.Rows) ([]*entity.SilenceMark, error) {
 		err := rows.Scan(
 			&silence.ID, &alertID, &instance, &fingerprint, &labels,
 			&startAt, &endAt, &silence.CreatedBy, &silence.CreatedByEmail,
-			&silence.Reason, &source, &createdAt,
+			&silence.Reason, &source, &createdAt, &silence.Version, &silence.TenantID,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("scan silence row: %w", err)
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -487,6 +488,7 @@ func TestSilenceRepository_Update(t *testing.T) {
 
 		err := repo.Update(context.Background(), silence)
 		assert.ErrorIs(t, err, entity.ErrSilenceNotFound)
+		assert.ErrorIs(t, err, repository.ErrNotFound)
 	})
 }
 
@@ -525,6 +527,7 @@ func TestSilenceRepository_Delete(t *testing.T) {
 	t.Run("delete nonexistent silence", func(t *testing.T) {
 		err := repo.Delete(context.Background(), "nonexistent")
 		assert.ErrorIs(t, err, entity.ErrSilenceNotFound)
+		assert.ErrorIs(t, err, repository.ErrNotFound)
 	})
 }
 
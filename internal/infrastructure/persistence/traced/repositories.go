@@ -0,0 +1,316 @@
+// Package traced provides repository decorators that record an OpenTelemetry
+// span for every storage call, independent of the underlying backend.
+package traced
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/observability"
+)
+
+// startSpan starts a span named "<repo>.<operation>".
+func startSpan(ctx context.Context, repo, operation string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	attrs = append(attrs, observability.AttrDBOperation.String(operation))
+	return observability.StartSpan(ctx, repo+"."+operation, attrs...)
+}
+
+// AlertRepository wraps a repository.AlertRepository with tracing.
+type AlertRepository struct {
+	next repository.AlertRepository
+}
+
+// NewAlertRepository creates a tracing decorator for an alert repository.
+func NewAlertRepository(next repository.AlertRepository) *AlertRepository {
+	return &AlertRepository{next: next}
+}
+
+func (r *AlertRepository) span(ctx context.Context, op string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return startSpan(ctx, "AlertRepository", op, attrs...)
+}
+
+// Save persists a new alert.
+func (r *AlertRepository) Save(ctx context.Context, alert *entity.Alert) (err error) {
+	ctx, span := r.span(ctx, "Save", observability.AttrAlertID.String(alert.ID), observability.AttrAlertFingerprint.String(alert.Fingerprint))
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.Save(ctx, alert)
+}
+
+// UpsertByFingerprint saves the alert unless a firing alert with the same fingerprint exists.
+func (r *AlertRepository) UpsertByFingerprint(ctx context.Context, alert *entity.Alert) (_ *entity.Alert, _ bool, err error) {
+	ctx, span := r.span(ctx, "UpsertByFingerprint", observability.AttrAlertFingerprint.String(alert.Fingerprint))
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.UpsertByFingerprint(ctx, alert)
+}
+
+// FindByID retrieves an alert by its unique identifier.
+func (r *AlertRepository) FindByID(ctx context.Context, id string) (_ *entity.Alert, err error) {
+	ctx, span := r.span(ctx, "FindByID", observability.AttrAlertID.String(id))
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.FindByID(ctx, id)
+}
+
+// FindByFingerprint finds alerts matching the Alertmanager fingerprint.
+func (r *AlertRepository) FindByFingerprint(ctx context.Context, fingerprint string) (_ []*entity.Alert, err error) {
+	ctx, span := r.span(ctx, "FindByFingerprint", observability.AttrAlertFingerprint.String(fingerprint))
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.FindByFingerprint(ctx, fingerprint)
+}
+
+// FindByExternalReference finds an alert by its external system reference.
+func (r *AlertRepository) FindByExternalReference(ctx context.Context, system, referenceID string) (_ *entity.Alert, err error) {
+	ctx, span := r.span(ctx, "FindByExternalReference", observability.AttrNotifierName.String(system))
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.FindByExternalReference(ctx, system, referenceID)
+}
+
+// Update modifies an existing alert.
+func (r *AlertRepository) Update(ctx context.Context, alert *entity.Alert) (err error) {
+	ctx, span := r.span(ctx, "Update", observability.AttrAlertID.String(alert.ID), observability.AttrAlertFingerprint.String(alert.Fingerprint))
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.Update(ctx, alert)
+}
+
+// FindActive returns all currently active alerts.
+func (r *AlertRepository) FindActive(ctx context.Context) (_ []*entity.Alert, err error) {
+	ctx, span := r.span(ctx, "FindActive")
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.FindActive(ctx)
+}
+
+// GetActiveAlerts returns active alerts, optionally filtered by severity.
+func (r *AlertRepository) GetActiveAlerts(ctx context.Context, severity string) (_ []*entity.Alert, err error) {
+	ctx, span := r.span(ctx, "GetActiveAlerts")
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.GetActiveAlerts(ctx, severity)
+}
+
+// GetActiveAlertsByPriority returns active alerts, most urgent first.
+func (r *AlertRepository) GetActiveAlertsByPriority(ctx context.Context, severity string, weights entity.SeverityWeights) (_ []*entity.Alert, err error) {
+	ctx, span := r.span(ctx, "GetActiveAlertsByPriority")
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.GetActiveAlertsByPriority(ctx, severity, weights)
+}
+
+// FindFiring returns all firing alerts.
+func (r *AlertRepository) FindFiring(ctx context.Context) (_ []*entity.Alert, err error) {
+	ctx, span := r.span(ctx, "FindFiring")
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.FindFiring(ctx)
+}
+
+// FindFiringByCorrelationID returns the firing alerts sharing a correlation ID.
+func (r *AlertRepository) FindFiringByCorrelationID(ctx context.Context, correlationID string) (_ []*entity.Alert, err error) {
+	ctx, span := r.span(ctx, "FindFiringByCorrelationID")
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.FindFiringByCorrelationID(ctx, correlationID)
+}
+
+// FindNotSeenSince returns the firing alerts last seen before the given time.
+func (r *AlertRepository) FindNotSeenSince(ctx context.Context, before time.Time) (_ []*entity.Alert, err error) {
+	ctx, span := r.span(ctx, "FindNotSeenSince")
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.FindNotSeenSince(ctx, before)
+}
+
+// FindByLabels returns the firing alerts carrying every matcher label.
+func (r *AlertRepository) FindByLabels(ctx context.Context, matchers map[string]string) (_ []*entity.Alert, err error) {
+	ctx, span := r.span(ctx, "FindByLabels")
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.FindByLabels(ctx, matchers)
+}
+
+// CountByStateSeverity aggregates stored alerts by state and severity.
+func (r *AlertRepository) CountByStateSeverity(ctx context.Context) (_ []*entity.StateSeverityCount, err error) {
+	ctx, span := r.span(ctx, "CountByStateSeverity")
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.CountByStateSeverity(ctx)
+}
+
+// Delete removes an alert by ID.
+func (r *AlertRepository) Delete(ctx context.Context, id string) (err error) {
+	ctx, span := r.span(ctx, "Delete", observability.AttrAlertID.String(id))
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.Delete(ctx, id)
+}
+
+// AckEventRepository wraps a repository.AckEventRepository with tracing.
+type AckEventRepository struct {
+	next repository.AckEventRepository
+}
+
+// NewAckEventRepository creates a tracing decorator for an ack event repository.
+func NewAckEventRepository(next repository.AckEventRepository) *AckEventRepository {
+	return &AckEventRepository{next: next}
+}
+
+func (r *AckEventRepository) span(ctx context.Context, op string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return startSpan(ctx, "AckEventRepository", op, attrs...)
+}
+
+// Save persists a new ack event.
+func (r *AckEventRepository) Save(ctx context.Context, event *entity.AckEvent) (err error) {
+	ctx, span := r.span(ctx, "Save", observability.AttrAlertID.String(event.AlertID))
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.Save(ctx, event)
+}
+
+// FindByAlertID retrieves all ack events for an alert.
+func (r *AckEventRepository) FindByAlertID(ctx context.Context, alertID string) (_ []*entity.AckEvent, err error) {
+	ctx, span := r.span(ctx, "FindByAlertID", observability.AttrAlertID.String(alertID))
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.FindByAlertID(ctx, alertID)
+}
+
+// FindByID retrieves an ack event by its ID.
+func (r *AckEventRepository) FindByID(ctx context.Context, id string) (_ *entity.AckEvent, err error) {
+	ctx, span := r.span(ctx, "FindByID")
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.FindByID(ctx, id)
+}
+
+// FindLatestByAlertID retrieves the most recent ack event for an alert.
+func (r *AckEventRepository) FindLatestByAlertID(ctx context.Context, alertID string) (_ *entity.AckEvent, err error) {
+	ctx, span := r.span(ctx, "FindLatestByAlertID", observability.AttrAlertID.String(alertID))
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.FindLatestByAlertID(ctx, alertID)
+}
+
+// GetTopAcknowledgers returns users with the most acknowledgments.
+func (r *AckEventRepository) GetTopAcknowledgers(ctx context.Context, limit int) (_ []*entity.UserAckCount, err error) {
+	ctx, span := r.span(ctx, "GetTopAcknowledgers")
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.GetTopAcknowledgers(ctx, limit)
+}
+
+// SilenceRepository wraps a repository.SilenceRepository with tracing.
+type SilenceRepository struct {
+	next repository.SilenceRepository
+}
+
+// NewSilenceRepository creates a tracing decorator for a silence repository.
+func NewSilenceRepository(next repository.SilenceRepository) *SilenceRepository {
+	return &SilenceRepository{next: next}
+}
+
+func (r *SilenceRepository) span(ctx context.Context, op string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return startSpan(ctx, "SilenceRepository", op, attrs...)
+}
+
+// Save persists a new silence.
+func (r *SilenceRepository) Save(ctx context.Context, silence *entity.SilenceMark) (err error) {
+	ctx, span := r.span(ctx, "Save")
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.Save(ctx, silence)
+}
+
+// FindByID retrieves a silence by its ID.
+func (r *SilenceRepository) FindByID(ctx context.Context, id string) (_ *entity.SilenceMark, err error) {
+	ctx, span := r.span(ctx, "FindByID")
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.FindByID(ctx, id)
+}
+
+// FindActive returns all currently active silences.
+func (r *SilenceRepository) FindActive(ctx context.Context) (_ []*entity.SilenceMark, err error) {
+	ctx, span := r.span(ctx, "FindActive")
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.FindActive(ctx)
+}
+
+// FindByAlertID retrieves active silences for a specific alert.
+func (r *SilenceRepository) FindByAlertID(ctx context.Context, alertID string) (_ []*entity.SilenceMark, err error) {
+	ctx, span := r.span(ctx, "FindByAlertID", observability.AttrAlertID.String(alertID))
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.FindByAlertID(ctx, alertID)
+}
+
+// FindByInstance retrieves active silences for a specific instance.
+func (r *SilenceRepository) FindByInstance(ctx context.Context, instance string) (_ []*entity.SilenceMark, err error) {
+	ctx, span := r.span(ctx, "FindByInstance")
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.FindByInstance(ctx, instance)
+}
+
+// FindByFingerprint retrieves active silences for a specific fingerprint.
+func (r *SilenceRepository) FindByFingerprint(ctx context.Context, fingerprint string) (_ []*entity.SilenceMark, err error) {
+	ctx, span := r.span(ctx, "FindByFingerprint", observability.AttrAlertFingerprint.String(fingerprint))
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.FindByFingerprint(ctx, fingerprint)
+}
+
+// FindMatchingAlert returns all active silences that match the given alert.
+func (r *SilenceRepository) FindMatchingAlert(ctx context.Context, alert *entity.Alert) (_ []*entity.SilenceMark, err error) {
+	ctx, span := r.span(ctx, "FindMatchingAlert", observability.AttrAlertFingerprint.String(alert.Fingerprint))
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.FindMatchingAlert(ctx, alert)
+}
+
+// CountActive returns the number of currently active silences.
+func (r *SilenceRepository) CountActive(ctx context.Context) (_ int, err error) {
+	ctx, span := r.span(ctx, "CountActive")
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.CountActive(ctx)
+}
+
+// Update modifies an existing silence.
+func (r *SilenceRepository) Update(ctx context.Context, silence *entity.SilenceMark) (err error) {
+	ctx, span := r.span(ctx, "Update")
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.Update(ctx, silence)
+}
+
+// Delete removes a silence by ID.
+func (r *SilenceRepository) Delete(ctx context.Context, id string) (err error) {
+	ctx, span := r.span(ctx, "Delete")
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.Delete(ctx, id)
+}
+
+// DeleteExpired removes all expired silences.
+func (r *SilenceRepository) DeleteExpired(ctx context.Context) (_ int, err error) {
+	ctx, span := r.span(ctx, "DeleteExpired")
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.DeleteExpired(ctx)
+}
+
+// DeliveryRepository wraps a repository.DeliveryRepository with tracing.
+type DeliveryRepository struct {
+	next repository.DeliveryRepository
+}
+
+// NewDeliveryRepository creates a tracing decorator for a delivery repository.
+func NewDeliveryRepository(next repository.DeliveryRepository) *DeliveryRepository {
+	return &DeliveryRepository{next: next}
+}
+
+func (r *DeliveryRepository) span(ctx context.Context, op string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return startSpan(ctx, "DeliveryRepository", op, attrs...)
+}
+
+// Save records a delivery.
+func (r *DeliveryRepository) Save(ctx context.Context, delivery *entity.NotificationDelivery) (err error) {
+	ctx, span := r.span(ctx, "Save", observability.AttrAlertID.String(delivery.AlertID))
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.Save(ctx, delivery)
+}
+
+// FindByAlertID retrieves the deliveries of an alert.
+func (r *DeliveryRepository) FindByAlertID(ctx context.Context, alertID string) (_ []*entity.NotificationDelivery, err error) {
+	ctx, span := r.span(ctx, "FindByAlertID", observability.AttrAlertID.String(alertID))
+	defer func() { observability.EndSpan(span, err) }()
+	return r.next.FindByAlertID(ctx, alertID)
+}
+
+// Compile-time interface checks.
+var (
+	_ repository.AlertRepository    = (*AlertRepository)(nil)
+	_ repository.AckEventRepository = (*AckEventRepository)(nil)
+	_ repository.SilenceRepository  = (*SilenceRepository)(nil)
+	_ repository.DeliveryRepository = (*DeliveryRepository)(nil)
+)
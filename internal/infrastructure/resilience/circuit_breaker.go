@@ -38,18 +38,26 @@ var (
 	ErrCircuitOpen = errors.New("circuit breaker is open")
 )
 
+// StateChangeFunc is called after a circuit breaker moves between states.
+type StateChangeFunc func(name string, from, to State)
+
 // CircuitBreaker implements the circuit breaker pattern to prevent cascading failures.
+// After maxFailures consecutive failures it opens and rejects requests until
+// timeout has passed, then half-opens and lets one request at a time probe
+// for recovery.
 type CircuitBreaker struct {
-	name         string
-	maxFailures  int
-	timeout      time.Duration
-	halfOpenSucc int // Successes needed in half-open to close
+	name          string
+	maxFailures   int
+	timeout       time.Duration
+	halfOpenSucc  int // Successes needed in half-open to close
+	onStateChange StateChangeFunc
 
 	mu           sync.RWMutex
 	state        State
 	failures     int
 	lastFailTime time.Time
 	successCount int
+	probing      bool // A half-open probe is in flight
 }
 
 // NewCircuitBreaker creates a new circuit breaker with the given configuration.
@@ -63,7 +71,14 @@ func NewCircuitBreaker(name string, maxFailures int, timeout time.Duration) *Cir
 	}
 }
 
+// SetOnStateChange registers a callback for state transitions.
+// It is called without the breaker's lock held.
+func (cb *CircuitBreaker) SetOnStateChange(fn StateChangeFunc) {
+	cb.onStateChange = fn
+}
+
 // Execute runs the given function with circuit breaker protection.
+// Returns ErrCircuitOpen without calling fn while the circuit is open.
 func (cb *CircuitBreaker) Execute(ctx context.Context, fn func() error) error {
 	if err := cb.beforeRequest(); err != nil {
 		return err
@@ -78,20 +93,35 @@ func (cb *CircuitBreaker) Execute(ctx context.Context, fn func() error) error {
 // beforeRequest checks if the request should be allowed.
 func (cb *CircuitBreaker) beforeRequest() error {
 	cb.mu.Lock()
-	defer cb.mu.Unlock()
+	from := cb.state
+	err := cb.allow()
+	to := cb.state
+	cb.mu.Unlock()
 
+	cb.notify(from, to)
+	return err
+}
+
+// allow decides on a request; the caller holds the lock.
+func (cb *CircuitBreaker) allow() error {
 	switch cb.state {
 	case StateOpen:
 		// Check if timeout has elapsed
 		if time.Since(cb.lastFailTime) > cb.timeout {
-			// Transition to half-open
+			// Transition to half-open and let this request probe
 			cb.state = StateHalfOpen
 			cb.successCount = 0
+			cb.probing = true
 			return nil
 		}
 		return ErrCircuitOpen
 
-	case StateHalfOpen, StateClosed:
+	case StateHalfOpen:
+		// Only one probe at a time
+		if cb.probing {
+			return ErrCircuitOpen
+		}
+		cb.probing = true
 		return nil
 
 	default:
@@ -102,7 +132,17 @@ func (cb *CircuitBreaker) beforeRequest() error {
 // afterRequest updates the circuit breaker state based on the result.
 func (cb *CircuitBreaker) afterRequest(err error) {
 	cb.mu.Lock()
-	defer cb.mu.Unlock()
+	from := cb.state
+	cb.record(err)
+	to := cb.state
+	cb.mu.Unlock()
+
+	cb.notify(from, to)
+}
+
+// record applies a request's result; the caller holds the lock.
+func (cb *CircuitBreaker) record(err error) {
+	cb.probing = false
 
 	if err != nil {
 		// Failure
@@ -132,6 +172,13 @@ func (cb *CircuitBreaker) afterRequest(err error) {
 	}
 }
 
+// notify reports a state transition, if any, to the registered callback.
+func (cb *CircuitBreaker) notify(from, to State) {
+	if from != to && cb.onStateChange != nil {
+		cb.onStateChange(cb.name, from, to)
+	}
+}
+
 // State returns the current circuit breaker state.
 func (cb *CircuitBreaker) State() State {
 	cb.mu.RLock()
@@ -0,0 +1,37 @@
+package resilience
+
+import (
+	"context"
+	"sync"
+)
+
+// InFlight tracks outstanding calls so a client can drain them on shutdown.
+// The zero value is ready to use.
+type InFlight struct {
+	wg sync.WaitGroup
+}
+
+// Start registers a call and returns the function that marks it finished.
+//
+//	defer c.inflight.Start()()
+func (f *InFlight) Start() func() {
+	f.wg.Add(1)
+	return f.wg.Done
+}
+
+// Wait blocks until all registered calls have finished or ctx is done.
+// Callers must stop starting new calls before waiting.
+func (f *InFlight) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		f.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,76 @@
+package resilience
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket that spaces calls to at most one per
+// interval once a burst is used up. Pause holds all calls back, e.g. for
+// the Retry-After period of a rate-limited response.
+type RateLimiter struct {
+	interval time.Duration
+	burst    int
+
+	mu sync.Mutex
+	// tat is the theoretical arrival time of the next call: a call may run
+	// once tat is less than burst intervals ahead of now
+	tat time.Time
+}
+
+// NewRateLimiter creates a rate limiter allowing burst calls at once and
+// one call per interval after that. A burst below 1 is treated as 1.
+func NewRateLimiter(interval time.Duration, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		interval: interval,
+		burst:    burst,
+	}
+}
+
+// Wait blocks until the call may run or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	delay := l.reserve(time.Now())
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// reserve takes a token and returns how long the caller must wait for it.
+func (l *RateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	tat := l.tat
+	if tat.Before(now) {
+		tat = now
+	}
+	allowAt := tat.Add(-time.Duration(l.burst-1) * l.interval)
+	l.tat = tat.Add(l.interval)
+	return allowAt.Sub(now)
+}
+
+// Pause holds back every call until d from now. Calls resume one per
+// interval rather than in a burst, so they do not trip the limit again.
+func (l *RateLimiter) Pause(d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	tat := time.Now().Add(d + time.Duration(l.burst-1)*l.interval)
+	if tat.After(l.tat) {
+		l.tat = tat
+	}
+}
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/rpc"
+	pb "github.com/qj0r9j0vc2/alert-bridge/internal/adapter/rpc/alertbridgev1"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/config"
+)
+
+// GRPCServer serves the gRPC API next to the HTTP server.
+type GRPCServer struct {
+	server          *grpc.Server
+	health          *health.Server
+	addr            string
+	shutdownTimeout time.Duration
+	logger          *slog.Logger
+}
+
+// NewGRPC creates a gRPC server for service on cfg.GRPCPort, using the
+// HTTP server's TLS certificate when one is configured. The standard
+// health service and server reflection are registered alongside and, unlike
+// the AlertBridge service, need no admin token.
+func NewGRPC(cfg config.ServerConfig, service pb.AlertBridgeServer, logger *slog.Logger) (*GRPCServer, error) {
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(rpc.UnaryAuth(cfg.AdminToken), rpc.UnaryTenantScope),
+		grpc.ChainStreamInterceptor(rpc.StreamAuth(cfg.AdminToken)),
+	}
+	if cfg.TLS.Enabled() {
+		tlsConfig, err := newTLSConfig(cfg.TLS, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure TLS: %w", err)
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	s := &GRPCServer{
+		server:          grpc.NewServer(opts...),
+		health:          health.NewServer(),
+		addr:            fmt.Sprintf(":%d", cfg.GRPCPort),
+		shutdownTimeout: cfg.ShutdownTimeout,
+		logger:          logger,
+	}
+	pb.RegisterAlertBridgeServer(s.server, service)
+	healthpb.RegisterHealthServer(s.server, s.health)
+	reflection.Register(s.server)
+	s.health.SetServingStatus(pb.AlertBridge_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	return s, nil
+}
+
+// Run serves until ctx is cancelled, then stops gracefully, cutting off
+// calls still running after the shutdown timeout.
+func (s *GRPCServer) Run(ctx context.Context) error {
+	listener, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("gRPC server error: %w", err)
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		s.logger.Info("starting gRPC server", "addr", s.addr)
+		if err := s.server.Serve(listener); err != nil {
+			errChan <- fmt.Errorf("gRPC server error: %w", err)
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+	case err := <-errChan:
+		return err
+	}
+
+	// Tell health checkers first so load balancers drain the instance
+	s.health.Shutdown()
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+		s.logger.Info("gRPC server stopped gracefully")
+	case <-time.After(s.shutdownTimeout):
+		s.logger.Warn("timed out waiting for gRPC calls to finish")
+		s.server.Stop()
+	}
+	return nil
+}
+
+// Addr returns the server address.
+func (s *GRPCServer) Addr() string {
+	return s.addr
+}
@@ -22,6 +22,21 @@ type Handlers struct {
 	Ready            *handler.ReadyHandler
 	Reload           *handler.ReloadHandler
 	Metrics          *handler.MetricsHandler
+	LogLevel         *handler.LogLevelHandler
+	Config           *handler.ConfigHandler
+	Preview          *handler.PreviewHandler
+	Stats            *handler.StatsHandler
+	Renotify         *handler.RenotifyHandler
+	Timeline         *handler.TimelineHandler
+	Deliveries       *handler.DeliveriesHandler
+	ListAlerts       *handler.ListAlertsHandler
+	Silenced         *handler.SilencedAlertsHandler
+	Ingest           *handler.IngestHandler
+	BulkAck          *handler.BulkAckHandler
+	Ack              *handler.AckHandler
+	Assign           *handler.AssignHandler
+	SilencePreview   *handler.SilencePreviewHandler
+	SilenceExpire    *handler.SilenceExpireHandler
 }
 
 // RouterConfig holds optional configuration for the router.
@@ -31,9 +46,16 @@ type RouterConfig struct {
 	// Static configuration (backward compatibility)
 	AlertmanagerWebhookSecret string
 	SlackSigningSecret        string
+	SlackRequestMaxAge        time.Duration // Oldest accepted Slack request timestamp; zero uses middleware.DefaultSlackRequestMaxAge
 	PagerDutyWebhookSecret    string
 	RequestTimeout            time.Duration
 	Metrics                   *observability.Metrics
+	// Bearer token for /api/v1/admin endpoints; admin API is disabled when empty
+	AdminToken string
+	// Largest accepted request body in bytes; unlimited when zero
+	MaxBodyBytes int64
+	// JWT bearer tokens of API users; their ack endpoint is disabled without a secret
+	UserAuth middleware.JWTConfig
 }
 
 // NewRouter creates the HTTP router with all handlers (backward compatible).
@@ -57,6 +79,14 @@ func NewRouterWithConfig(handlers *Handlers, logger *slog.Logger, cfg *RouterCon
 		mux.Handle("/ready", handlers.Health)
 	}
 
+	// Prometheus-style probe aliases, for manifests written for Prometheus tooling
+	mux.HandleFunc("/-/healthy", handlers.Health.ServeHealthy)
+	if handlers.Ready != nil {
+		mux.HandleFunc("/-/ready", handlers.Ready.ServeReady)
+	} else {
+		mux.HandleFunc("/-/ready", handlers.Health.ServeHealthy)
+	}
+
 	// Observability endpoints
 	if handlers.Metrics != nil {
 		mux.Handle("/metrics", handlers.Metrics)
@@ -67,9 +97,69 @@ func NewRouterWithConfig(handlers *Handlers, logger *slog.Logger, cfg *RouterCon
 		mux.Handle("/-/reload", handlers.Reload)
 	}
 
+	// Authenticated admin API
+	if cfg != nil && cfg.AdminToken != "" {
+		auth := middleware.AdminAuth(cfg.AdminToken, logger)
+		// Admin requests may scope themselves to one tenant
+		adminAuth := func(next http.Handler) http.Handler {
+			return auth(middleware.TenantScope(next))
+		}
+		if handlers.LogLevel != nil {
+			mux.Handle("/api/v1/admin/log-level", adminAuth(handlers.LogLevel))
+		}
+		if handlers.Config != nil {
+			mux.Handle("/api/v1/admin/config", adminAuth(handlers.Config))
+		}
+		if handlers.Preview != nil {
+			mux.Handle("/api/v1/preview", adminAuth(handlers.Preview))
+		}
+		// Read endpoints are compressed; webhook and write routes are not
+		if handlers.Stats != nil {
+			mux.Handle("/api/v1/stats", adminAuth(middleware.Gzip(handlers.Stats)))
+		}
+		if handlers.ListAlerts != nil {
+			mux.Handle("GET /api/v1/alerts", adminAuth(middleware.Gzip(handlers.ListAlerts)))
+		}
+		if handlers.Silenced != nil {
+			mux.Handle("GET /api/v1/alerts/silenced", adminAuth(middleware.Gzip(handlers.Silenced)))
+		}
+		if handlers.Ingest != nil {
+			mux.Handle("/api/v1/alerts", adminAuth(handlers.Ingest))
+		}
+		if handlers.BulkAck != nil {
+			mux.Handle("/api/v1/alerts/ack", adminAuth(handlers.BulkAck))
+		}
+		if handlers.SilencePreview != nil {
+			mux.Handle("POST /api/v1/silences/preview", adminAuth(handlers.SilencePreview))
+		}
+		if handlers.SilenceExpire != nil {
+			mux.Handle("POST /api/v1/silences/{id}/expire", adminAuth(handlers.SilenceExpire))
+		}
+		if handlers.Renotify != nil {
+			mux.Handle("/api/v1/alerts/{id}/notify", adminAuth(handlers.Renotify))
+		}
+		if handlers.Assign != nil {
+			mux.Handle("/api/v1/alerts/{id}/assign", adminAuth(handlers.Assign))
+		}
+		if handlers.Timeline != nil {
+			mux.Handle("/api/v1/alerts/{id}/timeline", adminAuth(middleware.Gzip(handlers.Timeline)))
+		}
+		if handlers.Deliveries != nil {
+			mux.Handle("/api/v1/alerts/{id}/deliveries", adminAuth(middleware.Gzip(handlers.Deliveries)))
+		}
+		logger.Info("admin API enabled")
+	}
+
+	// Acknowledgments attributed to authenticated API users
+	if handlers.Ack != nil && cfg != nil && cfg.UserAuth.Secret != "" {
+		userAuth := middleware.UserAuth(cfg.UserAuth, logger)
+		mux.Handle("POST /api/v1/alerts/{id}/ack", userAuth(middleware.TenantScope(handlers.Ack)))
+		logger.Info("API user authentication enabled")
+	}
+
 	// Webhook endpoints
 	if handlers.Alertmanager != nil {
-		var h http.Handler = handlers.Alertmanager
+		var h http.Handler = middleware.TenantScope(handlers.Alertmanager)
 
 		// Apply authentication middleware if secret is configured
 		if cfg != nil && cfg.AlertmanagerWebhookSecret != "" {
@@ -85,7 +175,7 @@ func NewRouterWithConfig(handlers *Handlers, logger *slog.Logger, cfg *RouterCon
 
 		// Apply Slack authentication middleware
 		if cfg != nil && cfg.SlackSigningSecret != "" {
-			h = middleware.SlackAuth(cfg.SlackSigningSecret, logger)(h)
+			h = middleware.SlackAuth(cfg.SlackSigningSecret, cfg.SlackRequestMaxAge, logger)(h)
 			logger.Info("Slack commands webhook authentication enabled")
 		}
 
@@ -97,7 +187,7 @@ func NewRouterWithConfig(handlers *Handlers, logger *slog.Logger, cfg *RouterCon
 
 		// Apply Slack authentication middleware
 		if cfg != nil && cfg.SlackSigningSecret != "" {
-			h = middleware.SlackAuth(cfg.SlackSigningSecret, logger)(h)
+			h = middleware.SlackAuth(cfg.SlackSigningSecret, cfg.SlackRequestMaxAge, logger)(h)
 			logger.Info("Slack interactions webhook authentication enabled")
 		}
 
@@ -109,7 +199,7 @@ func NewRouterWithConfig(handlers *Handlers, logger *slog.Logger, cfg *RouterCon
 
 		// Apply Slack authentication middleware
 		if cfg != nil && cfg.SlackSigningSecret != "" {
-			h = middleware.SlackAuth(cfg.SlackSigningSecret, logger)(h)
+			h = middleware.SlackAuth(cfg.SlackSigningSecret, cfg.SlackRequestMaxAge, logger)(h)
 			logger.Info("Slack events webhook authentication enabled")
 		}
 
@@ -145,10 +235,18 @@ func NewRouterWithConfig(handlers *Handlers, logger *slog.Logger, cfg *RouterCon
 
 	// Apply middleware stack
 	var h http.Handler = mux
-	h = middleware.RequestID(h)
+
+	// Reject oversized bodies before authentication reads them
+	if cfg != nil && cfg.MaxBodyBytes > 0 {
+		h = middleware.MaxBodyBytes(cfg.MaxBodyBytes, logger)(h)
+	}
+
 	h = middleware.Logging(logger)(h)
 	h = middleware.Recovery(logger)(h)
 
+	// Start a span per request; no-op unless a tracer provider is configured
+	h = middleware.Tracing()(h)
+
 	// Apply observability metrics middleware if configured
 	if cfg != nil && cfg.Metrics != nil {
 		h = middleware.Observability(cfg.Metrics)(h)
@@ -160,5 +258,8 @@ func NewRouterWithConfig(handlers *Handlers, logger *slog.Logger, cfg *RouterCon
 		h = middleware.Timeout(cfg.RequestTimeout, logger)(h)
 	}
 
+	// Outermost, so every middleware above logs the request ID
+	h = middleware.RequestID(h)
+
 	return h
 }
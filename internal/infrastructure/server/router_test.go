@@ -0,0 +1,45 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/handler"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/handler/middleware"
+)
+
+func TestRouter_AccessLogCarriesRequestID(t *testing.T) {
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&logs, nil))
+	router := NewRouter(&Handlers{Health: handler.NewHealthHandler()}, logger)
+
+	req := httptest.NewRequest(http.MethodGet, "/health", nil)
+	req.Header.Set(middleware.RequestIDHeader, "req-123")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if got := w.Header().Get(middleware.RequestIDHeader); got != "req-123" {
+		t.Errorf("expected the request ID echoed, got %q", got)
+	}
+
+	var entry map[string]any
+	for _, line := range bytes.Split(bytes.TrimSpace(logs.Bytes()), []byte("\n")) {
+		if err := json.Unmarshal(line, &entry); err != nil {
+			t.Fatalf("failed to decode log line %q: %v", line, err)
+		}
+		if entry["msg"] == "request completed" {
+			break
+		}
+		entry = nil
+	}
+	if entry == nil {
+		t.Fatalf("expected an access log line, got %s", logs.String())
+	}
+	if entry["request_id"] != "req-123" {
+		t.Errorf("expected request_id 'req-123' in the access log, got %v", entry["request_id"])
+	}
+}
@@ -32,6 +32,18 @@ func New(cfg config.Config, handler http.Handler, logger *slog.Logger) (*Server,
 		cfg:    cfg.Server,
 	}
 
+	// Serve HTTPS with HTTP/2 when a certificate is configured
+	if cfg.Server.TLS.Enabled() {
+		tlsConfig, err := newTLSConfig(cfg.Server.TLS, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure TLS: %w", err)
+		}
+		s.server.TLSConfig = tlsConfig
+		s.server.Protocols = new(http.Protocols)
+		s.server.Protocols.SetHTTP1(true)
+		s.server.Protocols.SetHTTP2(true)
+	}
+
 	// Initialize Socket Mode client if enabled
 	if cfg.Slack.Enabled && cfg.Slack.SocketMode.Enabled {
 		logger.Info("initializing Socket Mode client",
@@ -64,15 +76,25 @@ func (s *Server) Run(ctx context.Context) error {
 	go func() {
 		s.logger.Info("starting HTTP server",
 			"addr", s.server.Addr,
+			"tls", s.server.TLSConfig != nil,
 		)
-		if err := s.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		var err error
+		if s.server.TLSConfig != nil {
+			// Certificates come from TLSConfig.GetCertificate
+			err = s.server.ListenAndServeTLS("", "")
+		} else {
+			err = s.server.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
 			errChan <- fmt.Errorf("HTTP server error: %w", err)
 		}
 	}()
 
 	// Start Socket Mode client if enabled
+	socketModeDone := make(chan struct{})
 	if s.socketModeClient != nil {
 		go func() {
+			defer close(socketModeDone)
 			s.logger.Info("starting Socket Mode client")
 			if err := s.socketModeClient.Run(ctx); err != nil {
 				// Log connection details on error
@@ -140,9 +162,14 @@ func (s *Server) Run(ctx context.Context) error {
 		return fmt.Errorf("HTTP server shutdown: %w", err)
 	}
 
-	// Socket Mode client will stop when context is cancelled
+	// Socket Mode client stops when context is cancelled; wait for it to close the connection
 	if s.socketModeClient != nil {
-		s.logger.Info("Socket Mode client stopped")
+		select {
+		case <-socketModeDone:
+			s.logger.Info("Socket Mode client stopped")
+		case <-shutdownCtx.Done():
+			s.logger.Warn("timed out waiting for Socket Mode client to stop")
+		}
 	}
 
 	s.logger.Info("server stopped gracefully")
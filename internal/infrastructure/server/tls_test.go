@@ -0,0 +1,210 @@
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"log/slog"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/config"
+)
+
+// writeSelfSignedPair writes a self-signed certificate for commonName and
+// its key to certFile and keyFile, setting both modification times to modTime.
+func writeSelfSignedPair(t *testing.T, certFile, keyFile, commonName string, modTime time.Time) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("creating certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshaling key: %v", err)
+	}
+
+	writePEM(t, certFile, "CERTIFICATE", der, modTime)
+	writePEM(t, keyFile, "EC PRIVATE KEY", keyDER, modTime)
+}
+
+func writePEM(t *testing.T, path, blockType string, der []byte, modTime time.Time) {
+	t.Helper()
+
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("writing %s: %v", path, err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatalf("setting modification time of %s: %v", path, err)
+	}
+}
+
+// servedName returns the common name of the certificate the reloader serves.
+func servedName(t *testing.T, r *certReloader) string {
+	t.Helper()
+
+	cert, err := r.GetCertificate(&tls.ClientHelloInfo{})
+	if err != nil {
+		t.Fatalf("getting certificate: %v", err)
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		t.Fatalf("parsing certificate: %v", err)
+	}
+	return leaf.Subject.CommonName
+}
+
+func discardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+func TestNewTLSConfig(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "tls.crt")
+	keyFile := filepath.Join(dir, "tls.key")
+	writeSelfSignedPair(t, certFile, keyFile, "first.example.com", time.Now())
+
+	tests := []struct {
+		name       string
+		minVersion string
+		want       uint16
+		wantErr    bool
+	}{
+		{name: "default", want: tls.VersionTLS12},
+		{name: "1.2", minVersion: "1.2", want: tls.VersionTLS12},
+		{name: "1.3", minVersion: "1.3", want: tls.VersionTLS13},
+		{name: "unsupported", minVersion: "1.1", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.ServerTLSConfig{CertFile: certFile, KeyFile: keyFile, MinVersion: tt.minVersion}
+			tlsConfig, err := newTLSConfig(cfg, discardLogger())
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error for an unsupported TLS version")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tlsConfig.MinVersion != tt.want {
+				t.Errorf("expected min version %x, got %x", tt.want, tlsConfig.MinVersion)
+			}
+			if len(tlsConfig.NextProtos) == 0 || tlsConfig.NextProtos[0] != "h2" {
+				t.Errorf("expected HTTP/2 to be offered first, got %v", tlsConfig.NextProtos)
+			}
+
+			cert, err := tlsConfig.GetCertificate(&tls.ClientHelloInfo{})
+			if err != nil || cert == nil {
+				t.Fatalf("expected the loaded certificate, got %v, %v", cert, err)
+			}
+		})
+	}
+}
+
+func TestNewTLSConfig_FailsFastOnBadFiles(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "tls.crt")
+	keyFile := filepath.Join(dir, "tls.key")
+	writeSelfSignedPair(t, certFile, keyFile, "first.example.com", time.Now())
+
+	otherCert := filepath.Join(dir, "other.crt")
+	otherKey := filepath.Join(dir, "other.key")
+	writeSelfSignedPair(t, otherCert, otherKey, "other.example.com", time.Now())
+
+	garbage := filepath.Join(dir, "garbage.pem")
+	if err := os.WriteFile(garbage, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatalf("writing garbage: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		certFile string
+		keyFile  string
+	}{
+		{name: "missing certificate", certFile: filepath.Join(dir, "missing.crt"), keyFile: keyFile},
+		{name: "missing key", certFile: certFile, keyFile: filepath.Join(dir, "missing.key")},
+		{name: "malformed certificate", certFile: garbage, keyFile: keyFile},
+		{name: "malformed key", certFile: certFile, keyFile: garbage},
+		{name: "key of another certificate", certFile: certFile, keyFile: otherKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.ServerTLSConfig{CertFile: tt.certFile, KeyFile: tt.keyFile}
+			if _, err := newTLSConfig(cfg, discardLogger()); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestCertReloader_ReloadsChangedFiles(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "tls.crt")
+	keyFile := filepath.Join(dir, "tls.key")
+	start := time.Now().Add(-time.Hour).Truncate(time.Second)
+	writeSelfSignedPair(t, certFile, keyFile, "first.example.com", start)
+
+	r, err := newCertReloader(certFile, keyFile, discardLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := servedName(t, r); got != "first.example.com" {
+		t.Fatalf("expected the first certificate, got %s", got)
+	}
+
+	// A renewal is not picked up until the next check is due
+	writeSelfSignedPair(t, certFile, keyFile, "renewed.example.com", start.Add(time.Minute))
+	if got := servedName(t, r); got != "first.example.com" {
+		t.Errorf("expected the first certificate before the next check, got %s", got)
+	}
+
+	r.lastCheck = time.Time{}
+	if got := servedName(t, r); got != "renewed.example.com" {
+		t.Errorf("expected the renewed certificate, got %s", got)
+	}
+
+	// Unchanged files are not read again
+	r.lastCheck = time.Time{}
+	before := r.cert
+	servedName(t, r)
+	if r.cert != before {
+		t.Error("expected unchanged files not to be reloaded")
+	}
+
+	// A broken rewrite keeps the certificate that is being served
+	if err := os.WriteFile(certFile, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatalf("writing certificate: %v", err)
+	}
+	if err := os.Chtimes(certFile, start.Add(2*time.Minute), start.Add(2*time.Minute)); err != nil {
+		t.Fatalf("setting modification time: %v", err)
+	}
+	r.lastCheck = time.Time{}
+	if got := servedName(t, r); got != "renewed.example.com" {
+		t.Errorf("expected the renewed certificate to be kept, got %s", got)
+	}
+}
@@ -5,64 +5,420 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/slack-go/slack"
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
 	domainerrors "github.com/qj0r9j0vc2/alert-bridge/internal/domain/errors"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/resilience"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/templates"
 )
 
+// ChannelsLabel is the alert label listing extra channel IDs (comma-separated)
+// that should receive a copy of the alert message.
+const ChannelsLabel = "slack_channels"
+
+// Slack Web API methods the client rate limits.
+const (
+	methodPostMessage = "chat.postMessage"
+	methodUpdate      = "chat.update"
+)
+
+// defaultRateLimitPause holds calls back after a rate_limited error that
+// came without a Retry-After header.
+const defaultRateLimitPause = time.Second
+
 // Client wraps the Slack API client with domain-specific operations.
 // Implements the alert.Notifier interface.
 type Client struct {
-	api            *slack.Client
-	channelID      string
-	messageBuilder *MessageBuilder
+	api                  *slack.Client
+	httpClient           *http.Client
+	inflight             resilience.InFlight
+	limiters             map[string]*resilience.RateLimiter
+	channelMu            sync.RWMutex
+	channelID            string // Guarded by channelMu, as it is reloadable
+	additionalChannelIDs []string
+	tenantChannelIDs     map[string]string
+	fallbackChannelID    string
+	repostOnMissing      bool
+	mentionGroups        map[entity.AlertSeverity]string
+	messageBuilder       *MessageBuilder
 }
 
 // NewClient creates a new Slack client.
 func NewClient(botToken, channelID string, silenceDurations []time.Duration, apiURL ...string) *Client {
-	var api *slack.Client
+	httpClient := &http.Client{}
+	options := []slack.Option{slack.OptionHTTPClient(httpClient)}
 	if len(apiURL) > 0 && apiURL[0] != "" {
 		// Use custom API URL (for E2E testing)
-		api = slack.New(botToken, slack.OptionAPIURL(apiURL[0]))
-	} else {
-		api = slack.New(botToken)
+		options = append(options, slack.OptionAPIURL(apiURL[0]))
 	}
 
 	return &Client{
-		api:            api,
+		api:        slack.New(botToken, options...),
+		httpClient: httpClient,
+		// Slack allows about one message per second per channel with short
+		// bursts, and chat.update is a Tier 3 method (~50 per minute)
+		limiters: map[string]*resilience.RateLimiter{
+			methodPostMessage: resilience.NewRateLimiter(time.Second, 10),
+			methodUpdate:      resilience.NewRateLimiter(time.Minute/50, 10),
+		},
 		channelID:      channelID,
 		messageBuilder: NewMessageBuilder(silenceDurations),
 	}
 }
 
-// Notify sends an alert to Slack.
-// Returns the message ID in the format "channel:timestamp".
+// postMessage calls chat.postMessage within the method's rate limit.
+func (c *Client) postMessage(ctx context.Context, channelID string, options ...slack.MsgOption) (string, string, error) {
+	if err := c.limiters[methodPostMessage].Wait(ctx); err != nil {
+		return "", "", err
+	}
+	respChannel, respTimestamp, err := c.api.PostMessageContext(ctx, channelID, options...)
+	c.backOff(methodPostMessage, err)
+	return respChannel, respTimestamp, err
+}
+
+// updateMessage calls chat.update within the method's rate limit.
+func (c *Client) updateMessage(ctx context.Context, channelID, timestamp string, options ...slack.MsgOption) error {
+	if err := c.limiters[methodUpdate].Wait(ctx); err != nil {
+		return err
+	}
+	_, _, _, err := c.api.UpdateMessageContext(ctx, channelID, timestamp, options...)
+	c.backOff(methodUpdate, err)
+	return err
+}
+
+// backOff pauses a method's calls for the Retry-After period when Slack
+// rejected a call as rate limited.
+func (c *Client) backOff(method string, err error) {
+	var rateLimitErr *slack.RateLimitedError
+	switch {
+	case errors.As(err, &rateLimitErr):
+		c.limiters[method].Pause(rateLimitErr.RetryAfter)
+	case isSlackError(err, "rate_limited"), isSlackError(err, "ratelimited"):
+		c.limiters[method].Pause(defaultRateLimitPause)
+	}
+}
+
+// SetChannel sets the default channel alert messages are posted to. It is
+// safe to call while messages are being posted, e.g. on config reload.
+func (c *Client) SetChannel(channelID string) {
+	c.channelMu.Lock()
+	defer c.channelMu.Unlock()
+	c.channelID = channelID
+}
+
+// channel returns the default channel.
+func (c *Client) channel() string {
+	c.channelMu.RLock()
+	defer c.channelMu.RUnlock()
+	return c.channelID
+}
+
+// SetAdditionalChannels sets channels that receive a copy of every alert
+// message of the default tenant in addition to the default channel. Tenant
+// alerts are not copied, so one tenant's alerts do not reach another's channels.
+func (c *Client) SetAdditionalChannels(channelIDs []string) {
+	c.additionalChannelIDs = channelIDs
+}
+
+// SetTenantChannels sets, per tenant ID, the channel that receives the
+// tenant's alerts in place of the default channel. Alerts of unlisted
+// tenants go to the default channel.
+func (c *Client) SetTenantChannels(channelIDs map[string]string) {
+	c.tenantChannelIDs = channelIDs
+}
+
+// SetFallbackChannel sets the channel that receives an alert message when a
+// channel it is routed to no longer exists or was archived.
+func (c *Client) SetFallbackChannel(channelID string) {
+	c.fallbackChannelID = channelID
+}
+
+// SetMentionGroups sets the Slack user group IDs mentioned, per severity, when
+// an alert is first posted.
+func (c *Client) SetMentionGroups(groups map[entity.AlertSeverity]string) {
+	c.mentionGroups = groups
+	c.messageBuilder.SetMentionGroups(groups)
+}
+
+// SetRepostOnMissing makes UpdateMessage post a fresh message when the
+// original was deleted (or its channel is gone) instead of failing.
+func (c *Client) SetRepostOnMissing(enabled bool) {
+	c.repostOnMissing = enabled
+}
+
+// SetSeverityMap sets the severity map used to color firing alert messages.
+func (c *Client) SetSeverityMap(severities entity.SeverityMap) {
+	c.messageBuilder.SetSeverityMap(severities)
+}
+
+// SetPriorityMap sets the priority map used to style firing alert messages.
+func (c *Client) SetPriorityMap(priorities entity.SeverityMap) {
+	c.messageBuilder.SetPriorityMap(priorities)
+}
+
+// SetAllowCustomBody makes alerts with a slack_message annotation render
+// that text in place of the generated summary and details.
+func (c *Client) SetAllowCustomBody(enabled bool) {
+	c.messageBuilder.SetAllowCustomBody(enabled)
+}
+
+// SetTemplates sets the configured Slack templates of alert messages.
+func (c *Client) SetTemplates(registry *templates.Registry) {
+	c.messageBuilder.SetBodyTemplate(registry.Lookup(templates.Slack, "body"))
+}
+
+// SetLabelDisplay sets which labels alert messages show; see
+// MessageBuilder.SetLabelDisplay.
+func (c *Client) SetLabelDisplay(display, hide []string, limit int) {
+	c.messageBuilder.SetLabelDisplay(display, hide, limit)
+}
+
+// SetPublicURL sets the base URL of the bridge that overflow messages link to.
+func (c *Client) SetPublicURL(url string) {
+	c.messageBuilder.SetPublicURL(url)
+}
+
+// SetTimeFormat sets how message times are rendered: SlackDateFormat for
+// Slack date tokens shown in each reader's timezone, or a Go time layout.
+func (c *Client) SetTimeFormat(format string) {
+	c.messageBuilder.SetTimeFormat(format)
+}
+
+// SetTimezone sets the timezone of static message times and date token fallbacks.
+func (c *Client) SetTimezone(location *time.Location) {
+	c.messageBuilder.SetTimezone(location)
+}
+
+// SetInstanceSilenceDuration sets how long the "silence instance" button
+// silences alerts from the alert's instance.
+func (c *Client) SetInstanceSilenceDuration(d time.Duration) {
+	c.messageBuilder.SetInstanceSilenceDuration(d)
+}
+
+// defaultChannelFor returns the alert's tenant channel, or the default channel.
+func (c *Client) defaultChannelFor(alert *entity.Alert) string {
+	if channelID := c.tenantChannelIDs[alert.TenantID]; alert.TenantID != "" && channelID != "" {
+		return channelID
+	}
+	return c.channel()
+}
+
+// channelsFor returns the de-duplicated channels an alert is posted to: the
+// default or tenant channel, the configured additional channels for alerts
+// of the default tenant and any channels listed in the alert's ChannelsLabel.
+func (c *Client) channelsFor(alert *entity.Alert) []string {
+	candidates := []string{c.defaultChannelFor(alert)}
+	if alert.TenantID == "" {
+		candidates = append(candidates, c.additionalChannelIDs...)
+	}
+	candidates = append(candidates, strings.Split(alert.GetLabel(ChannelsLabel), ",")...)
+
+	seen := make(map[string]bool, len(candidates))
+	channels := make([]string, 0, len(candidates))
+	for _, channel := range candidates {
+		channel = strings.TrimSpace(channel)
+		if channel == "" || seen[channel] {
+			continue
+		}
+		seen[channel] = true
+		channels = append(channels, channel)
+	}
+	return channels
+}
+
+// Notify sends an alert to every Slack channel it is routed to.
+// Returns one "channel:timestamp" message ID per posted copy, joined with
+// entity.ReferenceSeparator. The notification only fails if no copy could be
+// posted, so a retry never duplicates messages in channels that succeeded.
 func (c *Client) Notify(ctx context.Context, alert *entity.Alert) (string, error) {
-	blocks := c.messageBuilder.BuildAlertMessage(alert)
+	defer c.inflight.Start()()
+
+	blocks := c.messageBuilder.BuildNotificationMessage(alert)
 
 	options := []slack.MsgOption{
 		slack.MsgOptionBlocks(blocks...),
 	}
 
-	channelID, timestamp, err := c.api.PostMessageContext(ctx, c.channelID, options...)
+	// A correlated alert replies in its group's thread, falling back to a
+	// top-level message if no copy of the thread accepts the reply
+	if thread := alert.GetExternalReference(entity.SlackThreadReference); thread != "" {
+		if refs := c.postInThread(ctx, thread, options); len(refs) > 0 {
+			return entity.JoinMessageRefs(refs), nil
+		}
+	}
+
+	channels := c.channelsFor(alert)
+
+	var refs []entity.MessageRef
+	var firstErr error
+	for i := 0; i < len(channels); i++ {
+		channelID, timestamp, err := c.postMessage(ctx, channels[i], options...)
+		if err != nil {
+			// A gone channel hands its copy to the fallback channel, once
+			if isMissingChannelError(err) && c.fallbackChannelID != "" && !slices.Contains(channels, c.fallbackChannelID) {
+				channels = append(channels, c.fallbackChannelID)
+			}
+			if firstErr == nil {
+				firstErr = categorizeSlackError(err, "posting slack message")
+			}
+			continue
+		}
+		refs = append(refs, entity.MessageRef{Channel: channelID, Timestamp: timestamp})
+	}
+
+	if len(refs) == 0 {
+		return "", firstErr
+	}
+	return entity.JoinMessageRefs(refs), nil
+}
+
+// NotifyDigest posts one message summarizing the alerts to each channel
+// they belong to: a tenant's alerts go to its tenant channel, the rest to
+// the default channel. Every channel is tried; the first error is returned.
+func (c *Client) NotifyDigest(ctx context.Context, alerts []*entity.Alert) error {
+	defer c.inflight.Start()()
+
+	var channels []string
+	byChannel := make(map[string][]*entity.Alert)
+	for _, alert := range alerts {
+		channelID := c.defaultChannelFor(alert)
+		if _, ok := byChannel[channelID]; !ok {
+			channels = append(channels, channelID)
+		}
+		byChannel[channelID] = append(byChannel[channelID], alert)
+	}
+
+	var firstErr error
+	for _, channelID := range channels {
+		blocks := c.messageBuilder.BuildDigestMessage(byChannel[channelID])
+		if _, _, err := c.postMessage(ctx, channelID, slack.MsgOptionBlocks(blocks...)); err != nil && firstErr == nil {
+			firstErr = categorizeSlackError(err, "posting slack digest")
+		}
+	}
+	return firstErr
+}
+
+// NotifyOverflow posts one message counting the alerts that were not
+// posted because too many fired at once.
+func (c *Client) NotifyOverflow(ctx context.Context, suppressed int) error {
+	defer c.inflight.Start()()
+
+	blocks := c.messageBuilder.BuildOverflowMessage(suppressed)
+	if _, _, err := c.postMessage(ctx, c.channel(), slack.MsgOptionBlocks(blocks...)); err != nil {
+		return categorizeSlackError(err, "posting slack overflow message")
+	}
+	return nil
+}
+
+// postInThread posts the message as a reply under every copy of a thread
+// and returns the references of the replies that were posted. The replies
+// are stored without their thread, like any other message.
+func (c *Client) postInThread(ctx context.Context, thread string, options []slack.MsgOption) []entity.MessageRef {
+	var refs []entity.MessageRef
+	_ = forEachMessage(thread, func(ref entity.MessageRef) error {
+		replyOptions := append([]slack.MsgOption{slack.MsgOptionTS(ref.ThreadRoot())}, options...)
+		replyChannelID, replyTimestamp, err := c.postMessage(ctx, ref.Channel, replyOptions...)
+		if err != nil {
+			return err
+		}
+		refs = append(refs, entity.MessageRef{Channel: replyChannelID, Timestamp: replyTimestamp})
+		return nil
+	})
+	return refs
+}
+
+// Preview returns the channels and Block Kit blocks that Notify would post for the alert.
+func (c *Client) Preview(alert *entity.Alert) (any, error) {
+	preview := map[string]any{
+		"channels": c.channelsFor(alert),
+		"blocks":   c.messageBuilder.BuildNotificationMessage(alert),
+	}
+	if thread := alert.GetExternalReference(entity.SlackThreadReference); thread != "" {
+		preview["thread"] = thread
+	}
+	return preview, nil
+}
+
+// SelfTest verifies the bot token via auth.test, the default and fallback
+// channels via ValidateChannel and the mention groups via ValidateMentionGroups.
+func (c *Client) SelfTest(ctx context.Context) error {
+	if _, err := c.api.AuthTestContext(ctx); err != nil {
+		return categorizeSlackError(err, "slack auth.test")
+	}
+
+	if err := c.ValidateChannel(ctx, c.channel()); err != nil {
+		return err
+	}
+	if c.fallbackChannelID != "" {
+		if err := c.ValidateChannel(ctx, c.fallbackChannelID); err != nil {
+			return fmt.Errorf("fallback channel: %w", err)
+		}
+	}
+	return c.ValidateMentionGroups(ctx)
+}
+
+// ValidateMentionGroups checks via usergroups.list that every configured
+// mention group exists and is enabled.
+func (c *Client) ValidateMentionGroups(ctx context.Context) error {
+	if len(c.mentionGroups) == 0 {
+		return nil
+	}
+
+	groups, err := c.api.GetUserGroupsContext(ctx)
 	if err != nil {
-		return "", categorizeSlackError(err, "posting slack message")
+		return categorizeSlackError(err, "slack usergroups.list")
+	}
+	enabled := make(map[string]bool, len(groups))
+	for _, group := range groups {
+		enabled[group.ID] = group.DateDelete == 0
 	}
 
-	// Return channel:timestamp as message ID
-	return fmt.Sprintf("%s:%s", channelID, timestamp), nil
+	var unknown []string
+	for severity, groupID := range c.mentionGroups {
+		if !enabled[groupID] {
+			unknown = append(unknown, fmt.Sprintf("%s (%s)", groupID, severity))
+		}
+	}
+	if len(unknown) > 0 {
+		slices.Sort(unknown)
+		return domainerrors.NewPermanentError(
+			fmt.Sprintf("unknown or disabled slack mention groups: %s", strings.Join(unknown, ", ")),
+			nil,
+		)
+	}
+	return nil
 }
 
-// UpdateMessage updates an existing Slack message.
-func (c *Client) UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error {
-	channelID, timestamp, err := parseMessageID(messageID)
+// ValidateChannel checks via conversations.info that the channel exists, is
+// not archived and that the bot can post to it: private channels require the
+// bot to be a member, public ones are reachable through chat:write.public.
+func (c *Client) ValidateChannel(ctx context.Context, channelID string) error {
+	channel, err := c.api.GetConversationInfoContext(ctx, &slack.GetConversationInfoInput{ChannelID: channelID})
 	if err != nil {
-		return err
+		return categorizeSlackError(err, fmt.Sprintf("slack conversations.info for channel %s", channelID))
 	}
+	if channel.IsArchived {
+		return domainerrors.NewPermanentError(fmt.Sprintf("slack channel %s is archived", channelID), nil)
+	}
+	if channel.IsPrivate && !channel.IsMember {
+		return domainerrors.NewPermanentError(fmt.Sprintf("slack bot is not a member of channel %s", channelID), nil)
+	}
+	return nil
+}
+
+// UpdateMessage updates every copy of an existing Slack message.
+// With repost-on-missing enabled, a copy that no longer exists is posted again
+// and its ID is replaced in the alert's "slack" external reference; callers
+// should persist the alert when that reference changes.
+func (c *Client) UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error {
+	defer c.inflight.Start()()
 
 	var blocks []slack.Block
 	switch {
@@ -81,12 +437,71 @@ func (c *Client) UpdateMessage(ctx context.Context, messageID string, alert *ent
 		slack.MsgOptionBlocks(blocks...),
 	}
 
-	_, _, _, err = c.api.UpdateMessageContext(ctx, channelID, timestamp, options...)
-	if err != nil {
-		return categorizeSlackError(err, "updating slack message")
+	reposted := make(map[string]string)
+	err := forEachMessage(messageID, func(ref entity.MessageRef) error {
+		err := c.updateMessage(ctx, ref.Channel, ref.Timestamp, options...)
+		if err == nil {
+			return nil
+		}
+		if !c.repostOnMissing || !isMissingMessageError(err) {
+			return categorizeSlackError(err, "updating slack message")
+		}
+
+		// The original is gone; a deleted channel falls back to the default
+		// one, or to the fallback channel if the default itself is gone
+		target := ref.Channel
+		if isSlackError(err, "channel_not_found") {
+			target = c.defaultChannelFor(alert)
+			if ref.Channel == target && c.fallbackChannelID != "" {
+				target = c.fallbackChannelID
+			}
+		}
+		newChannelID, newTimestamp, err := c.postMessage(ctx, target, options...)
+		if err != nil {
+			return categorizeSlackError(err, "re-posting missing slack message")
+		}
+		reposted[ref.WithoutThread().String()] = entity.MessageRef{Channel: newChannelID, Timestamp: newTimestamp}.String()
+		return nil
+	})
+
+	if len(reposted) > 0 {
+		replaceMessageIDs(alert, reposted)
 	}
+	return err
+}
 
-	return nil
+// replaceMessageIDs swaps re-posted message IDs into the alert's Slack reference.
+func replaceMessageIDs(alert *entity.Alert, reposted map[string]string) {
+	ids := alert.ExternalReferenceIDs("slack")
+	for i, id := range ids {
+		if newID, ok := reposted[id]; ok {
+			ids[i] = newID
+			delete(reposted, id)
+		}
+	}
+	// Messages that were not in the stored reference are tracked from now on
+	for _, newID := range reposted {
+		ids = append(ids, newID)
+	}
+	alert.SetExternalReference("slack", entity.JoinReferenceIDs(ids))
+}
+
+// isMissingMessageError reports whether Slack rejected a call because the
+// message or its channel no longer exists.
+func isMissingMessageError(err error) bool {
+	return isSlackError(err, "message_not_found") || isSlackError(err, "channel_not_found")
+}
+
+// isMissingChannelError reports whether Slack rejected a post because the
+// channel no longer exists or was archived.
+func isMissingChannelError(err error) bool {
+	return isSlackError(err, "channel_not_found") || isSlackError(err, "is_archived")
+}
+
+// isSlackError reports whether err is a Slack API error with the given code.
+func isSlackError(err error, code string) bool {
+	var slackErr slack.SlackErrorResponse
+	return errors.As(err, &slackErr) && slackErr.Err == code
 }
 
 // Name returns the notifier identifier.
@@ -94,24 +509,30 @@ func (c *Client) Name() string {
 	return "slack"
 }
 
-// PostThreadReply posts a reply in a thread.
-func (c *Client) PostThreadReply(ctx context.Context, messageID, text string) error {
-	channelID, timestamp, err := parseMessageID(messageID)
-	if err != nil {
-		return err
+// Close waits for in-flight Slack API calls to finish, then releases idle connections.
+func (c *Client) Close(ctx context.Context) error {
+	if err := c.inflight.Wait(ctx); err != nil {
+		return fmt.Errorf("waiting for in-flight slack requests: %w", err)
 	}
+	c.httpClient.CloseIdleConnections()
+	return nil
+}
 
-	options := []slack.MsgOption{
-		slack.MsgOptionText(text, false),
-		slack.MsgOptionTS(timestamp),
-	}
+// PostThreadReply posts a reply in the thread of every copy of a message.
+func (c *Client) PostThreadReply(ctx context.Context, messageID, text string) error {
+	defer c.inflight.Start()()
 
-	_, _, err = c.api.PostMessageContext(ctx, channelID, options...)
-	if err != nil {
-		return categorizeSlackError(err, "posting thread reply")
-	}
+	return forEachMessage(messageID, func(ref entity.MessageRef) error {
+		options := []slack.MsgOption{
+			slack.MsgOptionText(text, false),
+			slack.MsgOptionTS(ref.ThreadRoot()),
+		}
 
-	return nil
+		if _, _, err := c.postMessage(ctx, ref.Channel, options...); err != nil {
+			return categorizeSlackError(err, "posting thread reply")
+		}
+		return nil
+	})
 }
 
 // GetUserInfo retrieves user information by ID.
@@ -132,22 +553,20 @@ func (c *Client) GetUserEmail(ctx context.Context, userID string) (string, error
 	return user.Profile.Email, nil
 }
 
-// AddReaction adds an emoji reaction to a message.
+// AddReaction adds an emoji reaction to every copy of a message.
 func (c *Client) AddReaction(ctx context.Context, messageID, emoji string) error {
-	channelID, timestamp, err := parseMessageID(messageID)
-	if err != nil {
-		return err
-	}
-
-	err = c.api.AddReactionContext(ctx, emoji, slack.ItemRef{
-		Channel:   channelID,
-		Timestamp: timestamp,
+	defer c.inflight.Start()()
+
+	return forEachMessage(messageID, func(ref entity.MessageRef) error {
+		err := c.api.AddReactionContext(ctx, emoji, slack.ItemRef{
+			Channel:   ref.Channel,
+			Timestamp: ref.Timestamp,
+		})
+		if err != nil {
+			return categorizeSlackError(err, "adding reaction")
+		}
+		return nil
 	})
-	if err != nil {
-		return categorizeSlackError(err, "adding reaction")
-	}
-
-	return nil
 }
 
 // OpenModal opens a modal view using the trigger ID from a slash command or interaction.
@@ -159,6 +578,49 @@ func (c *Client) OpenModal(ctx context.Context, triggerID string, view slack.Mod
 	return nil
 }
 
+// PublishHomeView publishes the App Home tab of a user listing the alerts.
+func (c *Client) PublishHomeView(ctx context.Context, userID string, alerts []*entity.Alert) error {
+	view := c.messageBuilder.BuildHomeView(alerts)
+	if _, err := c.api.PublishViewContext(ctx, slack.PublishViewContextRequest{UserID: userID, View: view}); err != nil {
+		return categorizeSlackError(err, "publishing home view")
+	}
+	return nil
+}
+
+// TenantsOfUser returns the tenants whose channel, set by SetTenantChannels,
+// the user is a member of, sorted.
+func (c *Client) TenantsOfUser(ctx context.Context, userID string) ([]string, error) {
+	tenantOf := make(map[string]string, len(c.tenantChannelIDs))
+	for tenantID, channelID := range c.tenantChannelIDs {
+		tenantOf[channelID] = tenantID
+	}
+
+	var tenants []string
+	params := &slack.GetConversationsForUserParameters{
+		UserID:          userID,
+		Types:           []string{"public_channel", "private_channel"},
+		Limit:           200,
+		ExcludeArchived: true,
+	}
+	for {
+		channels, cursor, err := c.api.GetConversationsForUserContext(ctx, params)
+		if err != nil {
+			return nil, categorizeSlackError(err, "listing user conversations")
+		}
+		for _, channel := range channels {
+			if tenantID, ok := tenantOf[channel.ID]; ok {
+				tenants = append(tenants, tenantID)
+			}
+		}
+		if cursor == "" {
+			break
+		}
+		params.Cursor = cursor
+	}
+	slices.Sort(tenants)
+	return tenants, nil
+}
+
 // GetActiveAlertLabels retrieves unique label keys and values from active alerts.
 // This is used to populate label autocomplete in the silence modal.
 func (c *Client) GetActiveAlertLabels(ctx context.Context, alertRepo interface {
@@ -224,12 +686,36 @@ func categorizeSlackError(err error, operation string) error {
 		)
 	}
 
+	// Rate limited by HTTP status (transient)
+	var rateLimitErr *slack.RateLimitedError
+	if errors.As(err, &rateLimitErr) {
+		return domainerrors.NewTransientError(
+			fmt.Sprintf("%s: rate limited (retry after %s)", operation, rateLimitErr.RetryAfter),
+			err,
+		)
+	}
+
+	// Non-2xx HTTP responses: 5xx is transient, other statuses are permanent
+	var statusErr slack.StatusCodeError
+	if errors.As(err, &statusErr) {
+		if statusErr.Retryable() {
+			return domainerrors.NewTransientError(
+				fmt.Sprintf("%s: slack server error (status %d)", operation, statusErr.Code),
+				err,
+			)
+		}
+		return domainerrors.NewPermanentError(
+			fmt.Sprintf("%s: client error (status %d)", operation, statusErr.Code),
+			err,
+		)
+	}
+
 	// Check for Slack API errors
 	var slackErr slack.SlackErrorResponse
 	if errors.As(err, &slackErr) {
 		switch slackErr.Err {
 		// Rate limiting - transient
-		case "rate_limited":
+		case "rate_limited", "ratelimited":
 			return domainerrors.NewTransientError(
 				fmt.Sprintf("%s: rate limited", operation),
 				err,
@@ -244,7 +730,8 @@ func categorizeSlackError(err error, operation string) error {
 
 		// Client errors - permanent
 		case "invalid_auth", "account_inactive", "token_revoked", "no_permission",
-			"channel_not_found", "not_in_channel", "is_archived":
+			"channel_not_found", "not_in_channel", "is_archived",
+			"message_not_found", "cant_update_message", "edit_window_closed":
 			return domainerrors.NewPermanentError(
 				fmt.Sprintf("%s: %s", operation, slackErr.Err),
 				err,
@@ -274,11 +761,14 @@ func categorizeSlackError(err error, operation string) error {
 	)
 }
 
-// parseMessageID parses a message ID in the format "channel:timestamp".
-func parseMessageID(messageID string) (channelID, timestamp string, err error) {
-	parts := strings.SplitN(messageID, ":", 2)
-	if len(parts) != 2 {
-		return "", "", fmt.Errorf("invalid message ID format: %s", messageID)
+// forEachMessage calls fn for each reference in a (possibly multi-channel)
+// message ID. Every copy is attempted; errors, including those of malformed
+// references, are joined.
+func forEachMessage(messageID string, fn func(ref entity.MessageRef) error) error {
+	refs, err := entity.ParseMessageRefs(messageID)
+	errs := []error{err}
+	for _, ref := range refs {
+		errs = append(errs, fn(ref))
 	}
-	return parts[0], parts[1], nil
+	return errors.Join(errs...)
 }
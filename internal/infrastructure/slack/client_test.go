@@ -0,0 +1,308 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	domainerrors "github.com/qj0r9j0vc2/alert-bridge/internal/domain/errors"
+)
+
+// fakeSlackAPI records chat.postMessage and chat.update calls per channel.
+type fakeSlackAPI struct {
+	mu      sync.Mutex
+	posted  []string
+	updated []string
+
+	// missing lists channels whose messages were deleted
+	missing map[string]bool
+
+	// gone lists channels that were deleted
+	gone map[string]bool
+
+	// channels maps channel IDs to their conversations.info response
+	channels map[string]string
+
+	// threads records the "channel:thread_ts" of posted thread replies
+	threads []string
+
+	// rateLimited is how many chat.postMessage calls are rejected with 429
+	// before posts succeed again
+	rateLimited int
+}
+
+func (f *fakeSlackAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	_ = r.ParseForm()
+	channel := r.Form.Get("channel")
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case strings.HasSuffix(r.URL.Path, "chat.postMessage") && f.rateLimited > 0:
+		f.rateLimited--
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(http.StatusTooManyRequests)
+	case strings.HasSuffix(r.URL.Path, "chat.postMessage") && f.gone[channel]:
+		fmt.Fprint(w, `{"ok":false,"error":"channel_not_found"}`)
+	case strings.HasSuffix(r.URL.Path, "conversations.info") && f.channels[channel] == "":
+		fmt.Fprint(w, `{"ok":false,"error":"channel_not_found"}`)
+	case strings.HasSuffix(r.URL.Path, "conversations.info"):
+		fmt.Fprintf(w, `{"ok":true,"channel":%s}`, f.channels[channel])
+	case strings.HasSuffix(r.URL.Path, "usergroups.list"):
+		fmt.Fprint(w, `{"ok":true,"usergroups":[{"id":"S0123ABCDEF","handle":"oncall"}]}`)
+	case strings.HasSuffix(r.URL.Path, "chat.postMessage"):
+		f.posted = append(f.posted, channel)
+		if threadTS := r.Form.Get("thread_ts"); threadTS != "" {
+			f.threads = append(f.threads, channel+":"+threadTS)
+		}
+		fmt.Fprintf(w, `{"ok":true,"channel":%q,"ts":"1700000000.%06d"}`, channel, len(f.posted))
+	case strings.HasSuffix(r.URL.Path, "chat.update") && f.missing[channel]:
+		fmt.Fprint(w, `{"ok":false,"error":"message_not_found"}`)
+	case strings.HasSuffix(r.URL.Path, "chat.update"):
+		f.updated = append(f.updated, channel+":"+r.Form.Get("ts"))
+		fmt.Fprintf(w, `{"ok":true,"channel":%q,"ts":%q}`, channel, r.Form.Get("ts"))
+	default:
+		fmt.Fprint(w, `{"ok":false,"error":"unknown_method"}`)
+	}
+}
+
+func TestClient_NotifyAndUpdateAllChannels(t *testing.T) {
+	api := &fakeSlackAPI{}
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	client := NewClient("xoxb-test", "C1", nil, server.URL+"/")
+	client.SetAdditionalChannels([]string{"C2"})
+
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	alert.Labels = map[string]string{ChannelsLabel: "C3, C1"}
+
+	messageID, err := client.Notify(context.Background(), alert)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"C1", "C2", "C3"}, api.posted)
+
+	alert.SetExternalReference(client.Name(), messageID)
+	refs := alert.ExternalReferenceIDs("slack")
+	assert.Equal(t, []string{"C1:1700000000.000001", "C2:1700000000.000002", "C3:1700000000.000003"}, refs)
+
+	alert.Resolve("alertmanager", alert.FiredAt)
+	require.NoError(t, client.UpdateMessage(context.Background(), messageID, alert))
+	assert.Equal(t, refs, api.updated)
+}
+
+func TestClient_SetChannel(t *testing.T) {
+	api := &fakeSlackAPI{}
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	client := NewClient("xoxb-test", "C1", nil, server.URL+"/")
+	_, err := client.Notify(context.Background(), entity.NewAlert("fp-1", "High CPU", "host-1", "", "", entity.SeverityCritical))
+	require.NoError(t, err)
+
+	// A reloaded channel receives the messages posted from then on
+	client.SetChannel("C2")
+	_, err = client.Notify(context.Background(), entity.NewAlert("fp-2", "High CPU", "host-2", "", "", entity.SeverityCritical))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"C1", "C2"}, api.posted)
+}
+
+func TestClient_NotifyTenantChannel(t *testing.T) {
+	api := &fakeSlackAPI{}
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	client := NewClient("xoxb-test", "C1", nil, server.URL+"/")
+	client.SetTenantChannels(map[string]string{"team-a": "CA"})
+	client.SetAdditionalChannels([]string{"C2"})
+
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	alert.TenantID = "team-a"
+	_, err := client.Notify(context.Background(), alert)
+	require.NoError(t, err)
+
+	// Tenants without a channel use the default one
+	alert.TenantID = "team-b"
+	_, err = client.Notify(context.Background(), alert)
+	require.NoError(t, err)
+
+	// Only alerts of the default tenant are copied to the additional channels
+	alert.TenantID = ""
+	_, err = client.Notify(context.Background(), alert)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"CA", "C1", "C1", "C2"}, api.posted)
+}
+
+func TestClient_NotifyDigestPerTenantChannel(t *testing.T) {
+	api := &fakeSlackAPI{}
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	client := NewClient("xoxb-test", "C1", nil, server.URL+"/")
+	client.SetTenantChannels(map[string]string{"team-a": "CA"})
+
+	alerts := make([]*entity.Alert, 0, 3)
+	for _, tenantID := range []string{"team-a", "", "team-a"} {
+		alert := entity.NewAlert("fp", "Disk filling", "host-1", "", "", entity.SeverityWarning)
+		alert.TenantID = tenantID
+		alerts = append(alerts, alert)
+	}
+
+	require.NoError(t, client.NotifyDigest(context.Background(), alerts))
+	assert.Equal(t, []string{"CA", "C1"}, api.posted)
+}
+
+func TestClient_UpdateMessageRepostsMissing(t *testing.T) {
+	api := &fakeSlackAPI{missing: map[string]bool{"C2": true}}
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	client := NewClient("xoxb-test", "C1", nil, server.URL+"/")
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	messageID := "C1:1.1,C2:2.2"
+	alert.SetExternalReference("slack", messageID)
+
+	// Disabled: the missing copy fails the update permanently
+	err := client.UpdateMessage(context.Background(), messageID, alert)
+	require.Error(t, err)
+	assert.False(t, domainerrors.IsTransientError(err))
+	assert.Equal(t, messageID, alert.GetExternalReference("slack"))
+
+	client.SetRepostOnMissing(true)
+	require.NoError(t, client.UpdateMessage(context.Background(), messageID, alert))
+	assert.Equal(t, []string{"C2"}, api.posted)
+	assert.Equal(t, "C1:1.1,C2:1700000000.000001", alert.GetExternalReference("slack"))
+}
+
+func TestClient_NotifyRepliesInThread(t *testing.T) {
+	api := &fakeSlackAPI{gone: map[string]bool{"C2": true}}
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	client := NewClient("xoxb-test", "C1", nil, server.URL+"/")
+
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	alert.SetExternalReference(entity.SlackThreadReference, "C1:1.1,C2:2.2")
+
+	// Copies of the thread in gone channels are skipped
+	messageID, err := client.Notify(context.Background(), alert)
+	require.NoError(t, err)
+	assert.Equal(t, "C1:1700000000.000001", messageID)
+	assert.Equal(t, []string{"C1:1.1"}, api.threads)
+
+	// Without any reachable thread copy the alert is posted top-level
+	alert.SetExternalReference(entity.SlackThreadReference, "C2:2.2")
+	messageID, err = client.Notify(context.Background(), alert)
+	require.NoError(t, err)
+	assert.Equal(t, "C1:1700000000.000002", messageID)
+	assert.Equal(t, []string{"C1:1.1"}, api.threads)
+}
+
+func TestClient_NotifyFallsBackForMissingChannel(t *testing.T) {
+	api := &fakeSlackAPI{gone: map[string]bool{"C1": true, "C3": true}}
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	client := NewClient("xoxb-test", "C1", nil, server.URL+"/")
+	client.SetAdditionalChannels([]string{"C2", "C3"})
+
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+
+	// Without a fallback the copy for the missing channel is dropped
+	messageID, err := client.Notify(context.Background(), alert)
+	require.NoError(t, err)
+	assert.Equal(t, "C2:1700000000.000001", messageID)
+
+	// Both gone channels hand over to a single fallback copy
+	client.SetFallbackChannel("CFALLBACK")
+	messageID, err = client.Notify(context.Background(), alert)
+	require.NoError(t, err)
+	assert.Equal(t, "C2:1700000000.000002,CFALLBACK:1700000000.000003", messageID)
+}
+
+func TestClient_NotifyBacksOffWhenRateLimited(t *testing.T) {
+	api := &fakeSlackAPI{rateLimited: 1}
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	client := NewClient("xoxb-test", "C1", nil, server.URL+"/")
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+
+	// The 429 is transient, so the retry wrapper tries again
+	_, err := client.Notify(context.Background(), alert)
+	require.Error(t, err)
+	assert.True(t, domainerrors.IsTransientError(err))
+
+	// The retry waits out Retry-After instead of hammering Slack
+	start := time.Now()
+	messageID, err := client.Notify(context.Background(), alert)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+	assert.True(t, strings.HasPrefix(messageID, "C1:"))
+	assert.Equal(t, []string{"C1"}, api.posted)
+}
+
+func TestClient_ValidateChannel(t *testing.T) {
+	api := &fakeSlackAPI{channels: map[string]string{
+		"CMEMBER":   `{"id":"CMEMBER","is_member":true}`,
+		"CARCHIVED": `{"id":"CARCHIVED","is_member":true,"is_archived":true}`,
+		"CPUBLIC":   `{"id":"CPUBLIC","is_member":false}`,
+		"COUTSIDER": `{"id":"COUTSIDER","is_member":false,"is_private":true}`,
+	}}
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	client := NewClient("xoxb-test", "CMEMBER", nil, server.URL+"/")
+	ctx := context.Background()
+
+	require.NoError(t, client.ValidateChannel(ctx, "CMEMBER"))
+	require.NoError(t, client.ValidateChannel(ctx, "CPUBLIC"))
+	assert.ErrorContains(t, client.ValidateChannel(ctx, "CARCHIVED"), "archived")
+	assert.ErrorContains(t, client.ValidateChannel(ctx, "COUTSIDER"), "not a member")
+	assert.ErrorContains(t, client.ValidateChannel(ctx, "CMISSING"), "channel_not_found")
+}
+
+func TestClient_ValidateMentionGroups(t *testing.T) {
+	server := httptest.NewServer(&fakeSlackAPI{})
+	defer server.Close()
+
+	client := NewClient("xoxb-test", "C1", nil, server.URL+"/")
+	ctx := context.Background()
+	require.NoError(t, client.ValidateMentionGroups(ctx))
+
+	client.SetMentionGroups(map[entity.AlertSeverity]string{entity.SeverityCritical: "S0123ABCDEF"})
+	require.NoError(t, client.ValidateMentionGroups(ctx))
+
+	client.SetMentionGroups(map[entity.AlertSeverity]string{
+		entity.SeverityCritical: "S0123ABCDEF",
+		entity.SeverityWarning:  "SMISSING",
+	})
+	err := client.ValidateMentionGroups(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "SMISSING (warning)")
+	assert.NotContains(t, err.Error(), "S0123ABCDEF")
+}
+
+func TestSilenceModalTenant(t *testing.T) {
+	tenantID, ok := SilenceModalTenant(SilenceModalMetadata("team-db"))
+	assert.True(t, ok)
+	assert.Equal(t, "team-db", tenantID)
+
+	// The default tenant is a scope too
+	tenantID, ok = SilenceModalTenant(SilenceModalMetadata(""))
+	assert.True(t, ok)
+	assert.Empty(t, tenantID)
+
+	_, ok = SilenceModalTenant(BuildSilenceModal(nil).PrivateMetadata)
+	assert.False(t, ok)
+}
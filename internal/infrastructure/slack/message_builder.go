@@ -2,26 +2,95 @@ package slack
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/slack-go/slack"
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/templates"
 )
 
 // Severity color codes for visual distinction
+var (
+	colorCritical = templates.HexColor(templates.ColorCritical)
+	colorWarning  = templates.HexColor(templates.ColorWarning)
+	colorInfo     = templates.HexColor(templates.ColorInfo)
+	colorResolved = templates.HexColor(templates.ColorResolved)
+	colorAcked    = templates.HexColor(templates.ColorAcked)
+)
+
+// alertmanagerAnnotation is the alert annotation rendered as a link button
+// to the alert in the Alertmanager UI.
+const alertmanagerAnnotation = "alertmanager_url"
+
+// Values of the options in the "more actions" overflow menu of alert
+// messages. All but OverflowFingerprint open a link.
 const (
-	colorCritical = "#E01E5A" // Red
-	colorWarning  = "#ECB22E" // Yellow/Orange
-	colorInfo     = "#36C5F0" // Blue
-	colorResolved = "#2EB67D" // Green
-	colorAcked    = "#9B59B6" // Purple
+	OverflowRunbook      = "runbook"
+	OverflowAlertmanager = "alertmanager"
+	OverflowPagerDuty    = "pagerduty"
+	OverflowFingerprint  = "fingerprint"
+)
+
+// onCallAnnotation is the alert annotation naming who is on call.
+const onCallAnnotation = "oncall"
+
+// customBodyAnnotation is the alert annotation holding a preformatted mrkdwn
+// message rendered in place of the generated layout when custom bodies are allowed.
+const customBodyAnnotation = "slack_message"
+
+// maxSectionTextLength is the longest text Slack accepts in a section block.
+const maxSectionTextLength = 3000
+
+// Limits of digest messages: alerts past maxDigestAlerts are only counted,
+// and names, instances and summaries are cut to maxDigestFieldLength runes.
+const (
+	maxDigestAlerts      = 50
+	maxDigestFieldLength = 150
+)
+
+// defaultMaxDisplayLabels is how many labels a message shows by default, and
+// maxLabelDisplayLength how many runes of each label value.
+const (
+	defaultMaxDisplayLabels = 10
+	maxLabelDisplayLength   = 100
+)
+
+// maxHomeAlerts is how many alerts the App Home tab lists; Slack accepts at
+// most 100 blocks per view and a few are needed around the list.
+const maxHomeAlerts = 90
+
+// mrkdwnEscaper escapes the characters Slack treats as control sequences in
+// mrkdwn, so a custom body cannot inject links or @channel mentions.
+var mrkdwnEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// SlackDateFormat renders times as Slack date tokens, which Slack shows in
+// each reader's own timezone.
+const SlackDateFormat = "slack"
+
+// Layouts of the fallback text of Slack date tokens.
+const (
+	dateTimeLayout = "Jan 2, 15:04 MST"
+	timeLayout     = "15:04 MST"
 )
 
 // MessageBuilder constructs Slack Block Kit messages for alerts.
 type MessageBuilder struct {
-	silenceDurations []time.Duration
+	silenceDurations        []time.Duration
+	instanceSilenceDuration time.Duration
+	severityMap             entity.SeverityMap
+	priorityMap             entity.SeverityMap
+	mentionGroups           map[entity.AlertSeverity]string
+	allowCustomBody         bool
+	displayLabels           []string
+	hideLabels              map[string]bool
+	maxDisplayLabels        int
+	timeFormat              string
+	location                *time.Location
+	publicURL               string
+	bodyTemplate            *templates.Template
 }
 
 // NewMessageBuilder creates a new message builder with the given silence durations.
@@ -35,10 +104,108 @@ func NewMessageBuilder(silenceDurations []time.Duration) *MessageBuilder {
 		}
 	}
 	return &MessageBuilder{
-		silenceDurations: silenceDurations,
+		silenceDurations:        silenceDurations,
+		instanceSilenceDuration: time.Hour,
+		maxDisplayLabels:        defaultMaxDisplayLabels,
+		timeFormat:              SlackDateFormat,
+		location:                time.Local,
+	}
+}
+
+// SetTimeFormat sets how times are rendered: SlackDateFormat for Slack date
+// tokens, or a Go time layout for static text in the builder's timezone.
+// Empty keeps the current format.
+func (b *MessageBuilder) SetTimeFormat(format string) {
+	if format != "" {
+		b.timeFormat = format
+	}
+}
+
+// SetTimezone sets the timezone of static times and of the fallback text
+// shown by clients that cannot render Slack date tokens. Nil keeps the
+// current timezone.
+func (b *MessageBuilder) SetTimezone(location *time.Location) {
+	if location != nil {
+		b.location = location
 	}
 }
 
+// SetInstanceSilenceDuration sets the duration of the "silence instance" button.
+func (b *MessageBuilder) SetInstanceSilenceDuration(d time.Duration) {
+	if d > 0 {
+		b.instanceSilenceDuration = d
+	}
+}
+
+// SetSeverityMap sets the severity map whose colors override the built-in
+// severity colors of firing alerts.
+func (b *MessageBuilder) SetSeverityMap(severities entity.SeverityMap) {
+	b.severityMap = severities
+}
+
+// SetPriorityMap sets the map of priority annotation values whose severity
+// and color override the styling derived from the severity label.
+func (b *MessageBuilder) SetPriorityMap(priorities entity.SeverityMap) {
+	b.priorityMap = priorities
+}
+
+// SetMentionGroups sets the Slack user group IDs mentioned, per severity, when
+// an alert is first posted.
+func (b *MessageBuilder) SetMentionGroups(groups map[entity.AlertSeverity]string) {
+	b.mentionGroups = groups
+}
+
+// SetAllowCustomBody makes alerts with a slack_message annotation render
+// that text in place of the generated summary and details.
+func (b *MessageBuilder) SetAllowCustomBody(enabled bool) {
+	b.allowCustomBody = enabled
+}
+
+// SetBodyTemplate sets the template whose mrkdwn output replaces the
+// generated summary and details of every alert message. A slack_message
+// annotation, when custom bodies are allowed, still takes precedence.
+func (b *MessageBuilder) SetBodyTemplate(tmpl *templates.Template) {
+	b.bodyTemplate = tmpl
+}
+
+// SetLabelDisplay sets which labels messages show: the display labels in
+// their order, or else every label but the hidden ones, sorted by name.
+// With neither set no labels are shown. Labels beyond limit are summarized
+// as "+N more"; a limit of zero keeps the current one.
+func (b *MessageBuilder) SetLabelDisplay(display, hide []string, limit int) {
+	b.displayLabels = display
+	b.hideLabels = make(map[string]bool, len(hide))
+	for _, name := range hide {
+		b.hideLabels[name] = true
+	}
+	if limit > 0 {
+		b.maxDisplayLabels = limit
+	}
+}
+
+// SetPublicURL sets the base URL the bridge is reachable at, so overflow
+// messages link to its alert list.
+func (b *MessageBuilder) SetPublicURL(url string) {
+	b.publicURL = strings.TrimSuffix(url, "/")
+}
+
+// BuildNotificationMessage creates the message for an alert's first post:
+// the alert message, preceded by the user group mention for its severity.
+// Updates use BuildAlertMessage so they do not mention the group again.
+func (b *MessageBuilder) BuildNotificationMessage(alert *entity.Alert) []slack.Block {
+	blocks := b.BuildAlertMessage(alert)
+
+	groupID := b.mentionGroups[alert.Severity]
+	if groupID == "" {
+		return blocks
+	}
+	mention := slack.NewSectionBlock(
+		slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf("<!subteam^%s>", groupID), false, false),
+		nil, nil,
+	)
+	return append([]slack.Block{mention}, blocks...)
+}
+
 // BuildAlertMessage creates a Block Kit message for an alert.
 func (b *MessageBuilder) BuildAlertMessage(alert *entity.Alert) []slack.Block {
 	return b.buildMessage(alert, true, true)
@@ -54,6 +221,133 @@ func (b *MessageBuilder) BuildResolvedMessage(alert *entity.Alert) []slack.Block
 	return b.buildMessage(alert, false, false)
 }
 
+// BuildDigestMessage creates one summary message listing the alerts, at most
+// maxDigestAlerts of them, in the given order.
+func (b *MessageBuilder) BuildDigestMessage(alerts []*entity.Alert) []slack.Block {
+	title := fmt.Sprintf("📋 Alert digest: %d alerts", len(alerts))
+	if len(alerts) == 1 {
+		title = "📋 Alert digest: 1 alert"
+	}
+	blocks := []slack.Block{
+		slack.NewHeaderBlock(slack.NewTextBlockObject(slack.PlainTextType, title, true, false)),
+	}
+
+	// Pack the lines into as few sections as their length allows
+	var section strings.Builder
+	flush := func() {
+		if section.Len() == 0 {
+			return
+		}
+		blocks = append(blocks, slack.NewSectionBlock(
+			slack.NewTextBlockObject(slack.MarkdownType, section.String(), false, false), nil, nil))
+		section.Reset()
+	}
+	for i, alert := range alerts {
+		if i == maxDigestAlerts {
+			break
+		}
+		line := fmt.Sprintf("%s *%s*", b.getSeverityBadge(alert), mrkdwnEscaper.Replace(shorten(alert.Name, maxDigestFieldLength)))
+		if alert.Instance != "" {
+			line += " on `" + mrkdwnEscaper.Replace(shorten(alert.Instance, maxDigestFieldLength)) + "`"
+		}
+		line += " · fired " + b.formatTime(alert.FiredAt, "{date_short_pretty} {time}", dateTimeLayout)
+		if alert.Summary != "" {
+			line += "\n      " + mrkdwnEscaper.Replace(shorten(alert.Summary, maxDigestFieldLength))
+		}
+
+		if section.Len()+len(line)+1 > maxSectionTextLength {
+			flush()
+		}
+		if section.Len() > 0 {
+			section.WriteString("\n")
+		}
+		section.WriteString(line)
+	}
+	flush()
+
+	if hidden := len(alerts) - maxDigestAlerts; hidden > 0 {
+		blocks = append(blocks, slack.NewContextBlock("",
+			slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf("…and %d more", hidden), false, false)))
+	}
+	return blocks
+}
+
+// BuildOverflowMessage creates the message standing in for alerts that
+// were not posted because too many fired at once.
+func (b *MessageBuilder) BuildOverflowMessage(suppressed int) []slack.Block {
+	title := fmt.Sprintf("⚠️ *%d additional alerts suppressed*", suppressed)
+	if suppressed == 1 {
+		title = "⚠️ *1 additional alert suppressed*"
+	}
+	text := title + "\nToo many alerts fired at once; the ones still firing are posted here as the rate allows."
+
+	list := "List the firing alerts with `GET /api/v1/alerts`."
+	if b.publicURL != "" {
+		list = fmt.Sprintf("<%s/api/v1/alerts|List the firing alerts>", b.publicURL)
+	}
+	return []slack.Block{
+		slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, text, false, false), nil, nil),
+		slack.NewContextBlock("", slack.NewTextBlockObject(slack.MarkdownType, list, false, false)),
+	}
+}
+
+// BuildHomeView creates the App Home tab listing the alerts, at most
+// maxHomeAlerts of them, in the given order. Alerts not yet acknowledged
+// get an acknowledge button, handled like the one on alert messages.
+func (b *MessageBuilder) BuildHomeView(alerts []*entity.Alert) slack.HomeTabViewRequest {
+	title := fmt.Sprintf("🚨 Active alerts: %d", len(alerts))
+	blocks := []slack.Block{
+		slack.NewHeaderBlock(slack.NewTextBlockObject(slack.PlainTextType, title, true, false)),
+		slack.NewContextBlock("", slack.NewTextBlockObject(slack.MarkdownType,
+			"Updated "+b.formatTime(time.Now(), "{date_short_pretty} {time}", dateTimeLayout), false, false)),
+	}
+
+	if len(alerts) == 0 {
+		blocks = append(blocks, slack.NewSectionBlock(
+			slack.NewTextBlockObject(slack.MarkdownType, "✅ No active alerts.", false, false), nil, nil))
+	}
+
+	for i, alert := range alerts {
+		if i == maxHomeAlerts {
+			break
+		}
+		text := fmt.Sprintf("%s *%s*", b.getSeverityBadge(alert), mrkdwnEscaper.Replace(shorten(alert.Name, maxDigestFieldLength)))
+		if alert.Instance != "" {
+			text += " on `" + mrkdwnEscaper.Replace(shorten(alert.Instance, maxDigestFieldLength)) + "`"
+		}
+		text += "\n" + b.formatState(alert.State) + " · fired " + b.formatTime(alert.FiredAt, "{date_short_pretty} {time}", dateTimeLayout)
+		if alert.IsAcked() && alert.AckedBy != "" {
+			text += " · acked by " + mrkdwnEscaper.Replace(alert.AckedBy)
+		}
+		if alert.Summary != "" {
+			text += "\n" + mrkdwnEscaper.Replace(shorten(alert.Summary, maxDigestFieldLength))
+		}
+
+		var accessory *slack.Accessory
+		if !alert.IsAcked() {
+			ackBtn := slack.NewButtonBlockElement(
+				fmt.Sprintf("ack_%s", alert.ID),
+				alert.ID,
+				slack.NewTextBlockObject(slack.PlainTextType, "✓ Acknowledge", true, false),
+			)
+			ackBtn.Style = slack.StylePrimary
+			accessory = slack.NewAccessory(ackBtn)
+		}
+		blocks = append(blocks, slack.NewSectionBlock(
+			slack.NewTextBlockObject(slack.MarkdownType, text, false, false), nil, accessory))
+	}
+
+	if hidden := len(alerts) - maxHomeAlerts; hidden > 0 {
+		blocks = append(blocks, slack.NewContextBlock("",
+			slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf("…and %d more", hidden), false, false)))
+	}
+
+	return slack.HomeTabViewRequest{
+		Type:   slack.VTHomeTab,
+		Blocks: slack.Blocks{BlockSet: blocks},
+	}
+}
+
 // buildMessage creates a Block Kit message with configurable button options.
 func (b *MessageBuilder) buildMessage(alert *entity.Alert, showAckButton, showSilenceButton bool) []slack.Block {
 	var blocks []slack.Block
@@ -61,6 +355,23 @@ func (b *MessageBuilder) buildMessage(alert *entity.Alert, showAckButton, showSi
 	// Status banner with emoji and severity indicator
 	blocks = append(blocks, b.buildStatusBanner(alert))
 
+	// A custom body replaces the generated layout; the status banner and
+	// buttons stay so the message still tracks state and can be acted on
+	body := b.customBody(alert)
+	if body == "" {
+		body = b.templateBody(alert)
+	}
+	if body != "" {
+		blocks = append(blocks, slack.NewSectionBlock(
+			slack.NewTextBlockObject(slack.MarkdownType, body, false, false),
+			nil, nil,
+		))
+		if actionBlock := b.buildActionButtons(alert, showAckButton, showSilenceButton); actionBlock != nil {
+			blocks = append(blocks, actionBlock)
+		}
+		return blocks
+	}
+
 	// Alert name as header
 	blocks = append(blocks, slack.NewHeaderBlock(
 		slack.NewTextBlockObject(slack.PlainTextType, alert.Name, true, false),
@@ -77,22 +388,114 @@ func (b *MessageBuilder) buildMessage(alert *entity.Alert, showAckButton, showSi
 	// Alert details in a compact format
 	blocks = append(blocks, b.buildDetailsSection(alert))
 
+	// Labels chosen by the display or hide list
+	if labelsSection := b.buildLabelsSection(alert); labelsSection != nil {
+		blocks = append(blocks, labelsSection)
+	}
+
 	// Thin divider
 	blocks = append(blocks, slack.NewDividerBlock())
 
 	// Timeline context
 	blocks = append(blocks, b.buildTimelineContext(alert))
 
-	// Action buttons (configurable)
-	if showAckButton || showSilenceButton {
-		if actionBlock := b.buildActionButtons(alert.ID, showAckButton, showSilenceButton); actionBlock != nil {
-			blocks = append(blocks, actionBlock)
-		}
+	// Who to expect on it, until it is resolved
+	if onCall := alert.GetAnnotation(onCallAnnotation); onCall != "" && !alert.IsResolved() {
+		blocks = append(blocks, slack.NewContextBlock("",
+			slack.NewTextBlockObject(slack.MarkdownType,
+				fmt.Sprintf("📟 On-call: *@%s*", mrkdwnEscaper.Replace(onCall)), false, false)))
+	}
+
+	// Suppressed children are only posted here, in their parent's thread
+	if alert.GetExternalReference(entity.SuppressedByReference) != "" && !alert.IsResolved() {
+		blocks = append(blocks, slack.NewContextBlock("",
+			slack.NewTextBlockObject(slack.MarkdownType,
+				"🔗 Suppressed while its parent alert is firing; not paged", false, false)))
+	}
+
+	// Action buttons (configurable); the overflow menu of links is shown in every state
+	if actionBlock := b.buildActionButtons(alert, showAckButton, showSilenceButton); actionBlock != nil {
+		blocks = append(blocks, actionBlock)
 	}
 
 	return blocks
 }
 
+// shorten cuts s to at most n runes, ending it with an ellipsis if cut.
+func shorten(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-1]) + "…"
+}
+
+// customBody returns the alert's slack_message annotation escaped for
+// mrkdwn and cut to fit a section block, or "" if custom bodies are off or
+// the annotation is blank.
+func (b *MessageBuilder) customBody(alert *entity.Alert) string {
+	if !b.allowCustomBody {
+		return ""
+	}
+	text := strings.TrimSpace(alert.Annotations[customBodyAnnotation])
+	if text == "" {
+		return ""
+	}
+
+	escaped := mrkdwnEscaper.Replace(text)
+	if len(escaped) <= maxSectionTextLength {
+		return escaped
+	}
+
+	// Cut whole runes of the original so no escape sequence is split
+	const ellipsis = "…"
+	var body strings.Builder
+	for _, r := range text {
+		next := mrkdwnEscaper.Replace(string(r))
+		if body.Len()+len(next)+len(ellipsis) > maxSectionTextLength {
+			break
+		}
+		body.WriteString(next)
+	}
+	return body.String() + ellipsis
+}
+
+// templateBody renders the body template cut to fit a section block, or
+// returns "" if there is none or it fails or renders blank, so the
+// generated layout is used. The template sees the alert values escaped, so
+// they cannot inject mentions or links, while its own mrkdwn is kept.
+func (b *MessageBuilder) templateBody(alert *entity.Alert) string {
+	if b.bodyTemplate == nil {
+		return ""
+	}
+	return shorten(b.bodyTemplate.Text(escapedAlert(alert)), maxSectionTextLength)
+}
+
+// escapedAlert returns a copy of alert with its free-text values escaped
+// for mrkdwn.
+func escapedAlert(alert *entity.Alert) *entity.Alert {
+	escaped := *alert
+	escaped.Name = mrkdwnEscaper.Replace(alert.Name)
+	escaped.Instance = mrkdwnEscaper.Replace(alert.Instance)
+	escaped.Target = mrkdwnEscaper.Replace(alert.Target)
+	escaped.Summary = mrkdwnEscaper.Replace(alert.Summary)
+	escaped.Description = mrkdwnEscaper.Replace(alert.Description)
+	escaped.AckedBy = mrkdwnEscaper.Replace(alert.AckedBy)
+	escaped.AssignedTo = mrkdwnEscaper.Replace(alert.AssignedTo)
+	escaped.Labels = escapeValues(alert.Labels)
+	escaped.Annotations = escapeValues(alert.Annotations)
+	return &escaped
+}
+
+// escapeValues returns a copy of m with its values escaped for mrkdwn.
+func escapeValues(m map[string]string) map[string]string {
+	escaped := make(map[string]string, len(m))
+	for k, v := range m {
+		escaped[k] = mrkdwnEscaper.Replace(v)
+	}
+	return escaped
+}
+
 // buildStatusBanner creates a visual status banner at the top.
 func (b *MessageBuilder) buildStatusBanner(alert *entity.Alert) *slack.SectionBlock {
 	emoji, statusText, color := b.getStatusInfo(alert)
@@ -119,19 +522,43 @@ func (b *MessageBuilder) getStatusInfo(alert *entity.Alert) (emoji, text, color
 		return "✅", "RESOLVED", colorResolved
 	case alert.IsAcked():
 		return "👁️", "ACKNOWLEDGED", colorAcked
-	case alert.Severity == entity.SeverityCritical:
-		return "🚨", "CRITICAL", colorCritical
-	case alert.Severity == entity.SeverityWarning:
-		return "⚠️", "WARNING", colorWarning
+	}
+
+	mapping, prioritized := b.priorityMap.ForPriority(alert)
+	switch b.styleSeverity(alert) {
+	case entity.SeverityCritical:
+		emoji, text, color = "🚨", "CRITICAL", colorCritical
+	case entity.SeverityWarning:
+		emoji, text, color = "⚠️", "WARNING", colorWarning
 	default:
-		return "ℹ️", "INFO", colorInfo
+		emoji, text, color = "ℹ️", "INFO", colorInfo
 	}
+	if !prioritized {
+		mapping, _ = b.severityMap.ForAlert(alert)
+	}
+	if mapping.Color != "" {
+		color = mapping.Color
+	}
+	return emoji, text, color
+}
+
+// styleSeverity returns the severity a message is styled by: that of the
+// priority map entry for the alert's priority annotation, if any, else its own.
+func (b *MessageBuilder) styleSeverity(alert *entity.Alert) entity.AlertSeverity {
+	if mapping, ok := b.priorityMap.ForPriority(alert); ok {
+		return mapping.Severity
+	}
+	return alert.Severity
 }
 
-// getSeverityBadge returns a formatted severity badge.
+// getSeverityBadge returns a formatted severity badge, naming the alert's
+// priority instead of its severity when the priority is mapped.
 func (b *MessageBuilder) getSeverityBadge(alert *entity.Alert) string {
 	severity := strings.ToUpper(string(alert.Severity))
-	switch alert.Severity {
+	if _, ok := b.priorityMap.ForPriority(alert); ok {
+		severity = alert.GetAnnotation(entity.PriorityAnnotation)
+	}
+	switch b.styleSeverity(alert) {
 	case entity.SeverityCritical:
 		return fmt.Sprintf("`🔴 %s`", severity)
 	case entity.SeverityWarning:
@@ -186,12 +613,63 @@ func (b *MessageBuilder) buildDetailsSection(alert *entity.Alert) *slack.Section
 	return slack.NewSectionBlock(nil, fields, nil)
 }
 
+// buildLabelsSection lists the alert labels selected for display, or returns
+// nil if no labels are configured or the alert carries none of them.
+func (b *MessageBuilder) buildLabelsSection(alert *entity.Alert) *slack.SectionBlock {
+	names := b.labelsToShow(alert)
+	if len(names) == 0 {
+		return nil
+	}
+
+	shown := names
+	if len(shown) > b.maxDisplayLabels {
+		shown = shown[:b.maxDisplayLabels]
+	}
+	labels := make([]string, 0, len(shown)+1)
+	for _, name := range shown {
+		value := shorten(alert.GetLabel(name), maxLabelDisplayLength)
+		labels = append(labels, fmt.Sprintf("`%s=%s`", mrkdwnEscaper.Replace(name), mrkdwnEscaper.Replace(value)))
+	}
+	if more := len(names) - len(shown); more > 0 {
+		labels = append(labels, fmt.Sprintf("_+%d more_", more))
+	}
+
+	return slack.NewSectionBlock(
+		slack.NewTextBlockObject(slack.MarkdownType, "*🏷️ Labels*\n"+strings.Join(labels, "  "), false, false),
+		nil, nil,
+	)
+}
+
+// labelsToShow returns the names of the alert labels to display, in display order.
+func (b *MessageBuilder) labelsToShow(alert *entity.Alert) []string {
+	var names []string
+	if len(b.displayLabels) > 0 {
+		for _, name := range b.displayLabels {
+			if _, ok := alert.Labels[name]; ok {
+				names = append(names, name)
+			}
+		}
+		return names
+	}
+	if len(b.hideLabels) == 0 {
+		return nil
+	}
+
+	for name := range alert.Labels {
+		if !b.hideLabels[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
 // buildTimelineContext creates the timeline context with fired/acked/resolved times.
 func (b *MessageBuilder) buildTimelineContext(alert *entity.Alert) *slack.ContextBlock {
 	var elements []slack.MixedElement
 
 	// Fired time
-	firedAt := alert.FiredAt.Format("Jan 2, 15:04 MST")
+	firedAt := b.formatTime(alert.FiredAt, "{date_short_pretty} {time}", dateTimeLayout)
 	elements = append(elements,
 		slack.NewTextBlockObject(slack.MarkdownType,
 			fmt.Sprintf("🔥 Fired: *%s*", firedAt), false, false))
@@ -200,26 +678,47 @@ func (b *MessageBuilder) buildTimelineContext(alert *entity.Alert) *slack.Contex
 	if alert.IsAcked() && alert.AckedBy != "" {
 		ackedAt := "unknown"
 		if alert.AckedAt != nil {
-			ackedAt = alert.AckedAt.Format("15:04 MST")
+			ackedAt = b.formatTime(*alert.AckedAt, "{time}", timeLayout)
 		}
 		elements = append(elements,
 			slack.NewTextBlockObject(slack.MarkdownType,
 				fmt.Sprintf("  •  👁️ Acked by *%s* at %s", alert.AckedBy, ackedAt), false, false))
 	}
 
+	// Assignee
+	if alert.AssignedTo != "" {
+		elements = append(elements,
+			slack.NewTextBlockObject(slack.MarkdownType,
+				fmt.Sprintf("  •  👤 Assigned to *%s*", mrkdwnEscaper.Replace(alert.AssignedTo)), false, false))
+	}
+
 	// Resolved info
 	if alert.IsResolved() && alert.ResolvedAt != nil {
-		resolvedAt := alert.ResolvedAt.Format("15:04 MST")
+		resolvedAt := b.formatTime(*alert.ResolvedAt, "{time}", timeLayout)
+		text := fmt.Sprintf("  •  ✅ Resolved: *%s*", resolvedAt)
+		if t := alert.LastTransition; t != nil && t.State == entity.StateResolved && t.By != "" {
+			text = fmt.Sprintf("  •  ✅ Resolved by *%s* at %s", t.By, resolvedAt)
+		}
 		elements = append(elements,
-			slack.NewTextBlockObject(slack.MarkdownType,
-				fmt.Sprintf("  •  ✅ Resolved: *%s*", resolvedAt), false, false))
+			slack.NewTextBlockObject(slack.MarkdownType, text, false, false))
 	}
 
 	return slack.NewContextBlock("", elements...)
 }
 
+// formatTime renders t as a Slack date token with the given token format,
+// falling back to fallbackLayout, or as static text in the configured layout.
+func (b *MessageBuilder) formatTime(t time.Time, tokenFormat, fallbackLayout string) string {
+	local := t.In(b.location)
+	if b.timeFormat != SlackDateFormat {
+		return local.Format(b.timeFormat)
+	}
+	return fmt.Sprintf("<!date^%d^%s|%s>", t.Unix(), tokenFormat, local.Format(fallbackLayout))
+}
+
 // buildActionButtons creates the interactive action buttons.
-func (b *MessageBuilder) buildActionButtons(alertID string, showAck, showSilence bool) *slack.ActionBlock {
+func (b *MessageBuilder) buildActionButtons(alert *entity.Alert, showAck, showSilence bool) *slack.ActionBlock {
+	alertID := alert.ID
 	var elements []slack.BlockElement
 
 	// Acknowledge button
@@ -233,8 +732,16 @@ func (b *MessageBuilder) buildActionButtons(alertID string, showAck, showSilence
 		elements = append(elements, ackBtn)
 	}
 
-	// Silence duration dropdown
-	if showSilence {
+	// A silenced alert offers to delete its silence instead of adding another
+	if silenceID := alert.GetExternalReference(entity.SilenceReference); showSilence && silenceID != "" {
+		unsilenceBtn := slack.NewButtonBlockElement(
+			fmt.Sprintf("unsilence_%s", alertID),
+			silenceID,
+			slack.NewTextBlockObject(slack.PlainTextType, "🔔 Unsilence", true, false),
+		)
+		elements = append(elements, unsilenceBtn)
+	} else if showSilence {
+		// Silence duration dropdown
 		options := make([]*slack.OptionBlockObject, len(b.silenceDurations))
 		for i, d := range b.silenceDurations {
 			options[i] = slack.NewOptionBlockObject(
@@ -251,6 +758,42 @@ func (b *MessageBuilder) buildActionButtons(alertID string, showAck, showSilence
 			options...,
 		)
 		elements = append(elements, silenceSelect)
+
+		// One-click silence for every alert from the same instance
+		if alert.Instance != "" {
+			instanceBtn := slack.NewButtonBlockElement(
+				fmt.Sprintf("silenceinstance_%s", alertID),
+				alertID,
+				slack.NewTextBlockObject(slack.PlainTextType,
+					fmt.Sprintf("🔕 Silence instance %s", b.formatDuration(b.instanceSilenceDuration)), true, false),
+			)
+			elements = append(elements, instanceBtn)
+		}
+	}
+
+	// Responders pick the assignee from the workspace's users until it resolves
+	if !alert.IsResolved() {
+		placeholder := "👤 Assign..."
+		if alert.AssignedTo != "" {
+			placeholder = "👤 Reassign..."
+		}
+		elements = append(elements, slack.NewOptionsSelectBlockElement(
+			slack.OptTypeUser,
+			slack.NewTextBlockObject(slack.PlainTextType, placeholder, true, false),
+			fmt.Sprintf("assign_%s", alertID),
+		))
+		if alert.AssignedTo != "" {
+			elements = append(elements, slack.NewButtonBlockElement(
+				fmt.Sprintf("unassign_%s", alertID),
+				alertID,
+				slack.NewTextBlockObject(slack.PlainTextType, "Unassign", true, false),
+			))
+		}
+	}
+
+	// Secondary actions go in a "⋯" menu to keep the row short
+	if overflow := b.buildOverflowMenu(alert); overflow != nil {
+		elements = append(elements, overflow)
 	}
 
 	if len(elements) == 0 {
@@ -260,6 +803,46 @@ func (b *MessageBuilder) buildActionButtons(alertID string, showAck, showSilence
 	return slack.NewActionBlock(fmt.Sprintf("actions_%s", alertID), elements...)
 }
 
+// buildOverflowMenu creates the "more actions" menu of an alert message: its
+// runbook, Alertmanager and PagerDuty links, and a fingerprint
+// option answered by the interaction handler. Slack wants two to five
+// options in a menu, so a single option is rendered as a button instead.
+func (b *MessageBuilder) buildOverflowMenu(alert *entity.Alert) slack.BlockElement {
+	var options []*slack.OptionBlockObject
+	addOption := func(value, text, link string) {
+		option := slack.NewOptionBlockObject(value, slack.NewTextBlockObject(slack.PlainTextType, text, true, false), nil)
+		option.URL = link
+		options = append(options, option)
+	}
+
+	if runbookURL := alert.GetAnnotation(entity.RunbookAnnotation); runbookURL != "" {
+		addOption(OverflowRunbook, "📖 Runbook", runbookURL)
+	}
+	if alertmanagerURL := alert.GetAnnotation(alertmanagerAnnotation); alertmanagerURL != "" {
+		addOption(OverflowAlertmanager, "🔎 View in Alertmanager", alertmanagerURL)
+	}
+	if pagerDutyURL := alert.GetExternalReference(entity.PagerDutyURLReference); pagerDutyURL != "" {
+		addOption(OverflowPagerDuty, "📟 View in PagerDuty", pagerDutyURL)
+	}
+	if alert.Fingerprint != "" {
+		addOption(OverflowFingerprint, "🔑 Copy fingerprint", "")
+	}
+
+	actionID := fmt.Sprintf("more_%s", alert.ID)
+	switch len(options) {
+	case 0:
+		return nil
+	case 1:
+		button := slack.NewButtonBlockElement(actionID, options[0].Value, options[0].Text)
+		if options[0].URL != "" {
+			button = button.WithURL(options[0].URL)
+		}
+		return button
+	default:
+		return slack.NewOverflowBlockElement(actionID, options...)
+	}
+}
+
 // formatDuration formats a duration for display.
 func (b *MessageBuilder) formatDuration(d time.Duration) string {
 	if d < time.Hour {
@@ -0,0 +1,522 @@
+package slack
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/templates"
+)
+
+// actionButtons returns the buttons of a message's action block by action ID.
+func actionButtons(t *testing.T, blocks []slack.Block) map[string]*slack.ButtonBlockElement {
+	t.Helper()
+
+	buttons := make(map[string]*slack.ButtonBlockElement)
+	for _, block := range blocks {
+		actions, ok := block.(*slack.ActionBlock)
+		if !ok {
+			continue
+		}
+		for _, element := range actions.Elements.ElementSet {
+			if button, ok := element.(*slack.ButtonBlockElement); ok {
+				buttons[button.ActionID] = button
+			}
+		}
+	}
+	require.NotEmpty(t, buttons)
+	return buttons
+}
+
+// overflowOptions returns the options of a message's overflow menu by value.
+func overflowOptions(t *testing.T, blocks []slack.Block) map[string]*slack.OptionBlockObject {
+	t.Helper()
+
+	options := make(map[string]*slack.OptionBlockObject)
+	for _, block := range blocks {
+		actions, ok := block.(*slack.ActionBlock)
+		if !ok {
+			continue
+		}
+		for _, element := range actions.Elements.ElementSet {
+			if overflow, ok := element.(*slack.OverflowBlockElement); ok {
+				for _, option := range overflow.Options {
+					options[option.Value] = option
+				}
+			}
+		}
+	}
+	return options
+}
+
+func TestMessageBuilder_InstanceSilenceButton(t *testing.T) {
+	builder := NewMessageBuilder(nil)
+	builder.SetInstanceSilenceDuration(4 * time.Hour)
+
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	button := actionButtons(t, builder.BuildAlertMessage(alert))["silenceinstance_"+alert.ID]
+	require.NotNil(t, button)
+	assert.Equal(t, alert.ID, button.Value)
+	assert.Equal(t, "🔕 Silence instance 4 hours", button.Text.Text)
+
+	// Still offered once acked, gone once resolved
+	assert.Contains(t, actionButtons(t, builder.BuildAckedMessage(alert)), "silenceinstance_"+alert.ID)
+	assert.NotContains(t, actionButtons(t, builder.BuildResolvedMessage(alert)), "silenceinstance_"+alert.ID)
+
+	// Alerts without an instance have nothing to silence
+	alert.Instance = ""
+	assert.NotContains(t, actionButtons(t, builder.BuildAlertMessage(alert)), "silenceinstance_"+alert.ID)
+}
+
+func TestMessageBuilder_UnsilenceButton(t *testing.T) {
+	builder := NewMessageBuilder(nil)
+
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	require.NoError(t, alert.Acknowledge("oncall@example.com", time.Now()))
+	assert.NotContains(t, actionButtons(t, builder.BuildAckedMessage(alert)), "unsilence_"+alert.ID)
+
+	// A silenced alert offers to delete the silence in place of the silence options
+	alert.SetExternalReference(entity.SilenceReference, "silence-1")
+	buttons := actionButtons(t, builder.BuildAckedMessage(alert))
+	button := buttons["unsilence_"+alert.ID]
+	require.NotNil(t, button)
+	assert.Equal(t, "silence-1", button.Value)
+	assert.Equal(t, "🔔 Unsilence", button.Text.Text)
+	assert.NotContains(t, buttons, "silenceinstance_"+alert.ID)
+
+	alert.RemoveExternalReference(entity.SilenceReference)
+	assert.Contains(t, actionButtons(t, builder.BuildAckedMessage(alert)), "silenceinstance_"+alert.ID)
+}
+
+func TestMessageBuilder_OverflowMenu(t *testing.T) {
+	builder := NewMessageBuilder(nil)
+
+	// A lone option is a button, as Slack wants at least two in a menu
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	blocks := builder.BuildAlertMessage(alert)
+	assert.Empty(t, overflowOptions(t, blocks))
+	button := actionButtons(t, blocks)["more_"+alert.ID]
+	require.NotNil(t, button)
+	assert.Equal(t, OverflowFingerprint, button.Value)
+	assert.Empty(t, button.URL)
+
+	link := "https://alertmanager.example.com/#/alerts?filter=%7Balertname%3D%22HighCPU%22%7D"
+	alert.AddAnnotation(entity.RunbookAnnotation, "https://wiki.example.com/cpu")
+	alert.AddAnnotation(alertmanagerAnnotation, link)
+	alert.SetExternalReference(entity.PagerDutyURLReference, "https://acme.pagerduty.com/incidents/Q1")
+
+	blocks = builder.BuildAlertMessage(alert)
+	actions, ok := blocks[len(blocks)-1].(*slack.ActionBlock)
+	require.True(t, ok)
+	elements := actions.Elements.ElementSet
+	require.Len(t, elements, 5)
+
+	// The primary ack button leads the row, the menu ends it
+	ackButton, ok := elements[0].(*slack.ButtonBlockElement)
+	require.True(t, ok)
+	assert.Equal(t, "ack_"+alert.ID, ackButton.ActionID)
+	assert.Equal(t, slack.StylePrimary, ackButton.Style)
+	overflow, ok := elements[4].(*slack.OverflowBlockElement)
+	require.True(t, ok)
+	assert.Equal(t, "more_"+alert.ID, overflow.ActionID)
+
+	var values []string
+	for _, option := range overflow.Options {
+		values = append(values, option.Value)
+	}
+	assert.Equal(t, []string{OverflowRunbook, OverflowAlertmanager, OverflowPagerDuty, OverflowFingerprint}, values)
+
+	options := overflowOptions(t, blocks)
+	assert.Equal(t, "https://wiki.example.com/cpu", options[OverflowRunbook].URL)
+	assert.Equal(t, link, options[OverflowAlertmanager].URL)
+	assert.Equal(t, "🔎 View in Alertmanager", options[OverflowAlertmanager].Text.Text)
+	assert.Equal(t, "https://acme.pagerduty.com/incidents/Q1", options[OverflowPagerDuty].URL)
+	assert.Empty(t, options[OverflowFingerprint].URL)
+
+	// The links stay once the alert is resolved
+	require.NoError(t, alert.Resolve("alertmanager", time.Now()))
+	assert.Len(t, overflowOptions(t, builder.BuildResolvedMessage(alert)), 4)
+}
+
+func TestMessageBuilder_Digest(t *testing.T) {
+	builder := NewMessageBuilder(nil)
+	builder.SetTimeFormat("15:04")
+	builder.SetTimezone(time.UTC)
+
+	firedAt := time.Date(2025, 1, 2, 3, 4, 0, 0, time.UTC)
+	disk := entity.NewAlert("fp-1", "Disk <80%>", "host-1", "", "Disk filling up", entity.SeverityWarning)
+	disk.FiredAt = firedAt
+	cert := entity.NewAlert("fp-2", "Cert expiring", "", "", "", entity.SeverityInfo)
+	cert.FiredAt = firedAt
+
+	blocks := builder.BuildDigestMessage([]*entity.Alert{disk, cert})
+	require.Len(t, blocks, 2)
+	header, ok := blocks[0].(*slack.HeaderBlock)
+	require.True(t, ok)
+	assert.Equal(t, "📋 Alert digest: 2 alerts", header.Text.Text)
+
+	section, ok := blocks[1].(*slack.SectionBlock)
+	require.True(t, ok)
+	assert.Equal(t, "`🟡 WARNING` *Disk &lt;80%&gt;* on `host-1` · fired 03:04\n      Disk filling up\n"+
+		"`🔵 INFO` *Cert expiring* · fired 03:04", section.Text.Text)
+
+	// Long digests list the first alerts and count the rest
+	many := make([]*entity.Alert, maxDigestAlerts+5)
+	for i := range many {
+		many[i] = entity.NewAlert("fp", strings.Repeat("x", 200), "", "", strings.Repeat("y", 200), entity.SeverityInfo)
+	}
+	blocks = builder.BuildDigestMessage(many)
+	last, ok := blocks[len(blocks)-1].(*slack.ContextBlock)
+	require.True(t, ok)
+	assert.Equal(t, "…and 5 more", last.ContextElements.Elements[0].(*slack.TextBlockObject).Text)
+	for _, block := range blocks {
+		if section, ok := block.(*slack.SectionBlock); ok {
+			assert.LessOrEqual(t, len(section.Text.Text), maxSectionTextLength)
+		}
+	}
+}
+
+func TestMessageBuilder_HomeView(t *testing.T) {
+	builder := NewMessageBuilder(nil)
+	builder.SetTimeFormat("15:04")
+	builder.SetTimezone(time.UTC)
+
+	firedAt := time.Date(2025, 1, 2, 3, 4, 0, 0, time.UTC)
+	disk := entity.NewAlert("fp-1", "Disk <80%>", "host-1", "", "Disk filling up", entity.SeverityCritical)
+	disk.FiredAt = firedAt
+	cert := entity.NewAlert("fp-2", "Cert expiring", "", "", "", entity.SeverityInfo)
+	cert.FiredAt = firedAt
+	require.NoError(t, cert.Acknowledge("jane@example.com", firedAt.Add(time.Minute)))
+
+	view := builder.BuildHomeView([]*entity.Alert{disk, cert})
+	assert.Equal(t, slack.VTHomeTab, view.Type)
+	blocks := view.Blocks.BlockSet
+	require.Len(t, blocks, 4)
+	header, ok := blocks[0].(*slack.HeaderBlock)
+	require.True(t, ok)
+	assert.Equal(t, "🚨 Active alerts: 2", header.Text.Text)
+
+	section, ok := blocks[2].(*slack.SectionBlock)
+	require.True(t, ok)
+	assert.Equal(t, "`🔴 CRITICAL` *Disk &lt;80%&gt;* on `host-1`\n🔴 Firing · fired 03:04\nDisk filling up", section.Text.Text)
+	require.NotNil(t, section.Accessory)
+	assert.Equal(t, "ack_"+disk.ID, section.Accessory.ButtonElement.ActionID)
+
+	// Acknowledged alerts have no button
+	section, ok = blocks[3].(*slack.SectionBlock)
+	require.True(t, ok)
+	assert.Equal(t, "`🔵 INFO` *Cert expiring*\n👁️ Acknowledged · fired 03:04 · acked by jane@example.com", section.Text.Text)
+	assert.Nil(t, section.Accessory)
+
+	view = builder.BuildHomeView(nil)
+	require.Len(t, view.Blocks.BlockSet, 3)
+	empty, ok := view.Blocks.BlockSet[2].(*slack.SectionBlock)
+	require.True(t, ok)
+	assert.Equal(t, "✅ No active alerts.", empty.Text.Text)
+
+	// Views stay within Slack's block limit
+	many := make([]*entity.Alert, maxHomeAlerts+5)
+	for i := range many {
+		many[i] = entity.NewAlert("fp", "x", "", "", "", entity.SeverityInfo)
+	}
+	blocks = builder.BuildHomeView(many).Blocks.BlockSet
+	assert.LessOrEqual(t, len(blocks), 100)
+	last, ok := blocks[len(blocks)-1].(*slack.ContextBlock)
+	require.True(t, ok)
+	assert.Equal(t, "…and 5 more", last.ContextElements.Elements[0].(*slack.TextBlockObject).Text)
+}
+
+func TestMessageBuilder_SeverityMapColor(t *testing.T) {
+	builder := NewMessageBuilder(nil)
+	builder.SetSeverityMap(entity.SeverityMap{
+		"ticket": {Severity: entity.SeverityWarning, Color: "#FF8C00"},
+	})
+
+	alert := entity.NewAlert("fp", "Disk", "host-1", "", "", entity.SeverityWarning)
+	_, text, color := builder.getStatusInfo(alert)
+	assert.Equal(t, "WARNING", text)
+	assert.Equal(t, colorWarning, color)
+
+	alert.AddLabel(entity.SeverityLabel, "ticket")
+	_, text, color = builder.getStatusInfo(alert)
+	assert.Equal(t, "WARNING", text)
+	assert.Equal(t, "#FF8C00", color)
+
+	// Acknowledged alerts keep the acknowledged color
+	require.NoError(t, alert.Acknowledge("oncall@example.com", time.Now()))
+	_, _, color = builder.getStatusInfo(alert)
+	assert.Equal(t, colorAcked, color)
+}
+
+func TestMessageBuilder_PriorityMap(t *testing.T) {
+	builder := NewMessageBuilder(nil)
+	builder.SetSeverityMap(entity.SeverityMap{
+		"ticket": {Severity: entity.SeverityWarning, Color: "#FF8C00"},
+	})
+	builder.SetPriorityMap(entity.SeverityMap{
+		"P1": {Severity: entity.SeverityCritical},
+		"P3": {Severity: entity.SeverityInfo, Color: "#123456"},
+	})
+
+	alert := entity.NewAlert("fp", "Disk", "host-1", "", "", entity.SeverityWarning)
+	alert.AddLabel(entity.SeverityLabel, "ticket")
+	alert.AddAnnotation(entity.PriorityAnnotation, "P1")
+	_, text, color := builder.getStatusInfo(alert)
+	assert.Equal(t, "CRITICAL", text)
+	assert.Equal(t, colorCritical, color, "priority styling wins over the severity map color")
+	assert.Equal(t, "`🔴 P1`", builder.getSeverityBadge(alert))
+
+	alert.AddAnnotation(entity.PriorityAnnotation, "P3")
+	_, text, color = builder.getStatusInfo(alert)
+	assert.Equal(t, "INFO", text)
+	assert.Equal(t, "#123456", color)
+
+	// Unmapped priorities keep the severity label styling
+	alert.AddAnnotation(entity.PriorityAnnotation, "P9")
+	_, text, color = builder.getStatusInfo(alert)
+	assert.Equal(t, "WARNING", text)
+	assert.Equal(t, "#FF8C00", color)
+	assert.Equal(t, "`🟡 WARNING`", builder.getSeverityBadge(alert))
+}
+
+// mentionText returns the text of the message's leading mention block, if any.
+func mentionText(blocks []slack.Block) string {
+	section, ok := blocks[0].(*slack.SectionBlock)
+	if !ok || section.Text == nil || !strings.HasPrefix(section.Text.Text, "<!subteam^") {
+		return ""
+	}
+	return section.Text.Text
+}
+
+func TestMessageBuilder_MentionGroups(t *testing.T) {
+	builder := NewMessageBuilder(nil)
+	builder.SetMentionGroups(map[entity.AlertSeverity]string{entity.SeverityCritical: "S0123ABCDEF"})
+
+	critical := entity.NewAlert("fp-1", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	assert.Equal(t, "<!subteam^S0123ABCDEF>", mentionText(builder.BuildNotificationMessage(critical)))
+
+	// Updates never mention the group again
+	assert.Empty(t, mentionText(builder.BuildAlertMessage(critical)))
+	assert.Empty(t, mentionText(builder.BuildAckedMessage(critical)))
+	assert.Empty(t, mentionText(builder.BuildResolvedMessage(critical)))
+
+	warning := entity.NewAlert("fp-2", "High CPU", "host-1", "", "", entity.SeverityWarning)
+	assert.Empty(t, mentionText(builder.BuildNotificationMessage(warning)))
+}
+
+// sectionTexts returns the text of every section block in a message.
+func sectionTexts(blocks []slack.Block) []string {
+	var texts []string
+	for _, block := range blocks {
+		if section, ok := block.(*slack.SectionBlock); ok && section.Text != nil {
+			texts = append(texts, section.Text.Text)
+		}
+	}
+	return texts
+}
+
+func TestMessageBuilder_CustomBody(t *testing.T) {
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "CPU above 90%", entity.SeverityCritical)
+
+	builder := NewMessageBuilder(nil)
+	builder.SetAllowCustomBody(true)
+
+	// Without the annotation the generated layout is used
+	generated := builder.BuildAlertMessage(alert)
+	assert.Contains(t, sectionTexts(generated), "_CPU above 90%_")
+
+	alert.AddAnnotation("slack_message", "*Checkout degraded* <!channel>\nSee https://status.example.com & retry")
+	blocks := builder.BuildAlertMessage(alert)
+
+	texts := sectionTexts(blocks)
+	require.Len(t, texts, 2, "status banner and custom body")
+	assert.Equal(t, "*Checkout degraded* &lt;!channel&gt;\nSee https://status.example.com &amp; retry", texts[1])
+	assert.NotContains(t, texts, "_CPU above 90%_")
+	for _, block := range blocks {
+		assert.NotEqual(t, slack.MBTHeader, block.BlockType())
+	}
+	assert.Contains(t, actionButtons(t, blocks), "ack_"+alert.ID)
+
+	// Not opted in: the annotation is ignored
+	assert.Equal(t, generated, NewMessageBuilder(nil).BuildAlertMessage(alert))
+}
+
+func TestMessageBuilder_BodyTemplate(t *testing.T) {
+	tmpl, err := templates.Parse(templates.Slack, "body", "*{{ .Name }}* on `{{ .Instance }}`")
+	require.NoError(t, err)
+
+	builder := NewMessageBuilder(nil)
+	builder.SetAllowCustomBody(true)
+	builder.SetBodyTemplate(tmpl)
+
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "CPU above 90%", entity.SeverityCritical)
+	blocks := builder.BuildAlertMessage(alert)
+
+	texts := sectionTexts(blocks)
+	require.Len(t, texts, 2, "status banner and templated body")
+	assert.Equal(t, "*High CPU* on `host-1`", texts[1])
+	assert.Contains(t, actionButtons(t, blocks), "ack_"+alert.ID)
+
+	// Alert values are escaped, the template's own mrkdwn is not
+	injected := entity.NewAlert("fp", "<!channel> High CPU", "<https://evil.example|host-1>", "", "", entity.SeverityCritical)
+	assert.Equal(t, "*&lt;!channel&gt; High CPU* on `&lt;https://evil.example|host-1&gt;`", sectionTexts(builder.BuildAlertMessage(injected))[1])
+	assert.Equal(t, "<!channel> High CPU", injected.Name, "the alert itself is left untouched")
+
+	// A slack_message annotation takes precedence over the template
+	alert.AddAnnotation("slack_message", "Checkout degraded")
+	assert.Equal(t, "Checkout degraded", sectionTexts(builder.BuildAlertMessage(alert))[1])
+}
+
+func TestMessageBuilder_CustomBodyTruncated(t *testing.T) {
+	builder := NewMessageBuilder(nil)
+	builder.SetAllowCustomBody(true)
+
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	alert.AddAnnotation("slack_message", strings.Repeat("a<", 2000))
+
+	texts := sectionTexts(builder.BuildAlertMessage(alert))
+	require.Len(t, texts, 2)
+	body := texts[1]
+	assert.LessOrEqual(t, len(body), maxSectionTextLength)
+	assert.True(t, strings.HasSuffix(body, "&lt;…") || strings.HasSuffix(body, "a…"), "escape sequences are not split")
+}
+
+// contextTexts returns the text of every context element in a message.
+func contextTexts(blocks []slack.Block) string {
+	var texts []string
+	for _, block := range blocks {
+		context, ok := block.(*slack.ContextBlock)
+		if !ok {
+			continue
+		}
+		for _, element := range context.ContextElements.Elements {
+			if text, ok := element.(*slack.TextBlockObject); ok {
+				texts = append(texts, text.Text)
+			}
+		}
+	}
+	return strings.Join(texts, "")
+}
+
+func TestMessageBuilder_TimelineDateTokens(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	alert.FiredAt = time.Date(2024, 3, 5, 14, 30, 0, 0, time.UTC)
+	require.NoError(t, alert.Acknowledge("alice", time.Date(2024, 3, 5, 14, 45, 0, 0, time.UTC)))
+
+	builder := NewMessageBuilder(nil)
+	builder.SetTimezone(berlin)
+	text := contextTexts(builder.BuildAckedMessage(alert))
+	assert.Contains(t, text, "🔥 Fired: *<!date^1709649000^{date_short_pretty} {time}|Mar 5, 15:30 CET>*")
+	assert.Contains(t, text, "Acked by *alice* at <!date^1709649900^{time}|15:45 CET>")
+
+	// A static layout renders plain text in the configured timezone
+	builder.SetTimeFormat("2006-01-02 15:04 MST")
+	text = contextTexts(builder.BuildAckedMessage(alert))
+	assert.Contains(t, text, "🔥 Fired: *2024-03-05 15:30 CET*")
+	assert.Contains(t, text, "Acked by *alice* at 2024-03-05 15:45 CET")
+	assert.NotContains(t, text, "<!date^")
+}
+
+func TestMessageBuilder_OnCall(t *testing.T) {
+	builder := NewMessageBuilder(nil)
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	assert.NotContains(t, contextTexts(builder.BuildAlertMessage(alert)), "On-call")
+
+	alert.AddAnnotation("oncall", "Alice <Primary>")
+	assert.Contains(t, contextTexts(builder.BuildAlertMessage(alert)), "📟 On-call: *@Alice &lt;Primary&gt;*")
+
+	alert.Resolve("", time.Now())
+	assert.NotContains(t, contextTexts(builder.BuildResolvedMessage(alert)), "On-call")
+}
+
+func TestMessageBuilder_Assignment(t *testing.T) {
+	builder := NewMessageBuilder(nil)
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+
+	blocks := builder.BuildAlertMessage(alert)
+	assert.NotContains(t, contextTexts(blocks), "Assigned")
+	assert.NotContains(t, actionButtons(t, blocks), "unassign_"+alert.ID)
+	assertUserSelect := func(blocks []slack.Block, placeholder string) {
+		t.Helper()
+		actions, ok := blocks[len(blocks)-1].(*slack.ActionBlock)
+		require.True(t, ok)
+		for _, element := range actions.Elements.ElementSet {
+			if sel, ok := element.(*slack.SelectBlockElement); ok && sel.ActionID == "assign_"+alert.ID {
+				assert.Equal(t, slack.OptTypeUser, sel.Type)
+				assert.Equal(t, placeholder, sel.Placeholder.Text)
+				return
+			}
+		}
+		t.Fatalf("expected an assign select")
+	}
+	assertUserSelect(blocks, "👤 Assign...")
+
+	_, err := alert.Assign("Jane <jane@example.com>", "lead", time.Now())
+	require.NoError(t, err)
+	blocks = builder.BuildAlertMessage(alert)
+	assert.Contains(t, contextTexts(blocks), "👤 Assigned to *Jane &lt;jane@example.com&gt;*")
+	assert.Contains(t, actionButtons(t, blocks), "unassign_"+alert.ID)
+	assertUserSelect(blocks, "👤 Reassign...")
+
+	// Resolved alerts still name their assignee but cannot be reassigned
+	require.NoError(t, alert.Resolve("", time.Now()))
+	blocks = builder.BuildResolvedMessage(alert)
+	assert.Contains(t, contextTexts(blocks), "Assigned to")
+	assert.NotContains(t, actionButtons(t, blocks), "unassign_"+alert.ID)
+}
+
+// labelsText returns the text of a message's labels section, or "" if it has none.
+func labelsText(blocks []slack.Block) string {
+	for _, block := range blocks {
+		section, ok := block.(*slack.SectionBlock)
+		if ok && section.Text != nil && strings.HasPrefix(section.Text.Text, "*🏷️ Labels*") {
+			return section.Text.Text
+		}
+	}
+	return ""
+}
+
+func TestMessageBuilder_Labels(t *testing.T) {
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	alert.AddLabel("team", "infra")
+	alert.AddLabel("cluster", "prod-1")
+	alert.AddLabel("pod", "api-7d9f")
+	alert.AddLabel("namespace", "default")
+
+	t.Run("hidden unless configured", func(t *testing.T) {
+		builder := NewMessageBuilder(nil)
+		assert.Empty(t, labelsText(builder.BuildAlertMessage(alert)))
+	})
+
+	t.Run("display list in its order", func(t *testing.T) {
+		builder := NewMessageBuilder(nil)
+		builder.SetLabelDisplay([]string{"team", "missing", "cluster"}, nil, 0)
+		assert.Equal(t, "*🏷️ Labels*\n`team=infra`  `cluster=prod-1`", labelsText(builder.BuildAlertMessage(alert)))
+	})
+
+	t.Run("hide list shows the rest sorted", func(t *testing.T) {
+		builder := NewMessageBuilder(nil)
+		builder.SetLabelDisplay(nil, []string{"pod"}, 0)
+		assert.Equal(t, "*🏷️ Labels*\n`cluster=prod-1`  `namespace=default`  `team=infra`", labelsText(builder.BuildAlertMessage(alert)))
+	})
+
+	t.Run("labels beyond the limit are summarized", func(t *testing.T) {
+		builder := NewMessageBuilder(nil)
+		builder.SetLabelDisplay(nil, []string{"none"}, 2)
+		assert.Equal(t, "*🏷️ Labels*\n`cluster=prod-1`  `namespace=default`  _+2 more_", labelsText(builder.BuildAlertMessage(alert)))
+	})
+
+	t.Run("alert without the displayed labels", func(t *testing.T) {
+		builder := NewMessageBuilder(nil)
+		builder.SetLabelDisplay([]string{"service"}, nil, 0)
+		assert.Empty(t, labelsText(builder.BuildAlertMessage(alert)))
+	})
+}
@@ -3,6 +3,7 @@ package slack
 import (
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/slack-go/slack"
 )
@@ -127,6 +128,22 @@ func BuildSilenceModal(labelOptions map[string][]string) slack.ModalViewRequest
 	}
 }
 
+// silenceModalTenantPrefix marks the tenant scope carried in the silence
+// modal's PrivateMetadata.
+const silenceModalTenantPrefix = "tenant="
+
+// SilenceModalMetadata returns the PrivateMetadata carrying the tenant the
+// silence modal is opened for, so its submission creates the silence there.
+func SilenceModalMetadata(tenantID string) string {
+	return silenceModalTenantPrefix + tenantID
+}
+
+// SilenceModalTenant returns the tenant carried in the silence modal's
+// PrivateMetadata and whether the modal was scoped to one.
+func SilenceModalTenant(privateMetadata string) (string, bool) {
+	return strings.CutPrefix(privateMetadata, silenceModalTenantPrefix)
+}
+
 // buildDurationOptions creates the duration select options.
 func buildDurationOptions() []*slack.OptionBlockObject {
 	options := DefaultDurationOptions()
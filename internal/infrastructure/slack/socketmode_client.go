@@ -2,11 +2,13 @@ package slack
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/config"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/resilience"
 	"github.com/slack-go/slack"
 	"github.com/slack-go/slack/slackevents"
 	"github.com/slack-go/slack/socketmode"
@@ -27,7 +29,7 @@ type SocketModeClient struct {
 	cfg                config.SocketModeConfig
 	logger             Logger
 	reconnectCfg       ReconnectionConfig
-	circuitBreaker     *CircuitBreaker
+	circuitBreaker     *resilience.CircuitBreaker
 	eventHandler       EventHandler
 	commandHandler     CommandHandler
 	interactionHandler InteractionHandler
@@ -74,13 +76,15 @@ func NewSocketModeClient(botToken string, cfg config.SocketModeConfig, logger Lo
 		socketmode.OptionDebug(cfg.Debug),
 	)
 
+	reconnectCfg := DefaultReconnectionConfig()
+
 	return &SocketModeClient{
 		client:         socketClient,
 		slackAPI:       slackAPI,
 		cfg:            cfg,
 		logger:         logger,
-		reconnectCfg:   DefaultReconnectionConfig(),
-		circuitBreaker: NewCircuitBreaker(5),
+		reconnectCfg:   reconnectCfg,
+		circuitBreaker: resilience.NewCircuitBreaker("slack-socket-mode", reconnectCfg.MaxRetries, reconnectCfg.MaxBackoff),
 		isConnected:    false,
 	}, nil
 }
@@ -105,18 +109,17 @@ func (c *SocketModeClient) Connect(ctx context.Context) error {
 	attempt := 0
 
 	for {
-		// Check circuit breaker
-		if !ShouldRetry(c.circuitBreaker) {
+		// Attempt connection through the circuit breaker
+		err := c.circuitBreaker.Execute(ctx, func() error {
+			return c.attemptConnection(ctx)
+		})
+		if errors.Is(err, resilience.ErrCircuitOpen) {
 			c.logger.Error("Circuit breaker is open, stopping reconnection attempts",
-				"consecutive_failures", c.circuitBreaker.ConsecutiveFailures())
-			return fmt.Errorf("circuit breaker open after %d consecutive failures", c.circuitBreaker.ConsecutiveFailures())
+				"consecutive_failures", c.circuitBreaker.Failures())
+			return fmt.Errorf("circuit breaker open after %d consecutive failures", c.circuitBreaker.Failures())
 		}
-
-		// Attempt connection
-		err := c.attemptConnection(ctx)
 		if err == nil {
 			// Connection successful
-			c.circuitBreaker.RecordSuccess()
 			c.isConnected = true
 			c.lastReconnect = time.Now()
 			c.logger.Info("Successfully connected to Slack via Socket Mode",
@@ -133,10 +136,9 @@ func (c *SocketModeClient) Connect(ctx context.Context) error {
 			"error", err.Error(),
 			"attempt", attempt+1)
 
-		circuitOpen := c.circuitBreaker.RecordFailure()
-		if circuitOpen {
+		if c.circuitBreaker.State() == resilience.StateOpen {
 			c.logger.Error("Circuit breaker opened after consecutive failures",
-				"failures", c.circuitBreaker.ConsecutiveFailures())
+				"failures", c.circuitBreaker.Failures())
 			return fmt.Errorf("circuit breaker opened: %w", err)
 		}
 
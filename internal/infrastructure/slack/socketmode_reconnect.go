@@ -23,53 +23,6 @@ func DefaultReconnectionConfig() ReconnectionConfig {
 	}
 }
 
-// CircuitBreaker tracks connection failure state.
-type CircuitBreaker struct {
-	consecutiveFailures int
-	maxFailures         int
-	isOpen              bool
-	lastFailure         time.Time
-}
-
-// NewCircuitBreaker creates a new circuit breaker.
-func NewCircuitBreaker(maxFailures int) *CircuitBreaker {
-	return &CircuitBreaker{
-		consecutiveFailures: 0,
-		maxFailures:         maxFailures,
-		isOpen:              false,
-	}
-}
-
-// RecordFailure records a connection failure.
-// Returns true if circuit breaker is now open.
-func (cb *CircuitBreaker) RecordFailure() bool {
-	cb.consecutiveFailures++
-	cb.lastFailure = time.Now()
-
-	if cb.consecutiveFailures >= cb.maxFailures {
-		cb.isOpen = true
-		return true
-	}
-
-	return false
-}
-
-// RecordSuccess resets the circuit breaker on successful connection.
-func (cb *CircuitBreaker) RecordSuccess() {
-	cb.consecutiveFailures = 0
-	cb.isOpen = false
-}
-
-// IsOpen returns true if the circuit breaker is open.
-func (cb *CircuitBreaker) IsOpen() bool {
-	return cb.isOpen
-}
-
-// ConsecutiveFailures returns the number of consecutive failures.
-func (cb *CircuitBreaker) ConsecutiveFailures() int {
-	return cb.consecutiveFailures
-}
-
 // CalculateBackoff calculates the backoff duration based on attempt number.
 // Uses exponential backoff with jitter.
 func CalculateBackoff(cfg ReconnectionConfig, attempt int) time.Duration {
@@ -83,9 +36,3 @@ func CalculateBackoff(cfg ReconnectionConfig, attempt int) time.Duration {
 
 	return time.Duration(backoff)
 }
-
-// ShouldRetry determines if reconnection should be attempted.
-// Returns false if circuit breaker is open.
-func ShouldRetry(cb *CircuitBreaker) bool {
-	return !cb.IsOpen()
-}
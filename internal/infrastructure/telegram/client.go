@@ -0,0 +1,270 @@
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	domainerrors "github.com/qj0r9j0vc2/alert-bridge/internal/domain/errors"
+)
+
+const defaultAPIURL = "https://api.telegram.org"
+
+// Client sends alert notifications to a Telegram chat via the Bot API.
+// Implements the alert.Notifier interface.
+type Client struct {
+	httpClient *http.Client
+	botToken   string
+	chatID     string
+	apiURL     string
+	formatter  *MessageFormatter
+}
+
+// NewClient creates a new Telegram client.
+// An optional API URL can be provided to target a mock server (for E2E testing).
+func NewClient(botToken, chatID string, apiURL ...string) *Client {
+	baseURL := defaultAPIURL
+	if len(apiURL) > 0 && apiURL[0] != "" {
+		baseURL = strings.TrimSuffix(apiURL[0], "/")
+	}
+
+	return &Client{
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		botToken:   botToken,
+		chatID:     chatID,
+		apiURL:     baseURL,
+		formatter:  NewMessageFormatter(),
+	}
+}
+
+// apiResponse is the envelope returned by every Bot API method.
+type apiResponse struct {
+	OK          bool            `json:"ok"`
+	Result      json.RawMessage `json:"result,omitempty"`
+	ErrorCode   int             `json:"error_code,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Parameters  *struct {
+		RetryAfter int `json:"retry_after,omitempty"`
+	} `json:"parameters,omitempty"`
+}
+
+// apiError describes a failed Bot API call.
+type apiError struct {
+	StatusCode  int
+	Description string
+	RetryAfter  time.Duration
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("telegram api error (status %d): %s", e.StatusCode, e.Description)
+}
+
+// message is the subset of the Telegram Message object we need.
+type message struct {
+	MessageID int64 `json:"message_id"`
+}
+
+// Notify sends a new message for the alert.
+// Returns the Telegram message_id as the message reference.
+func (c *Client) Notify(ctx context.Context, alert *entity.Alert) (string, error) {
+	params := map[string]interface{}{
+		"chat_id":                  c.chatID,
+		"text":                     c.formatter.Format(alert),
+		"parse_mode":               "HTML",
+		"disable_web_page_preview": true,
+	}
+
+	var msg message
+	if err := c.call(ctx, "sendMessage", params, &msg); err != nil {
+		return "", categorizeTelegramError(err, "sending telegram message")
+	}
+
+	return strconv.FormatInt(msg.MessageID, 10), nil
+}
+
+// Preview returns the sendMessage parameters that Notify would send for the alert.
+func (c *Client) Preview(alert *entity.Alert) (any, error) {
+	return map[string]interface{}{
+		"chat_id":                  c.chatID,
+		"text":                     c.formatter.Format(alert),
+		"parse_mode":               "HTML",
+		"disable_web_page_preview": true,
+	}, nil
+}
+
+// UpdateMessage edits an existing message with the alert's current state.
+func (c *Client) UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error {
+	id, err := strconv.ParseInt(messageID, 10, 64)
+	if err != nil {
+		return domainerrors.NewPermanentError(
+			fmt.Sprintf("invalid telegram message ID: %s", messageID),
+			err,
+		)
+	}
+
+	params := map[string]interface{}{
+		"chat_id":                  c.chatID,
+		"message_id":               id,
+		"text":                     c.formatter.Format(alert),
+		"parse_mode":               "HTML",
+		"disable_web_page_preview": true,
+	}
+
+	if err := c.call(ctx, "editMessageText", params, nil); err != nil {
+		// Editing with identical content is reported as an error by Telegram.
+		var apiErr *apiError
+		if errors.As(err, &apiErr) && strings.Contains(apiErr.Description, "message is not modified") {
+			return nil
+		}
+		return categorizeTelegramError(err, "updating telegram message")
+	}
+
+	return nil
+}
+
+// Name returns the notifier name.
+func (c *Client) Name() string {
+	return "telegram"
+}
+
+// SelfTest verifies the bot token via getMe and that the bot can see the chat.
+func (c *Client) SelfTest(ctx context.Context) error {
+	if err := c.call(ctx, "getMe", map[string]interface{}{}, nil); err != nil {
+		return categorizeTelegramError(err, "telegram getMe")
+	}
+	if err := c.call(ctx, "getChat", map[string]interface{}{"chat_id": c.chatID}, nil); err != nil {
+		return categorizeTelegramError(err, "telegram getChat")
+	}
+	return nil
+}
+
+// call invokes a Bot API method and decodes its result into out (if non-nil).
+func (c *Client) call(ctx context.Context, method string, params map[string]interface{}, out interface{}) error {
+	payload, err := json.Marshal(params)
+	if err != nil {
+		return fmt.Errorf("marshaling request: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("%s/bot%s/%s", c.apiURL, c.botToken, method)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending request: %w", c.redactURL(err, method))
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading response: %w", err)
+	}
+
+	var apiResp apiResponse
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return &apiError{StatusCode: resp.StatusCode, Description: string(body)}
+		}
+		return fmt.Errorf("unmarshaling response: %w", err)
+	}
+
+	if !apiResp.OK {
+		apiErr := &apiError{
+			StatusCode:  resp.StatusCode,
+			Description: apiResp.Description,
+		}
+		if apiResp.ErrorCode != 0 {
+			apiErr.StatusCode = apiResp.ErrorCode
+		}
+		if apiResp.Parameters != nil && apiResp.Parameters.RetryAfter > 0 {
+			apiErr.RetryAfter = time.Duration(apiResp.Parameters.RetryAfter) * time.Second
+		}
+		return apiErr
+	}
+
+	if out != nil && len(apiResp.Result) > 0 {
+		if err := json.Unmarshal(apiResp.Result, out); err != nil {
+			return fmt.Errorf("unmarshaling result: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// redactURL removes the bot token, which the Bot API takes in the URL path,
+// from a transport error's URL so the error can be logged.
+func (c *Client) redactURL(err error, method string) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		urlErr.URL = fmt.Sprintf("%s/bot<redacted>/%s", c.apiURL, method)
+	}
+	return err
+}
+
+// categorizeTelegramError wraps Telegram API errors as transient or permanent domain errors.
+func categorizeTelegramError(err error, operation string) error {
+	if err == nil {
+		return nil
+	}
+
+	// Check for network errors (transient)
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return domainerrors.NewTransientError(
+			fmt.Sprintf("%s: network error", operation),
+			err,
+		)
+	}
+
+	var apiErr *apiError
+	if errors.As(err, &apiErr) {
+		// Rate limiting (HTTP 429) - transient, honour retry_after
+		if apiErr.StatusCode == http.StatusTooManyRequests {
+			return domainerrors.NewTransientError(
+				fmt.Sprintf("%s: rate limited", operation),
+				err,
+			).WithRetryAfter(apiErr.RetryAfter)
+		}
+
+		// Server errors (5xx) - transient
+		if apiErr.StatusCode >= 500 && apiErr.StatusCode < 600 {
+			return domainerrors.NewTransientError(
+				fmt.Sprintf("%s: telegram server error (status %d)", operation, apiErr.StatusCode),
+				err,
+			)
+		}
+
+		// Client errors (4xx) - permanent
+		return domainerrors.NewPermanentError(
+			fmt.Sprintf("%s: client error (status %d)", operation, apiErr.StatusCode),
+			err,
+		)
+	}
+
+	// Check for context errors (transient)
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return domainerrors.NewTransientError(
+			fmt.Sprintf("%s: context timeout", operation),
+			err,
+		)
+	}
+
+	// Default to permanent error
+	return domainerrors.NewPermanentError(
+		fmt.Sprintf("%s: %v", operation, err),
+		err,
+	)
+}
@@ -0,0 +1,105 @@
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainerrors "github.com/qj0r9j0vc2/alert-bridge/internal/domain/errors"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/notifiertest"
+)
+
+const testToken = "123456:secret-token"
+
+// newTestServer serves Bot API calls with the given handler.
+func newTestServer(t *testing.T, handler http.HandlerFunc) *Client {
+	return NewClient(testToken, "-100123", notifiertest.NewServer(t, handler))
+}
+
+func TestClient_Notify(t *testing.T) {
+	var gotPath string
+	var gotParams map[string]any
+	client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&gotParams))
+		w.Write([]byte(`{"ok": true, "result": {"message_id": 42}}`))
+	})
+
+	messageID, err := client.Notify(context.Background(), notifiertest.Alert())
+	require.NoError(t, err)
+
+	assert.Equal(t, "42", messageID)
+	assert.Equal(t, "/bot"+testToken+"/sendMessage", gotPath)
+	assert.Equal(t, "-100123", gotParams["chat_id"])
+	assert.Equal(t, "HTML", gotParams["parse_mode"])
+	assert.Contains(t, gotParams["text"], "HighCPU")
+}
+
+func TestClient_UpdateMessageNotModified(t *testing.T) {
+	client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"ok": false, "error_code": 400, "description": "Bad Request: message is not modified"}`))
+	})
+
+	assert.NoError(t, client.UpdateMessage(context.Background(), "42", notifiertest.Alert()))
+}
+
+func TestClient_RateLimited(t *testing.T) {
+	client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"ok": false, "error_code": 429, "description": "Too Many Requests: retry after 7", "parameters": {"retry_after": 7}}`))
+	})
+
+	_, err := client.Notify(context.Background(), notifiertest.Alert())
+	require.Error(t, err)
+
+	assert.True(t, domainerrors.IsTransientError(err))
+	retryAfter, ok := domainerrors.RetryAfter(err)
+	assert.True(t, ok)
+	assert.Equal(t, 7*time.Second, retryAfter)
+}
+
+func TestClient_ErrorClassification(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		body      string
+		transient bool
+	}{
+		{name: "server error", status: http.StatusBadGateway, body: `{"ok": false, "error_code": 502, "description": "Bad Gateway"}`, transient: true},
+		{name: "non-JSON server error", status: http.StatusServiceUnavailable, body: `unavailable`, transient: true},
+		{name: "chat not found", status: http.StatusBadRequest, body: `{"ok": false, "error_code": 400, "description": "Bad Request: chat not found"}`},
+		{name: "invalid token", status: http.StatusUnauthorized, body: `{"ok": false, "error_code": 401, "description": "Unauthorized"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			})
+
+			_, err := client.Notify(context.Background(), notifiertest.Alert())
+			require.Error(t, err)
+			assert.Equal(t, tt.transient, domainerrors.IsTransientError(err))
+			_, ok := domainerrors.RetryAfter(err)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestClient_TransportErrorRedactsToken(t *testing.T) {
+	client := NewClient(testToken, "-100123", notifiertest.UnreachableURL())
+
+	_, err := client.Notify(context.Background(), notifiertest.Alert())
+	require.Error(t, err)
+
+	assert.True(t, domainerrors.IsTransientError(err))
+	assert.NotContains(t, err.Error(), "secret-token")
+	assert.Contains(t, err.Error(), "/bot<redacted>/sendMessage")
+}
@@ -0,0 +1,91 @@
+package telegram
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+)
+
+// MessageFormatter renders alerts as Telegram HTML messages.
+type MessageFormatter struct{}
+
+// NewMessageFormatter creates a new message formatter.
+func NewMessageFormatter() *MessageFormatter {
+	return &MessageFormatter{}
+}
+
+// Format builds the message text for an alert in its current state.
+func (f *MessageFormatter) Format(alert *entity.Alert) string {
+	var sb strings.Builder
+
+	emoji, statusText := f.getStatusInfo(alert)
+	fmt.Fprintf(&sb, "%s <b>%s</b>  %s\n", emoji, statusText, f.getSeverityBadge(alert))
+	fmt.Fprintf(&sb, "<b>%s</b>\n", html.EscapeString(alert.Name))
+
+	if alert.Summary != "" {
+		fmt.Fprintf(&sb, "<i>%s</i>\n", html.EscapeString(alert.Summary))
+	}
+
+	sb.WriteString("\n")
+	if alert.Instance != "" {
+		fmt.Fprintf(&sb, "🖥️ Instance: <code>%s</code>\n", html.EscapeString(alert.Instance))
+	}
+	if alert.Target != "" {
+		fmt.Fprintf(&sb, "🎯 Target: <code>%s</code>\n", html.EscapeString(alert.Target))
+	}
+	if alert.Fingerprint != "" {
+		fp := alert.Fingerprint
+		if len(fp) > 12 {
+			fp = fp[:12] + "..."
+		}
+		fmt.Fprintf(&sb, "🔑 ID: <code>%s</code>\n", html.EscapeString(fp))
+	}
+
+	sb.WriteString("\n")
+	fmt.Fprintf(&sb, "🔥 Fired: %s", alert.FiredAt.Format("Jan 2, 15:04 MST"))
+
+	if alert.IsAcked() && alert.AckedBy != "" {
+		ackedAt := "unknown"
+		if alert.AckedAt != nil {
+			ackedAt = alert.AckedAt.Format("15:04 MST")
+		}
+		fmt.Fprintf(&sb, "\n👁️ Acked by <b>%s</b> at %s", html.EscapeString(alert.AckedBy), ackedAt)
+	}
+
+	if alert.IsResolved() && alert.ResolvedAt != nil {
+		fmt.Fprintf(&sb, "\n✅ Resolved: %s", alert.ResolvedAt.Format("15:04 MST"))
+	}
+
+	return sb.String()
+}
+
+// getStatusInfo returns emoji and text for the alert status.
+func (f *MessageFormatter) getStatusInfo(alert *entity.Alert) (emoji, text string) {
+	switch {
+	case alert.IsResolved():
+		return "✅", "RESOLVED"
+	case alert.IsAcked():
+		return "👁️", "ACKNOWLEDGED"
+	case alert.Severity == entity.SeverityCritical:
+		return "🚨", "CRITICAL"
+	case alert.Severity == entity.SeverityWarning:
+		return "⚠️", "WARNING"
+	default:
+		return "ℹ️", "INFO"
+	}
+}
+
+// getSeverityBadge returns a formatted severity badge.
+func (f *MessageFormatter) getSeverityBadge(alert *entity.Alert) string {
+	severity := strings.ToUpper(string(alert.Severity))
+	switch alert.Severity {
+	case entity.SeverityCritical:
+		return fmt.Sprintf("<code>🔴 %s</code>", severity)
+	case entity.SeverityWarning:
+		return fmt.Sprintf("<code>🟡 %s</code>", severity)
+	default:
+		return fmt.Sprintf("<code>🔵 %s</code>", severity)
+	}
+}
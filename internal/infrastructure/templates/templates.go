@@ -0,0 +1,251 @@
+// Package templates holds the configurable notification templates of each
+// notifier. Every template is a Go template executed against the
+// *entity.Alert being notified, with a shared set of helper functions.
+package templates
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"log/slog"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+)
+
+// Notifiers whose messages can be templated.
+const (
+	Slack     = "slack"
+	PagerDuty = "pagerduty"
+	Email     = "email"
+	Discord   = "discord"
+)
+
+// names lists the templates each notifier renders.
+var names = map[string][]string{
+	Slack:     {"body"},               // mrkdwn replacing the generated summary, details and labels
+	PagerDuty: {"summary", "details"}, // Incident summary, and a "details" custom detail
+	Email:     {"subject", "body"},    // Subject line, and the HTML body
+	Discord:   {"description"},        // Embed description
+}
+
+// htmlTemplates are rendered as HTML, escaping the alert values they insert.
+var htmlTemplates = map[string]bool{
+	Email + "/body": true,
+}
+
+// Severity colors shared by the notifiers that color their messages, as
+// RGB values. Discord uses them as is, Slack and the templates as hex.
+const (
+	ColorCritical = 0xE01E5A // Red
+	ColorWarning  = 0xECB22E // Yellow/Orange
+	ColorInfo     = 0x36C5F0 // Blue
+	ColorResolved = 0x2EB67D // Green
+	ColorAcked    = 0x9B59B6 // Purple
+)
+
+// HexColor renders an RGB color as "#RRGGBB".
+func HexColor(rgb int) string {
+	return fmt.Sprintf("#%06X", rgb)
+}
+
+// funcs are the helper functions available to every template.
+var funcs = map[string]any{
+	"severityColor":  severityColor,
+	"stateColor":     stateColor,
+	"formatDuration": formatDuration,
+	"since":          time.Since,
+	"upper":          strings.ToUpper,
+	"lower":          strings.ToLower,
+	"join":           strings.Join,
+	"pathEscape":     url.PathEscape,
+	"queryEscape":    url.QueryEscape,
+}
+
+// executor is satisfied by both text and HTML templates.
+type executor interface {
+	Execute(w io.Writer, data any) error
+}
+
+// Template is a parsed notification template.
+type Template struct {
+	name   string
+	tmpl   executor
+	logger *slog.Logger // Reports render failures in Text, nil to drop them
+}
+
+// Render executes the template against alert, trimming surrounding space.
+func (t *Template) Render(alert *entity.Alert) (string, error) {
+	var buf bytes.Buffer
+	if err := t.tmpl.Execute(&buf, alert); err != nil {
+		return "", fmt.Errorf("rendering %s template: %w", t.name, err)
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// Text renders the template against alert, or returns "" if it fails,
+// logging the error, so the caller falls back to its generated layout. It is
+// safe to call on a nil template.
+func (t *Template) Text(alert *entity.Alert) string {
+	if t == nil {
+		return ""
+	}
+	text, err := t.Render(alert)
+	if err != nil {
+		if t.logger != nil {
+			t.logger.Warn("notification template failed, using the default layout",
+				"template", t.name,
+				"alertID", alert.ID,
+				"error", err,
+			)
+		}
+		return ""
+	}
+	return text
+}
+
+// Parse parses the template name of notifier and checks that it executes
+// against a sample alert, so references to unknown fields fail at startup.
+// Missing label or annotation keys render as empty strings.
+func Parse(notifier, name, text string) (*Template, error) {
+	known, ok := names[notifier]
+	if !ok {
+		return nil, fmt.Errorf("unknown notifier %q for templates", notifier)
+	}
+	if !slices.Contains(known, name) {
+		return nil, fmt.Errorf("unknown %s template %q (expected one of: %s)", notifier, name, strings.Join(known, ", "))
+	}
+
+	key := notifier + "/" + name
+	var tmpl executor
+	var err error
+	if htmlTemplates[key] {
+		tmpl, err = htmltemplate.New(key).Option("missingkey=zero").Funcs(funcs).Parse(text)
+	} else {
+		tmpl, err = template.New(key).Option("missingkey=zero").Funcs(funcs).Parse(text)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s template: %w", key, err)
+	}
+
+	t := &Template{name: key, tmpl: tmpl}
+	if _, err := t.Render(sampleAlert()); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// ParseRunbookURL parses a runbook URL template. Unlike notifier templates it
+// is executed against the alert's name, labels and annotations, with only the
+// pathEscape and queryEscape helpers available.
+func ParseRunbookURL(text string) (*template.Template, error) {
+	tmpl, err := template.New("runbook_url").
+		Option("missingkey=zero").
+		Funcs(template.FuncMap{
+			"pathEscape":  url.PathEscape,
+			"queryEscape": url.QueryEscape,
+		}).
+		Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parsing runbook url template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// Registry holds the parsed templates of every notifier.
+type Registry struct {
+	templates map[string]*Template // "notifier/name" -> template
+}
+
+// NewRegistry parses the templates of each notifier, keyed by notifier and
+// then template name. Every invalid template is reported. Render failures
+// of the parsed templates are logged to logger.
+func NewRegistry(sets map[string]map[string]string, logger *slog.Logger) (*Registry, error) {
+	r := &Registry{templates: make(map[string]*Template)}
+
+	var errs []error
+	for _, notifier := range slices.Sorted(maps.Keys(sets)) {
+		for _, name := range slices.Sorted(maps.Keys(sets[notifier])) {
+			t, err := Parse(notifier, name, sets[notifier][name])
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			t.logger = logger
+			r.templates[notifier+"/"+name] = t
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return r, nil
+}
+
+// Lookup returns the template name of notifier, or nil if none is
+// configured. It is safe to call on a nil registry.
+func (r *Registry) Lookup(notifier, name string) *Template {
+	if r == nil {
+		return nil
+	}
+	return r.templates[notifier+"/"+name]
+}
+
+// severityColor returns the hex color of a severity.
+func severityColor(severity entity.AlertSeverity) string {
+	switch severity {
+	case entity.SeverityCritical:
+		return HexColor(ColorCritical)
+	case entity.SeverityWarning:
+		return HexColor(ColorWarning)
+	default:
+		return HexColor(ColorInfo)
+	}
+}
+
+// stateColor returns the hex color of the alert's current state: that of
+// resolved or acknowledged alerts, else of its severity.
+func stateColor(alert *entity.Alert) string {
+	switch {
+	case alert.IsResolved():
+		return HexColor(ColorResolved)
+	case alert.IsAcked():
+		return HexColor(ColorAcked)
+	default:
+		return severityColor(alert.Severity)
+	}
+}
+
+// formatDuration renders d in its two largest units, e.g. "1h 5m" or "2d 3h".
+func formatDuration(d time.Duration) string {
+	d = d.Round(time.Second)
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh %dm", int(d.Hours()), int(d.Minutes())%60)
+	default:
+		return fmt.Sprintf("%dd %dh", int(d.Hours())/24, int(d.Hours())%24)
+	}
+}
+
+// sampleAlert returns an alert with every field set, to check templates against.
+func sampleAlert() *entity.Alert {
+	now := time.Now().UTC()
+	alert := entity.NewAlert("sample", "SampleAlert", "host-1", "target-1", "Sample summary", entity.SeverityWarning)
+	alert.Description = "Sample description"
+	alert.AddLabel("alertname", "SampleAlert")
+	alert.AddAnnotation("summary", "Sample summary")
+	alert.AckedBy = "oncall@example.com"
+	alert.AckedAt = &now
+	alert.ResolvedAt = &now
+	return alert
+}
@@ -0,0 +1,87 @@
+package templates
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+)
+
+func TestRegistry(t *testing.T) {
+	registry, err := NewRegistry(map[string]map[string]string{
+		Slack:     {"body": "*{{ .Name }}* on {{ .Instance }} ({{ .Labels.team }})"},
+		PagerDuty: {"summary": "{{ upper (print .Severity) }}: {{ .Name }}"},
+		Email:     {"body": `<p style="color: {{ stateColor . }}">{{ .Summary }}</p>`},
+	}, nil)
+	require.NoError(t, err)
+
+	alert := entity.NewAlert("fp", "DiskFull", "db-1", "", "<b>90%</b> used", entity.SeverityCritical)
+	alert.AddLabel("team", "storage")
+
+	body, err := registry.Lookup(Slack, "body").Render(alert)
+	require.NoError(t, err)
+	assert.Equal(t, "*DiskFull* on db-1 (storage)", body)
+
+	summary, err := registry.Lookup(PagerDuty, "summary").Render(alert)
+	require.NoError(t, err)
+	assert.Equal(t, "CRITICAL: DiskFull", summary)
+
+	// HTML templates escape the alert values they insert
+	html, err := registry.Lookup(Email, "body").Render(alert)
+	require.NoError(t, err)
+	assert.Equal(t, `<p style="color: #E01E5A">&lt;b&gt;90%&lt;/b&gt; used</p>`, html)
+
+	assert.Nil(t, registry.Lookup(Email, "subject"))
+	assert.Nil(t, (*Registry)(nil).Lookup(Slack, "body"))
+}
+
+func TestNewRegistry_Invalid(t *testing.T) {
+	_, err := NewRegistry(map[string]map[string]string{
+		Slack:     {"body": "{{ .Name "},
+		PagerDuty: {"summary": "{{ .Nmae }}", "title": "x"},
+		"webhook": {"body": "x"},
+	}, nil)
+	require.Error(t, err)
+
+	// Every invalid template is reported, including fields unknown to the alert
+	assert.Contains(t, err.Error(), "parsing slack/body template")
+	assert.Contains(t, err.Error(), "rendering pagerduty/summary template")
+	assert.Contains(t, err.Error(), `unknown pagerduty template "title"`)
+	assert.Contains(t, err.Error(), `unknown notifier "webhook"`)
+}
+
+func TestTemplate_TextLogsFailures(t *testing.T) {
+	var logs bytes.Buffer
+	registry, err := NewRegistry(map[string]map[string]string{
+		Discord: {"description": "{{ slice .Name 0 6 }}"},
+	}, slog.New(slog.NewTextHandler(&logs, nil)))
+	require.NoError(t, err)
+
+	tmpl := registry.Lookup(Discord, "description")
+	assert.Equal(t, "DiskFu", tmpl.Text(entity.NewAlert("fp", "DiskFull", "db-1", "", "", entity.SeverityWarning)))
+	assert.Empty(t, logs.String())
+
+	// A failed render yields "" for the default layout and is logged
+	assert.Empty(t, tmpl.Text(entity.NewAlert("fp", "Disk", "db-1", "", "", entity.SeverityWarning)))
+	assert.Contains(t, logs.String(), "notification template failed")
+	assert.Contains(t, logs.String(), "discord/description")
+
+	assert.Empty(t, (*Template)(nil).Text(entity.NewAlert("fp", "Disk", "db-1", "", "", entity.SeverityWarning)))
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := map[time.Duration]string{
+		42 * time.Second:               "42s",
+		5*time.Minute + 30*time.Second: "5m",
+		time.Hour + 5*time.Minute:      "1h 5m",
+		51 * time.Hour:                 "2d 3h",
+	}
+	for d, want := range tests {
+		assert.Equal(t, want, formatDuration(d))
+	}
+}
@@ -0,0 +1,81 @@
+package ack
+
+import (
+	"context"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/logger"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+)
+
+// MessageUpdater updates the message posted for an alert.
+type MessageUpdater interface {
+	UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error
+}
+
+// AcknowledgeUseCase acknowledges single alerts through the API on behalf
+// of an authenticated user. The ack is synced like any other, and the
+// alert's Slack message, which no ack syncer updates, is updated too.
+type AcknowledgeUseCase struct {
+	alertRepo    repository.AlertRepository
+	syncAck      *SyncAckUseCase
+	slackUpdater MessageUpdater
+	logger       Logger
+}
+
+// NewAcknowledgeUseCase creates a new AcknowledgeUseCase.
+func NewAcknowledgeUseCase(alertRepo repository.AlertRepository, syncAck *SyncAckUseCase, logger Logger) *AcknowledgeUseCase {
+	return &AcknowledgeUseCase{
+		alertRepo: alertRepo,
+		syncAck:   syncAck,
+		logger:    logger,
+	}
+}
+
+// SetSlackUpdater updates the Slack message of acknowledged alerts with updater.
+func (uc *AcknowledgeUseCase) SetSlackUpdater(updater MessageUpdater) {
+	uc.slackUpdater = updater
+}
+
+// Execute records the acknowledgment, syncs it to the connected systems and
+// updates every copy of the alert's Slack message. A repeated ack without a
+// note changes nothing and returns the existing acknowledgment.
+func (uc *AcknowledgeUseCase) Execute(ctx context.Context, input SyncAckInput) (*SyncAckOutput, error) {
+	output, err := uc.syncAck.Execute(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if output.AlreadyAcked || uc.slackUpdater == nil {
+		return output, nil
+	}
+
+	alert := output.Alert
+	before := alert.GetExternalReference("slack")
+	updated := 0
+	for _, messageID := range alert.ExternalReferenceIDs("slack") {
+		if err := uc.slackUpdater.UpdateMessage(ctx, messageID, alert); err != nil {
+			logger.WithContext(ctx, uc.logger).Error("failed to update Slack message",
+				"alertID", alert.ID,
+				"slackMessageID", messageID,
+				"error", err,
+			)
+			output.SyncErrors = append(output.SyncErrors, SyncError{System: "slack", Error: err})
+			continue
+		}
+		updated++
+	}
+	if updated > 0 {
+		output.SyncedTo = append(output.SyncedTo, "slack")
+	}
+
+	// The client re-posts a deleted message under a new reference
+	if alert.GetExternalReference("slack") != before {
+		if err := uc.alertRepo.Update(ctx, alert); err != nil {
+			logger.WithContext(ctx, uc.logger).Error("failed to store re-posted Slack message ID",
+				"alertID", alert.ID,
+				"error", err,
+			)
+		}
+	}
+	return output, nil
+}
@@ -0,0 +1,59 @@
+package ack
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+// flakyUpdater records the messages it was asked to update and fails those
+// in failing.
+type flakyUpdater struct {
+	updated []string
+	failing map[string]bool
+}
+
+func (u *flakyUpdater) UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error {
+	u.updated = append(u.updated, messageID)
+	if u.failing[messageID] {
+		return errors.New("channel_not_found")
+	}
+	return nil
+}
+
+func TestAcknowledge_UpdatesEverySlackCopy(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	syncAck := NewSyncAckUseCase(alertRepo, memory.NewAckEventRepository(), memory.NewTransactionManager(), nil, nopLogger{}, nil)
+	uc := NewAcknowledgeUseCase(alertRepo, syncAck, nopLogger{})
+	slack := &flakyUpdater{failing: map[string]bool{"C2:1700000000.000200": true}}
+	uc.SetSlackUpdater(slack)
+
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	alert.SetExternalReference("slack", "C1:1700000000.000100,C2:1700000000.000200,C3:1700000000.000300")
+	require.NoError(t, alertRepo.Save(ctx, alert))
+
+	output, err := uc.Execute(ctx, SyncAckInput{
+		AlertID:   alert.ID,
+		Source:    entity.AckSourceAPI,
+		UserEmail: "jane@example.com",
+	})
+	require.NoError(t, err)
+
+	// A failing copy does not keep the others from showing the ack
+	assert.Equal(t, []string{"C1:1700000000.000100", "C2:1700000000.000200", "C3:1700000000.000300"}, slack.updated)
+	assert.Contains(t, output.SyncedTo, "slack")
+	require.Len(t, output.SyncErrors, 1)
+	assert.Equal(t, "slack", output.SyncErrors[0].System)
+
+	// Acking again updates nothing
+	_, err = uc.Execute(ctx, SyncAckInput{AlertID: alert.ID, Source: entity.AckSourceAPI, UserEmail: "jane@example.com"})
+	require.NoError(t, err)
+	assert.Len(t, slack.updated, 3)
+}
@@ -0,0 +1,94 @@
+package ack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/logger"
+)
+
+// ErrEmptyBulkAck is returned when a bulk ack names neither alert IDs nor a selector.
+var ErrEmptyBulkAck = errors.New("alert IDs or a label selector are required")
+
+// BulkAckInput acknowledges several alerts on behalf of one user. Either
+// AlertIDs or Selector is set; a selector matches every unacknowledged
+// firing alert whose labels include all of its pairs.
+type BulkAckInput struct {
+	AlertIDs  []string
+	Selector  map[string]string
+	Source    entity.AckSource
+	UserID    string
+	UserEmail string
+	UserName  string
+	Note      string
+}
+
+// BulkAckResult is the outcome of acknowledging one alert of a bulk ack.
+type BulkAckResult struct {
+	AlertID string
+	Output  *SyncAckOutput // nil if Err is set
+	Err     error
+}
+
+// ExecuteBulk acknowledges each selected alert through Execute, so every
+// one records its AckEvent and syncs to external systems. A failure is
+// reported in that alert's result and does not stop the rest of the batch.
+// The returned error is only set when the alerts could not be selected.
+func (uc *SyncAckUseCase) ExecuteBulk(ctx context.Context, input BulkAckInput) ([]BulkAckResult, error) {
+	alertIDs := input.AlertIDs
+	if len(alertIDs) == 0 {
+		if len(input.Selector) == 0 {
+			return nil, ErrEmptyBulkAck
+		}
+
+		var err error
+		if alertIDs, err = uc.selectAlerts(ctx, input.Selector); err != nil {
+			return nil, err
+		}
+	}
+
+	results := make([]BulkAckResult, 0, len(alertIDs))
+	for _, alertID := range alertIDs {
+		output, err := uc.Execute(ctx, SyncAckInput{
+			AlertID:   alertID,
+			Source:    input.Source,
+			UserID:    input.UserID,
+			UserEmail: input.UserEmail,
+			UserName:  input.UserName,
+			Note:      input.Note,
+		})
+		if err != nil {
+			logger.WithContext(ctx, uc.logger).Warn("bulk ack failed for alert",
+				"alertID", alertID,
+				"error", err,
+			)
+		}
+		results = append(results, BulkAckResult{AlertID: alertID, Output: output, Err: err})
+	}
+
+	logger.WithContext(ctx, uc.logger).Info("bulk ack processed",
+		"source", input.Source,
+		"userEmail", input.UserEmail,
+		"alerts", len(results),
+	)
+	return results, nil
+}
+
+// selectAlerts returns the IDs of the unacknowledged firing alerts whose
+// labels include every selector pair.
+func (uc *SyncAckUseCase) selectAlerts(ctx context.Context, selector map[string]string) ([]string, error) {
+	alerts, err := uc.alertRepo.FindByLabels(ctx, selector)
+	if err != nil {
+		return nil, fmt.Errorf("finding alerts by labels: %w", err)
+	}
+
+	var alertIDs []string
+	for _, alert := range alerts {
+		if alert.IsActive() {
+			alertIDs = append(alertIDs, alert.ID)
+		}
+	}
+	return alertIDs, nil
+}
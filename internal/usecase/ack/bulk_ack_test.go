@@ -0,0 +1,104 @@
+package ack
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+// recordingSyncer records the alerts it was asked to acknowledge.
+type recordingSyncer struct {
+	acked []string
+}
+
+func (s *recordingSyncer) Acknowledge(ctx context.Context, alert *entity.Alert, ackEvent *entity.AckEvent) error {
+	s.acked = append(s.acked, alert.ID)
+	return nil
+}
+
+func (s *recordingSyncer) SupportsAck() bool { return true }
+func (s *recordingSyncer) Name() string      { return "pagerduty" }
+
+func TestExecuteBulk_BySelector(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	ackEventRepo := memory.NewAckEventRepository()
+	syncer := &recordingSyncer{}
+	uc := NewSyncAckUseCase(alertRepo, ackEventRepo, memory.NewTransactionManager(), []AckSyncer{syncer}, nopLogger{}, nil)
+
+	newAlert := func(fingerprint, service string) *entity.Alert {
+		alert := entity.NewAlert(fingerprint, "High CPU", "host-1", "", "", entity.SeverityCritical)
+		alert.AddLabel("service", service)
+		alert.AddLabel("env", "prod")
+		alert.SetExternalReference("pagerduty", "dedup-"+alert.ID)
+		require.NoError(t, alertRepo.Save(ctx, alert))
+		return alert
+	}
+	api := newAlert("fp-1", "api")
+	newAlert("fp-2", "db")
+	alreadyAcked := newAlert("fp-3", "api")
+	require.NoError(t, alreadyAcked.Acknowledge("bob@example.com", time.Now().UTC()))
+	require.NoError(t, alertRepo.Update(ctx, alreadyAcked))
+
+	results, err := uc.ExecuteBulk(ctx, BulkAckInput{
+		Selector:  map[string]string{"service": "api", "env": "prod"},
+		Source:    entity.AckSourceAPI,
+		UserEmail: "jane@example.com",
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, api.ID, results[0].AlertID)
+	require.NoError(t, results[0].Err)
+	assert.Equal(t, entity.StateAcked, results[0].Output.Alert.State)
+	assert.Equal(t, []string{api.ID}, syncer.acked)
+
+	events, err := ackEventRepo.FindByAlertID(ctx, api.ID)
+	require.NoError(t, err)
+	assert.Len(t, events, 1)
+}
+
+func TestExecuteBulk_ContinuesAfterFailure(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	ackEventRepo := memory.NewAckEventRepository()
+	syncer := &recordingSyncer{}
+	uc := NewSyncAckUseCase(alertRepo, ackEventRepo, memory.NewTransactionManager(), []AckSyncer{syncer}, nopLogger{}, nil)
+
+	first := entity.NewAlert("fp-1", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	first.SetExternalReference("pagerduty", "dedup-1")
+	second := entity.NewAlert("fp-2", "High CPU", "host-2", "", "", entity.SeverityCritical)
+	second.SetExternalReference("pagerduty", "dedup-2")
+	require.NoError(t, alertRepo.Save(ctx, first))
+	require.NoError(t, alertRepo.Save(ctx, second))
+
+	results, err := uc.ExecuteBulk(ctx, BulkAckInput{
+		AlertIDs:  []string{first.ID, "missing", second.ID},
+		Source:    entity.AckSourceAPI,
+		UserEmail: "jane@example.com",
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	assert.NoError(t, results[0].Err)
+	assert.ErrorIs(t, results[1].Err, entity.ErrAlertNotFound)
+	assert.NoError(t, results[2].Err)
+	assert.Equal(t, []string{first.ID, second.ID}, syncer.acked)
+
+	for _, id := range []string{first.ID, second.ID} {
+		events, err := ackEventRepo.FindByAlertID(ctx, id)
+		require.NoError(t, err)
+		assert.Len(t, events, 1)
+	}
+}
+
+func TestExecuteBulk_RequiresAlertsOrSelector(t *testing.T) {
+	uc := NewSyncAckUseCase(memory.NewAlertRepository(), memory.NewAckEventRepository(), memory.NewTransactionManager(), nil, nopLogger{}, nil)
+
+	_, err := uc.ExecuteBulk(context.Background(), BulkAckInput{Source: entity.AckSourceAPI})
+	assert.ErrorIs(t, err, ErrEmptyBulkAck)
+}
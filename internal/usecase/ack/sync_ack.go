@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"strings"
 	"time"
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
@@ -29,6 +31,15 @@ type SyncAckOutput struct {
 	AckEvent   *entity.AckEvent
 	SyncedTo   []string // Names of systems that were updated
 	SyncErrors []SyncError
+
+	// NoteErrors lists systems the ack was synced to (they are in SyncedTo)
+	// whose copy of the ack note failed.
+	NoteErrors []SyncError
+
+	// AlreadyAcked is set when the alert was acknowledged before, e.g. by a
+	// double click. AckEvent is then the existing acknowledgment and nothing
+	// was recorded or synced.
+	AlreadyAcked bool
 }
 
 // SyncError represents a sync failure to a specific system.
@@ -52,6 +63,9 @@ type AckSyncer interface {
 // Logger is the unified logging interface from domain layer.
 type Logger = logger.Logger
 
+// AuditLogger records acknowledgments for compliance.
+type AuditLogger = logger.AuditLogger
+
 // SyncAckUseCase handles acknowledgment synchronization across systems.
 type SyncAckUseCase struct {
 	alertRepo    repository.AlertRepository
@@ -60,6 +74,7 @@ type SyncAckUseCase struct {
 	syncers      []AckSyncer
 	logger       Logger
 	metrics      *observability.Metrics
+	auditLogger  AuditLogger
 }
 
 // NewSyncAckUseCase creates a new SyncAckUseCase with dependencies.
@@ -81,11 +96,22 @@ func NewSyncAckUseCase(
 	}
 }
 
+// SetAuditLogger records every acknowledgment in the audit log.
+func (uc *SyncAckUseCase) SetAuditLogger(auditLogger AuditLogger) {
+	uc.auditLogger = auditLogger
+}
+
 // Execute processes an acknowledgment and syncs to all connected systems.
-func (uc *SyncAckUseCase) Execute(ctx context.Context, input SyncAckInput) (*SyncAckOutput, error) {
+func (uc *SyncAckUseCase) Execute(ctx context.Context, input SyncAckInput) (output *SyncAckOutput, err error) {
 	var syncedCount int
 	var errorCount int
 
+	ctx, span := observability.StartSpan(ctx, "SyncAckUseCase.Execute",
+		observability.AttrAlertID.String(input.AlertID),
+		observability.AttrAckSource.String(string(input.Source)),
+	)
+	defer func() { observability.EndSpan(span, err) }()
+
 	defer func() {
 		if uc.metrics != nil {
 			uc.metrics.RecordAcknowledgmentSynced(
@@ -97,18 +123,9 @@ func (uc *SyncAckUseCase) Execute(ctx context.Context, input SyncAckInput) (*Syn
 		}
 	}()
 
-	output := &SyncAckOutput{}
+	output = &SyncAckOutput{}
 
-	// 1. Load the alert (outside transaction - read-only)
-	alert, err := uc.alertRepo.FindByID(ctx, input.AlertID)
-	if err != nil {
-		return nil, fmt.Errorf("finding alert: %w", err)
-	}
-	if alert == nil {
-		return nil, entity.ErrAlertNotFound
-	}
-
-	// 2. Create ack event
+	// 1. Create ack event
 	ackEvent := entity.NewAckEvent(
 		input.AlertID,
 		input.Source,
@@ -123,49 +140,100 @@ func (uc *SyncAckUseCase) Execute(ctx context.Context, input SyncAckInput) (*Syn
 		ackEvent.WithDuration(*input.Duration)
 	}
 
-	// 3-5. Save ack event and update alert in a transaction
-	err = uc.txManager.WithTransaction(ctx, func(txCtx context.Context) error {
-		// 3. Save ack event (for audit trail)
-		if err := uc.ackEventRepo.Save(txCtx, ackEvent); err != nil {
-			return fmt.Errorf("saving ack event: %w", err)
+	// 2-5. Load the alert, save the ack event and update the alert in one
+	// transaction, so an ack event is never stored without its alert change
+	var alert *entity.Alert
+	var duplicate bool
+	var existing *entity.AckEvent
+	runAck := func(txCtx context.Context) error {
+		// 2. Load the alert
+		var err error
+		alert, err = uc.alertRepo.FindByID(txCtx, input.AlertID)
+		if err != nil {
+			return fmt.Errorf("finding alert: %w", err)
+		}
+		if alert == nil {
+			return entity.ErrAlertNotFound
 		}
 
-		// 4. Update alert state
-		err := alert.Acknowledge(input.UserEmail, time.Now().UTC())
-		if err != nil {
-			// If already acknowledged, continue to sync (idempotent behavior)
-			if !errors.Is(err, entity.ErrAlertAlreadyAcked) && !errors.Is(err, entity.ErrAlertAlreadyResolved) {
-				return fmt.Errorf("acknowledging alert: %w", err)
+		// A repeated ack, e.g. a double click, keeps the first one. Notes
+		// on acknowledged alerts are still recorded.
+		duplicate = alert.IsAcked() && input.Note == ""
+		if duplicate {
+			existing, err = uc.ackEventRepo.FindLatestByAlertID(txCtx, alert.ID)
+			if err != nil {
+				return fmt.Errorf("finding existing ack event: %w", err)
+			}
+			return nil
+		}
+
+		// 3. Update alert state. A resolved alert cannot be acknowledged, so
+		// it is rejected before anything is recorded; a note on an already
+		// acknowledged alert is still recorded below.
+		if err := alert.Acknowledge(input.UserEmail, time.Now().UTC()); err != nil {
+			if !errors.Is(err, entity.ErrAlertAlreadyAcked) {
+				return err
 			}
-			uc.logger.Debug("alert already acked/resolved, continuing sync",
+			logger.WithContext(ctx, uc.logger).Debug("alert already acked, recording note",
 				"alertID", alert.ID,
-				"state", alert.State,
 			)
 		}
 
+		// 4. Save ack event (for audit trail), in the alert's tenant
+		ackEvent.WithTenant(alert.TenantID)
+		if err := uc.ackEventRepo.Save(txCtx, ackEvent); err != nil {
+			return fmt.Errorf("saving ack event: %w", err)
+		}
+
 		// 5. Persist alert state change
 		if err := uc.alertRepo.Update(txCtx, alert); err != nil {
 			return fmt.Errorf("updating alert: %w", err)
 		}
 
 		return nil
-	})
+	}
 
+	err = uc.txManager.WithTransaction(ctx, runAck)
+	// A concurrent ack of the same alert won; seeing it acked now, the
+	// retry returns that ack instead of recording a second one
+	if errors.Is(err, repository.ErrConcurrentUpdate) {
+		err = uc.txManager.WithTransaction(ctx, runAck)
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	if duplicate {
+		logger.WithContext(ctx, uc.logger).Debug("alert already acked, ignoring repeated ack",
+			"alertID", alert.ID,
+			"source", input.Source,
+			"userEmail", input.UserEmail,
+		)
+		output.Alert = alert
+		output.AckEvent = existing
+		output.AlreadyAcked = true
+		return output, nil
+	}
+
 	output.AckEvent = ackEvent
 	output.Alert = alert
 
+	if uc.auditLogger != nil {
+		uc.auditLogger.Audit(ctx, entity.NewAuditEvent(entity.AuditActionAcknowledge, ackEvent.Actor(), string(input.Source)).
+			ForAlert(alert.ID).
+			WithNote(input.Note))
+	}
+
 	// 6. Sync to other systems (outside transaction - external API calls)
+	references := maps.Clone(alert.ExternalReferences)
 	uc.syncToExternalSystems(ctx, alert, ackEvent, input.Source, output)
+	uc.persistSyncedReferences(ctx, alert, references)
 
 	// Update metrics counters
 	syncedCount = len(output.SyncedTo)
 	errorCount = len(output.SyncErrors)
 
-	uc.logger.Info("ack synced",
+	logger.WithContext(ctx, uc.logger).Info("ack synced",
 		"alertID", alert.ID,
 		"source", input.Source,
 		"userEmail", input.UserEmail,
@@ -191,7 +259,7 @@ func (uc *SyncAckUseCase) syncToExternalSystems(
 
 		// Skip syncing back to the source system
 		if syncer.Name() == string(source) {
-			uc.logger.Debug("skipping sync to source",
+			logger.WithContext(ctx, uc.logger).Debug("skipping sync to source",
 				"source", source,
 				"syncer", syncer.Name(),
 			)
@@ -200,7 +268,7 @@ func (uc *SyncAckUseCase) syncToExternalSystems(
 
 		// Check if we should sync based on existing message/incident ID
 		if !uc.shouldSync(alert, syncer.Name()) {
-			uc.logger.Debug("skipping sync - no message ID",
+			logger.WithContext(ctx, uc.logger).Debug("skipping sync - no message ID",
 				"alertID", alert.ID,
 				"syncer", syncer.Name(),
 			)
@@ -208,8 +276,29 @@ func (uc *SyncAckUseCase) syncToExternalSystems(
 		}
 
 		// Sync to this system
-		if err := syncer.Acknowledge(ctx, alert, ackEvent); err != nil {
-			uc.logger.Error("failed to sync ack",
+		syncCtx, span := observability.StartSpan(ctx, "AckSyncer.Acknowledge",
+			observability.AttrAlertID.String(alert.ID),
+			observability.AttrAlertFingerprint.String(alert.Fingerprint),
+			observability.AttrNotifierName.String(syncer.Name()),
+		)
+		err := syncer.Acknowledge(syncCtx, alert, ackEvent)
+		observability.EndSpan(span, err)
+		if errors.Is(err, entity.ErrAckNoteNotRecorded) {
+			// The ack itself went through; only the note is missing
+			logger.WithContext(ctx, uc.logger).Warn("ack synced without its note",
+				"syncer", syncer.Name(),
+				"alertID", alert.ID,
+				"error", err,
+			)
+			output.SyncedTo = append(output.SyncedTo, syncer.Name())
+			output.NoteErrors = append(output.NoteErrors, SyncError{
+				System: syncer.Name(),
+				Error:  err,
+			})
+			continue
+		}
+		if err != nil {
+			logger.WithContext(ctx, uc.logger).Error("failed to sync ack",
 				"syncer", syncer.Name(),
 				"alertID", alert.ID,
 				"error", err,
@@ -222,16 +311,59 @@ func (uc *SyncAckUseCase) syncToExternalSystems(
 		}
 
 		output.SyncedTo = append(output.SyncedTo, syncer.Name())
-		uc.logger.Info("ack synced to external system",
+		logger.WithContext(ctx, uc.logger).Info("ack synced to external system",
 			"syncer", syncer.Name(),
 			"alertID", alert.ID,
 		)
 	}
 }
 
+// persistSyncedReferences stores the external references syncers added to the
+// alert while syncing, e.g. a PagerDuty incident ID looked up by dedup key, so
+// later acks and notes do not repeat the lookup. The alert is reloaded so that
+// changes made since the ack are kept.
+func (uc *SyncAckUseCase) persistSyncedReferences(ctx context.Context, alert *entity.Alert, before map[string]string) {
+	added := make(map[string]string)
+	for system, ref := range alert.ExternalReferences {
+		if ref != "" && before[system] != ref {
+			added[system] = ref
+		}
+	}
+	if len(added) == 0 {
+		return
+	}
+
+	err := uc.txManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		current, err := uc.alertRepo.FindByID(txCtx, alert.ID)
+		if err != nil {
+			return fmt.Errorf("finding alert: %w", err)
+		}
+		if current == nil {
+			return entity.ErrAlertNotFound
+		}
+		for system, ref := range added {
+			current.SetExternalReference(system, ref)
+		}
+		return uc.alertRepo.Update(txCtx, current)
+	})
+	if err != nil {
+		logger.WithContext(ctx, uc.logger).Warn("failed to persist references recorded during ack sync",
+			"alertID", alert.ID,
+			"error", err,
+		)
+	}
+}
+
 // shouldSync determines if we should sync to a specific system.
+// Any reference owned by the system counts, e.g. "pagerduty" (dedup key) or
+// "pagerduty_incident" (incident ID recorded from a webhook).
 func (uc *SyncAckUseCase) shouldSync(alert *entity.Alert, syncerName string) bool {
-	return alert.HasExternalReference(syncerName)
+	for system, ref := range alert.ExternalReferences {
+		if ref != "" && (system == syncerName || strings.HasPrefix(system, syncerName+"_")) {
+			return true
+		}
+	}
+	return false
 }
 
 // AddSyncer adds a syncer to the use case.
@@ -0,0 +1,372 @@
+package ack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/observability"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/sqlite"
+)
+
+// failingUpdateRepo fails every alert update after the ack event was saved.
+type failingUpdateRepo struct {
+	repository.AlertRepository
+}
+
+var errUpdateFailed = errors.New("update failed")
+
+func (r *failingUpdateRepo) Update(ctx context.Context, alert *entity.Alert) error {
+	return errUpdateFailed
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...any) {}
+func (nopLogger) Info(string, ...any)  {}
+func (nopLogger) Warn(string, ...any)  {}
+func (nopLogger) Error(string, ...any) {}
+
+func TestSyncAck_RollsBackAckEventWhenAlertUpdateFails(t *testing.T) {
+	type backend struct {
+		alerts    repository.AlertRepository
+		ackEvents repository.AckEventRepository
+		txManager repository.TransactionManager
+	}
+	backends := map[string]func(t *testing.T) backend{
+		"sqlite": func(t *testing.T) backend {
+			db, err := sqlite.NewDB(":memory:")
+			require.NoError(t, err)
+			t.Cleanup(func() { db.Close() })
+			require.NoError(t, db.Migrate(context.Background()))
+			repos := sqlite.NewRepositories(db)
+			return backend{repos.Alert, repos.AckEvent, db}
+		},
+		"memory": func(t *testing.T) backend {
+			return backend{memory.NewAlertRepository(), memory.NewAckEventRepository(), memory.NewTransactionManager()}
+		},
+	}
+
+	for name, newBackend := range backends {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			repos := newBackend(t)
+
+			alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+			require.NoError(t, repos.alerts.Save(ctx, alert))
+
+			uc := NewSyncAckUseCase(&failingUpdateRepo{repos.alerts}, repos.ackEvents, repos.txManager, nil, nopLogger{}, nil)
+			_, err := uc.Execute(ctx, SyncAckInput{
+				AlertID:   alert.ID,
+				Source:    entity.AckSourceSlack,
+				UserEmail: "oncall@example.com",
+			})
+			require.ErrorIs(t, err, errUpdateFailed)
+
+			events, err := repos.ackEvents.FindByAlertID(ctx, alert.ID)
+			require.NoError(t, err)
+			assert.Empty(t, events)
+
+			latest, err := repos.ackEvents.FindLatestByAlertID(ctx, alert.ID)
+			require.NoError(t, err)
+			assert.Nil(t, latest)
+
+			stored, err := repos.alerts.FindByID(ctx, alert.ID)
+			require.NoError(t, err)
+			assert.Equal(t, entity.StateActive, stored.State)
+
+			// The same ack commits both writes once the update succeeds
+			uc = NewSyncAckUseCase(repos.alerts, repos.ackEvents, repos.txManager, nil, nopLogger{}, nil)
+			output, err := uc.Execute(ctx, SyncAckInput{
+				AlertID:   alert.ID,
+				Source:    entity.AckSourceSlack,
+				UserEmail: "oncall@example.com",
+			})
+			require.NoError(t, err)
+			assert.Equal(t, entity.StateAcked, output.Alert.State)
+
+			events, err = repos.ackEvents.FindByAlertID(ctx, alert.ID)
+			require.NoError(t, err)
+			assert.Len(t, events, 1)
+		})
+	}
+}
+
+// recordingAuditLogger keeps every audit event it receives.
+type recordingAuditLogger struct {
+	events []*entity.AuditEvent
+}
+
+func (l *recordingAuditLogger) Audit(ctx context.Context, event *entity.AuditEvent) {
+	l.events = append(l.events, event)
+}
+
+func TestSyncAck_RecordsAuditEvent(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	require.NoError(t, alertRepo.Save(ctx, alert))
+
+	auditLogger := &recordingAuditLogger{}
+	uc := NewSyncAckUseCase(alertRepo, memory.NewAckEventRepository(), memory.NewTransactionManager(), nil, nopLogger{}, nil)
+	uc.SetAuditLogger(auditLogger)
+
+	_, err := uc.Execute(ctx, SyncAckInput{
+		AlertID:  alert.ID,
+		Source:   entity.AckSourcePagerDuty,
+		UserName: "Jane",
+		Note:     "on it",
+	})
+	require.NoError(t, err)
+
+	// A failed ack is not recorded
+	_, err = uc.Execute(ctx, SyncAckInput{AlertID: "missing", Source: entity.AckSourceAPI})
+	require.Error(t, err)
+
+	require.Len(t, auditLogger.events, 1)
+	event := auditLogger.events[0]
+	assert.Equal(t, entity.AuditActionAcknowledge, event.Action)
+	assert.Equal(t, alert.ID, event.AlertID)
+	assert.Equal(t, "Jane", event.Actor)
+	assert.Equal(t, "pagerduty", event.Source)
+	assert.Equal(t, "on it", event.Note)
+}
+
+// countingSyncer counts the acknowledgments synced to it.
+type countingSyncer struct {
+	mu    sync.Mutex
+	acked int
+}
+
+func (s *countingSyncer) Acknowledge(ctx context.Context, alert *entity.Alert, ackEvent *entity.AckEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.acked++
+	return nil
+}
+
+func (s *countingSyncer) SupportsAck() bool { return true }
+func (s *countingSyncer) Name() string      { return "pagerduty" }
+
+func TestSyncAck_RepeatedAckIsIdempotent(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	ackEventRepo := memory.NewAckEventRepository()
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	alert.SetExternalReference("pagerduty", "dedup-key")
+	require.NoError(t, alertRepo.Save(ctx, alert))
+
+	syncer := &countingSyncer{}
+	uc := NewSyncAckUseCase(alertRepo, ackEventRepo, memory.NewTransactionManager(), []AckSyncer{syncer}, nopLogger{}, nil)
+
+	// Two clicks on the Acknowledge button arriving at once
+	outputs := make([]*SyncAckOutput, 2)
+	var wg sync.WaitGroup
+	for i := range outputs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			output, err := uc.Execute(ctx, SyncAckInput{
+				AlertID:   alert.ID,
+				Source:    entity.AckSourceSlack,
+				UserEmail: "oncall@example.com",
+			})
+			assert.NoError(t, err)
+			outputs[i] = output
+		}()
+	}
+	wg.Wait()
+
+	events, err := ackEventRepo.FindByAlertID(ctx, alert.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, 1, syncer.acked)
+
+	// The repeated ack returns the existing one
+	first, second := outputs[0], outputs[1]
+	if first.AlreadyAcked {
+		first, second = second, first
+	}
+	assert.False(t, first.AlreadyAcked)
+	assert.True(t, second.AlreadyAcked)
+	assert.Equal(t, events[0].ID, first.AckEvent.ID)
+	assert.Equal(t, events[0].ID, second.AckEvent.ID)
+	assert.Equal(t, entity.StateAcked, second.Alert.State)
+
+	// A note on the acknowledged alert is still recorded
+	_, err = uc.Execute(ctx, SyncAckInput{
+		AlertID:   alert.ID,
+		Source:    entity.AckSourcePagerDuty,
+		UserEmail: "oncall@example.com",
+		Note:      "rolling back the deploy",
+	})
+	require.NoError(t, err)
+	events, err = ackEventRepo.FindByAlertID(ctx, alert.ID)
+	require.NoError(t, err)
+	assert.Len(t, events, 2)
+}
+
+// incidentSyncer records an incident ID on the alert like the PagerDuty
+// client does after looking it up by dedup key.
+type incidentSyncer struct {
+	countingSyncer
+}
+
+func (s *incidentSyncer) Acknowledge(ctx context.Context, alert *entity.Alert, ackEvent *entity.AckEvent) error {
+	alert.SetExternalReference(entity.PagerDutyIncidentReference, "PINC123")
+	return s.countingSyncer.Acknowledge(ctx, alert, ackEvent)
+}
+
+func TestSyncAck_PersistsReferencesRecordedBySyncers(t *testing.T) {
+	ctx := context.Background()
+	db, err := sqlite.NewDB(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, db.Migrate(ctx))
+	repos := sqlite.NewRepositories(db)
+
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	alert.SetExternalReference("pagerduty", "dedup-key")
+	require.NoError(t, repos.Alert.Save(ctx, alert))
+
+	uc := NewSyncAckUseCase(repos.Alert, repos.AckEvent, db, []AckSyncer{&incidentSyncer{}}, nopLogger{}, nil)
+
+	_, err = uc.Execute(ctx, SyncAckInput{
+		AlertID:   alert.ID,
+		Source:    entity.AckSourceSlack,
+		UserEmail: "oncall@example.com",
+	})
+	require.NoError(t, err)
+
+	stored, err := repos.Alert.FindByID(ctx, alert.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "PINC123", stored.GetExternalReference(entity.PagerDutyIncidentReference))
+	assert.Equal(t, "dedup-key", stored.GetExternalReference("pagerduty"))
+	assert.Equal(t, entity.StateAcked, stored.State)
+}
+
+// noteFailingSyncer acknowledges but fails to add the ack note.
+type noteFailingSyncer struct {
+	countingSyncer
+}
+
+func (s *noteFailingSyncer) Acknowledge(ctx context.Context, alert *entity.Alert, ackEvent *entity.AckEvent) error {
+	s.countingSyncer.Acknowledge(ctx, alert, ackEvent)
+	return fmt.Errorf("%w: note endpoint unavailable", entity.ErrAckNoteNotRecorded)
+}
+
+func TestSyncAck_NoteFailureIsNotASyncFailure(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	alert.SetExternalReference("pagerduty", "dedup-key")
+	require.NoError(t, alertRepo.Save(ctx, alert))
+
+	syncer := &noteFailingSyncer{}
+	uc := NewSyncAckUseCase(alertRepo, memory.NewAckEventRepository(), memory.NewTransactionManager(), []AckSyncer{syncer}, nopLogger{}, nil)
+
+	output, err := uc.Execute(ctx, SyncAckInput{
+		AlertID:   alert.ID,
+		Source:    entity.AckSourceSlack,
+		UserEmail: "oncall@example.com",
+		Note:      "rolling back the deploy",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"pagerduty"}, output.SyncedTo)
+	assert.Empty(t, output.SyncErrors)
+	require.Len(t, output.NoteErrors, 1)
+	assert.Equal(t, "pagerduty", output.NoteErrors[0].System)
+	assert.Equal(t, 1, syncer.acked)
+}
+
+func TestSyncAck_RecordsSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	alert.SetExternalReference("pagerduty", "dedup-key")
+	require.NoError(t, alertRepo.Save(ctx, alert))
+	uc := NewSyncAckUseCase(alertRepo, memory.NewAckEventRepository(), memory.NewTransactionManager(), []AckSyncer{&countingSyncer{}}, nopLogger{}, nil)
+
+	_, err := uc.Execute(ctx, SyncAckInput{AlertID: alert.ID, Source: entity.AckSourceSlack})
+	require.NoError(t, err)
+	_, err = uc.Execute(ctx, SyncAckInput{AlertID: "missing", Source: entity.AckSourceAPI})
+	require.Error(t, err)
+
+	alertIDs := func(span sdktrace.ReadOnlySpan) []string {
+		var ids []string
+		for _, attr := range span.Attributes() {
+			if attr.Key == observability.AttrAlertID {
+				ids = append(ids, attr.Value.AsString())
+			}
+		}
+		return ids
+	}
+
+	// The syncer span ends inside the first use case span
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+	assert.Equal(t, "AckSyncer.Acknowledge", spans[0].Name())
+	assert.Equal(t, []string{alert.ID}, alertIDs(spans[0]))
+	assert.Equal(t, spans[1].SpanContext().SpanID(), spans[0].Parent().SpanID())
+
+	assert.Equal(t, "SyncAckUseCase.Execute", spans[1].Name())
+	assert.Equal(t, []string{alert.ID}, alertIDs(spans[1]))
+	assert.Equal(t, codes.Unset, spans[1].Status().Code)
+
+	assert.Equal(t, "SyncAckUseCase.Execute", spans[2].Name())
+	assert.Equal(t, []string{"missing"}, alertIDs(spans[2]))
+	assert.Equal(t, codes.Error, spans[2].Status().Code)
+}
+
+func TestSyncAck_RejectsResolvedAlert(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	ackEventRepo := memory.NewAckEventRepository()
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	alert.SetExternalReference("pagerduty", "dedup-key")
+	require.NoError(t, alert.Resolve("alertmanager", time.Now().UTC()))
+	require.NoError(t, alertRepo.Save(ctx, alert))
+
+	syncer := &countingSyncer{}
+	auditLogger := &recordingAuditLogger{}
+	uc := NewSyncAckUseCase(alertRepo, ackEventRepo, memory.NewTransactionManager(), []AckSyncer{syncer}, nopLogger{}, nil)
+	uc.SetAuditLogger(auditLogger)
+
+	for _, note := range []string{"", "on it"} {
+		_, err := uc.Execute(ctx, SyncAckInput{AlertID: alert.ID, Source: entity.AckSourceSlack, UserEmail: "jane@example.com", Note: note})
+
+		var transitionErr *entity.TransitionError
+		require.ErrorAs(t, err, &transitionErr)
+		assert.ErrorIs(t, err, entity.ErrAlertAlreadyResolved)
+	}
+
+	event, err := ackEventRepo.FindLatestByAlertID(ctx, alert.ID)
+	require.NoError(t, err)
+	assert.Nil(t, event)
+	assert.Zero(t, syncer.acked)
+	assert.Empty(t, auditLogger.events)
+
+	stored, err := alertRepo.FindByID(ctx, alert.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.StateResolved, stored.State)
+	assert.Empty(t, stored.AckedBy)
+}
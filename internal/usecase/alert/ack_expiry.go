@@ -0,0 +1,206 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/logger"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+)
+
+// DefaultAckExpiryInterval is how often acknowledged alerts are checked for
+// an expired acknowledgment.
+const DefaultAckExpiryInterval = time.Minute
+
+// ackExpiryActor is recorded as the actor returning alerts to active when
+// their acknowledgment expires.
+const ackExpiryActor = "ack-expiry"
+
+// SetAckExpiry makes acknowledgments expire: an alert still firing once the
+// duration of the ack event that acknowledged it has passed, or
+// defaultDuration for acks without one, returns to active and its
+// notifications are updated, so PagerDuty opens a new incident and pages
+// again. A zero defaultDuration keeps acks without a duration until the
+// alert resolves.
+func (uc *ProcessAlertUseCase) SetAckExpiry(ackEventRepo repository.AckEventRepository, defaultDuration time.Duration) {
+	uc.ackEventRepo = ackEventRepo
+	uc.ackExpiryDefault = defaultDuration
+}
+
+// SetThreadReplier replies in the Slack thread of alerts whose
+// acknowledgment expired, mentioning who acknowledged them.
+func (uc *ProcessAlertUseCase) SetThreadReplier(replier ThreadReplier) {
+	uc.threadReplier = replier
+}
+
+// ExpireAcks returns the acknowledged alerts whose acknowledgment expired by
+// now to active and returns how many it reactivated. It does nothing unless
+// SetAckExpiry enabled expiry.
+func (uc *ProcessAlertUseCase) ExpireAcks(ctx context.Context, now time.Time) (int, error) {
+	if uc.ackEventRepo == nil {
+		return 0, nil
+	}
+
+	alerts, err := uc.alertRepo.FindFiring(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("finding firing alerts: %w", err)
+	}
+
+	reactivated := 0
+	for _, alert := range alerts {
+		if !alert.IsAcked() {
+			continue
+		}
+		tenantCtx := repository.NewContextWithTenant(ctx, alert.TenantID)
+
+		ackEvents, err := uc.ackEventRepo.FindByAlertID(tenantCtx, alert.ID)
+		if err != nil {
+			logger.WithContext(ctx, uc.logger).Error("failed to find ack events, skipping alert",
+				"alertID", alert.ID,
+				"error", err,
+			)
+			continue
+		}
+		ackEvent := ackingEvent(alert, ackEvents)
+		expiresAt := uc.ackExpiresAt(alert, ackEvent)
+		if expiresAt.IsZero() || now.Before(expiresAt) {
+			continue
+		}
+
+		ackedBy := alert.AckedBy
+		if err := uc.reactivate(tenantCtx, alert, ackEvent, now); err != nil {
+			// The alert was resolved or acknowledged again in the meantime
+			if errors.Is(err, repository.ErrConcurrentUpdate) {
+				continue
+			}
+			return reactivated, err
+		}
+		reactivated++
+		logger.WithContext(ctx, uc.logger).Info("acknowledgment expired, alert active again",
+			"alertID", alert.ID,
+			"ackedBy", ackedBy,
+			"expiredAt", expiresAt,
+		)
+	}
+	return reactivated, nil
+}
+
+// ackingEvent returns the ack event that acknowledged the alert: the latest
+// one recorded by its ack time. Later events only add notes to the ack and
+// do not restart its expiry. Returns nil if there is none.
+func ackingEvent(alert *entity.Alert, ackEvents []*entity.AckEvent) *entity.AckEvent {
+	var acking *entity.AckEvent
+	for _, ackEvent := range ackEvents {
+		if alert.AckedAt != nil && ackEvent.CreatedAt.After(*alert.AckedAt) {
+			break
+		}
+		acking = ackEvent
+	}
+	return acking
+}
+
+// ackExpiresAt returns when the alert's acknowledgment expires, or the zero
+// time if it does not. The latest ack event's duration takes precedence
+// over the default; without an event the expiry runs from the ack time.
+func (uc *ProcessAlertUseCase) ackExpiresAt(alert *entity.Alert, ackEvent *entity.AckEvent) time.Time {
+	duration := uc.ackExpiryDefault
+	if ackEvent != nil && ackEvent.HasDuration() {
+		duration = *ackEvent.Duration
+	}
+	if duration <= 0 {
+		return time.Time{}
+	}
+
+	switch {
+	case ackEvent != nil:
+		return ackEvent.CreatedAt.Add(duration)
+	case alert.AckedAt != nil:
+		return alert.AckedAt.Add(duration)
+	default:
+		return time.Time{}
+	}
+}
+
+// reactivate returns an alert whose acknowledgment expired to active,
+// updates its notifications and tells whoever acknowledged it in Slack.
+func (uc *ProcessAlertUseCase) reactivate(ctx context.Context, alert *entity.Alert, ackEvent *entity.AckEvent, now time.Time) error {
+	ackedBy := alert.AckedBy
+	if err := alert.Reactivate(ackExpiryActor, now.UTC()); err != nil {
+		return err
+	}
+	err := uc.withOutbox(ctx, func(ctx context.Context) error {
+		if err := uc.alertRepo.Update(ctx, alert); err != nil {
+			return fmt.Errorf("updating reactivated alert: %w", err)
+		}
+		return uc.enqueue(ctx, alert, entity.OutboxActionUpdate)
+	})
+	if err != nil {
+		return err
+	}
+
+	if uc.auditLogger != nil {
+		uc.auditLogger.Audit(ctx, entity.NewAuditEvent(entity.AuditActionAckExpire, ackExpiryActor, ackExpiryActor).
+			ForAlert(alert.ID).WithNote("acknowledged by "+ackedBy))
+	}
+
+	if uc.outboxRepo == nil {
+		uc.updateNotifications(ctx, alert, &dto.ProcessAlertOutput{AlertID: alert.ID})
+	}
+	uc.replyAckExpired(ctx, alert, ackEvent, ackedBy)
+	return nil
+}
+
+// replyAckExpired replies in the alert's Slack thread that its
+// acknowledgment expired, mentioning the acker. Failures are logged; the
+// alert is active again either way.
+func (uc *ProcessAlertUseCase) replyAckExpired(ctx context.Context, alert *entity.Alert, ackEvent *entity.AckEvent, ackedBy string) {
+	messageID := alert.GetExternalReference("slack")
+	if uc.threadReplier == nil || messageID == "" {
+		return
+	}
+
+	acker := ackedBy
+	switch {
+	case ackEvent != nil && ackEvent.IsFromSlack() && ackEvent.UserID != "":
+		acker = fmt.Sprintf("<@%s>", ackEvent.UserID)
+	case ackEvent != nil && acker == "":
+		acker = ackEvent.Actor()
+	}
+	reply := ":rotating_light: The acknowledgment expired and the alert is still firing"
+	if acker != "" {
+		reply = fmt.Sprintf(":rotating_light: %s, your acknowledgment expired and the alert is still firing", acker)
+	}
+
+	if err := uc.threadReplier.PostThreadReply(ctx, messageID, reply); err != nil {
+		logger.WithContext(ctx, uc.logger).Error("failed to post ack expiry to Slack",
+			"alertID", alert.ID,
+			"slackMessageID", messageID,
+			"error", err,
+		)
+	}
+}
+
+// RunAckExpiry calls ExpireAcks every interval until ctx is cancelled.
+func (uc *ProcessAlertUseCase) RunAckExpiry(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultAckExpiryInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if _, err := uc.ExpireAcks(ctx, time.Now()); err != nil && ctx.Err() == nil {
+			logger.WithContext(ctx, uc.logger).Error("expiring acknowledgments failed", "error", err)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+// recordingReplier records the thread replies posted per message.
+type recordingReplier struct {
+	replies map[string][]string
+}
+
+func (r *recordingReplier) PostThreadReply(ctx context.Context, messageID, text string) error {
+	if r.replies == nil {
+		r.replies = make(map[string][]string)
+	}
+	r.replies[messageID] = append(r.replies[messageID], text)
+	return nil
+}
+
+// failingAckEvents fails to list the ack events of one alert.
+type failingAckEvents struct {
+	repository.AckEventRepository
+	alertID string
+}
+
+func (r failingAckEvents) FindByAlertID(ctx context.Context, alertID string) ([]*entity.AckEvent, error) {
+	if alertID == r.alertID {
+		return nil, errors.New("connection reset")
+	}
+	return r.AckEventRepository.FindByAlertID(ctx, alertID)
+}
+
+func TestProcessAlert_AckExpiry(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	ackEventRepo := memory.NewAckEventRepository()
+	notifier := &severityNotifier{recordingNotifier: recordingNotifier{name: "slack"}}
+	uc := NewProcessAlertUseCase(alertRepo, memory.NewSilenceRepository(), []Notifier{notifier}, nopLogger{}, nil)
+
+	ackedAt := time.Date(2025, 1, 2, 3, 0, 0, 0, time.UTC)
+	acked := func(fingerprint string, duration time.Duration) string {
+		t.Helper()
+		output, err := uc.Execute(ctx, dto.ProcessAlertInput{
+			Fingerprint: fingerprint,
+			Name:        "High CPU",
+			Severity:    entity.SeverityCritical,
+			Status:      "firing",
+			FiredAt:     ackedAt.Add(-time.Hour),
+		})
+		require.NoError(t, err)
+
+		alert, err := alertRepo.FindByID(ctx, output.AlertID)
+		require.NoError(t, err)
+		require.NoError(t, alert.Acknowledge("alice@example.com", ackedAt))
+		require.NoError(t, alertRepo.Update(ctx, alert))
+
+		ackEvent := entity.NewAckEvent(alert.ID, entity.AckSourceSlack, "U1", "alice@example.com", "alice")
+		ackEvent.CreatedAt = ackedAt
+		if duration > 0 {
+			ackEvent.WithDuration(duration)
+		}
+		require.NoError(t, ackEventRepo.Save(ctx, ackEvent))
+		return alert.ID
+	}
+
+	snoozed := acked("fp-snoozed", 30*time.Minute)
+	plain := acked("fp-plain", 0)
+
+	// A note added later does not restart the expiry of the ack
+	note := entity.NewAckEvent(plain, entity.AckSourcePagerDuty, "PUSER1", "bob@example.com", "bob").WithNote("still digging")
+	note.CreatedAt = ackedAt.Add(90 * time.Minute)
+	require.NoError(t, ackEventRepo.Save(ctx, note))
+
+	// Disabled by default
+	reactivated, err := uc.ExpireAcks(ctx, ackedAt.Add(24*time.Hour))
+	require.NoError(t, err)
+	assert.Zero(t, reactivated)
+
+	uc.SetAckExpiry(ackEventRepo, 2*time.Hour)
+	replier := &recordingReplier{}
+	uc.SetThreadReplier(replier)
+
+	// Nothing expires before its duration
+	reactivated, err = uc.ExpireAcks(ctx, ackedAt.Add(29*time.Minute))
+	require.NoError(t, err)
+	assert.Zero(t, reactivated)
+
+	// The ack's own duration takes precedence over the default
+	reactivated, err = uc.ExpireAcks(ctx, ackedAt.Add(30*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 1, reactivated)
+	assert.Len(t, notifier.updates, 1)
+
+	stored, err := alertRepo.FindByID(ctx, snoozed)
+	require.NoError(t, err)
+	assert.True(t, stored.IsActive())
+	assert.Nil(t, stored.AckedAt)
+	assert.Empty(t, stored.AckedBy)
+	require.NotNil(t, stored.LastTransition)
+	assert.Equal(t, "ack-expiry", stored.LastTransition.By)
+
+	// The acker is mentioned in the alert's thread
+	replies := replier.replies[stored.GetExternalReference("slack")]
+	require.Len(t, replies, 1)
+	assert.Contains(t, replies[0], "<@U1>, your acknowledgment expired")
+
+	stored, err = alertRepo.FindByID(ctx, plain)
+	require.NoError(t, err)
+	assert.True(t, stored.IsAcked())
+
+	// Acks without a duration expire after the default, once
+	reactivated, err = uc.ExpireAcks(ctx, ackedAt.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, reactivated)
+
+	reactivated, err = uc.ExpireAcks(ctx, ackedAt.Add(3*time.Hour))
+	require.NoError(t, err)
+	assert.Zero(t, reactivated)
+	assert.Len(t, notifier.updates, 2)
+
+	// Without a default, acks without a duration are kept until resolved
+	uc.SetAckExpiry(ackEventRepo, 0)
+	acked("fp-kept", 0)
+	reactivated, err = uc.ExpireAcks(ctx, ackedAt.Add(24*time.Hour))
+	require.NoError(t, err)
+	assert.Zero(t, reactivated)
+
+	// An alert whose ack events cannot be read is skipped, not the sweep
+	broken := acked("fp-broken", time.Minute)
+	acked("fp-next", time.Minute)
+	uc.SetAckExpiry(failingAckEvents{AckEventRepository: ackEventRepo, alertID: broken}, 0)
+	reactivated, err = uc.ExpireAcks(ctx, ackedAt.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, reactivated)
+
+	stored, err = alertRepo.FindByID(ctx, broken)
+	require.NoError(t, err)
+	assert.True(t, stored.IsAcked())
+}
@@ -0,0 +1,124 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/logger"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+)
+
+// MessageUpdater updates the message posted for an alert.
+type MessageUpdater interface {
+	UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error
+}
+
+// AssignUseCase assigns alerts to the responders who own them.
+type AssignUseCase struct {
+	alertRepo    repository.AlertRepository
+	slackUpdater MessageUpdater
+	logger       Logger
+}
+
+// NewAssignUseCase creates a new AssignUseCase.
+func NewAssignUseCase(alertRepo repository.AlertRepository, logger Logger) *AssignUseCase {
+	return &AssignUseCase{
+		alertRepo: alertRepo,
+		logger:    logger,
+	}
+}
+
+// SetSlackUpdater updates the Slack message of reassigned alerts with updater.
+func (uc *AssignUseCase) SetSlackUpdater(updater MessageUpdater) {
+	uc.slackUpdater = updater
+}
+
+// AssignInput names the alert, its new assignee and who assigned it. An
+// empty Assignee unassigns the alert.
+type AssignInput struct {
+	AlertID  string
+	Assignee string
+	By       string
+
+	// MessageRef is the Slack message the assignment was made from, if any.
+	// It is updated along with the alert's stored messages.
+	MessageRef entity.MessageRef
+}
+
+// AssignOutput is the result of an assignment.
+type AssignOutput struct {
+	Alert *entity.Alert
+
+	// Changed is false if the alert already had the requested assignee.
+	Changed bool
+}
+
+// Execute records the assignment and updates the alert's Slack message.
+// Returns repository.ErrAlertNotFound for an unknown alert ID and
+// entity.ErrAlertAlreadyResolved when assigning a resolved alert; resolved
+// alerts may still be unassigned.
+func (uc *AssignUseCase) Execute(ctx context.Context, input AssignInput) (*AssignOutput, error) {
+	alert, err := uc.alertRepo.FindByID(ctx, input.AlertID)
+	if err != nil {
+		return nil, fmt.Errorf("finding alert: %w", err)
+	}
+	if alert == nil {
+		return nil, repository.ErrAlertNotFound
+	}
+
+	changed, err := alert.Assign(input.Assignee, input.By, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !changed {
+		return &AssignOutput{Alert: alert}, nil
+	}
+	if err := uc.alertRepo.Update(ctx, alert); err != nil {
+		return nil, fmt.Errorf("updating alert: %w", err)
+	}
+
+	logger.WithContext(ctx, uc.logger).Info("alert assignment changed",
+		"alertID", alert.ID,
+		"assignee", alert.AssignedTo,
+		"by", input.By,
+	)
+	uc.updateSlackMessages(ctx, alert, input.MessageRef)
+	return &AssignOutput{Alert: alert, Changed: true}, nil
+}
+
+// updateSlackMessages shows the new assignee in every Slack message of the
+// alert, including the one it was assigned from. Failures are logged; the
+// assignment itself is already stored.
+func (uc *AssignUseCase) updateSlackMessages(ctx context.Context, alert *entity.Alert, from entity.MessageRef) {
+	before := alert.GetExternalReference("slack")
+	ids := alert.ExternalReferenceIDs("slack")
+	if from.Timestamp != "" && !slices.Contains(ids, from.WithoutThread().String()) {
+		ids = append(ids, from.WithoutThread().String())
+	}
+	if uc.slackUpdater == nil || len(ids) == 0 {
+		return
+	}
+
+	messageID := entity.JoinReferenceIDs(ids)
+	if err := uc.slackUpdater.UpdateMessage(ctx, messageID, alert); err != nil {
+		logger.WithContext(ctx, uc.logger).Error("failed to update Slack message",
+			"alertID", alert.ID,
+			"slackMessageID", messageID,
+			"error", err,
+		)
+		return
+	}
+
+	// The client re-posts a deleted message under a new reference
+	if alert.GetExternalReference("slack") != before {
+		if err := uc.alertRepo.Update(ctx, alert); err != nil {
+			logger.WithContext(ctx, uc.logger).Error("failed to store re-posted Slack message ID",
+				"alertID", alert.ID,
+				"error", err,
+			)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package alert
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+// recordingUpdater records the messages it was asked to update.
+type recordingUpdater struct {
+	updated []string
+}
+
+func (u *recordingUpdater) UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error {
+	u.updated = append(u.updated, messageID)
+	return nil
+}
+
+func TestAssign(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	uc := NewAssignUseCase(alertRepo, nopLogger{})
+	slack := &recordingUpdater{}
+	uc.SetSlackUpdater(slack)
+
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	alert.SetExternalReference("slack", "C1:1700000000.000100")
+	require.NoError(t, alertRepo.Save(ctx, alert))
+
+	_, err := uc.Execute(ctx, AssignInput{AlertID: "missing", Assignee: "jane@example.com"})
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+
+	output, err := uc.Execute(ctx, AssignInput{AlertID: alert.ID, Assignee: "jane@example.com", By: "lead@example.com"})
+	require.NoError(t, err)
+	assert.True(t, output.Changed)
+	assert.Equal(t, []string{"C1:1700000000.000100"}, slack.updated)
+
+	// Assigning the same responder again changes nothing
+	output, err = uc.Execute(ctx, AssignInput{AlertID: alert.ID, Assignee: "jane@example.com", By: "lead@example.com"})
+	require.NoError(t, err)
+	assert.False(t, output.Changed)
+	assert.Len(t, slack.updated, 1)
+
+	output, err = uc.Execute(ctx, AssignInput{AlertID: alert.ID, Assignee: "sam@example.com", By: "jane@example.com"})
+	require.NoError(t, err)
+	assert.True(t, output.Changed)
+
+	stored, err := alertRepo.FindByID(ctx, alert.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "sam@example.com", stored.AssignedTo)
+	require.Len(t, stored.Assignments, 2)
+	assert.Equal(t, "jane@example.com", stored.Assignments[1].By)
+
+	// Resolved alerts can be unassigned but not reassigned
+	require.NoError(t, stored.Resolve("alertmanager", stored.UpdatedAt))
+	require.NoError(t, alertRepo.Update(ctx, stored))
+	_, err = uc.Execute(ctx, AssignInput{AlertID: alert.ID, Assignee: "jane@example.com"})
+	assert.ErrorIs(t, err, entity.ErrAlertAlreadyResolved)
+	output, err = uc.Execute(ctx, AssignInput{AlertID: alert.ID, By: "sam@example.com"})
+	require.NoError(t, err)
+	assert.Empty(t, output.Alert.AssignedTo)
+}
+
+func TestAssign_UpdatesSourceMessage(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	uc := NewAssignUseCase(alertRepo, nopLogger{})
+	slack := &recordingUpdater{}
+	uc.SetSlackUpdater(slack)
+
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	alert.SetExternalReference("slack", "C1:1700000000.000100")
+	require.NoError(t, alertRepo.Save(ctx, alert))
+
+	// A copy the alert does not track is updated with the stored ones
+	from := entity.MessageRef{Channel: "C2", Timestamp: "1700000000.000200", ThreadTimestamp: "1700000000.000100"}
+	_, err := uc.Execute(ctx, AssignInput{AlertID: alert.ID, Assignee: "jane@example.com", MessageRef: from})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"C1:1700000000.000100,C2:1700000000.000200"}, slack.updated)
+
+	// A stored copy is updated once
+	from = entity.MessageRef{Channel: "C1", Timestamp: "1700000000.000100"}
+	_, err = uc.Execute(ctx, AssignInput{AlertID: alert.ID, Assignee: "sam@example.com", MessageRef: from})
+	require.NoError(t, err)
+	assert.Equal(t, "C1:1700000000.000100", slack.updated[1])
+}
@@ -0,0 +1,129 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/logger"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+)
+
+// DefaultAutoResolveInterval is how often firing alerts are checked for
+// having gone stale.
+const DefaultAutoResolveInterval = time.Minute
+
+// autoResolveActor is recorded as the resolver of alerts resolved for not
+// being seen again.
+const autoResolveActor = "auto-resolve"
+
+// SetAutoResolveAfter resolves firing alerts that were not re-fired for the
+// given duration, for sources that stop sending an alert without resolving
+// it. Zero disables auto-resolution.
+func (uc *ProcessAlertUseCase) SetAutoResolveAfter(after time.Duration) {
+	uc.autoResolveAfter = after
+}
+
+// markSeen records a re-fire of the firing alert when auto-resolution is
+// enabled; otherwise nothing reads it and the write would only bump the
+// alert's version. A failure only delays auto-resolution, so it is logged
+// rather than returned.
+func (uc *ProcessAlertUseCase) markSeen(ctx context.Context, alert *entity.Alert) {
+	if uc.autoResolveAfter <= 0 {
+		return
+	}
+	alert.MarkSeen(time.Now().UTC())
+	if err := uc.alertRepo.Update(ctx, alert); err != nil {
+		// A concurrent delivery of the same re-fire already recorded it
+		if errors.Is(err, repository.ErrConcurrentUpdate) {
+			return
+		}
+		logger.WithContext(ctx, uc.logger).Warn("failed to record alert re-fire",
+			"error", err,
+			"alertID", alert.ID,
+		)
+	}
+}
+
+// ResolveStale resolves the firing alerts not seen since the auto-resolve
+// duration before now and returns how many it resolved. It does nothing
+// unless SetAutoResolveAfter enabled auto-resolution.
+func (uc *ProcessAlertUseCase) ResolveStale(ctx context.Context, now time.Time) (int, error) {
+	if uc.autoResolveAfter <= 0 {
+		return 0, nil
+	}
+
+	alerts, err := uc.alertRepo.FindNotSeenSince(ctx, now.Add(-uc.autoResolveAfter))
+	if err != nil {
+		return 0, fmt.Errorf("finding stale alerts: %w", err)
+	}
+
+	resolved := 0
+	for _, alert := range alerts {
+		if err := uc.resolveStale(repository.NewContextWithTenant(ctx, alert.TenantID), alert, now); err != nil {
+			// The alert re-fired or was resolved in the meantime
+			if errors.Is(err, repository.ErrConcurrentUpdate) {
+				continue
+			}
+			return resolved, err
+		}
+		resolved++
+		logger.WithContext(ctx, uc.logger).Info("auto-resolved alert not seen since",
+			"alertID", alert.ID,
+			"lastSeenAt", alert.LastSeenAt,
+		)
+	}
+	return resolved, nil
+}
+
+// resolveStale resolves an alert that stopped firing and updates its notifications.
+func (uc *ProcessAlertUseCase) resolveStale(ctx context.Context, alert *entity.Alert, now time.Time) error {
+	if err := alert.Resolve(autoResolveActor, now.UTC()); err != nil {
+		return err
+	}
+	err := uc.withOutbox(ctx, func(ctx context.Context) error {
+		if err := uc.alertRepo.Update(ctx, alert); err != nil {
+			return fmt.Errorf("updating auto-resolved alert: %w", err)
+		}
+		return uc.enqueue(ctx, alert, entity.OutboxActionUpdate)
+	})
+	if err != nil {
+		return err
+	}
+
+	if uc.auditLogger != nil {
+		uc.auditLogger.Audit(ctx, entity.NewAuditEvent(entity.AuditActionResolve, autoResolveActor, autoResolveActor).ForAlert(alert.ID))
+	}
+
+	if uc.outboxRepo == nil {
+		uc.updateNotifications(ctx, alert, &dto.ProcessAlertOutput{AlertID: alert.ID})
+	}
+
+	// Alerts it suppressed page now if they still fire
+	uc.ReleaseDependents(ctx, alert)
+	return nil
+}
+
+// RunAutoResolve calls ResolveStale every interval until ctx is cancelled.
+func (uc *ProcessAlertUseCase) RunAutoResolve(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultAutoResolveInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if _, err := uc.ResolveStale(ctx, time.Now()); err != nil && ctx.Err() == nil {
+			logger.WithContext(ctx, uc.logger).Error("auto-resolving stale alerts failed", "error", err)
+		}
+	}
+}
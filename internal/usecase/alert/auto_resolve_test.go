@@ -0,0 +1,90 @@
+package alert
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+func TestProcessAlert_AutoResolve(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	notifier := &severityNotifier{recordingNotifier: recordingNotifier{name: "slack"}}
+	uc := NewProcessAlertUseCase(alertRepo, memory.NewSilenceRepository(), []Notifier{notifier}, nopLogger{}, nil)
+
+	fire := func(fingerprint string) *dto.ProcessAlertOutput {
+		t.Helper()
+		output, err := uc.Execute(ctx, dto.ProcessAlertInput{
+			Fingerprint: fingerprint,
+			Name:        "High CPU",
+			Severity:    entity.SeverityCritical,
+			Status:      "firing",
+			FiredAt:     time.Now().UTC(),
+		})
+		require.NoError(t, err)
+		return output
+	}
+
+	stale := fire("fp-stale")
+	live := fire("fp-live")
+	now := time.Now().UTC()
+
+	// Disabled by default
+	resolved, err := uc.ResolveStale(ctx, now.Add(24*time.Hour))
+	require.NoError(t, err)
+	assert.Zero(t, resolved)
+
+	// and re-fires do not write the alert
+	before, err := alertRepo.FindByID(ctx, live.AlertID)
+	require.NoError(t, err)
+	fire("fp-live")
+	after, err := alertRepo.FindByID(ctx, live.AlertID)
+	require.NoError(t, err)
+	assert.Equal(t, before.Version, after.Version)
+
+	uc.SetAutoResolveAfter(time.Hour)
+
+	// The source stopped sending one alert two hours ago
+	stored, err := alertRepo.FindByID(ctx, stale.AlertID)
+	require.NoError(t, err)
+	stored.MarkSeen(now.Add(-2 * time.Hour))
+	require.NoError(t, alertRepo.Update(ctx, stored))
+
+	// A re-fire records when the other was last seen
+	fire("fp-live")
+	stored, err = alertRepo.FindByID(ctx, live.AlertID)
+	require.NoError(t, err)
+	assert.False(t, stored.LastSeenAt.Before(now))
+
+	resolved, err = uc.ResolveStale(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, resolved)
+	assert.Len(t, notifier.updates, 1)
+
+	stored, err = alertRepo.FindByID(ctx, stale.AlertID)
+	require.NoError(t, err)
+	assert.True(t, stored.IsResolved())
+	require.NotNil(t, stored.LastTransition)
+	assert.Equal(t, "auto-resolve", stored.LastTransition.By)
+
+	stored, err = alertRepo.FindByID(ctx, live.AlertID)
+	require.NoError(t, err)
+	assert.True(t, stored.IsActive())
+
+	// Once the other alert goes quiet for long enough it is resolved too, once
+	resolved, err = uc.ResolveStale(ctx, now.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, resolved)
+
+	resolved, err = uc.ResolveStale(ctx, now.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Zero(t, resolved)
+	assert.Len(t, notifier.updates, 2)
+}
@@ -0,0 +1,246 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/logger"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+)
+
+// DefaultBurstFlushInterval is how often the overflow of the burst guard is
+// checked for a finished window.
+const DefaultBurstFlushInterval = 10 * time.Second
+
+// chatNotifierNames are the notifiers the burst guard applies to. Pages and
+// emails are never held back.
+var chatNotifierNames = map[string]bool{
+	slackNotifierName: true,
+	"discord":         true,
+	"telegram":        true,
+}
+
+// BurstGuard caps how many new alerts are posted to chat within a rolling
+// window, so a cascading failure cannot flood the channel. Alerts over the
+// cap are counted instead, reported in one overflow message per window and
+// posted later as the window has room. Counts are kept per instance.
+type BurstGuard struct {
+	limit  int
+	window time.Duration
+
+	mu               sync.Mutex
+	sent             []time.Time // Notifications in the current window, oldest first
+	suppressed       int
+	firstSuppression time.Time
+}
+
+// NewBurstGuard allows limit notifications per window.
+func NewBurstGuard(limit int, window time.Duration) *BurstGuard {
+	return &BurstGuard{limit: limit, window: window}
+}
+
+// Allow reports whether an alert firing at now may be notified, counting it
+// against the window if so and as suppressed otherwise.
+func (g *BurstGuard) Allow(now time.Time) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.take(now) {
+		return true
+	}
+	if g.suppressed == 0 {
+		g.firstSuppression = now
+	}
+	g.suppressed++
+	return false
+}
+
+// Room reports whether the window has room for one more notification at
+// now, counting it against the window if so. Unlike Allow it does not count
+// a refusal as suppressed.
+func (g *BurstGuard) Room(now time.Time) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.take(now)
+}
+
+// take counts a notification at now against the window if it has room.
+// The caller holds mu.
+func (g *BurstGuard) take(now time.Time) bool {
+	cutoff := now.Add(-g.window)
+	expired := 0
+	for expired < len(g.sent) && !g.sent[expired].After(cutoff) {
+		expired++
+	}
+	g.sent = g.sent[expired:]
+
+	if len(g.sent) < g.limit {
+		g.sent = append(g.sent, now)
+		return true
+	}
+	return false
+}
+
+// Overflow returns how many alerts were suppressed and resets the count,
+// once a window has passed since the first of them; until then it returns 0.
+func (g *BurstGuard) Overflow(now time.Time) int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.suppressed == 0 || now.Sub(g.firstSuppression) < g.window {
+		return 0
+	}
+	suppressed := g.suppressed
+	g.suppressed = 0
+	return suppressed
+}
+
+// restore adds back a count Overflow returned that could not be reported,
+// due again on the next call to Overflow.
+func (g *BurstGuard) restore(suppressed int, now time.Time) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.firstSuppression = now.Add(-g.window)
+	g.suppressed += suppressed
+}
+
+// SetBurstGuard caps the chat notifications of new alerts with guard. Alerts
+// over the cap are still paged and emailed, but held back from chat until
+// FlushOverflow finds room in the window; their count is reported through
+// notifier. A nil guard disables the cap.
+func (uc *ProcessAlertUseCase) SetBurstGuard(guard *BurstGuard, notifier OverflowNotifier) {
+	uc.burstGuard = guard
+	uc.overflowNotifier = notifier
+}
+
+// isBurstHeld reports whether the alert is held back from chat by the burst guard.
+func isBurstHeld(alert *entity.Alert) bool {
+	return alert.GetExternalReference(entity.BurstHeldReference) != ""
+}
+
+// withoutChat returns the given notifiers except the chat ones.
+func withoutChat(notifiers []Notifier) []Notifier {
+	kept := make([]Notifier, 0, len(notifiers))
+	for _, notifier := range notifiers {
+		if !chatNotifierNames[notifier.Name()] {
+			kept = append(kept, notifier)
+		}
+	}
+	return kept
+}
+
+// throttled reports whether the new alert exceeds the burst guard. Alerts
+// routed to no chat notifier are never throttled.
+func (uc *ProcessAlertUseCase) throttled(ctx context.Context, alert *entity.Alert) bool {
+	if uc.burstGuard == nil {
+		return false
+	}
+	notifiers := uc.notifiersFor(alert)
+	if len(withoutChat(notifiers)) == len(notifiers) || uc.burstGuard.Allow(time.Now()) {
+		return false
+	}
+	logger.WithContext(ctx, uc.logger).Warn("alert held back from chat, too many alerts in the burst window",
+		"alertID", alert.ID,
+		"fingerprint", alert.Fingerprint,
+	)
+	return true
+}
+
+// FlushOverflow posts the alerts the burst guard held back from chat as the
+// window has room, and sends one message counting them once their window
+// has passed. It returns the count reported; a failed message is retried on
+// the next flush.
+func (uc *ProcessAlertUseCase) FlushOverflow(ctx context.Context, now time.Time) (int, error) {
+	if uc.burstGuard == nil {
+		return 0, nil
+	}
+	if err := uc.releaseBurstHeld(ctx, now); err != nil {
+		return 0, err
+	}
+
+	suppressed := uc.burstGuard.Overflow(now)
+	if suppressed == 0 {
+		return 0, nil
+	}
+	if uc.overflowNotifier == nil {
+		logger.WithContext(ctx, uc.logger).Warn("alerts suppressed by the burst guard", "alerts", suppressed)
+		return suppressed, nil
+	}
+	if err := uc.overflowNotifier.NotifyOverflow(ctx, suppressed); err != nil {
+		uc.burstGuard.restore(suppressed, now)
+		return 0, fmt.Errorf("sending overflow message: %w", err)
+	}
+	logger.WithContext(ctx, uc.logger).Info("reported alerts suppressed by the burst guard", "alerts", suppressed)
+	return suppressed, nil
+}
+
+// releaseBurstHeld posts the active alerts held back by the burst guard,
+// oldest first, while the window has room. Alerts resolved or acknowledged
+// in the meantime stay unposted.
+func (uc *ProcessAlertUseCase) releaseBurstHeld(ctx context.Context, now time.Time) error {
+	alerts, err := uc.alertRepo.GetActiveAlerts(ctx, "")
+	if err != nil {
+		return fmt.Errorf("getting active alerts: %w", err)
+	}
+
+	held := make([]*entity.Alert, 0, len(alerts))
+	for _, alert := range alerts {
+		if isBurstHeld(alert) && alert.IsActive() {
+			held = append(held, alert)
+		}
+	}
+	sort.SliceStable(held, func(i, j int) bool {
+		return held[i].CreatedAt.Before(held[j].CreatedAt)
+	})
+
+	for _, alert := range held {
+		if !uc.burstGuard.Room(now) {
+			return nil
+		}
+
+		output := &dto.ProcessAlertOutput{AlertID: alert.ID}
+		if err := uc.release(repository.NewContextWithTenant(ctx, alert.TenantID), alert, entity.BurstHeldReference, output); err != nil {
+			// Another instance released it first
+			if errors.Is(err, repository.ErrConcurrentUpdate) {
+				continue
+			}
+			return err
+		}
+		logger.WithContext(ctx, uc.logger).Info("posted alert held back by the burst guard",
+			"alertID", alert.ID,
+			"sent", output.NotificationsSent,
+			"failed", len(output.NotificationsFailed),
+		)
+	}
+	return nil
+}
+
+// RunBurstGuard flushes the burst guard overflow every interval until ctx
+// is cancelled.
+func (uc *ProcessAlertUseCase) RunBurstGuard(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultBurstFlushInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if _, err := uc.FlushOverflow(ctx, time.Now()); err != nil && ctx.Err() == nil {
+			logger.WithContext(ctx, uc.logger).Error("reporting burst guard overflow failed", "error", err)
+		}
+	}
+}
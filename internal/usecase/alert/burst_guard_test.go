@@ -0,0 +1,128 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+type recordingOverflow struct {
+	reported []int
+	err      error
+}
+
+func (o *recordingOverflow) NotifyOverflow(ctx context.Context, suppressed int) error {
+	if o.err != nil {
+		return o.err
+	}
+	o.reported = append(o.reported, suppressed)
+	return nil
+}
+
+func TestBurstGuard_RollingWindow(t *testing.T) {
+	guard := NewBurstGuard(2, time.Minute)
+	start := time.Now()
+
+	assert.True(t, guard.Allow(start))
+	assert.True(t, guard.Allow(start.Add(10*time.Second)))
+	assert.False(t, guard.Allow(start.Add(20*time.Second)))
+
+	// The first notification leaves the window, making room for one more
+	assert.True(t, guard.Allow(start.Add(61*time.Second)))
+	assert.False(t, guard.Allow(start.Add(62*time.Second)))
+
+	// Reported once a window has passed since the first suppression
+	assert.Zero(t, guard.Overflow(start.Add(30*time.Second)))
+	assert.Equal(t, 2, guard.Overflow(start.Add(80*time.Second)))
+	assert.Zero(t, guard.Overflow(start.Add(200*time.Second)))
+}
+
+func TestProcessAlert_BurstGuard(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	notifier := &recordingNotifier{name: "slack"}
+	pager := &recordingNotifier{name: "pagerduty"}
+	overflow := &recordingOverflow{}
+	uc := NewProcessAlertUseCase(alertRepo, memory.NewSilenceRepository(), []Notifier{notifier, pager}, nopLogger{}, nil)
+	uc.SetBurstGuard(NewBurstGuard(3, time.Minute), overflow)
+
+	var outputs []*dto.ProcessAlertOutput
+	for i := range 10 {
+		output, err := uc.Execute(ctx, dto.ProcessAlertInput{
+			Fingerprint: fmt.Sprintf("fp-%d", i),
+			Name:        "NodeDown",
+			Severity:    entity.SeverityCritical,
+			Status:      "firing",
+			FiredAt:     time.Now().UTC(),
+		})
+		require.NoError(t, err)
+		outputs = append(outputs, output)
+	}
+
+	// Only the first three are posted to Slack; every alert is paged
+	assert.Equal(t, 3, notifier.notified)
+	assert.Equal(t, 10, pager.notified)
+	for i, output := range outputs {
+		assert.True(t, output.IsNew)
+		assert.Equal(t, i >= 3, output.IsThrottled, "alert %d", i)
+	}
+	active, err := alertRepo.GetActiveAlerts(ctx, "")
+	require.NoError(t, err)
+	assert.Len(t, active, 10)
+
+	// Re-fires of throttled alerts are deduplicated, not counted again
+	_, err = uc.Execute(ctx, dto.ProcessAlertInput{
+		Fingerprint: "fp-9",
+		Name:        "NodeDown",
+		Severity:    entity.SeverityCritical,
+		Status:      "firing",
+		FiredAt:     time.Now().UTC(),
+	})
+	require.NoError(t, err)
+
+	// Nothing is reported or posted before the window has passed
+	reported, err := uc.FlushOverflow(ctx, time.Now())
+	require.NoError(t, err)
+	assert.Zero(t, reported)
+	assert.Equal(t, 3, notifier.notified)
+
+	// A held alert resolved in the meantime is never posted, but its page is resolved
+	_, err = uc.Execute(ctx, dto.ProcessAlertInput{
+		Fingerprint: "fp-3",
+		Name:        "NodeDown",
+		Severity:    entity.SeverityCritical,
+		Status:      "resolved",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 11, pager.notified)
+
+	// A failed overflow message is retried on the next flush
+	overflow.err = errors.New("slack unavailable")
+	_, err = uc.FlushOverflow(ctx, time.Now().Add(2*time.Minute))
+	require.Error(t, err)
+
+	overflow.err = nil
+	reported, err = uc.FlushOverflow(ctx, time.Now().Add(2*time.Minute+DefaultBurstFlushInterval))
+	require.NoError(t, err)
+	assert.Equal(t, 7, reported)
+	assert.Equal(t, []int{7}, overflow.reported)
+
+	// Held alerts are posted as the window has room, three per window
+	assert.Equal(t, 6, notifier.notified)
+	assert.Equal(t, 11, pager.notified)
+	_, err = uc.FlushOverflow(ctx, time.Now().Add(4*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 9, notifier.notified)
+	_, err = uc.FlushOverflow(ctx, time.Now().Add(6*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 9, notifier.notified, "the resolved alert stays unposted")
+}
@@ -0,0 +1,137 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	domainerrors "github.com/qj0r9j0vc2/alert-bridge/internal/domain/errors"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/logger"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/observability"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/resilience"
+)
+
+// Default circuit breaker settings for notifiers.
+const (
+	DefaultCircuitMaxFailures = 5
+	DefaultCircuitCooldown    = 30 * time.Second
+)
+
+// CircuitBreakingNotifier wraps a Notifier with a circuit breaker. After
+// maxFailures consecutive failures calls fail fast with
+// resilience.ErrCircuitOpen for the cooldown, so a dead integration does not
+// stall every alert on its timeouts; then single calls probe for recovery.
+type CircuitBreakingNotifier struct {
+	notifier Notifier
+	breaker  *resilience.CircuitBreaker
+	logger   Logger
+	metrics  *observability.Metrics
+}
+
+// NewCircuitBreakingNotifier creates a new CircuitBreakingNotifier.
+// metrics may be nil.
+func NewCircuitBreakingNotifier(notifier Notifier, maxFailures int, cooldown time.Duration, logger Logger, metrics *observability.Metrics) *CircuitBreakingNotifier {
+	n := &CircuitBreakingNotifier{
+		notifier: notifier,
+		breaker:  resilience.NewCircuitBreaker(notifier.Name(), maxFailures, cooldown),
+		logger:   logger,
+		metrics:  metrics,
+	}
+	n.breaker.SetOnStateChange(n.stateChanged)
+	return n
+}
+
+// Notify sends a notification unless the circuit is open.
+func (n *CircuitBreakingNotifier) Notify(ctx context.Context, alert *entity.Alert) (string, error) {
+	var messageID string
+	var notifyErr error
+	err := n.breaker.Execute(ctx, func() error {
+		messageID, notifyErr = n.notifier.Notify(ctx, alert)
+		return healthError(notifyErr)
+	})
+	if errors.Is(err, resilience.ErrCircuitOpen) {
+		n.shortCircuited(ctx, alert)
+		return "", err
+	}
+	return messageID, notifyErr
+}
+
+// UpdateMessage updates a notification unless the circuit is open.
+func (n *CircuitBreakingNotifier) UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error {
+	var updateErr error
+	err := n.breaker.Execute(ctx, func() error {
+		updateErr = n.notifier.UpdateMessage(ctx, messageID, alert)
+		return healthError(updateErr)
+	})
+	if errors.Is(err, resilience.ErrCircuitOpen) {
+		n.shortCircuited(ctx, alert)
+		return err
+	}
+	return updateErr
+}
+
+// State returns the current circuit breaker state.
+func (n *CircuitBreakingNotifier) State() resilience.State {
+	return n.breaker.State()
+}
+
+// Name returns the underlying notifier name.
+func (n *CircuitBreakingNotifier) Name() string {
+	return n.notifier.Name()
+}
+
+// SelfTest forwards to the underlying notifier's self-test, if it has one.
+func (n *CircuitBreakingNotifier) SelfTest(ctx context.Context) error {
+	return SelfTest(ctx, n.notifier)
+}
+
+// Preview delegates to the wrapped notifier's preview, if supported.
+func (n *CircuitBreakingNotifier) Preview(alert *entity.Alert) (any, error) {
+	return Preview(n.notifier, alert)
+}
+
+// Close forwards to the underlying notifier's Close, if it has one.
+func (n *CircuitBreakingNotifier) Close(ctx context.Context) error {
+	return Close(ctx, n.notifier)
+}
+
+// shortCircuited logs and counts a call skipped by the open circuit.
+func (n *CircuitBreakingNotifier) shortCircuited(ctx context.Context, alert *entity.Alert) {
+	logger.WithContext(ctx, n.logger).Warn("circuit breaker open, skipping notification",
+		"notifier", n.notifier.Name(),
+		"alert_id", alert.ID,
+	)
+	if n.metrics != nil {
+		n.metrics.RecordNotificationShortCircuited(ctx, n.notifier.Name())
+	}
+}
+
+// stateChanged logs and counts circuit breaker transitions.
+func (n *CircuitBreakingNotifier) stateChanged(name string, from, to resilience.State) {
+	if to == resilience.StateOpen {
+		n.logger.Error("notifier circuit breaker opened",
+			"notifier", name,
+			"from", from.String(),
+		)
+	} else {
+		n.logger.Info("notifier circuit breaker state changed",
+			"notifier", name,
+			"from", from.String(),
+			"to", to.String(),
+		)
+	}
+	if n.metrics != nil {
+		n.metrics.RecordNotifierCircuitTransition(context.Background(), name, from.String(), to.String())
+	}
+}
+
+// healthError returns err if it counts against the notifier's health.
+// Rejections of the request itself, such as an invalid payload, show the
+// integration is reachable and do not trip the breaker.
+func healthError(err error) error {
+	if domainerrors.IsValidationError(err) || domainerrors.IsNotFoundError(err) || domainerrors.IsConflictError(err) {
+		return nil
+	}
+	return err
+}
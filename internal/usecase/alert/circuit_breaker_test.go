@@ -0,0 +1,95 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	domainerrors "github.com/qj0r9j0vc2/alert-bridge/internal/domain/errors"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/resilience"
+)
+
+// flakyNotifier returns err, if set, from every call and counts the calls.
+type flakyNotifier struct {
+	recordingNotifier
+	err error
+}
+
+func (n *flakyNotifier) Notify(ctx context.Context, alert *entity.Alert) (string, error) {
+	n.notified++
+	if n.err != nil {
+		return "", n.err
+	}
+	return "msg", nil
+}
+
+func (n *flakyNotifier) UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error {
+	n.notified++
+	return n.err
+}
+
+func TestCircuitBreakingNotifier_Transitions(t *testing.T) {
+	ctx := context.Background()
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	down := errors.New("connection refused")
+
+	inner := &flakyNotifier{recordingNotifier: recordingNotifier{name: "slack"}, err: down}
+	n := NewCircuitBreakingNotifier(inner, 3, 20*time.Millisecond, nopLogger{}, nil)
+
+	// Closed: failures below the threshold reach the notifier
+	for i := 0; i < 2; i++ {
+		_, err := n.Notify(ctx, alert)
+		require.ErrorIs(t, err, down)
+		assert.Equal(t, resilience.StateClosed, n.State())
+	}
+
+	// The third consecutive failure opens the circuit
+	_, err := n.Notify(ctx, alert)
+	require.ErrorIs(t, err, down)
+	assert.Equal(t, resilience.StateOpen, n.State())
+
+	// Open: calls fail fast without reaching the notifier
+	_, err = n.Notify(ctx, alert)
+	require.ErrorIs(t, err, resilience.ErrCircuitOpen)
+	require.ErrorIs(t, n.UpdateMessage(ctx, "msg", alert), resilience.ErrCircuitOpen)
+	assert.Equal(t, 3, inner.notified)
+
+	// Half-open: after the cooldown a failed probe reopens the circuit
+	time.Sleep(30 * time.Millisecond)
+	_, err = n.Notify(ctx, alert)
+	require.ErrorIs(t, err, down)
+	assert.Equal(t, 4, inner.notified)
+	assert.Equal(t, resilience.StateOpen, n.State())
+
+	// Successful probes close it again
+	time.Sleep(30 * time.Millisecond)
+	inner.err = nil
+	messageID, err := n.Notify(ctx, alert)
+	require.NoError(t, err)
+	assert.Equal(t, "msg", messageID)
+	assert.Equal(t, resilience.StateHalfOpen, n.State())
+
+	require.NoError(t, n.UpdateMessage(ctx, "msg", alert))
+	assert.Equal(t, resilience.StateClosed, n.State())
+}
+
+func TestCircuitBreakingNotifier_RejectedRequestsKeepCircuitClosed(t *testing.T) {
+	ctx := context.Background()
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	invalid := domainerrors.NewValidationError("invalid payload")
+
+	inner := &flakyNotifier{recordingNotifier: recordingNotifier{name: "slack"}, err: invalid}
+	n := NewCircuitBreakingNotifier(inner, 2, time.Minute, nopLogger{}, nil)
+
+	for i := 0; i < 5; i++ {
+		_, err := n.Notify(ctx, alert)
+		require.ErrorIs(t, err, invalid)
+	}
+	assert.Equal(t, resilience.StateClosed, n.State())
+	assert.Equal(t, 5, inner.notified)
+}
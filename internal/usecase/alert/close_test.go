@@ -0,0 +1,58 @@
+package alert
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClose_ReachesWrappedNotifier(t *testing.T) {
+	tests := []struct {
+		name string
+		wrap func(Notifier) Notifier
+	}{
+		{
+			name: "retry",
+			wrap: func(n Notifier) Notifier { return NewRetryableNotifier(n, DefaultRetryPolicy(), nopLogger{}, nil) },
+		},
+		{
+			name: "circuit breaker",
+			wrap: func(n Notifier) Notifier { return NewCircuitBreakingNotifier(n, 3, time.Minute, nopLogger{}, nil) },
+		},
+		{
+			name: "concurrency",
+			wrap: func(n Notifier) Notifier { return NewConcurrencyLimitedNotifier(n, 2) },
+		},
+		{
+			name: "dry run",
+			wrap: func(n Notifier) Notifier { return NewDryRunNotifier(n, nopLogger{}) },
+		},
+		{
+			// The order the app wires the decorators in
+			name: "full stack",
+			wrap: func(n Notifier) Notifier {
+				n = NewConcurrencyLimitedNotifier(n, 2)
+				n = NewRetryableNotifier(n, DefaultRetryPolicy(), nopLogger{}, nil)
+				n = NewCircuitBreakingNotifier(n, 3, time.Minute, nopLogger{}, nil)
+				return NewDryRunNotifier(n, nopLogger{})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inner := &lifecycleNotifier{recordingNotifier: recordingNotifier{name: "inner"}}
+
+			require.NoError(t, Close(context.Background(), tt.wrap(inner)))
+			assert.True(t, inner.closed)
+		})
+	}
+}
+
+func TestClose_IgnoresNotifierWithoutClose(t *testing.T) {
+	n := NewRetryableNotifier(&recordingNotifier{name: "inner"}, DefaultRetryPolicy(), nopLogger{}, nil)
+
+	assert.NoError(t, Close(context.Background(), n))
+}
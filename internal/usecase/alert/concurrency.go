@@ -0,0 +1,78 @@
+package alert
+
+import (
+	"context"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+)
+
+// ConcurrencyLimitedNotifier wraps a Notifier, bounding its in-flight Notify
+// and UpdateMessage calls so an alert storm does not trip the provider's
+// rate limits. Calls beyond the limit wait for a free slot or until their
+// context is done.
+type ConcurrencyLimitedNotifier struct {
+	notifier Notifier
+	slots    chan struct{}
+}
+
+// NewConcurrencyLimitedNotifier creates a new ConcurrencyLimitedNotifier
+// allowing at most limit concurrent calls. limit must be positive.
+func NewConcurrencyLimitedNotifier(notifier Notifier, limit int) *ConcurrencyLimitedNotifier {
+	return &ConcurrencyLimitedNotifier{
+		notifier: notifier,
+		slots:    make(chan struct{}, limit),
+	}
+}
+
+// Notify sends a notification once a slot is free.
+func (n *ConcurrencyLimitedNotifier) Notify(ctx context.Context, alert *entity.Alert) (string, error) {
+	if err := n.acquire(ctx); err != nil {
+		return "", err
+	}
+	defer n.release()
+	return n.notifier.Notify(ctx, alert)
+}
+
+// UpdateMessage updates a notification once a slot is free.
+func (n *ConcurrencyLimitedNotifier) UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error {
+	if err := n.acquire(ctx); err != nil {
+		return err
+	}
+	defer n.release()
+	return n.notifier.UpdateMessage(ctx, messageID, alert)
+}
+
+// Name returns the underlying notifier name.
+func (n *ConcurrencyLimitedNotifier) Name() string {
+	return n.notifier.Name()
+}
+
+// SelfTest forwards to the underlying notifier's self-test, if it has one.
+func (n *ConcurrencyLimitedNotifier) SelfTest(ctx context.Context) error {
+	return SelfTest(ctx, n.notifier)
+}
+
+// Preview delegates to the wrapped notifier's preview, if supported.
+func (n *ConcurrencyLimitedNotifier) Preview(alert *entity.Alert) (any, error) {
+	return Preview(n.notifier, alert)
+}
+
+// Close forwards to the underlying notifier's Close, if it has one.
+func (n *ConcurrencyLimitedNotifier) Close(ctx context.Context) error {
+	return Close(ctx, n.notifier)
+}
+
+// acquire waits for a free slot, returning the context error if it is done first.
+func (n *ConcurrencyLimitedNotifier) acquire(ctx context.Context) error {
+	select {
+	case n.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// release frees the slot taken by acquire.
+func (n *ConcurrencyLimitedNotifier) release() {
+	<-n.slots
+}
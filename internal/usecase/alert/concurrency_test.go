@@ -0,0 +1,85 @@
+package alert
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+)
+
+// slowNotifier holds every call briefly and records the peak number of
+// concurrent calls.
+type slowNotifier struct {
+	recordingNotifier
+	inFlight atomic.Int32
+	peak     atomic.Int32
+}
+
+func (n *slowNotifier) call() {
+	current := n.inFlight.Add(1)
+	defer n.inFlight.Add(-1)
+	for {
+		peak := n.peak.Load()
+		if current <= peak || n.peak.CompareAndSwap(peak, current) {
+			break
+		}
+	}
+	time.Sleep(5 * time.Millisecond)
+}
+
+func (n *slowNotifier) Notify(ctx context.Context, alert *entity.Alert) (string, error) {
+	n.call()
+	return "msg", nil
+}
+
+func (n *slowNotifier) UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error {
+	n.call()
+	return nil
+}
+
+func TestConcurrencyLimitedNotifier_BoundsInFlightCalls(t *testing.T) {
+	const limit = 3
+	inner := &slowNotifier{recordingNotifier: recordingNotifier{name: "slack"}}
+	n := NewConcurrencyLimitedNotifier(inner, limit)
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, err := n.Notify(context.Background(), alert)
+			assert.NoError(t, err)
+		}()
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, n.UpdateMessage(context.Background(), "msg", alert))
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, inner.peak.Load(), int32(limit))
+	assert.Equal(t, int32(limit), inner.peak.Load(), "queued calls should use every slot")
+}
+
+func TestConcurrencyLimitedNotifier_QueuedCallRespectsContext(t *testing.T) {
+	inner := &slowNotifier{recordingNotifier: recordingNotifier{name: "slack"}}
+	n := NewConcurrencyLimitedNotifier(inner, 1)
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+
+	// Take the only slot
+	require.NoError(t, n.acquire(context.Background()))
+	defer n.release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := n.Notify(ctx, alert)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, int32(0), inner.peak.Load(), "the queued call must not reach the notifier")
+}
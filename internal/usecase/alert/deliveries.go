@@ -0,0 +1,55 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+)
+
+// DeliveriesUseCase lists the notification attempts made for an alert, to
+// audit whether it reached Slack and PagerDuty.
+type DeliveriesUseCase struct {
+	alertRepo    repository.AlertRepository
+	deliveryRepo repository.DeliveryRepository
+}
+
+// NewDeliveriesUseCase creates a new DeliveriesUseCase.
+func NewDeliveriesUseCase(alertRepo repository.AlertRepository, deliveryRepo repository.DeliveryRepository) *DeliveriesUseCase {
+	return &DeliveriesUseCase{
+		alertRepo:    alertRepo,
+		deliveryRepo: deliveryRepo,
+	}
+}
+
+// Execute returns the alert's deliveries, oldest first.
+// Returns repository.ErrAlertNotFound for an unknown alert ID.
+func (uc *DeliveriesUseCase) Execute(ctx context.Context, alertID string) ([]dto.NotificationDelivery, error) {
+	// Looked up first so alerts of other tenants stay hidden
+	alert, err := uc.alertRepo.FindByID(ctx, alertID)
+	if err != nil {
+		return nil, fmt.Errorf("finding alert: %w", err)
+	}
+	if alert == nil {
+		return nil, repository.ErrAlertNotFound
+	}
+
+	deliveries, err := uc.deliveryRepo.FindByAlertID(ctx, alert.ID)
+	if err != nil {
+		return nil, fmt.Errorf("finding deliveries: %w", err)
+	}
+
+	result := make([]dto.NotificationDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		result = append(result, dto.NotificationDelivery{
+			Notifier:          delivery.Notifier,
+			Action:            string(delivery.Action),
+			Status:            string(delivery.Status),
+			ExternalReference: delivery.ExternalReference,
+			Error:             delivery.Error,
+			At:                delivery.CreatedAt,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,235 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/logger"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+)
+
+// slackNotifierName is the name of the Slack notifier, the only one that
+// receives suppressed alerts.
+const slackNotifierName = "slack"
+
+// Dependency makes alerts depend on a parent alert, e.g. every service alert
+// of a node on the node being up.
+type Dependency struct {
+	// Parent requires each label of a parent alert to equal the given value.
+	Parent map[string]string
+
+	// Children requires each label of a child alert to equal the given
+	// value. Empty matches any alert.
+	Children map[string]string
+
+	// Equal lists the labels a child must share with its parent, e.g. instance.
+	Equal []string
+}
+
+// DependencySuppressor finds the firing parent alert a new alert depends on.
+type DependencySuppressor struct {
+	alertRepo    repository.AlertRepository
+	dependencies []Dependency
+}
+
+// NewDependencySuppressor creates a suppressor for the given dependencies.
+func NewDependencySuppressor(alertRepo repository.AlertRepository, dependencies []Dependency) *DependencySuppressor {
+	return &DependencySuppressor{
+		alertRepo:    alertRepo,
+		dependencies: dependencies,
+	}
+}
+
+// ParentOf returns the oldest firing parent of the alert in the tenant of
+// ctx, or nil if the alert does not depend on a firing alert. An alert
+// matching a dependency's parent selector is never its child.
+func (s *DependencySuppressor) ParentOf(ctx context.Context, alert *entity.Alert) (*entity.Alert, error) {
+	var parent *entity.Alert
+	for _, dependency := range s.dependencies {
+		matchers, ok := dependency.parentMatchers(alert)
+		if !ok {
+			continue
+		}
+
+		candidates, err := s.alertRepo.FindByLabels(ctx, matchers)
+		if err != nil {
+			return nil, fmt.Errorf("finding parent alerts: %w", err)
+		}
+		for _, candidate := range candidates {
+			if candidate.ID == alert.ID || candidate.Fingerprint == alert.Fingerprint {
+				continue
+			}
+			if parent == nil || candidate.CreatedAt.Before(parent.CreatedAt) {
+				parent = candidate
+			}
+		}
+	}
+	return parent, nil
+}
+
+// parentMatchers returns the labels a parent of the alert carries, or false
+// if the dependency does not apply to the alert.
+func (d Dependency) parentMatchers(alert *entity.Alert) (map[string]string, bool) {
+	if alert.HasLabels(d.Parent) || !alert.HasLabels(d.Children) {
+		return nil, false
+	}
+
+	matchers := make(map[string]string, len(d.Parent)+len(d.Equal))
+	for key, value := range d.Parent {
+		matchers[key] = value
+	}
+	for _, label := range d.Equal {
+		value := alert.GetLabel(label)
+		if value == "" {
+			return nil, false
+		}
+		if required, ok := matchers[label]; ok && required != value {
+			return nil, false
+		}
+		matchers[label] = value
+	}
+	return matchers, true
+}
+
+// SetDependencySuppressor suppresses new alerts while a parent alert they
+// depend on is firing. Suppressed alerts are stored and posted to Slack only,
+// as replies in their parent's thread, and page through the other notifiers
+// once the parent resolves while they still fire.
+func (uc *ProcessAlertUseCase) SetDependencySuppressor(suppressor *DependencySuppressor) {
+	uc.dependencies = suppressor
+}
+
+// isSuppressed reports whether the alert is suppressed by a parent alert.
+func isSuppressed(alert *entity.Alert) bool {
+	return alert.GetExternalReference(entity.SuppressedByReference) != ""
+}
+
+// onlySlack returns the Slack notifier among the given ones, if any.
+func onlySlack(notifiers []Notifier) []Notifier {
+	for _, notifier := range notifiers {
+		if notifier.Name() == slackNotifierName {
+			return []Notifier{notifier}
+		}
+	}
+	return nil
+}
+
+// suppressByDependency marks a new alert suppressed if a parent it depends
+// on is firing, threading it under the parent's Slack message.
+func (uc *ProcessAlertUseCase) suppressByDependency(ctx context.Context, alert *entity.Alert, output *dto.ProcessAlertOutput) {
+	if uc.dependencies == nil {
+		return
+	}
+
+	parent, err := uc.dependencies.ParentOf(ctx, alert)
+	if err != nil {
+		logger.WithContext(ctx, uc.logger).Warn("failed to check alert dependencies",
+			"error", err,
+			"alertID", alert.ID,
+		)
+		return
+	}
+	if parent == nil {
+		return
+	}
+
+	alert.SetExternalReference(entity.SuppressedByReference, parent.ID)
+	thread := parent.GetExternalReference(entity.SlackThreadReference)
+	if thread == "" {
+		thread = parent.GetExternalReference(slackNotifierName)
+	}
+	if thread != "" {
+		alert.SetExternalReference(entity.SlackThreadReference, thread)
+	}
+	output.IsSuppressed = true
+
+	logger.WithContext(ctx, uc.logger).Info("alert suppressed by parent alert",
+		"alertID", alert.ID,
+		"parentAlertID", parent.ID,
+		"parentName", parent.Name,
+	)
+}
+
+// ReleaseDependents lifts the suppression of the firing alerts the resolved
+// parent suppressed. An alert that depends on another firing parent moves
+// under it; the others are sent to the notifiers they were held back from.
+func (uc *ProcessAlertUseCase) ReleaseDependents(ctx context.Context, parent *entity.Alert) {
+	if uc.dependencies == nil {
+		return
+	}
+
+	alerts, err := uc.alertRepo.FindFiring(ctx)
+	if err != nil {
+		logger.WithContext(ctx, uc.logger).Warn("failed to find alerts suppressed by resolved parent",
+			"error", err,
+			"parentAlertID", parent.ID,
+		)
+		return
+	}
+
+	for _, alert := range alerts {
+		if alert.GetExternalReference(entity.SuppressedByReference) != parent.ID {
+			continue
+		}
+		if err := uc.releaseSuppressed(ctx, alert); err != nil && !errors.Is(err, repository.ErrConcurrentUpdate) {
+			logger.WithContext(ctx, uc.logger).Error("failed to release suppressed alert",
+				"error", err,
+				"alertID", alert.ID,
+				"parentAlertID", parent.ID,
+			)
+		}
+	}
+}
+
+// releaseSuppressed re-evaluates a suppressed alert whose parent resolved.
+func (uc *ProcessAlertUseCase) releaseSuppressed(ctx context.Context, alert *entity.Alert) error {
+	parent, err := uc.dependencies.ParentOf(ctx, alert)
+	if err != nil {
+		return err
+	}
+	if parent != nil {
+		alert.SetExternalReference(entity.SuppressedByReference, parent.ID)
+		if err := uc.alertRepo.Update(ctx, alert); err != nil {
+			return fmt.Errorf("updating suppressed alert: %w", err)
+		}
+		return nil
+	}
+
+	alert.RemoveExternalReference(entity.SuppressedByReference)
+
+	// ReleaseHeld sends it once quiet hours are over
+	if isHeld(alert) {
+		if err := uc.alertRepo.Update(ctx, alert); err != nil {
+			return fmt.Errorf("updating released alert: %w", err)
+		}
+		return nil
+	}
+
+	err = uc.withOutbox(ctx, func(ctx context.Context) error {
+		if err := uc.alertRepo.Update(ctx, alert); err != nil {
+			return fmt.Errorf("updating released alert: %w", err)
+		}
+		if err := uc.enqueue(ctx, alert, entity.OutboxActionUpdate); err != nil {
+			return err
+		}
+		return uc.enqueue(ctx, alert, entity.OutboxActionNotify)
+	})
+	if err != nil {
+		return err
+	}
+
+	output := &dto.ProcessAlertOutput{AlertID: alert.ID}
+	if uc.outboxRepo == nil {
+		uc.updateNotifications(ctx, alert, output)
+		uc.sendNotifications(ctx, alert, output)
+	}
+	logger.WithContext(ctx, uc.logger).Info("released alert suppressed by resolved parent",
+		"alertID", alert.ID,
+		"sent", output.NotificationsSent,
+		"failed", len(output.NotificationsFailed),
+	)
+	return nil
+}
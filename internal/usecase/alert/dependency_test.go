@@ -0,0 +1,129 @@
+package alert
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+// pagingNotifier records the names of the alerts it posted new messages for.
+type pagingNotifier struct {
+	name  string
+	paged []string
+}
+
+func (n *pagingNotifier) Notify(ctx context.Context, alert *entity.Alert) (string, error) {
+	n.paged = append(n.paged, alert.Name)
+	return "incident", nil
+}
+
+func (n *pagingNotifier) UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error {
+	return nil
+}
+
+func (n *pagingNotifier) Name() string { return n.name }
+
+func TestProcessAlert_DependencySuppression(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	slack := &recordingNotifier{name: "slack"}
+	pagerDuty := &pagingNotifier{name: "pagerduty"}
+
+	uc := NewProcessAlertUseCase(alertRepo, memory.NewSilenceRepository(), []Notifier{slack, pagerDuty}, nopLogger{}, nil)
+	uc.SetDependencySuppressor(NewDependencySuppressor(alertRepo, []Dependency{{
+		Parent: map[string]string{"alertname": "NodeDown"},
+		Equal:  []string{"instance"},
+	}}))
+
+	process := func(fingerprint, name, instance, status string) *dto.ProcessAlertOutput {
+		output, err := uc.Execute(ctx, dto.ProcessAlertInput{
+			Fingerprint: fingerprint,
+			Name:        name,
+			Instance:    instance,
+			Severity:    entity.SeverityCritical,
+			Status:      status,
+			Labels:      map[string]string{"alertname": name, "instance": instance},
+		})
+		require.NoError(t, err)
+		return output
+	}
+
+	parent := process("fp-node", "NodeDown", "node-1", "firing")
+	assert.False(t, parent.IsSuppressed)
+	assert.Equal(t, []string{"NodeDown"}, pagerDuty.paged)
+
+	// A child on the same node is stored and posted to Slack only, in the parent's thread
+	child := process("fp-api", "APIDown", "node-1", "firing")
+	assert.True(t, child.IsSuppressed)
+	assert.Equal(t, []string{"slack"}, child.NotificationsSent)
+	assert.Equal(t, []string{"NodeDown"}, pagerDuty.paged)
+
+	stored, err := alertRepo.FindByID(ctx, child.AlertID)
+	require.NoError(t, err)
+	assert.Equal(t, parent.AlertID, stored.GetExternalReference(entity.SuppressedByReference))
+	assert.Equal(t, "msg", stored.GetExternalReference(entity.SlackThreadReference))
+
+	// A child on another node pages as usual
+	other := process("fp-web", "WebDown", "node-2", "firing")
+	assert.False(t, other.IsSuppressed)
+	assert.Equal(t, []string{"NodeDown", "WebDown"}, pagerDuty.paged)
+
+	// The parent resolving pages the child still firing
+	process("fp-node", "NodeDown", "node-1", "resolved")
+	assert.Equal(t, []string{"NodeDown", "WebDown", "APIDown"}, pagerDuty.paged)
+
+	stored, err = alertRepo.FindByID(ctx, child.AlertID)
+	require.NoError(t, err)
+	assert.Empty(t, stored.GetExternalReference(entity.SuppressedByReference))
+
+	// The parent firing again does not suppress the child already paged
+	process("fp-node", "NodeDown", "node-1", "firing")
+	process("fp-node", "NodeDown", "node-1", "resolved")
+	assert.Equal(t, []string{"NodeDown", "WebDown", "APIDown", "NodeDown"}, pagerDuty.paged)
+}
+
+func TestProcessAlert_DependencyResolvedChildStaysQuiet(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	pagerDuty := &pagingNotifier{name: "pagerduty"}
+
+	uc := NewProcessAlertUseCase(alertRepo, memory.NewSilenceRepository(), []Notifier{pagerDuty}, nopLogger{}, nil)
+	uc.SetDependencySuppressor(NewDependencySuppressor(alertRepo, []Dependency{{
+		Parent:   map[string]string{"alertname": "NodeDown"},
+		Children: map[string]string{"team": "api"},
+		Equal:    []string{"instance"},
+	}}))
+
+	process := func(fingerprint, name, status string, labels map[string]string) {
+		labels["alertname"] = name
+		labels["instance"] = "node-1"
+		_, err := uc.Execute(ctx, dto.ProcessAlertInput{
+			Fingerprint: fingerprint,
+			Name:        name,
+			Instance:    "node-1",
+			Severity:    entity.SeverityCritical,
+			Status:      status,
+			Labels:      labels,
+		})
+		require.NoError(t, err)
+	}
+
+	process("fp-node", "NodeDown", "firing", map[string]string{})
+	process("fp-api", "APIDown", "firing", map[string]string{"team": "api"})
+	assert.Equal(t, []string{"NodeDown"}, pagerDuty.paged)
+
+	// Alerts outside the children selector are not suppressed
+	process("fp-db", "DBDown", "firing", map[string]string{"team": "db"})
+	assert.Equal(t, []string{"NodeDown", "DBDown"}, pagerDuty.paged)
+
+	// A child resolved before its parent never pages
+	process("fp-api", "APIDown", "resolved", map[string]string{"team": "api"})
+	process("fp-node", "NodeDown", "resolved", map[string]string{})
+	assert.Equal(t, []string{"NodeDown", "DBDown"}, pagerDuty.paged)
+}
@@ -0,0 +1,157 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+)
+
+// DefaultDigestInterval is how often a digest is sent unless configured.
+const DefaultDigestInterval = 24 * time.Hour
+
+// DigestUseCase periodically sends one summary of the unacknowledged
+// alerts of the included severities that fired since the last digest.
+type DigestUseCase struct {
+	alertRepo  repository.AlertRepository
+	notifier   DigestNotifier
+	logger     Logger
+	interval   time.Duration
+	severities []entity.AlertSeverity
+	now        func() time.Time
+	lock       repository.IdempotencyStore
+
+	mu            sync.Mutex
+	lastDigest    time.Time
+	claimedPeriod time.Time // Interval this instance claimed and has not sent yet
+}
+
+// NewDigestUseCase creates a daily digest of warning and info alerts. The
+// first digest covers every unacknowledged alert.
+func NewDigestUseCase(alertRepo repository.AlertRepository, notifier DigestNotifier, logger Logger) *DigestUseCase {
+	return &DigestUseCase{
+		alertRepo:  alertRepo,
+		notifier:   notifier,
+		logger:     logger,
+		interval:   DefaultDigestInterval,
+		severities: []entity.AlertSeverity{entity.SeverityWarning, entity.SeverityInfo},
+		now:        time.Now,
+	}
+}
+
+// SetInterval sets how often Run sends a digest. Non-positive values are ignored.
+func (uc *DigestUseCase) SetInterval(interval time.Duration) {
+	if interval > 0 {
+		uc.interval = interval
+	}
+}
+
+// SetSeverities sets the severities a digest includes. Empty keeps the current ones.
+func (uc *DigestUseCase) SetSeverities(severities []entity.AlertSeverity) {
+	if len(severities) > 0 {
+		uc.severities = severities
+	}
+}
+
+// SetLock makes instances sharing store send one digest per interval
+// between them: each interval is claimed in store by the first instance to
+// send it, and the others skip it. A restarted instance then covers only
+// the last interval instead of every unacknowledged alert.
+func (uc *DigestUseCase) SetLock(store repository.IdempotencyStore) {
+	uc.lock = store
+}
+
+// LastDigest returns when the last digest was sent, or the zero time if none was.
+func (uc *DigestUseCase) LastDigest() time.Time {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	return uc.lastDigest
+}
+
+// Gather returns the unacknowledged alerts of the included severities that
+// fired after since, most urgent first.
+func (uc *DigestUseCase) Gather(ctx context.Context, since time.Time) ([]*entity.Alert, error) {
+	var alerts []*entity.Alert
+	for _, severity := range uc.severities {
+		active, err := uc.alertRepo.GetActiveAlerts(ctx, string(severity))
+		if err != nil {
+			return nil, fmt.Errorf("getting active %s alerts: %w", severity, err)
+		}
+		for _, alert := range active {
+			if alert.IsActive() && alert.FiredAt.After(since) {
+				alerts = append(alerts, alert)
+			}
+		}
+	}
+	entity.DefaultSeverityWeights().SortByPriority(alerts)
+	return alerts, nil
+}
+
+// Send posts a digest of the alerts fired since the last one and returns
+// how many it listed. Nothing is posted when there are none. The last
+// digest time only advances once the digest was posted, so a failed one
+// is covered by the next. With a lock, an interval another instance
+// claimed is skipped, and a failed digest is retried by the instance that
+// claimed it.
+func (uc *DigestUseCase) Send(ctx context.Context) (int, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	now := uc.now()
+	since := uc.lastDigest
+	if uc.lock != nil {
+		period := now.Truncate(uc.interval)
+		if !period.Equal(uc.claimedPeriod) {
+			claimed, err := uc.lock.MarkProcessed(ctx, "digest:"+period.UTC().Format(time.RFC3339), 2*uc.interval)
+			if err != nil {
+				return 0, fmt.Errorf("claiming digest: %w", err)
+			}
+			if !claimed {
+				return 0, nil
+			}
+			uc.claimedPeriod = period
+		}
+		if since.IsZero() {
+			since = now.Add(-uc.interval)
+		}
+	}
+
+	alerts, err := uc.Gather(ctx, since)
+	if err != nil {
+		return 0, err
+	}
+	if len(alerts) > 0 {
+		if err := uc.notifier.NotifyDigest(ctx, alerts); err != nil {
+			return 0, fmt.Errorf("sending digest: %w", err)
+		}
+	}
+	uc.lastDigest = now
+	uc.claimedPeriod = time.Time{}
+	return len(alerts), nil
+}
+
+// Run sends a digest every interval until ctx is cancelled.
+func (uc *DigestUseCase) Run(ctx context.Context) {
+	ticker := time.NewTicker(uc.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		count, err := uc.Send(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				uc.logger.Error("sending alert digest failed", "error", err)
+			}
+			continue
+		}
+		uc.logger.Info("sent alert digest", "alerts", count)
+	}
+}
@@ -0,0 +1,215 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+// recordingDigest records the alert names of every digest it is sent.
+type recordingDigest struct {
+	digests [][]string
+	err     error
+}
+
+func (d *recordingDigest) NotifyDigest(ctx context.Context, alerts []*entity.Alert) error {
+	if d.err != nil {
+		return d.err
+	}
+	names := make([]string, 0, len(alerts))
+	for _, alert := range alerts {
+		names = append(names, alert.Name)
+	}
+	d.digests = append(d.digests, names)
+	return nil
+}
+
+func TestDigest_Send(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	notifier := &recordingDigest{}
+	uc := NewDigestUseCase(alertRepo, notifier, nopLogger{})
+
+	start := time.Date(2025, 1, 2, 9, 0, 0, 0, time.UTC)
+	now := start
+	uc.now = func() time.Time { return now }
+
+	save := func(fingerprint, name string, severity entity.AlertSeverity, firedAt time.Time) *entity.Alert {
+		t.Helper()
+		alert := entity.NewAlert(fingerprint, name, "host-1", "", "", severity)
+		alert.FiredAt = firedAt
+		require.NoError(t, alertRepo.Save(ctx, alert))
+		return alert
+	}
+
+	save("fp-1", "Disk filling", entity.SeverityInfo, start.Add(-2*time.Hour))
+	save("fp-2", "High latency", entity.SeverityWarning, start.Add(-time.Hour))
+	save("fp-3", "Database down", entity.SeverityCritical, start.Add(-time.Hour))
+	acked := save("fp-4", "Queue backlog", entity.SeverityWarning, start.Add(-time.Hour))
+	require.NoError(t, acked.Acknowledge("oncall@example.com", start))
+	require.NoError(t, alertRepo.Update(ctx, acked))
+
+	// The first digest lists every unacked alert of the included severities, most urgent first
+	count, err := uc.Send(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, [][]string{{"High latency", "Disk filling"}}, notifier.digests)
+	assert.Equal(t, start, uc.LastDigest())
+
+	// Later digests only list alerts fired since the last one
+	now = start.Add(24 * time.Hour)
+	save("fp-5", "Cert expiring", entity.SeverityInfo, start.Add(time.Hour))
+	count, err = uc.Send(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, []string{"Cert expiring"}, notifier.digests[1])
+
+	// Nothing new: no message, but the digest time advances
+	now = start.Add(48 * time.Hour)
+	count, err = uc.Send(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+	assert.Len(t, notifier.digests, 2)
+	assert.Equal(t, now, uc.LastDigest())
+
+	// A failed digest is covered by the next one
+	save("fp-6", "Pod restarts", entity.SeverityWarning, start.Add(49*time.Hour))
+	now = start.Add(72 * time.Hour)
+	notifier.err = errors.New("slack unavailable")
+	_, err = uc.Send(ctx)
+	require.Error(t, err)
+	assert.Equal(t, start.Add(48*time.Hour), uc.LastDigest())
+
+	notifier.err = nil
+	now = start.Add(96 * time.Hour)
+	count, err = uc.Send(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, []string{"Pod restarts"}, notifier.digests[2])
+}
+
+func TestProcessAlert_DigestOnly(t *testing.T) {
+	ctx := context.Background()
+	notifier := &recordingNotifier{name: "slack"}
+	uc := NewProcessAlertUseCase(memory.NewAlertRepository(), memory.NewSilenceRepository(), []Notifier{notifier}, nopLogger{}, nil)
+	uc.SetDigestOnly([]entity.AlertSeverity{entity.SeverityInfo, entity.SeverityCritical})
+
+	firingInput := func(fingerprint string, severity entity.AlertSeverity) dto.ProcessAlertInput {
+		return dto.ProcessAlertInput{Fingerprint: fingerprint, Name: "High CPU", Severity: severity, Status: "firing", FiredAt: time.Now()}
+	}
+
+	info, err := uc.Execute(ctx, firingInput("fp-1", entity.SeverityInfo))
+	require.NoError(t, err)
+	assert.True(t, info.IsDigested)
+	assert.True(t, info.IsNew)
+	assert.Zero(t, notifier.notified)
+
+	// Critical alerts are always notified
+	critical, err := uc.Execute(ctx, firingInput("fp-2", entity.SeverityCritical))
+	require.NoError(t, err)
+	assert.False(t, critical.IsDigested)
+	assert.Equal(t, 1, notifier.notified)
+
+	// An alert left to the digest is posted once it escalates
+	escalated, err := uc.Execute(ctx, firingInput("fp-1", entity.SeverityWarning))
+	require.NoError(t, err)
+	assert.Equal(t, info.AlertID, escalated.AlertID)
+	assert.Equal(t, 2, notifier.notified)
+}
+
+func TestDigest_SendOncePerIntervalWithLock(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	lock := memory.NewIdempotencyStore(memory.DefaultIdempotencyCapacity)
+
+	start := time.Date(2025, 1, 2, 9, 0, 0, 0, time.UTC)
+	now := start
+	newInstance := func(notifier *recordingDigest) *DigestUseCase {
+		uc := NewDigestUseCase(alertRepo, notifier, nopLogger{})
+		uc.SetLock(lock)
+		uc.now = func() time.Time { return now }
+		return uc
+	}
+	first, second := &recordingDigest{}, &recordingDigest{}
+	a, b := newInstance(first), newInstance(second)
+
+	old := entity.NewAlert("fp-1", "Disk filling", "host-1", "", "", entity.SeverityWarning)
+	old.FiredAt = start.Add(-72 * time.Hour)
+	require.NoError(t, alertRepo.Save(ctx, old))
+	recent := entity.NewAlert("fp-2", "High latency", "host-1", "", "", entity.SeverityWarning)
+	recent.FiredAt = start.Add(-time.Hour)
+	require.NoError(t, alertRepo.Save(ctx, recent))
+
+	// One instance sends the interval's digest, covering the last interval only
+	_, err := a.Send(ctx)
+	require.NoError(t, err)
+	_, err = b.Send(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"High latency"}}, first.digests)
+	assert.Empty(t, second.digests)
+
+	// The instance that claimed an interval retries a failed digest
+	now = start.Add(24 * time.Hour)
+	late := entity.NewAlert("fp-3", "Cert expiring", "host-1", "", "", entity.SeverityInfo)
+	late.FiredAt = start.Add(time.Hour)
+	require.NoError(t, alertRepo.Save(ctx, late))
+	second.err = errors.New("slack unavailable")
+	_, err = b.Send(ctx)
+	require.Error(t, err)
+	_, err = a.Send(ctx)
+	require.NoError(t, err)
+	assert.Len(t, first.digests, 1)
+
+	second.err = nil
+	_, err = b.Send(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"Cert expiring"}}, second.digests)
+}
+
+func TestProcessAlert_QuietHoursDigest(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	notifier := &recordingNotifier{name: "slack"}
+	digest := &recordingDigest{}
+	uc := NewProcessAlertUseCase(alertRepo, memory.NewSilenceRepository(), []Notifier{notifier}, nopLogger{}, nil)
+	uc.SetQuietHoursDigest(digest)
+
+	now := time.Now().UTC()
+	quietHours, err := NewQuietHours(now.Add(-time.Hour).Format("15:04"), now.Add(time.Hour).Format("15:04"), time.UTC,
+		[]entity.AlertSeverity{entity.SeverityWarning, entity.SeverityInfo})
+	require.NoError(t, err)
+	uc.SetQuietHours(quietHours)
+
+	for _, fingerprint := range []string{"fp-1", "fp-2"} {
+		output, err := uc.Execute(ctx, dto.ProcessAlertInput{
+			Fingerprint: fingerprint, Name: "Disk " + fingerprint, Severity: entity.SeverityWarning, Status: "firing", FiredAt: now,
+		})
+		require.NoError(t, err)
+		require.True(t, output.IsHeld)
+	}
+
+	// A failed digest keeps the alerts held for the next attempt
+	digest.err = errors.New("slack unavailable")
+	_, err = uc.ReleaseHeld(ctx, now.Add(2*time.Hour))
+	require.Error(t, err)
+
+	digest.err = nil
+	released, err := uc.ReleaseHeld(ctx, now.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 2, released)
+	require.Len(t, digest.digests, 1)
+	assert.ElementsMatch(t, []string{"Disk fp-1", "Disk fp-2"}, digest.digests[0])
+	assert.Zero(t, notifier.notified)
+
+	released, err = uc.ReleaseHeld(ctx, now.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Zero(t, released)
+}
@@ -0,0 +1,87 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/logger"
+)
+
+// DryRunNotifier wraps a Notifier and logs the rendered payload instead of
+// calling the external API. Used to preview template changes safely.
+type DryRunNotifier struct {
+	notifier Notifier
+	logger   Logger
+}
+
+// NewDryRunNotifier creates a new DryRunNotifier around the given notifier.
+func NewDryRunNotifier(notifier Notifier, logger Logger) *DryRunNotifier {
+	return &DryRunNotifier{
+		notifier: notifier,
+		logger:   logger,
+	}
+}
+
+// Notify logs the payload that would be sent and returns a synthetic message ID.
+func (d *DryRunNotifier) Notify(ctx context.Context, alert *entity.Alert) (string, error) {
+	d.logPreview(ctx, "dry-run notification", "", alert)
+	return fmt.Sprintf("dry-run:%s", alert.ID), nil
+}
+
+// UpdateMessage logs the payload that the update would be rendered from.
+func (d *DryRunNotifier) UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error {
+	d.logPreview(ctx, "dry-run notification update", messageID, alert)
+	return nil
+}
+
+// Name returns the underlying notifier name.
+func (d *DryRunNotifier) Name() string {
+	return d.notifier.Name()
+}
+
+// Preview delegates to the wrapped notifier's preview, if supported.
+func (d *DryRunNotifier) Preview(alert *entity.Alert) (any, error) {
+	return Preview(d.notifier, alert)
+}
+
+// SelfTest forwards to the wrapped notifier's self-test, if it has one. Self
+// tests only verify credentials, so they run in dry-run mode too.
+func (d *DryRunNotifier) SelfTest(ctx context.Context) error {
+	return SelfTest(ctx, d.notifier)
+}
+
+// Close forwards to the wrapped notifier's Close, if it has one.
+func (d *DryRunNotifier) Close(ctx context.Context) error {
+	return Close(ctx, d.notifier)
+}
+
+func (d *DryRunNotifier) logPreview(ctx context.Context, msg, messageID string, alert *entity.Alert) {
+	keysAndValues := []any{
+		"notifier", d.notifier.Name(),
+		"alert_id", alert.ID,
+		"fingerprint", alert.Fingerprint,
+		"state", alert.State,
+	}
+	if messageID != "" {
+		keysAndValues = append(keysAndValues, "message_id", messageID)
+	}
+
+	payload, err := Preview(d.notifier, alert)
+	switch {
+	case errors.Is(err, ErrPreviewNotSupported):
+		keysAndValues = append(keysAndValues, "payload", "preview not supported")
+	case err != nil:
+		keysAndValues = append(keysAndValues, "preview_error", err)
+	default:
+		if data, err := json.Marshal(payload); err == nil {
+			keysAndValues = append(keysAndValues, "payload", string(data))
+		} else {
+			keysAndValues = append(keysAndValues, "preview_error", err)
+		}
+	}
+
+	logger.WithContext(ctx, d.logger).Info(msg, keysAndValues...)
+}
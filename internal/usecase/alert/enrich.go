@@ -0,0 +1,96 @@
+package alert
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/templates"
+)
+
+// DefaultRunbookURLTemplate maps an alert to "<base>/<alertname>".
+const DefaultRunbookURLTemplate = `{{ .BaseURL }}/{{ pathEscape .Name }}`
+
+// Enricher adds derived information to an alert before it is stored and notified.
+type Enricher interface {
+	Enrich(alert *entity.Alert)
+}
+
+// runbookTemplateData is the data available to runbook URL templates.
+type runbookTemplateData struct {
+	BaseURL     string
+	Name        string
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+// RunbookEnricher computes a runbook URL from alert labels using a configurable template.
+// Alerts that already carry a runbook_url annotation are left untouched.
+type RunbookEnricher struct {
+	baseURL string
+	tmpl    *template.Template
+}
+
+// NewRunbookEnricher creates a RunbookEnricher.
+// The template may reference .BaseURL, .Name, .Labels and .Annotations, and the
+// pathEscape / queryEscape helpers. An empty template uses DefaultRunbookURLTemplate.
+func NewRunbookEnricher(baseURL, urlTemplate string) (*RunbookEnricher, error) {
+	if urlTemplate == "" {
+		urlTemplate = DefaultRunbookURLTemplate
+	}
+
+	tmpl, err := templates.ParseRunbookURL(urlTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RunbookEnricher{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		tmpl:    tmpl,
+	}, nil
+}
+
+// Enrich sets the runbook_url annotation if it is not already present.
+func (e *RunbookEnricher) Enrich(alert *entity.Alert) {
+	if alert.GetAnnotation(entity.RunbookAnnotation) != "" {
+		return
+	}
+
+	data := runbookTemplateData{
+		BaseURL:     e.baseURL,
+		Name:        alert.Name,
+		Labels:      alert.Labels,
+		Annotations: alert.Annotations,
+	}
+
+	var buf bytes.Buffer
+	if err := e.tmpl.Execute(&buf, data); err != nil {
+		return
+	}
+
+	runbookURL := strings.TrimSpace(buf.String())
+	if runbookURL == "" {
+		return
+	}
+	alert.AddAnnotation(entity.RunbookAnnotation, runbookURL)
+}
+
+// SeverityEnricher maps the alert's severity label through a configured
+// severity map, so values like "page" or "ticket" get the intended internal
+// severity. Labels missing from the map keep the severity parsed from the webhook.
+type SeverityEnricher struct {
+	severities entity.SeverityMap
+}
+
+// NewSeverityEnricher creates a SeverityEnricher for the given map.
+func NewSeverityEnricher(severities entity.SeverityMap) *SeverityEnricher {
+	return &SeverityEnricher{severities: severities}
+}
+
+// Enrich replaces the alert's severity with the mapped one.
+func (e *SeverityEnricher) Enrich(alert *entity.Alert) {
+	if mapping, ok := e.severities.ForAlert(alert); ok {
+		alert.Severity = mapping.Severity
+	}
+}
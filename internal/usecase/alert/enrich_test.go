@@ -0,0 +1,92 @@
+package alert
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+)
+
+func TestRunbookEnricher(t *testing.T) {
+	tests := []struct {
+		name        string
+		baseURL     string
+		template    string
+		labels      map[string]string
+		annotations map[string]string
+		want        string
+	}{
+		{
+			name:    "default template uses alert name",
+			baseURL: "https://wiki.example.com/runbooks/",
+			want:    "https://wiki.example.com/runbooks/High%20CPU",
+		},
+		{
+			name:     "custom template with labels",
+			baseURL:  "https://wiki.example.com",
+			template: "{{ .BaseURL }}/{{ .Labels.team }}/{{ pathEscape .Name }}",
+			labels:   map[string]string{"team": "infra"},
+			want:     "https://wiki.example.com/infra/High%20CPU",
+		},
+		{
+			name:        "existing annotation is preserved",
+			baseURL:     "https://wiki.example.com",
+			annotations: map[string]string{"runbook_url": "https://other.example.com/cpu"},
+			want:        "https://other.example.com/cpu",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enricher, err := NewRunbookEnricher(tt.baseURL, tt.template)
+			require.NoError(t, err)
+
+			a := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityWarning)
+			for k, v := range tt.labels {
+				a.AddLabel(k, v)
+			}
+			for k, v := range tt.annotations {
+				a.AddAnnotation(k, v)
+			}
+
+			enricher.Enrich(a)
+			assert.Equal(t, tt.want, a.GetAnnotation(entity.RunbookAnnotation))
+		})
+	}
+}
+
+func TestNewRunbookEnricher_InvalidTemplate(t *testing.T) {
+	_, err := NewRunbookEnricher("https://wiki.example.com", "{{ .BaseURL")
+	assert.Error(t, err)
+}
+
+func TestSeverityEnricher(t *testing.T) {
+	enricher := NewSeverityEnricher(entity.SeverityMap{
+		"page":   {Severity: entity.SeverityCritical},
+		"ticket": {Severity: entity.SeverityWarning},
+		"none":   {Severity: entity.SeverityInfo},
+	})
+
+	tests := []struct {
+		label string
+		want  entity.AlertSeverity
+	}{
+		{label: "page", want: entity.SeverityCritical},
+		{label: "ticket", want: entity.SeverityWarning},
+		{label: "none", want: entity.SeverityInfo},
+		// Unmapped values keep the severity parsed from the webhook
+		{label: "critical", want: entity.SeverityCritical},
+		{label: "", want: entity.SeverityCritical},
+	}
+
+	for _, tt := range tests {
+		a := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+		if tt.label != "" {
+			a.AddLabel(entity.SeverityLabel, tt.label)
+		}
+		enricher.Enrich(a)
+		assert.Equal(t, tt.want, a.Severity, "label %q", tt.label)
+	}
+}
@@ -2,6 +2,7 @@ package alert
 
 import (
 	"context"
+	"errors"
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/logger"
@@ -21,5 +22,76 @@ type Notifier interface {
 	Name() string
 }
 
+// SelfTester is an optional interface for notifiers that can verify their
+// credentials and connectivity without sending an alert.
+type SelfTester interface {
+	SelfTest(ctx context.Context) error
+}
+
+// SelfTest runs the notifier's self-test if it implements SelfTester.
+// Notifiers without a self-test, or whose configuration cannot be verified,
+// return an error matching entity.ErrSelfTestNotSupported.
+func SelfTest(ctx context.Context, notifier Notifier) error {
+	if tester, ok := notifier.(SelfTester); ok {
+		return tester.SelfTest(ctx)
+	}
+	return entity.ErrSelfTestNotSupported
+}
+
+// Previewer is an optional interface for notifiers that can render the payload
+// they would send for an alert without contacting the external service.
+type Previewer interface {
+	Preview(alert *entity.Alert) (any, error)
+}
+
+// ErrPreviewNotSupported is returned by Preview for notifiers that cannot render a preview.
+var ErrPreviewNotSupported = errors.New("notifier does not support preview")
+
+// Preview renders the notifier's payload for the alert if it implements Previewer.
+func Preview(notifier Notifier, alert *entity.Alert) (any, error) {
+	if previewer, ok := notifier.(Previewer); ok {
+		return previewer.Preview(alert)
+	}
+	return nil, ErrPreviewNotSupported
+}
+
+// Closer is an optional interface for notifiers that hold connections or
+// in-flight requests that should be drained on shutdown.
+type Closer interface {
+	Close(ctx context.Context) error
+}
+
+// Close drains the notifier if it implements Closer.
+func Close(ctx context.Context, notifier Notifier) error {
+	if closer, ok := notifier.(Closer); ok {
+		return closer.Close(ctx)
+	}
+	return nil
+}
+
+// DigestNotifier posts one message summarizing several alerts.
+type DigestNotifier interface {
+	NotifyDigest(ctx context.Context, alerts []*entity.Alert) error
+}
+
+// OverflowNotifier posts one message standing in for alerts that were not
+// notified individually because too many fired at once.
+type OverflowNotifier interface {
+	NotifyOverflow(ctx context.Context, suppressed int) error
+}
+
+// ThreadReplier posts a reply in the thread of a notification message.
+type ThreadReplier interface {
+	PostThreadReply(ctx context.Context, messageID, text string) error
+}
+
+// OnCallLookup finds who is currently on call for a PagerDuty service.
+type OnCallLookup interface {
+	GetOnCall(ctx context.Context, serviceID string) (string, error)
+}
+
 // Logger is the unified logging interface from domain layer.
 type Logger = logger.Logger
+
+// AuditLogger records acknowledgments, silence changes and resolutions for compliance.
+type AuditLogger = logger.AuditLogger
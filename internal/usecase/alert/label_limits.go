@@ -0,0 +1,73 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"unicode/utf8"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/logger"
+)
+
+// SetLabelLimits bounds the size of incoming alerts. Firing alerts with more
+// than maxLabels labels or annotations are rejected with
+// entity.ErrTooManyLabels; label values longer than maxValueLen bytes are
+// truncated, in resolutions too. Zero disables a limit.
+func (uc *ProcessAlertUseCase) SetLabelLimits(maxLabels, maxValueLen int) {
+	uc.maxLabels = maxLabels
+	uc.maxLabelValueLen = maxValueLen
+}
+
+// limitLabels enforces the label limits on an incoming alert. Resolutions
+// are never rejected, but their values are truncated like those of the
+// firing alert, so the identity and tenant labels match the stored alert.
+func (uc *ProcessAlertUseCase) limitLabels(ctx context.Context, input *dto.ProcessAlertInput) error {
+	if input.Status != "resolved" && uc.maxLabels > 0 && (len(input.Labels) > uc.maxLabels || len(input.Annotations) > uc.maxLabels) {
+		if uc.metrics != nil {
+			uc.metrics.RecordAlertLabelsLimited(ctx, "rejected")
+		}
+		return fmt.Errorf("%w: %d labels and %d annotations, at most %d each allowed",
+			entity.ErrTooManyLabels, len(input.Labels), len(input.Annotations), uc.maxLabels)
+	}
+
+	if uc.maxLabelValueLen <= 0 {
+		return nil
+	}
+	var truncated []string
+	for key, value := range input.Labels {
+		if len(value) > uc.maxLabelValueLen {
+			truncated = append(truncated, key)
+		}
+	}
+	if len(truncated) == 0 {
+		return nil
+	}
+
+	// Copied, since the caller's map may be shared with other alerts of the payload
+	input.Labels = maps.Clone(input.Labels)
+	for _, key := range truncated {
+		input.Labels[key] = truncateUTF8(input.Labels[key], uc.maxLabelValueLen)
+	}
+	if uc.metrics != nil {
+		uc.metrics.RecordAlertLabelsLimited(ctx, "truncated")
+	}
+	logger.WithContext(ctx, uc.logger).Warn("truncated long label values",
+		"fingerprint", input.Fingerprint,
+		"labels", truncated,
+		"maxLength", uc.maxLabelValueLen,
+	)
+	return nil
+}
+
+// truncateUTF8 cuts s to at most n bytes without splitting a character.
+func truncateUTF8(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
@@ -0,0 +1,139 @@
+package alert
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+func TestProcessAlert_LabelLimits(t *testing.T) {
+	ctx := context.Background()
+
+	newUseCase := func() (*ProcessAlertUseCase, *memory.AlertRepository, *recordingNotifier) {
+		alertRepo := memory.NewAlertRepository()
+		notifier := &recordingNotifier{name: "slack"}
+		uc := NewProcessAlertUseCase(alertRepo, memory.NewSilenceRepository(), []Notifier{notifier}, nopLogger{}, nil)
+		uc.SetLabelLimits(3, 8)
+		return uc, alertRepo, notifier
+	}
+
+	t.Run("too many labels are rejected", func(t *testing.T) {
+		uc, alertRepo, notifier := newUseCase()
+
+		_, err := uc.Execute(ctx, dto.ProcessAlertInput{
+			Fingerprint: "fp",
+			Name:        "Runaway",
+			Severity:    entity.SeverityWarning,
+			Status:      "firing",
+			Labels:      map[string]string{"alertname": "Runaway", "a": "1", "b": "2", "c": "3"},
+		})
+		require.ErrorIs(t, err, entity.ErrTooManyLabels)
+		assert.ErrorContains(t, err, "4 labels")
+
+		alerts, err := alertRepo.FindByFingerprint(ctx, "fp")
+		require.NoError(t, err)
+		assert.Empty(t, alerts)
+		assert.Equal(t, 0, notifier.notified)
+	})
+
+	t.Run("too many annotations are rejected", func(t *testing.T) {
+		uc, _, _ := newUseCase()
+
+		_, err := uc.Execute(ctx, dto.ProcessAlertInput{
+			Fingerprint: "fp",
+			Name:        "Runaway",
+			Severity:    entity.SeverityWarning,
+			Status:      "firing",
+			Annotations: map[string]string{"a": "1", "b": "2", "c": "3", "d": "4"},
+		})
+		require.ErrorIs(t, err, entity.ErrTooManyLabels)
+	})
+
+	t.Run("long label values are truncated", func(t *testing.T) {
+		uc, alertRepo, _ := newUseCase()
+
+		labels := map[string]string{"alertname": "Disk", "path": "/var/lib/data", "zone": "äääää"}
+		output, err := uc.Execute(ctx, dto.ProcessAlertInput{
+			Fingerprint: "fp",
+			Name:        "Disk",
+			Severity:    entity.SeverityWarning,
+			Status:      "firing",
+			Labels:      labels,
+		})
+		require.NoError(t, err)
+
+		stored, err := alertRepo.FindByID(ctx, output.AlertID)
+		require.NoError(t, err)
+		assert.Equal(t, "Disk", stored.Labels["alertname"])
+		assert.Equal(t, "/var/lib", stored.Labels["path"])
+		// Cut before the character straddling the limit
+		assert.Equal(t, "ääää", stored.Labels["zone"])
+
+		// The caller's map is left alone
+		assert.Equal(t, "/var/lib/data", labels["path"])
+	})
+
+	t.Run("resolutions are not rejected", func(t *testing.T) {
+		uc, alertRepo, _ := newUseCase()
+
+		output, err := uc.Execute(ctx, dto.ProcessAlertInput{
+			Fingerprint: "fp",
+			Name:        "Disk",
+			Severity:    entity.SeverityWarning,
+			Status:      "firing",
+		})
+		require.NoError(t, err)
+
+		// The limits were lowered while the alert was firing
+		uc.SetLabelLimits(1, 0)
+		_, err = uc.Execute(ctx, dto.ProcessAlertInput{
+			Fingerprint: "fp",
+			Name:        "Disk",
+			Severity:    entity.SeverityWarning,
+			Status:      "resolved",
+			Labels:      map[string]string{"alertname": "Disk", "path": "/var"},
+		})
+		require.NoError(t, err)
+
+		stored, err := alertRepo.FindByID(ctx, output.AlertID)
+		require.NoError(t, err)
+		assert.True(t, stored.IsResolved())
+	})
+
+	t.Run("resolutions are truncated like their alert", func(t *testing.T) {
+		uc, alertRepo, _ := newUseCase()
+		uc.SetIdentityLabels([]string{"alertname", "path"})
+		uc.SetTenantLabel("team")
+
+		input := func(status string) dto.ProcessAlertInput {
+			return dto.ProcessAlertInput{
+				Fingerprint: "fp-" + status,
+				Name:        "Disk",
+				Severity:    entity.SeverityWarning,
+				Status:      status,
+				Labels:      map[string]string{"alertname": "Disk", "path": strings.Repeat("x", 10), "team": "storage-platform"},
+			}
+		}
+		output, err := uc.Execute(ctx, input("firing"))
+		require.NoError(t, err)
+
+		stored, err := alertRepo.FindByID(ctx, output.AlertID)
+		require.NoError(t, err)
+		assert.Equal(t, "storage-", stored.TenantID)
+
+		// The full values of the resolve find the alert stored under the truncated ones
+		_, err = uc.Execute(ctx, input("resolved"))
+		require.NoError(t, err)
+
+		stored, err = alertRepo.FindByID(ctx, output.AlertID)
+		require.NoError(t, err)
+		assert.True(t, stored.IsResolved())
+	})
+}
@@ -0,0 +1,38 @@
+package alert
+
+import (
+	"context"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/logger"
+)
+
+// OnCallAnnotation is the annotation key holding who was on call when an alert fired.
+const OnCallAnnotation = "oncall"
+
+// onCallTimeout bounds the on-call lookup so a slow PagerDuty API only
+// delays the notification briefly.
+const onCallTimeout = 2 * time.Second
+
+// addOnCall sets the oncall annotation on critical alerts that do not
+// already carry one. Lookup failures are logged and leave the alert as is.
+func (uc *ProcessAlertUseCase) addOnCall(ctx context.Context, alert *entity.Alert) {
+	if uc.onCall == nil || alert.Severity != entity.SeverityCritical || alert.GetAnnotation(OnCallAnnotation) != "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, onCallTimeout)
+	defer cancel()
+
+	name, err := uc.onCall.GetOnCall(ctx, uc.onCallServiceID)
+	if err != nil {
+		logger.WithContext(ctx, uc.logger).Warn("failed to look up on-call user",
+			"alertID", alert.ID,
+			"serviceID", uc.onCallServiceID,
+			"error", err,
+		)
+		return
+	}
+	alert.AddAnnotation(OnCallAnnotation, name)
+}
@@ -0,0 +1,56 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+)
+
+// PreviewAlertUseCase renders the notifications for a sample alert without
+// storing it or contacting any external service.
+type PreviewAlertUseCase struct {
+	notifiers []Notifier
+	enrichers []Enricher
+}
+
+// NewPreviewAlertUseCase creates a new PreviewAlertUseCase for the given notifiers.
+func NewPreviewAlertUseCase(notifiers []Notifier) *PreviewAlertUseCase {
+	return &PreviewAlertUseCase{
+		notifiers: notifiers,
+	}
+}
+
+// AddEnricher registers an enrichment step applied before rendering, matching ProcessAlertUseCase.
+func (uc *PreviewAlertUseCase) AddEnricher(enricher Enricher) {
+	uc.enrichers = append(uc.enrichers, enricher)
+}
+
+// Execute renders the payload every notifier would send for the input alert.
+func (uc *PreviewAlertUseCase) Execute(ctx context.Context, input dto.ProcessAlertInput) (*dto.PreviewAlertOutput, error) {
+	alert := newAlertFromInput(input, uc.enrichers)
+	if input.Status == "resolved" {
+		if err := alert.Resolve("preview", time.Now().UTC()); err != nil {
+			return nil, err
+		}
+	}
+
+	output := &dto.PreviewAlertOutput{
+		Previews: make(map[string]any),
+		Errors:   make(map[string]string),
+	}
+
+	for _, notifier := range uc.notifiers {
+		payload, err := Preview(notifier, alert)
+		if err != nil {
+			if !errors.Is(err, ErrPreviewNotSupported) {
+				output.Errors[notifier.Name()] = err.Error()
+			}
+			continue
+		}
+		output.Previews[notifier.Name()] = payload
+	}
+
+	return output, nil
+}
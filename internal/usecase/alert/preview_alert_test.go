@@ -0,0 +1,111 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+)
+
+// recordingNotifier counts calls that would reach the external service.
+type recordingNotifier struct {
+	name     string
+	notified int
+}
+
+func (n *recordingNotifier) Notify(ctx context.Context, alert *entity.Alert) (string, error) {
+	n.notified++
+	return "msg", nil
+}
+
+func (n *recordingNotifier) UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error {
+	n.notified++
+	return nil
+}
+
+func (n *recordingNotifier) Name() string { return n.name }
+
+// previewNotifier additionally renders the alert name and state as its payload.
+type previewNotifier struct{ recordingNotifier }
+
+func (n *previewNotifier) Preview(alert *entity.Alert) (any, error) {
+	return map[string]string{"name": alert.Name, "state": string(alert.State)}, nil
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...any) {}
+func (nopLogger) Info(string, ...any)  {}
+func (nopLogger) Warn(string, ...any)  {}
+func (nopLogger) Error(string, ...any) {}
+
+func TestPreviewAlertUseCase_Execute(t *testing.T) {
+	slack := &previewNotifier{recordingNotifier{name: "slack"}}
+	plain := &recordingNotifier{name: "plain"}
+	uc := NewPreviewAlertUseCase([]Notifier{slack, plain})
+
+	out, err := uc.Execute(context.Background(), dto.ProcessAlertInput{
+		Fingerprint: "fp",
+		Name:        "High CPU",
+		Status:      "resolved",
+		Severity:    entity.SeverityWarning,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"name": "High CPU", "state": "resolved"}, out.Previews["slack"])
+	assert.NotContains(t, out.Previews, "plain")
+	assert.Empty(t, out.Errors)
+	assert.Zero(t, slack.notified)
+}
+
+func TestDryRunNotifier_DoesNotSend(t *testing.T) {
+	inner := &previewNotifier{recordingNotifier{name: "slack"}}
+	n := NewDryRunNotifier(inner, nopLogger{})
+	a := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityWarning)
+
+	id, err := n.Notify(context.Background(), a)
+	require.NoError(t, err)
+	assert.Equal(t, "dry-run:"+a.ID, id)
+	require.NoError(t, n.UpdateMessage(context.Background(), id, a))
+
+	assert.Zero(t, inner.notified)
+	assert.Equal(t, "slack", n.Name())
+}
+
+// lifecycleNotifier records self-tests and closes.
+type lifecycleNotifier struct {
+	recordingNotifier
+	selfTestErr error
+	closed      bool
+}
+
+func (n *lifecycleNotifier) SelfTest(ctx context.Context) error { return n.selfTestErr }
+
+func (n *lifecycleNotifier) Close(ctx context.Context) error {
+	n.closed = true
+	return nil
+}
+
+func TestDryRunNotifier_ForwardsSelfTestAndClose(t *testing.T) {
+	inner := &lifecycleNotifier{recordingNotifier: recordingNotifier{name: "slack"}, selfTestErr: errors.New("invalid_auth")}
+	n := NewDryRunNotifier(inner, nopLogger{})
+
+	assert.EqualError(t, SelfTest(context.Background(), n), "invalid_auth")
+
+	require.NoError(t, Close(context.Background(), n))
+	assert.True(t, inner.closed)
+}
+
+func TestSelfTest_NotSupported(t *testing.T) {
+	err := SelfTest(context.Background(), &recordingNotifier{name: "webhook"})
+	assert.ErrorIs(t, err, entity.ErrSelfTestNotSupported)
+
+	// Wrappers report the wrapped notifier as untestable too
+	err = SelfTest(context.Background(), NewDryRunNotifier(&recordingNotifier{name: "webhook"}, nopLogger{}))
+	assert.ErrorIs(t, err, entity.ErrSelfTestNotSupported)
+}
@@ -2,11 +2,13 @@ package alert
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/logger"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/observability"
 )
@@ -16,8 +18,69 @@ type ProcessAlertUseCase struct {
 	alertRepo   repository.AlertRepository
 	silenceRepo repository.SilenceRepository
 	notifiers   []Notifier
+	enrichers   []Enricher
+	router      *Router
 	logger      Logger
 	metrics     *observability.Metrics
+
+	// deterministicIDs derives alert IDs from fingerprint and fire time.
+	deterministicIDs bool
+
+	// identityLabels lists the labels whose values identify repeated
+	// deliveries of an alert in place of the Alertmanager fingerprint.
+	identityLabels []string
+
+	// correlateBy lists the labels whose shared values group alerts into one Slack thread.
+	correlateBy []string
+
+	// tenantLabel names the label holding the tenant an alert belongs to.
+	tenantLabel string
+
+	// onCall, when set, looks up who is on call for onCallServiceID.
+	onCall          OnCallLookup
+	onCallServiceID string
+
+	// outboxRepo, when set, defers notifications to the outbox dispatcher.
+	outboxRepo repository.OutboxRepository
+	txManager  repository.TransactionManager
+
+	// auditLogger, when set, records every resolution.
+	auditLogger AuditLogger
+
+	// deliveryRepo, when set, records the outcome of every notification.
+	deliveryRepo repository.DeliveryRepository
+
+	// maxLabels and maxLabelValueLen bound incoming alerts; zero is unlimited.
+	maxLabels        int
+	maxLabelValueLen int
+
+	// quietHours, when set, holds back notifications of less urgent alerts.
+	quietHours *QuietHours
+
+	// quietHoursDigest, when set, sends alerts released after quiet hours as one digest.
+	quietHoursDigest DigestNotifier
+
+	// digestOnly lists the severities only reported in digests.
+	digestOnly map[entity.AlertSeverity]bool
+
+	// dependencies, when set, suppresses alerts while a parent alert is firing.
+	dependencies *DependencySuppressor
+
+	// autoResolveAfter, when positive, resolves alerts not re-fired for that long.
+	autoResolveAfter time.Duration
+
+	// ackEventRepo, when set, expires acknowledgments of alerts still firing
+	// after their duration, or ackExpiryDefault for acks without one.
+	ackEventRepo     repository.AckEventRepository
+	ackExpiryDefault time.Duration
+
+	// threadReplier, when set, tells ackers in Slack that their ack expired.
+	threadReplier ThreadReplier
+
+	// burstGuard, when set, caps how many new alerts are notified per
+	// window; overflowNotifier reports the rest in one message.
+	burstGuard       *BurstGuard
+	overflowNotifier OverflowNotifier
 }
 
 // NewProcessAlertUseCase creates a new ProcessAlertUseCase with dependencies.
@@ -37,11 +100,112 @@ func NewProcessAlertUseCase(
 	}
 }
 
+// AddEnricher registers an enrichment step applied to new alerts before they are stored.
+func (uc *ProcessAlertUseCase) AddEnricher(enricher Enricher) {
+	uc.enrichers = append(uc.enrichers, enricher)
+}
+
+// SetRouter sets the router that selects which notifiers receive an alert.
+// Without a router every alert goes to all notifiers.
+func (uc *ProcessAlertUseCase) SetRouter(router *Router) {
+	uc.router = router
+}
+
+// SetDeterministicIDs enables deriving new alert IDs from the fingerprint and
+// fire time instead of generating random ones. Instances sharing a database
+// then store the same firing event under one ID, and an ID collision on save
+// means another instance already notified.
+func (uc *ProcessAlertUseCase) SetDeterministicIDs(enabled bool) {
+	uc.deterministicIDs = enabled
+}
+
+// SetIdentityLabels deduplicates alerts by the values of the given labels
+// instead of the Alertmanager fingerprint, which changes with every label.
+// The derived key is stored as the alert's fingerprint, so silences and
+// PagerDuty dedup keys follow it too. Alerts lacking any of the labels keep
+// their fingerprint.
+func (uc *ProcessAlertUseCase) SetIdentityLabels(labels []string) {
+	uc.identityLabels = labels
+}
+
+// SetCorrelateBy groups new alerts that share the values of the given
+// labels. An alert joining a group with a firing alert already posted to
+// Slack is posted as a reply in that alert's thread.
+func (uc *ProcessAlertUseCase) SetCorrelateBy(labels []string) {
+	uc.correlateBy = labels
+}
+
+// SetTenantLabel assigns alerts to the tenant named by the given label.
+// Alerts without the label belong to the tenant the context is scoped to,
+// e.g. by an X-Tenant-ID header, or else to the default tenant. Alerts are
+// deduplicated, silenced and correlated within their tenant only.
+func (uc *ProcessAlertUseCase) SetTenantLabel(label string) {
+	uc.tenantLabel = label
+}
+
+// SetOnCallLookup annotates new critical alerts with the user on call for
+// the PagerDuty service, which Slack messages show. Alerts are sent without
+// the annotation when the lookup fails.
+func (uc *ProcessAlertUseCase) SetOnCallLookup(lookup OnCallLookup, serviceID string) {
+	uc.onCall = lookup
+	uc.onCallServiceID = serviceID
+}
+
+// SetAuditLogger records every resolved alert in the audit log.
+func (uc *ProcessAlertUseCase) SetAuditLogger(auditLogger AuditLogger) {
+	uc.auditLogger = auditLogger
+}
+
+// SetDeliveryRepository records whether each notification sent or updated
+// reached its notifier, so it can be audited whether an alert paged.
+func (uc *ProcessAlertUseCase) SetDeliveryRepository(repo repository.DeliveryRepository) {
+	uc.deliveryRepo = repo
+}
+
+// SetOutbox records notifications in the outbox within the same transaction
+// as the alert change instead of sending them inline. The outbox dispatcher
+// then delivers them through Deliver, so a crash after the save no longer
+// loses the notification.
+func (uc *ProcessAlertUseCase) SetOutbox(outboxRepo repository.OutboxRepository, txManager repository.TransactionManager) {
+	uc.outboxRepo = outboxRepo
+	uc.txManager = txManager
+}
+
+// SetDigestOnly stores new alerts of the given severities without sending
+// individual notifications, leaving them to the digest. Critical alerts are
+// always notified.
+func (uc *ProcessAlertUseCase) SetDigestOnly(severities []entity.AlertSeverity) {
+	uc.digestOnly = make(map[entity.AlertSeverity]bool, len(severities))
+	for _, severity := range severities {
+		if severity != entity.SeverityCritical {
+			uc.digestOnly[severity] = true
+		}
+	}
+}
+
+// SetQuietHoursDigest makes ReleaseHeld send the alerts released at the end
+// of quiet hours as one digest instead of individual notifications.
+func (uc *ProcessAlertUseCase) SetQuietHoursDigest(notifier DigestNotifier) {
+	uc.quietHoursDigest = notifier
+}
+
 // Execute processes an incoming alert.
-func (uc *ProcessAlertUseCase) Execute(ctx context.Context, input dto.ProcessAlertInput) (*dto.ProcessAlertOutput, error) {
+func (uc *ProcessAlertUseCase) Execute(ctx context.Context, input dto.ProcessAlertInput) (output *dto.ProcessAlertOutput, err error) {
 	start := time.Now()
 	success := false
 
+	ctx, span := observability.StartSpan(ctx, "ProcessAlertUseCase.Execute",
+		observability.AttrAlertFingerprint.String(input.Fingerprint),
+		observability.AttrAlertName.String(input.Name),
+		observability.AttrAlertStatus.String(input.Status),
+	)
+	defer func() {
+		if output != nil && output.AlertID != "" {
+			span.SetAttributes(observability.AttrAlertID.String(output.AlertID))
+		}
+		observability.EndSpan(span, err)
+	}()
+
 	defer func() {
 		duration := time.Since(start)
 		if uc.metrics != nil {
@@ -56,13 +220,31 @@ func (uc *ProcessAlertUseCase) Execute(ctx context.Context, input dto.ProcessAle
 		}
 	}()
 
-	output := &dto.ProcessAlertOutput{}
+	output = &dto.ProcessAlertOutput{}
 
-	// 1. Check if alert exists (by fingerprint)
+	if err := uc.limitLabels(ctx, &input); err != nil {
+		return nil, err
+	}
+
+	// Scope all reads to the alert's tenant
+	tenantID := uc.tenantOf(ctx, input)
+	ctx = repository.NewContextWithTenant(ctx, tenantID)
+
+	// 1. Check if alert exists (by fingerprint, or the configured identity labels)
+	input.Fingerprint = uc.identityKey(input)
 	existing, err := uc.alertRepo.FindByFingerprint(ctx, input.Fingerprint)
 	if err != nil {
 		return nil, fmt.Errorf("finding alert by fingerprint: %w", err)
 	}
+	if uc.findFiringAlert(existing) == nil {
+		legacy, err := uc.findLegacyFiringAlert(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if legacy != nil {
+			existing = append(existing, legacy)
+		}
+	}
 
 	var alert *entity.Alert
 
@@ -72,7 +254,7 @@ func (uc *ProcessAlertUseCase) Execute(ctx context.Context, input dto.ProcessAle
 		alert = uc.findFiringAlert(existing)
 		if alert == nil {
 			// No firing alert to resolve, skip
-			uc.logger.Debug("no firing alert found to resolve",
+			logger.WithContext(ctx, uc.logger).Debug("no firing alert found to resolve",
 				"fingerprint", input.Fingerprint,
 			)
 			success = true
@@ -80,16 +262,33 @@ func (uc *ProcessAlertUseCase) Execute(ctx context.Context, input dto.ProcessAle
 		}
 
 		// Resolve the alert
-		alert.Resolve(time.Now().UTC())
-		if err := uc.alertRepo.Update(ctx, alert); err != nil {
-			return nil, fmt.Errorf("updating resolved alert: %w", err)
+		if err := alert.Resolve("alertmanager", time.Now().UTC()); err != nil {
+			return nil, fmt.Errorf("resolving alert: %w", err)
+		}
+		err := uc.withOutbox(ctx, func(ctx context.Context) error {
+			if err := uc.alertRepo.Update(ctx, alert); err != nil {
+				return fmt.Errorf("updating resolved alert: %w", err)
+			}
+			return uc.enqueue(ctx, alert, entity.OutboxActionUpdate)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if uc.auditLogger != nil {
+			uc.auditLogger.Audit(ctx, entity.NewAuditEvent(entity.AuditActionResolve, "alertmanager", "alertmanager").ForAlert(alert.ID))
 		}
 
 		output.AlertID = alert.ID
 		output.IsNew = false
 
 		// Update notifications to show resolved state
-		uc.updateNotifications(ctx, alert, output)
+		if uc.outboxRepo == nil {
+			uc.updateNotifications(ctx, alert, output)
+		}
+
+		// Alerts it suppressed page now if they still fire
+		uc.ReleaseDependents(ctx, alert)
 
 		success = true
 		return output, nil
@@ -99,11 +298,21 @@ func (uc *ProcessAlertUseCase) Execute(ctx context.Context, input dto.ProcessAle
 	// 3. Check if we already have a firing alert for this fingerprint
 	alert = uc.findFiringAlert(existing)
 	if alert != nil {
+		// A re-fire at another severity escalates or de-escalates the alert
+		if refired := newAlertFromInput(input, uc.enrichers); refired.Severity != alert.Severity {
+			if err := uc.changeSeverity(ctx, alert, refired, output); err != nil {
+				return nil, err
+			}
+			success = true
+			return output, nil
+		}
+
 		// Already have a firing alert, skip (deduplication)
-		uc.logger.Debug("alert already firing, skipping",
+		logger.WithContext(ctx, uc.logger).Debug("alert already firing, skipping",
 			"alertID", alert.ID,
 			"fingerprint", input.Fingerprint,
 		)
+		uc.markSeen(ctx, alert)
 		output.AlertID = alert.ID
 		output.IsNew = false
 		success = true
@@ -111,36 +320,28 @@ func (uc *ProcessAlertUseCase) Execute(ctx context.Context, input dto.ProcessAle
 	}
 
 	// 4. Create new alert
-	alert = entity.NewAlert(
-		input.Fingerprint,
-		input.Name,
-		input.Instance,
-		input.Target,
-		input.Summary,
-		input.Severity,
-	)
-	alert.Description = input.Description
-	alert.FiredAt = input.FiredAt
-
-	// Copy labels and annotations
-	for k, v := range input.Labels {
-		alert.AddLabel(k, v)
-	}
-	for k, v := range input.Annotations {
-		alert.AddAnnotation(k, v)
+	alert = newAlertFromInput(input, uc.enrichers)
+	alert.TenantID = tenantID
+	if uc.deterministicIDs && !alert.FiredAt.IsZero() {
+		key := alert.Fingerprint
+		if tenantID != "" {
+			key = tenantID + "/" + key
+		}
+		alert.ID = entity.DeterministicAlertID(key, alert.FiredAt)
 	}
+	uc.correlate(ctx, alert)
 
 	// 5. Check if alert is silenced
 	silences, err := uc.silenceRepo.FindMatchingAlert(ctx, alert)
 	if err != nil {
-		uc.logger.Warn("failed to check silences",
+		logger.WithContext(ctx, uc.logger).Warn("failed to check silences",
 			"error", err,
 			"alertID", alert.ID,
 		)
 	}
 
 	if len(silences) > 0 {
-		uc.logger.Info("alert is silenced",
+		logger.WithContext(ctx, uc.logger).Info("alert is silenced",
 			"alertID", alert.ID,
 			"silenceID", silences[0].ID,
 			"silenceEndAt", silences[0].EndAt,
@@ -148,31 +349,347 @@ func (uc *ProcessAlertUseCase) Execute(ctx context.Context, input dto.ProcessAle
 		output.IsSilenced = true
 
 		// Still save the alert for tracking, but don't notify
-		if err := uc.alertRepo.Save(ctx, alert); err != nil {
-			return nil, fmt.Errorf("saving silenced alert: %w", err)
+		if err := uc.storeWithoutNotifying(ctx, alert, "silenced", output); err != nil {
+			return nil, err
 		}
+		success = true
+		return output, nil
+	}
 
-		output.AlertID = alert.ID
-		output.IsNew = true
+	// Store digest-only alerts without notifying; the next digest lists them
+	if uc.digestOnly[alert.Severity] {
+		logger.WithContext(ctx, uc.logger).Debug("alert left to the digest",
+			"alertID", alert.ID,
+			"severity", alert.Severity,
+		)
+		output.IsDigested = true
+
+		if err := uc.storeWithoutNotifying(ctx, alert, "digest", output); err != nil {
+			return nil, err
+		}
 		success = true
 		return output, nil
 	}
 
-	// 6. Save alert
-	if err := uc.alertRepo.Save(ctx, alert); err != nil {
+	uc.suppressByDependency(ctx, alert, output)
+
+	// Store alerts held for quiet hours without notifying; ReleaseHeld sends them later
+	if until, held := uc.holdUntil(alert, time.Now()); held {
+		alert.SetExternalReference(entity.QuietHoursReference, until.UTC().Format(time.RFC3339))
+		logger.WithContext(ctx, uc.logger).Info("alert held for quiet hours",
+			"alertID", alert.ID,
+			"severity", alert.Severity,
+			"until", until,
+		)
+		output.IsHeld = true
+
+		if err := uc.storeWithoutNotifying(ctx, alert, "held", output); err != nil {
+			return nil, err
+		}
+		success = true
+		return output, nil
+	}
+
+	// Alerts over the burst limit are paged but held back from chat; FlushOverflow posts them later
+	if uc.throttled(ctx, alert) {
+		alert.SetExternalReference(entity.BurstHeldReference, time.Now().UTC().Format(time.RFC3339))
+		output.IsThrottled = true
+	}
+
+	uc.addOnCall(ctx, alert)
+
+	// 6. Save alert atomically; a concurrent delivery may have created it first
+	var (
+		stored  *entity.Alert
+		created bool
+	)
+	err = uc.withOutbox(ctx, func(ctx context.Context) error {
+		var err error
+		stored, created, err = uc.alertRepo.UpsertByFingerprint(ctx, alert)
+		if err != nil || !created {
+			return err
+		}
+		return uc.enqueue(ctx, alert, entity.OutboxActionNotify)
+	})
+	if uc.isAlreadyNotified(err) {
+		logger.WithContext(ctx, uc.logger).Debug("alert already notified by another instance, skipping notifications",
+			"alertID", alert.ID,
+			"fingerprint", input.Fingerprint,
+		)
+		output.AlertID = alert.ID
+		success = true
+		return output, nil
+	}
+	if err != nil {
 		return nil, fmt.Errorf("saving alert: %w", err)
 	}
 
-	output.AlertID = alert.ID
+	output.AlertID = stored.ID
+	if !created {
+		logger.WithContext(ctx, uc.logger).Debug("alert created concurrently, skipping notifications",
+			"alertID", stored.ID,
+			"fingerprint", input.Fingerprint,
+		)
+		success = true
+		return output, nil
+	}
 	output.IsNew = true
 
-	// 7. Send notifications
-	uc.sendNotifications(ctx, alert, output)
+	// 7. Send notifications, unless the outbox dispatcher delivers them
+	if uc.outboxRepo == nil {
+		uc.sendNotifications(ctx, alert, output)
+	}
 
 	success = true
 	return output, nil
 }
 
+// storeWithoutNotifying saves a new alert that is not notified now, e.g.
+// because it is silenced. kind names the alert in the returned error.
+func (uc *ProcessAlertUseCase) storeWithoutNotifying(ctx context.Context, alert *entity.Alert, kind string, output *dto.ProcessAlertOutput) error {
+	stored, created, err := uc.alertRepo.UpsertByFingerprint(ctx, alert)
+	if uc.isAlreadyNotified(err) {
+		output.AlertID = alert.ID
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("saving %s alert: %w", kind, err)
+	}
+
+	output.AlertID = stored.ID
+	output.IsNew = created
+	return nil
+}
+
+// tenantOf returns the tenant an incoming alert belongs to.
+func (uc *ProcessAlertUseCase) tenantOf(ctx context.Context, input dto.ProcessAlertInput) string {
+	if uc.tenantLabel != "" {
+		if tenantID := input.Labels[uc.tenantLabel]; tenantID != "" {
+			return tenantID
+		}
+	}
+	tenantID, _ := repository.TenantFromContext(ctx)
+	return tenantID
+}
+
+// identityKey returns the key an incoming alert is deduplicated by.
+func (uc *ProcessAlertUseCase) identityKey(input dto.ProcessAlertInput) string {
+	if len(uc.identityLabels) == 0 {
+		return input.Fingerprint
+	}
+	alert := entity.Alert{Fingerprint: input.Fingerprint, Labels: input.Labels}
+	return alert.IdentityKey(uc.identityLabels)
+}
+
+// changeSeverity stores the re-fired alert's severity on the firing alert and
+// updates its notifications, so Slack re-renders and PagerDuty re-triggers the
+// incident at the new severity.
+func (uc *ProcessAlertUseCase) changeSeverity(ctx context.Context, alert, refired *entity.Alert, output *dto.ProcessAlertOutput) error {
+	previous := alert.Severity
+	alert.ChangeSeverity(refired.Severity, time.Now().UTC())
+	alert.MarkSeen(time.Now().UTC())
+	if label := refired.GetLabel(entity.SeverityLabel); label != "" {
+		alert.AddLabel(entity.SeverityLabel, label)
+	}
+
+	// A held alert raised to a severity quiet hours do not cover is sent
+	// now, and so is an alert left to the digest raised to a severity
+	// notified individually
+	release := false
+	if isHeld(alert) {
+		if _, held := uc.holdUntil(alert, time.Now()); !held {
+			alert.RemoveExternalReference(entity.QuietHoursReference)
+			release = true
+		}
+	}
+	if uc.digestOnly[previous] && !uc.digestOnly[alert.Severity] && !uc.notified(alert) {
+		release = true
+	}
+
+	err := uc.withOutbox(ctx, func(ctx context.Context) error {
+		if err := uc.alertRepo.Update(ctx, alert); err != nil {
+			return fmt.Errorf("updating alert severity: %w", err)
+		}
+		if release {
+			return uc.enqueue(ctx, alert, entity.OutboxActionNotify)
+		}
+		return uc.enqueue(ctx, alert, entity.OutboxActionUpdate)
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.WithContext(ctx, uc.logger).Info("alert severity changed on re-fire",
+		"alertID", alert.ID,
+		"fingerprint", alert.Fingerprint,
+		"from", previous,
+		"to", alert.Severity,
+	)
+
+	output.AlertID = alert.ID
+	output.IsNew = false
+	if uc.outboxRepo == nil {
+		if release {
+			uc.sendNotifications(ctx, alert, output)
+		} else {
+			uc.updateNotifications(ctx, alert, output)
+		}
+	}
+	return nil
+}
+
+// correlate stamps the new alert's correlation ID and threads it under the
+// Slack message of the oldest firing alert in its group that has one.
+func (uc *ProcessAlertUseCase) correlate(ctx context.Context, alert *entity.Alert) {
+	alert.CorrelationID = alert.CorrelationKey(uc.correlateBy)
+	if alert.CorrelationID == "" {
+		return
+	}
+
+	group, err := uc.alertRepo.FindFiringByCorrelationID(ctx, alert.CorrelationID)
+	if err != nil {
+		logger.WithContext(ctx, uc.logger).Warn("failed to find correlated alerts",
+			"error", err,
+			"alertID", alert.ID,
+			"correlationID", alert.CorrelationID,
+		)
+		return
+	}
+
+	for _, root := range group {
+		// Replies share their root's thread rather than nesting under each other
+		thread := root.GetExternalReference(entity.SlackThreadReference)
+		if thread == "" {
+			thread = root.GetExternalReference("slack")
+		}
+		if thread == "" {
+			continue
+		}
+
+		alert.SetExternalReference(entity.SlackThreadReference, thread)
+		logger.WithContext(ctx, uc.logger).Debug("alert correlated into existing thread",
+			"alertID", alert.ID,
+			"rootAlertID", root.ID,
+			"correlationID", alert.CorrelationID,
+		)
+		return
+	}
+}
+
+// withOutbox runs fn in a transaction when the outbox is enabled, so the
+// alert change and its outbox entry commit together.
+func (uc *ProcessAlertUseCase) withOutbox(ctx context.Context, fn func(ctx context.Context) error) error {
+	if uc.outboxRepo == nil || uc.txManager == nil {
+		return fn(ctx)
+	}
+	return uc.txManager.WithTransaction(ctx, fn)
+}
+
+// enqueue records a pending notification for the alert when the outbox is enabled.
+func (uc *ProcessAlertUseCase) enqueue(ctx context.Context, alert *entity.Alert, action entity.OutboxAction) error {
+	if uc.outboxRepo == nil {
+		return nil
+	}
+	if err := uc.outboxRepo.Save(ctx, entity.NewOutboxEntry(alert.ID, action)); err != nil {
+		return fmt.Errorf("saving outbox entry: %w", err)
+	}
+	return nil
+}
+
+// Deliver sends the notifications recorded by an outbox entry and returns the
+// joined notifier errors. A retried notify entry skips notifiers that already
+// hold a message ID for the alert, so only the failed ones post again.
+func (uc *ProcessAlertUseCase) Deliver(ctx context.Context, alert *entity.Alert, action entity.OutboxAction) error {
+	output := &dto.ProcessAlertOutput{AlertID: alert.ID}
+
+	switch action {
+	case entity.OutboxActionNotify:
+		uc.sendNotifications(ctx, alert, output)
+	case entity.OutboxActionUpdate:
+		uc.updateNotifications(ctx, alert, output)
+	default:
+		return fmt.Errorf("unknown outbox action %q", action)
+	}
+
+	return output.NotificationErr()
+}
+
+// Renotify re-sends the notifications for a stored alert without processing
+// it again, e.g. to recover after a notifier outage. Existing messages are
+// updated in place and routed notifiers without one post a new message; with
+// force every routed notifier posts a new message instead. Resolved alerts
+// only have their existing messages updated, since a new PagerDuty incident
+// for them would never be resolved.
+// Returns repository.ErrAlertNotFound if the alert does not exist.
+func (uc *ProcessAlertUseCase) Renotify(ctx context.Context, alertID string, force bool) (*dto.ProcessAlertOutput, error) {
+	alert, err := uc.alertRepo.FindByID(ctx, alertID)
+	if err != nil {
+		return nil, fmt.Errorf("finding alert: %w", err)
+	}
+	if alert == nil {
+		return nil, repository.ErrAlertNotFound
+	}
+
+	output := &dto.ProcessAlertOutput{AlertID: alert.ID}
+	switch {
+	case alert.IsResolved():
+		uc.updateNotifications(ctx, alert, output)
+	case force:
+		for _, notifier := range uc.notifiersFor(alert) {
+			uc.notify(ctx, notifier, alert, output)
+		}
+	default:
+		uc.updateNotifications(ctx, alert, output)
+		uc.sendNotifications(ctx, alert, output)
+	}
+
+	logger.WithContext(ctx, uc.logger).Info("alert renotified",
+		"alertID", alert.ID,
+		"force", force,
+		"sent", output.NotificationsSent,
+		"failed", len(output.NotificationsFailed),
+	)
+	return output, nil
+}
+
+// isAlreadyNotified reports whether a save failed because a deterministic ID
+// collided with an alert that was already stored, and therefore notified.
+func (uc *ProcessAlertUseCase) isAlreadyNotified(err error) bool {
+	if !uc.deterministicIDs || err == nil {
+		return false
+	}
+	return errors.Is(err, repository.ErrAlreadyExists)
+}
+
+// newAlertFromInput builds a new alert entity from the input and applies enrichment.
+func newAlertFromInput(input dto.ProcessAlertInput, enrichers []Enricher) *entity.Alert {
+	alert := entity.NewAlert(
+		input.Fingerprint,
+		input.Name,
+		input.Instance,
+		input.Target,
+		input.Summary,
+		input.Severity,
+	)
+	alert.Description = input.Description
+	alert.FiredAt = input.FiredAt
+
+	// Copy labels and annotations
+	for k, v := range input.Labels {
+		alert.AddLabel(k, v)
+	}
+	for k, v := range input.Annotations {
+		alert.AddAnnotation(k, v)
+	}
+
+	// Apply enrichment (e.g., runbook links)
+	for _, enricher := range enrichers {
+		enricher.Enrich(alert)
+	}
+
+	return alert
+}
+
 // findFiringAlert finds a firing (non-resolved) alert from the list.
 func (uc *ProcessAlertUseCase) findFiringAlert(alerts []*entity.Alert) *entity.Alert {
 	for _, alert := range alerts {
@@ -183,33 +700,105 @@ func (uc *ProcessAlertUseCase) findFiringAlert(alerts []*entity.Alert) *entity.A
 	return nil
 }
 
-// sendNotifications sends notifications to all configured notifiers.
-func (uc *ProcessAlertUseCase) sendNotifications(ctx context.Context, alert *entity.Alert, output *dto.ProcessAlertOutput) {
+// findLegacyFiringAlert returns the firing alert stored under the key the
+// input's legacy fingerprint gives, so alerts ingested before an upgrade
+// changed fingerprints keep deduplicating and resolve.
+func (uc *ProcessAlertUseCase) findLegacyFiringAlert(ctx context.Context, input dto.ProcessAlertInput) (*entity.Alert, error) {
+	if input.LegacyFingerprint == "" {
+		return nil, nil
+	}
+	legacyInput := input
+	legacyInput.Fingerprint = input.LegacyFingerprint
+	key := uc.identityKey(legacyInput)
+	if key == input.Fingerprint {
+		return nil, nil
+	}
+	legacy, err := uc.alertRepo.FindByFingerprint(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("finding alert by legacy fingerprint: %w", err)
+	}
+	return uc.findFiringAlert(legacy), nil
+}
+
+// notifiersFor returns the notifiers the router selects for the alert,
+// or all notifiers when there is no router or no route matches. Alerts
+// suppressed by a parent alert only go to Slack, and alerts held back by
+// the burst guard skip chat.
+func (uc *ProcessAlertUseCase) notifiersFor(alert *entity.Alert) []Notifier {
+	notifiers := uc.routedNotifiers(alert)
+	if isSuppressed(alert) {
+		notifiers = onlySlack(notifiers)
+	}
+	if isBurstHeld(alert) {
+		notifiers = withoutChat(notifiers)
+	}
+	return notifiers
+}
+
+// routedNotifiers returns the notifiers the router selects for the alert.
+func (uc *ProcessAlertUseCase) routedNotifiers(alert *entity.Alert) []Notifier {
+	if uc.router == nil {
+		return uc.notifiers
+	}
+	names := uc.router.Match(alert)
+	if len(names) == 0 {
+		return uc.notifiers
+	}
+
+	selected := make(map[string]bool, len(names))
+	for _, name := range names {
+		selected[name] = true
+	}
+	notifiers := make([]Notifier, 0, len(names))
 	for _, notifier := range uc.notifiers {
-		messageID, err := notifier.Notify(ctx, alert)
-		if err != nil {
-			uc.logger.Error("notification failed",
-				"notifier", notifier.Name(),
-				"alertID", alert.ID,
-				"error", err,
-			)
-			output.NotificationsFailed = append(output.NotificationsFailed, dto.NotificationError{
-				NotifierName: notifier.Name(),
-				Error:        err,
-			})
+		if selected[notifier.Name()] {
+			notifiers = append(notifiers, notifier)
+		}
+	}
+	return notifiers
+}
+
+// sendNotifications sends notifications to the notifiers routed for the alert.
+// Each notifier is called independently: a failure is recorded in the output
+// and does not stop the others, whose message IDs are still stored.
+func (uc *ProcessAlertUseCase) sendNotifications(ctx context.Context, alert *entity.Alert, output *dto.ProcessAlertOutput) {
+	for _, notifier := range uc.notifiersFor(alert) {
+		// Already delivered by an earlier attempt
+		if uc.getMessageID(alert, notifier.Name()) != "" {
 			continue
 		}
 
-		// Store message ID for later updates
-		uc.storeMessageID(ctx, alert, notifier.Name(), messageID)
-		output.NotificationsSent = append(output.NotificationsSent, notifier.Name())
+		uc.notify(ctx, notifier, alert, output)
+	}
+}
 
-		uc.logger.Info("notification sent",
+// notify posts a new notification for the alert and stores its message ID.
+func (uc *ProcessAlertUseCase) notify(ctx context.Context, notifier Notifier, alert *entity.Alert, output *dto.ProcessAlertOutput) {
+	messageID, err := notifier.Notify(ctx, alert)
+	if err != nil {
+		logger.WithContext(ctx, uc.logger).Error("notification failed",
 			"notifier", notifier.Name(),
 			"alertID", alert.ID,
-			"messageID", messageID,
+			"error", err,
 		)
+		output.NotificationsFailed = append(output.NotificationsFailed, dto.NotificationError{
+			NotifierName: notifier.Name(),
+			Error:        err,
+		})
+		uc.recordDelivery(ctx, entity.NewFailedDelivery(alert.ID, notifier.Name(), entity.OutboxActionNotify, err))
+		return
 	}
+
+	// Store message ID for later updates
+	uc.storeMessageID(ctx, alert, notifier.Name(), messageID)
+	uc.recordDelivery(ctx, entity.NewSentDelivery(alert.ID, notifier.Name(), entity.OutboxActionNotify, messageID))
+	output.NotificationsSent = append(output.NotificationsSent, notifier.Name())
+
+	logger.WithContext(ctx, uc.logger).Info("notification sent",
+		"notifier", notifier.Name(),
+		"alertID", alert.ID,
+		"messageID", messageID,
+	)
 }
 
 // updateNotifications updates existing notifications for resolved/acked alerts.
@@ -220,8 +809,21 @@ func (uc *ProcessAlertUseCase) updateNotifications(ctx context.Context, alert *e
 			continue
 		}
 
-		if err := notifier.UpdateMessage(ctx, messageID, alert); err != nil {
-			uc.logger.Error("failed to update notification",
+		err := notifier.UpdateMessage(ctx, messageID, alert)
+
+		// The notifier may have re-posted a missing message under a new ID
+		if newMessageID := uc.getMessageID(alert, notifier.Name()); newMessageID != messageID {
+			if updateErr := uc.alertRepo.Update(ctx, alert); updateErr != nil {
+				logger.WithContext(ctx, uc.logger).Error("failed to store re-posted message ID",
+					"notifier", notifier.Name(),
+					"alertID", alert.ID,
+					"error", updateErr,
+				)
+			}
+		}
+
+		if err != nil {
+			logger.WithContext(ctx, uc.logger).Error("failed to update notification",
 				"notifier", notifier.Name(),
 				"alertID", alert.ID,
 				"messageID", messageID,
@@ -231,10 +833,30 @@ func (uc *ProcessAlertUseCase) updateNotifications(ctx context.Context, alert *e
 				NotifierName: notifier.Name(),
 				Error:        err,
 			})
+			uc.recordDelivery(ctx, entity.NewFailedDelivery(alert.ID, notifier.Name(), entity.OutboxActionUpdate, err))
 			continue
 		}
 
 		output.NotificationsSent = append(output.NotificationsSent, notifier.Name())
+		uc.recordDelivery(ctx, entity.NewSentDelivery(alert.ID, notifier.Name(), entity.OutboxActionUpdate,
+			uc.getMessageID(alert, notifier.Name())))
+	}
+}
+
+// recordDelivery stores the outcome of a notification attempt. Failing to
+// record it is logged and does not fail the notification.
+func (uc *ProcessAlertUseCase) recordDelivery(ctx context.Context, delivery *entity.NotificationDelivery) {
+	if uc.deliveryRepo == nil {
+		return
+	}
+
+	if err := uc.deliveryRepo.Save(ctx, delivery); err != nil {
+		logger.WithContext(ctx, uc.logger).Warn("failed to record notification delivery",
+			"notifier", delivery.Notifier,
+			"alertID", delivery.AlertID,
+			"status", string(delivery.Status),
+			"error", err,
+		)
 	}
 }
 
@@ -244,7 +866,7 @@ func (uc *ProcessAlertUseCase) storeMessageID(ctx context.Context, alert *entity
 
 	// Update the alert with the new message ID
 	if err := uc.alertRepo.Update(ctx, alert); err != nil {
-		uc.logger.Error("failed to store message ID",
+		logger.WithContext(ctx, uc.logger).Error("failed to store message ID",
 			"notifier", notifierName,
 			"alertID", alert.ID,
 			"error", err,
@@ -252,6 +874,16 @@ func (uc *ProcessAlertUseCase) storeMessageID(ctx context.Context, alert *entity
 	}
 }
 
+// notified reports whether any notifier holds a message for the alert.
+func (uc *ProcessAlertUseCase) notified(alert *entity.Alert) bool {
+	for _, notifier := range uc.notifiers {
+		if uc.getMessageID(alert, notifier.Name()) != "" {
+			return true
+		}
+	}
+	return false
+}
+
 // getMessageID retrieves the message ID for a notifier.
 func (uc *ProcessAlertUseCase) getMessageID(alert *entity.Alert, notifierName string) string {
 	return alert.GetExternalReference(notifierName)
@@ -0,0 +1,497 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+// TestProcessAlert_LateDeliveryFromSecondInstance replays a firing event on a
+// second instance after the first one has already notified and resolved it.
+func TestProcessAlert_LateDeliveryFromSecondInstance(t *testing.T) {
+	firedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	firing := dto.ProcessAlertInput{
+		Fingerprint: "fp",
+		Name:        "High CPU",
+		Severity:    entity.SeverityCritical,
+		Status:      "firing",
+		FiredAt:     firedAt,
+	}
+	resolved := firing
+	resolved.Status = "resolved"
+
+	tests := []struct {
+		name             string
+		deterministicIDs bool
+		wantLateNotified int
+	}{
+		{name: "random IDs notify again", deterministicIDs: false, wantLateNotified: 1},
+		{name: "deterministic IDs notify once", deterministicIDs: true, wantLateNotified: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			alertRepo := memory.NewAlertRepository()
+			silenceRepo := memory.NewSilenceRepository()
+
+			notifierA := &recordingNotifier{name: "slack"}
+			instanceA := NewProcessAlertUseCase(alertRepo, silenceRepo, []Notifier{notifierA}, nopLogger{}, nil)
+			instanceA.SetDeterministicIDs(tt.deterministicIDs)
+
+			notifierB := &recordingNotifier{name: "slack"}
+			instanceB := NewProcessAlertUseCase(alertRepo, silenceRepo, []Notifier{notifierB}, nopLogger{}, nil)
+			instanceB.SetDeterministicIDs(tt.deterministicIDs)
+
+			first, err := instanceA.Execute(ctx, firing)
+			require.NoError(t, err)
+			assert.True(t, first.IsNew)
+			if tt.deterministicIDs {
+				assert.Equal(t, entity.DeterministicAlertID("fp", firedAt), first.AlertID)
+			}
+
+			_, err = instanceA.Execute(ctx, resolved)
+			require.NoError(t, err)
+
+			late, err := instanceB.Execute(ctx, firing)
+			require.NoError(t, err)
+			assert.Equal(t, !tt.deterministicIDs, late.IsNew)
+			if tt.deterministicIDs {
+				assert.Equal(t, first.AlertID, late.AlertID)
+			}
+
+			assert.Equal(t, tt.wantLateNotified, notifierB.notified)
+		})
+	}
+}
+
+// severityNotifier records the severity of every update it receives.
+type severityNotifier struct {
+	recordingNotifier
+	updates []entity.AlertSeverity
+}
+
+func (n *severityNotifier) UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error {
+	n.updates = append(n.updates, alert.Severity)
+	return nil
+}
+
+func TestProcessAlert_RefireChangesSeverity(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	notifier := &severityNotifier{recordingNotifier: recordingNotifier{name: "slack"}}
+	uc := NewProcessAlertUseCase(alertRepo, memory.NewSilenceRepository(), []Notifier{notifier}, nopLogger{}, nil)
+
+	firing := func(severity entity.AlertSeverity) dto.ProcessAlertInput {
+		return dto.ProcessAlertInput{
+			Fingerprint: "fp",
+			Name:        "High CPU",
+			Severity:    severity,
+			Status:      "firing",
+			FiredAt:     time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		}
+	}
+
+	first, err := uc.Execute(ctx, firing(entity.SeverityWarning))
+	require.NoError(t, err)
+	require.True(t, first.IsNew)
+
+	steps := []struct {
+		name        string
+		severity    entity.AlertSeverity
+		wantUpdates []entity.AlertSeverity
+	}{
+		{name: "upgrade", severity: entity.SeverityCritical, wantUpdates: []entity.AlertSeverity{entity.SeverityCritical}},
+		{name: "same severity is deduplicated", severity: entity.SeverityCritical, wantUpdates: []entity.AlertSeverity{entity.SeverityCritical}},
+		{name: "downgrade", severity: entity.SeverityInfo, wantUpdates: []entity.AlertSeverity{entity.SeverityCritical, entity.SeverityInfo}},
+	}
+	for _, step := range steps {
+		output, err := uc.Execute(ctx, firing(step.severity))
+		require.NoError(t, err, step.name)
+		assert.False(t, output.IsNew, step.name)
+		assert.Equal(t, first.AlertID, output.AlertID, step.name)
+		assert.Equal(t, step.wantUpdates, notifier.updates, step.name)
+
+		stored, err := alertRepo.FindByID(ctx, first.AlertID)
+		require.NoError(t, err)
+		assert.Equal(t, step.severity, stored.Severity, step.name)
+	}
+
+	assert.Equal(t, 1, notifier.notified)
+}
+
+func TestProcessAlert_Renotify(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	slack := &severityNotifier{recordingNotifier: recordingNotifier{name: "slack"}}
+	uc := NewProcessAlertUseCase(alertRepo, memory.NewSilenceRepository(), []Notifier{slack}, nopLogger{}, nil)
+
+	first, err := uc.Execute(ctx, dto.ProcessAlertInput{
+		Fingerprint: "fp",
+		Name:        "High CPU",
+		Severity:    entity.SeverityCritical,
+		Status:      "firing",
+		FiredAt:     time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, slack.notified)
+
+	// A notifier added later has no message yet and posts one
+	pagerduty := &recordingNotifier{name: "pagerduty"}
+	uc.notifiers = append(uc.notifiers, pagerduty)
+
+	output, err := uc.Renotify(ctx, first.AlertID, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"slack", "pagerduty"}, output.NotificationsSent)
+	assert.Equal(t, 1, slack.notified)
+	assert.Len(t, slack.updates, 1)
+	assert.Equal(t, 1, pagerduty.notified)
+
+	stored, err := alertRepo.FindByID(ctx, first.AlertID)
+	require.NoError(t, err)
+	assert.Equal(t, "msg", stored.GetExternalReference("pagerduty"))
+
+	// Forcing posts new messages everywhere
+	output, err = uc.Renotify(ctx, first.AlertID, true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"slack", "pagerduty"}, output.NotificationsSent)
+	assert.Equal(t, 2, slack.notified)
+	assert.Len(t, slack.updates, 1)
+	assert.Equal(t, 2, pagerduty.notified)
+
+	_, err = uc.Renotify(ctx, "missing", false)
+	assert.ErrorIs(t, err, repository.ErrAlertNotFound)
+}
+
+func TestProcessAlert_RenotifyResolved(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	silenceRepo := memory.NewSilenceRepository()
+	slack := &recordingNotifier{name: "slack"}
+	pagerduty := &recordingNotifier{name: "pagerduty"}
+	uc := NewProcessAlertUseCase(alertRepo, silenceRepo, []Notifier{slack, pagerduty}, nopLogger{}, nil)
+
+	// A silenced alert never got a message, let alone an incident
+	silence, err := entity.NewSilenceMark(time.Hour, "oncall", "oncall@example.com", entity.AckSourceSlack)
+	require.NoError(t, err)
+	silence.Fingerprint = "fp"
+	require.NoError(t, silenceRepo.Save(ctx, silence))
+
+	input := dto.ProcessAlertInput{
+		Fingerprint: "fp",
+		Name:        "High CPU",
+		Severity:    entity.SeverityCritical,
+		Status:      "firing",
+		FiredAt:     time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	first, err := uc.Execute(ctx, input)
+	require.NoError(t, err)
+	require.True(t, first.IsSilenced)
+	input.Status = "resolved"
+	_, err = uc.Execute(ctx, input)
+	require.NoError(t, err)
+
+	for _, force := range []bool{false, true} {
+		output, err := uc.Renotify(ctx, first.AlertID, force)
+		require.NoError(t, err)
+		assert.Empty(t, output.NotificationsSent, "force=%v", force)
+	}
+	assert.Zero(t, slack.notified)
+	assert.Zero(t, pagerduty.notified)
+}
+
+// threadNotifier records the Slack thread each new alert is posted under.
+type threadNotifier struct {
+	recordingNotifier
+	threads []string
+}
+
+func (n *threadNotifier) Notify(ctx context.Context, alert *entity.Alert) (string, error) {
+	n.notified++
+	n.threads = append(n.threads, alert.GetExternalReference(entity.SlackThreadReference))
+	return fmt.Sprintf("C1:%d", n.notified), nil
+}
+
+func TestProcessAlert_CorrelatesIntoThreads(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	notifier := &threadNotifier{recordingNotifier: recordingNotifier{name: "slack"}}
+	uc := NewProcessAlertUseCase(alertRepo, memory.NewSilenceRepository(), []Notifier{notifier}, nopLogger{}, nil)
+	uc.SetCorrelateBy([]string{"service", "cluster"})
+
+	firedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	fire := func(fingerprint string, labels map[string]string) *entity.Alert {
+		t.Helper()
+		firedAt = firedAt.Add(time.Minute)
+		output, err := uc.Execute(ctx, dto.ProcessAlertInput{
+			Fingerprint: fingerprint,
+			Name:        "High Latency",
+			Severity:    entity.SeverityCritical,
+			Status:      "firing",
+			Labels:      labels,
+			FiredAt:     firedAt,
+		})
+		require.NoError(t, err)
+		require.True(t, output.IsNew)
+
+		stored, err := alertRepo.FindByID(ctx, output.AlertID)
+		require.NoError(t, err)
+		return stored
+	}
+
+	apiRoot := fire("fp-api-1", map[string]string{"cluster": "prod", "service": "api"})
+	apiReply := fire("fp-api-2", map[string]string{"cluster": "prod", "service": "api", "pod": "api-2"})
+	dbRoot := fire("fp-db-1", map[string]string{"cluster": "prod", "service": "db"})
+	apiLate := fire("fp-api-3", map[string]string{"cluster": "prod", "service": "api"})
+	uncorrelated := fire("fp-node", map[string]string{"cluster": "prod"})
+
+	// The first alert of each group starts a thread, later ones reply in it
+	assert.Equal(t, []string{"", "C1:1", "", "C1:1", ""}, notifier.threads)
+
+	assert.NotEmpty(t, apiRoot.CorrelationID)
+	assert.Equal(t, apiRoot.CorrelationID, apiReply.CorrelationID)
+	assert.Equal(t, apiRoot.CorrelationID, apiLate.CorrelationID)
+	assert.NotEqual(t, apiRoot.CorrelationID, dbRoot.CorrelationID)
+	assert.Empty(t, uncorrelated.CorrelationID)
+
+	// A group whose alerts all resolved starts a new thread
+	_, err := uc.Execute(ctx, dto.ProcessAlertInput{Fingerprint: "fp-db-1", Status: "resolved"})
+	require.NoError(t, err)
+	fire("fp-db-2", map[string]string{"cluster": "prod", "service": "db"})
+	assert.Equal(t, "", notifier.threads[len(notifier.threads)-1])
+}
+
+func TestProcessAlert_IdentityLabels(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	notifier := &recordingNotifier{name: "slack"}
+	uc := NewProcessAlertUseCase(alertRepo, memory.NewSilenceRepository(), []Notifier{notifier}, nopLogger{}, nil)
+	uc.SetIdentityLabels([]string{"instance", "alertname"})
+
+	// Alertmanager computes a new fingerprint whenever the pod label changes
+	delivery := func(fingerprint, pod, status string) dto.ProcessAlertInput {
+		return dto.ProcessAlertInput{
+			Fingerprint: fingerprint,
+			Name:        "High CPU",
+			Instance:    "host-1",
+			Severity:    entity.SeverityCritical,
+			Status:      status,
+			Labels:      map[string]string{"alertname": "High CPU", "instance": "host-1", "pod": pod},
+			FiredAt:     time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		}
+	}
+
+	first, err := uc.Execute(ctx, delivery("fp-1", "web-1", "firing"))
+	require.NoError(t, err)
+	require.True(t, first.IsNew)
+
+	again, err := uc.Execute(ctx, delivery("fp-2", "web-2", "firing"))
+	require.NoError(t, err)
+	assert.False(t, again.IsNew)
+	assert.Equal(t, first.AlertID, again.AlertID)
+	assert.Equal(t, 1, notifier.notified)
+
+	_, err = uc.Execute(ctx, delivery("fp-3", "web-3", "resolved"))
+	require.NoError(t, err)
+	stored, err := alertRepo.FindByID(ctx, first.AlertID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.StateResolved, stored.State)
+
+	// Alerts lacking an identity label keep their fingerprint
+	partial := delivery("fp-4", "web-4", "firing")
+	delete(partial.Labels, "instance")
+	output, err := uc.Execute(ctx, partial)
+	require.NoError(t, err)
+	stored, err = alertRepo.FindByID(ctx, output.AlertID)
+	require.NoError(t, err)
+	assert.Equal(t, "fp-4", stored.Fingerprint)
+}
+
+func TestProcessAlert_TenantLabel(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	silenceRepo := memory.NewSilenceRepository()
+	notifier := &recordingNotifier{name: "slack"}
+	uc := NewProcessAlertUseCase(alertRepo, silenceRepo, []Notifier{notifier}, nopLogger{}, nil)
+	uc.SetTenantLabel("team")
+
+	delivery := func(team string) dto.ProcessAlertInput {
+		return dto.ProcessAlertInput{
+			Fingerprint: "fp",
+			Name:        "High CPU",
+			Severity:    entity.SeverityCritical,
+			Status:      "firing",
+			Labels:      map[string]string{"alertname": "High CPU", "team": team},
+		}
+	}
+
+	// A silence of one tenant does not silence another tenant's alerts
+	silence, err := entity.NewSilenceMark(time.Hour, "tester", "tester@example.com", entity.AckSourceAPI)
+	require.NoError(t, err)
+	silence.ForFingerprint("fp").ForTenant("team-b")
+	require.NoError(t, silenceRepo.Save(ctx, silence))
+
+	first, err := uc.Execute(ctx, delivery("team-a"))
+	require.NoError(t, err)
+	assert.True(t, first.IsNew)
+	assert.False(t, first.IsSilenced)
+
+	// The same fingerprint is a separate alert in another tenant
+	second, err := uc.Execute(ctx, delivery("team-b"))
+	require.NoError(t, err)
+	assert.True(t, second.IsNew)
+	assert.True(t, second.IsSilenced)
+	assert.NotEqual(t, first.AlertID, second.AlertID)
+
+	// Without the label the tenant the request is scoped to applies
+	scoped, err := uc.Execute(repository.NewContextWithTenant(ctx, "team-a"), delivery(""))
+	require.NoError(t, err)
+	assert.Equal(t, first.AlertID, scoped.AlertID)
+
+	stored, err := alertRepo.FindByID(ctx, second.AlertID)
+	require.NoError(t, err)
+	assert.Equal(t, "team-b", stored.TenantID)
+	assert.Equal(t, 1, notifier.notified)
+
+	// A global silence, e.g. imported from Alertmanager, applies to every tenant
+	global, err := entity.NewSilenceMark(time.Hour, "tester", "tester@example.com", entity.AckSourceAPI)
+	require.NoError(t, err)
+	global.ForFingerprint("fp-global")
+	require.NoError(t, silenceRepo.Save(ctx, global))
+
+	for _, team := range []string{"team-a", "team-b"} {
+		input := delivery(team)
+		input.Fingerprint = "fp-global"
+		output, err := uc.Execute(ctx, input)
+		require.NoError(t, err)
+		assert.True(t, output.IsSilenced, team)
+	}
+}
+
+// failingNotifier fails every notification.
+type failingNotifier struct{ recordingNotifier }
+
+func (n *failingNotifier) Notify(ctx context.Context, alert *entity.Alert) (string, error) {
+	n.notified++
+	return "", fmt.Errorf("%s is down", n.name)
+}
+
+func TestProcessAlert_NotifierFailureDoesNotBlockOthers(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	slack := &failingNotifier{recordingNotifier{name: "slack"}}
+	pagerDuty := &recordingNotifier{name: "pagerduty"}
+	uc := NewProcessAlertUseCase(alertRepo, memory.NewSilenceRepository(), []Notifier{slack, pagerDuty}, nopLogger{}, nil)
+
+	output, err := uc.Execute(ctx, dto.ProcessAlertInput{
+		Fingerprint: "fp",
+		Name:        "High CPU",
+		Severity:    entity.SeverityCritical,
+		Status:      "firing",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, slack.notified)
+	assert.Equal(t, 1, pagerDuty.notified)
+	assert.Equal(t, []string{"pagerduty"}, output.NotificationsSent)
+	assert.ErrorContains(t, output.NotificationErr(), "slack: slack is down")
+
+	stored, err := alertRepo.FindByID(ctx, output.AlertID)
+	require.NoError(t, err)
+	assert.Equal(t, "msg", stored.GetExternalReference("pagerduty"))
+	assert.False(t, stored.HasExternalReference("slack"))
+}
+
+func TestProcessAlert_RecordsDeliveries(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	deliveryRepo := memory.NewDeliveryRepository()
+	slack := &failingNotifier{recordingNotifier{name: "slack"}}
+	pagerDuty := &recordingNotifier{name: "pagerduty"}
+	uc := NewProcessAlertUseCase(alertRepo, memory.NewSilenceRepository(), []Notifier{slack, pagerDuty}, nopLogger{}, nil)
+	uc.SetDeliveryRepository(deliveryRepo)
+
+	input := dto.ProcessAlertInput{
+		Fingerprint: "fp",
+		Name:        "High CPU",
+		Severity:    entity.SeverityCritical,
+		Status:      "firing",
+	}
+	output, err := uc.Execute(ctx, input)
+	require.NoError(t, err)
+
+	input.Status = "resolved"
+	_, err = uc.Execute(ctx, input)
+	require.NoError(t, err)
+
+	deliveries, err := NewDeliveriesUseCase(alertRepo, deliveryRepo).Execute(ctx, output.AlertID)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 3)
+
+	assert.Equal(t, "slack", deliveries[0].Notifier)
+	assert.Equal(t, "notify", deliveries[0].Action)
+	assert.Equal(t, "failed", deliveries[0].Status)
+	assert.Equal(t, "slack is down", deliveries[0].Error)
+
+	assert.Equal(t, "pagerduty", deliveries[1].Notifier)
+	assert.Equal(t, "notify", deliveries[1].Action)
+	assert.Equal(t, "sent", deliveries[1].Status)
+	assert.Equal(t, "msg", deliveries[1].ExternalReference)
+
+	// Slack was never posted, so only PagerDuty is updated on resolve
+	assert.Equal(t, "pagerduty", deliveries[2].Notifier)
+	assert.Equal(t, "update", deliveries[2].Action)
+	assert.Equal(t, "sent", deliveries[2].Status)
+
+	_, err = NewDeliveriesUseCase(alertRepo, deliveryRepo).Execute(ctx, "missing")
+	assert.ErrorIs(t, err, repository.ErrAlertNotFound)
+}
+
+// stubOnCall returns a fixed on-call user or error.
+type stubOnCall struct {
+	name string
+	err  error
+}
+
+func (s stubOnCall) GetOnCall(ctx context.Context, serviceID string) (string, error) {
+	return s.name, s.err
+}
+
+func TestProcessAlert_OnCallAnnotation(t *testing.T) {
+	ctx := context.Background()
+	fire := func(lookup OnCallLookup, fingerprint string, severity entity.AlertSeverity) *entity.Alert {
+		alertRepo := memory.NewAlertRepository()
+		uc := NewProcessAlertUseCase(alertRepo, memory.NewSilenceRepository(), nil, nopLogger{}, nil)
+		uc.SetOnCallLookup(lookup, "PSERVICE")
+
+		output, err := uc.Execute(ctx, dto.ProcessAlertInput{
+			Fingerprint: fingerprint,
+			Name:        "High CPU",
+			Severity:    severity,
+			Status:      "firing",
+		})
+		require.NoError(t, err)
+		stored, err := alertRepo.FindByID(ctx, output.AlertID)
+		require.NoError(t, err)
+		return stored
+	}
+
+	critical := fire(stubOnCall{name: "Alice Primary"}, "fp-1", entity.SeverityCritical)
+	assert.Equal(t, "Alice Primary", critical.GetAnnotation(OnCallAnnotation))
+
+	warning := fire(stubOnCall{name: "Alice Primary"}, "fp-2", entity.SeverityWarning)
+	assert.Empty(t, warning.GetAnnotation(OnCallAnnotation))
+
+	// A failed lookup still notifies, without the annotation
+	failed := fire(stubOnCall{err: fmt.Errorf("pagerduty unavailable")}, "fp-3", entity.SeverityCritical)
+	assert.Empty(t, failed.GetAnnotation(OnCallAnnotation))
+}
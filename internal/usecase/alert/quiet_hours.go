@@ -0,0 +1,230 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/logger"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+)
+
+// DefaultQuietHoursReleaseInterval is how often held alerts are checked for
+// the end of their quiet hours.
+const DefaultQuietHoursReleaseInterval = time.Minute
+
+// QuietHours is a daily window during which the notifications of less
+// urgent alerts are held back. Critical alerts always break through.
+type QuietHours struct {
+	start, end int // minutes after midnight
+	location   *time.Location
+	severities map[entity.AlertSeverity]bool
+}
+
+// NewQuietHours creates a window from start to end, both "HH:MM" in the
+// given location (UTC if nil). An end before the start spans midnight.
+func NewQuietHours(start, end string, location *time.Location, severities []entity.AlertSeverity) (*QuietHours, error) {
+	startMinute, err := parseTimeOfDay(start)
+	if err != nil {
+		return nil, fmt.Errorf("quiet hours start: %w", err)
+	}
+	endMinute, err := parseTimeOfDay(end)
+	if err != nil {
+		return nil, fmt.Errorf("quiet hours end: %w", err)
+	}
+	if startMinute == endMinute {
+		return nil, errors.New("quiet hours start and end must differ")
+	}
+	if location == nil {
+		location = time.UTC
+	}
+
+	held := make(map[entity.AlertSeverity]bool, len(severities))
+	for _, severity := range severities {
+		if severity == entity.SeverityCritical {
+			return nil, errors.New("critical alerts cannot be held for quiet hours")
+		}
+		held[severity] = true
+	}
+
+	return &QuietHours{
+		start:      startMinute,
+		end:        endMinute,
+		location:   location,
+		severities: held,
+	}, nil
+}
+
+// parseTimeOfDay returns the minutes after midnight of an "HH:MM" time.
+func parseTimeOfDay(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q, want HH:MM", value)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// Until returns when the window containing now ends, or the zero time if
+// now is outside quiet hours. Both bounds are wall clock times, so the
+// window keeps its local hours across daylight saving changes.
+func (q *QuietHours) Until(now time.Time) time.Time {
+	local := now.In(q.location)
+	year, month, day := local.Date()
+	at := func(day, minute int) time.Time {
+		return time.Date(year, month, day, minute/60, minute%60, 0, 0, q.location)
+	}
+
+	start, end := at(day, q.start), at(day, q.end)
+	if q.start < q.end {
+		if !local.Before(start) && local.Before(end) {
+			return end
+		}
+		return time.Time{}
+	}
+
+	// The window spans midnight
+	if !local.Before(start) {
+		return at(day+1, q.end)
+	}
+	if local.Before(end) {
+		return end
+	}
+	return time.Time{}
+}
+
+// Hold returns until when the alert's notifications are held back at now.
+// It reports false for critical alerts, severities quiet hours do not
+// cover, and times outside the window.
+func (q *QuietHours) Hold(alert *entity.Alert, now time.Time) (time.Time, bool) {
+	if alert.Severity == entity.SeverityCritical || !q.severities[alert.Severity] {
+		return time.Time{}, false
+	}
+	until := q.Until(now)
+	return until, !until.IsZero()
+}
+
+// SetQuietHours holds back the notifications of new alerts that quiet hours
+// cover until the window ends. Held alerts are stored as usual, and sent by
+// ReleaseHeld once the window is over or when a re-fire raises them to a
+// severity that breaks through.
+func (uc *ProcessAlertUseCase) SetQuietHours(quietHours *QuietHours) {
+	uc.quietHours = quietHours
+}
+
+// holdUntil returns until when the alert's notifications are held back.
+func (uc *ProcessAlertUseCase) holdUntil(alert *entity.Alert, now time.Time) (time.Time, bool) {
+	if uc.quietHours == nil {
+		return time.Time{}, false
+	}
+	return uc.quietHours.Hold(alert, now)
+}
+
+// isHeld reports whether the alert's notifications are still being held back.
+func isHeld(alert *entity.Alert) bool {
+	return alert.GetExternalReference(entity.QuietHoursReference) != ""
+}
+
+// ReleaseHeld sends the notifications of active alerts whose quiet hours
+// ended by now and returns how many alerts it released. Alerts resolved or
+// acknowledged in the meantime stay unnotified. With a quiet hours digest
+// the released alerts are sent as one digest instead.
+func (uc *ProcessAlertUseCase) ReleaseHeld(ctx context.Context, now time.Time) (int, error) {
+	alerts, err := uc.alertRepo.GetActiveAlerts(ctx, "")
+	if err != nil {
+		return 0, fmt.Errorf("getting active alerts: %w", err)
+	}
+
+	released := 0
+	var digest []*entity.Alert
+	for _, alert := range alerts {
+		if !isHeld(alert) || !alert.IsActive() {
+			continue
+		}
+		until, err := time.Parse(time.RFC3339, alert.GetExternalReference(entity.QuietHoursReference))
+		if err == nil && now.Before(until) {
+			continue
+		}
+
+		if uc.quietHoursDigest != nil {
+			digest = append(digest, alert)
+			continue
+		}
+
+		output := &dto.ProcessAlertOutput{AlertID: alert.ID}
+		if err := uc.release(repository.NewContextWithTenant(ctx, alert.TenantID), alert, entity.QuietHoursReference, output); err != nil {
+			// Another instance released it first
+			if errors.Is(err, repository.ErrConcurrentUpdate) {
+				continue
+			}
+			return released, err
+		}
+		released++
+		logger.WithContext(ctx, uc.logger).Info("released alert held for quiet hours",
+			"alertID", alert.ID,
+			"sent", output.NotificationsSent,
+			"failed", len(output.NotificationsFailed),
+		)
+	}
+
+	if len(digest) == 0 {
+		return released, nil
+	}
+
+	// Lift the holds only once the digest is out, so a failed one is retried
+	entity.DefaultSeverityWeights().SortByPriority(digest)
+	if err := uc.quietHoursDigest.NotifyDigest(ctx, digest); err != nil {
+		return released, fmt.Errorf("sending quiet hours digest: %w", err)
+	}
+	for _, alert := range digest {
+		alert.RemoveExternalReference(entity.QuietHoursReference)
+		if err := uc.alertRepo.Update(repository.NewContextWithTenant(ctx, alert.TenantID), alert); err != nil && !errors.Is(err, repository.ErrConcurrentUpdate) {
+			return released, fmt.Errorf("updating released alert: %w", err)
+		}
+		released++
+	}
+	logger.WithContext(ctx, uc.logger).Info("released alerts held for quiet hours as a digest", "alerts", len(digest))
+	return released, nil
+}
+
+// release lifts the hold kept in the given reference on an alert and sends
+// the notifications it held back.
+func (uc *ProcessAlertUseCase) release(ctx context.Context, alert *entity.Alert, reference string, output *dto.ProcessAlertOutput) error {
+	alert.RemoveExternalReference(reference)
+	err := uc.withOutbox(ctx, func(ctx context.Context) error {
+		if err := uc.alertRepo.Update(ctx, alert); err != nil {
+			return fmt.Errorf("updating released alert: %w", err)
+		}
+		return uc.enqueue(ctx, alert, entity.OutboxActionNotify)
+	})
+	if err != nil {
+		return err
+	}
+	if uc.outboxRepo == nil {
+		uc.sendNotifications(ctx, alert, output)
+	}
+	return nil
+}
+
+// RunQuietHoursRelease calls ReleaseHeld every interval until ctx is cancelled.
+func (uc *ProcessAlertUseCase) RunQuietHoursRelease(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultQuietHoursReleaseInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if _, err := uc.ReleaseHeld(ctx, time.Now()); err != nil && ctx.Err() == nil {
+			logger.WithContext(ctx, uc.logger).Error("releasing alerts held for quiet hours failed", "error", err)
+		}
+	}
+}
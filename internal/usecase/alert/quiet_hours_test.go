@@ -0,0 +1,167 @@
+package alert
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+func TestQuietHours_Until(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		start, end string
+		location   *time.Location
+		now        time.Time
+		want       time.Time
+	}{
+		{
+			name:  "inside a same-day window",
+			start: "01:00", end: "06:00",
+			now:  time.Date(2025, 3, 10, 3, 0, 0, 0, time.UTC),
+			want: time.Date(2025, 3, 10, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "start is inclusive",
+			start: "01:00", end: "06:00",
+			now:  time.Date(2025, 3, 10, 1, 0, 0, 0, time.UTC),
+			want: time.Date(2025, 3, 10, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "end is exclusive",
+			start: "01:00", end: "06:00",
+			now: time.Date(2025, 3, 10, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "before midnight in a window spanning it",
+			start: "22:00", end: "07:00",
+			now:  time.Date(2025, 3, 10, 23, 30, 0, 0, time.UTC),
+			want: time.Date(2025, 3, 11, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "after midnight in a window spanning it",
+			start: "22:00", end: "07:00",
+			now:  time.Date(2025, 3, 11, 6, 59, 0, 0, time.UTC),
+			want: time.Date(2025, 3, 11, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "daytime outside a window spanning midnight",
+			start: "22:00", end: "07:00",
+			now: time.Date(2025, 3, 11, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "window in the configured timezone",
+			start: "22:00", end: "07:00", location: berlin,
+			// 21:30 UTC is 22:30 in Berlin (CET)
+			now:  time.Date(2025, 1, 15, 21, 30, 0, 0, time.UTC),
+			want: time.Date(2025, 1, 16, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "outside the window in the configured timezone",
+			start: "22:00", end: "07:00", location: berlin,
+			// 06:30 UTC is 07:30 in Berlin
+			now: time.Date(2025, 1, 16, 6, 30, 0, 0, time.UTC),
+		},
+		{
+			name:  "window end keeps its wall clock time across a DST change",
+			start: "22:00", end: "07:00", location: berlin,
+			// Clocks go forward on 2025-03-30, so 07:00 CEST is 05:00 UTC
+			now:  time.Date(2025, 3, 29, 22, 0, 0, 0, time.UTC),
+			want: time.Date(2025, 3, 30, 5, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := NewQuietHours(tt.start, tt.end, tt.location, []entity.AlertSeverity{entity.SeverityWarning})
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(q.Until(tt.now)), "want %v, got %v", tt.want, q.Until(tt.now))
+		})
+	}
+}
+
+func TestNewQuietHours_Invalid(t *testing.T) {
+	_, err := NewQuietHours("22:00", "22:00", nil, nil)
+	assert.Error(t, err)
+
+	_, err = NewQuietHours("10pm", "07:00", nil, nil)
+	assert.Error(t, err)
+
+	_, err = NewQuietHours("22:00", "07:00", nil, []entity.AlertSeverity{entity.SeverityCritical})
+	assert.Error(t, err)
+}
+
+func TestProcessAlert_QuietHours(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	notifier := &recordingNotifier{name: "slack"}
+	uc := NewProcessAlertUseCase(alertRepo, memory.NewSilenceRepository(), []Notifier{notifier}, nopLogger{}, nil)
+
+	// A window around the current time
+	now := time.Now().UTC()
+	quietHours, err := NewQuietHours(now.Add(-time.Hour).Format("15:04"), now.Add(time.Hour).Format("15:04"), time.UTC,
+		[]entity.AlertSeverity{entity.SeverityWarning, entity.SeverityInfo})
+	require.NoError(t, err)
+	uc.SetQuietHours(quietHours)
+
+	fire := func(fingerprint string, severity entity.AlertSeverity) *dto.ProcessAlertOutput {
+		t.Helper()
+		output, err := uc.Execute(ctx, dto.ProcessAlertInput{
+			Fingerprint: fingerprint,
+			Name:        "High CPU",
+			Severity:    severity,
+			Status:      "firing",
+			FiredAt:     now,
+		})
+		require.NoError(t, err)
+		return output
+	}
+
+	// Critical alerts break through
+	critical := fire("fp-critical", entity.SeverityCritical)
+	assert.False(t, critical.IsHeld)
+	assert.Equal(t, 1, notifier.notified)
+
+	// Others are stored but not sent
+	warning := fire("fp-warning", entity.SeverityWarning)
+	info := fire("fp-info", entity.SeverityInfo)
+	assert.True(t, warning.IsHeld)
+	assert.True(t, warning.IsNew)
+	assert.Equal(t, 1, notifier.notified)
+
+	stored, err := alertRepo.FindByID(ctx, warning.AlertID)
+	require.NoError(t, err)
+	assert.NotEmpty(t, stored.GetExternalReference(entity.QuietHoursReference))
+
+	// Nothing is released before the window ends
+	released, err := uc.ReleaseHeld(ctx, now)
+	require.NoError(t, err)
+	assert.Zero(t, released)
+
+	// A re-fire raising a held alert to critical sends it right away
+	fire("fp-info", entity.SeverityCritical)
+	assert.Equal(t, 2, notifier.notified)
+	stored, err = alertRepo.FindByID(ctx, info.AlertID)
+	require.NoError(t, err)
+	assert.Empty(t, stored.GetExternalReference(entity.QuietHoursReference))
+	assert.Equal(t, "msg", stored.GetExternalReference("slack"))
+
+	// Once the window is over the remaining held alert is sent, once
+	released, err = uc.ReleaseHeld(ctx, now.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, released)
+	assert.Equal(t, 3, notifier.notified)
+
+	released, err = uc.ReleaseHeld(ctx, now.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Zero(t, released)
+}
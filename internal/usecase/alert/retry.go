@@ -8,8 +8,8 @@ import (
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
 	domainerrors "github.com/qj0r9j0vc2/alert-bridge/internal/domain/errors"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/logger"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/observability"
-	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/resilience"
 )
 
 // RetryPolicy defines the retry behavior for failed operations.
@@ -32,31 +32,23 @@ func DefaultRetryPolicy() RetryPolicy {
 	}
 }
 
-// RetryableNotifier wraps a Notifier with retry logic and circuit breaker for transient failures.
-// It implements the Decorator pattern to add retry and resilience capabilities.
+// RetryableNotifier wraps a Notifier with retry logic for transient failures.
+// It implements the Decorator pattern to add retry capabilities; wrap it in a
+// CircuitBreakingNotifier to stop retrying an integration that is down.
 type RetryableNotifier struct {
-	notifier       Notifier
-	policy         RetryPolicy
-	logger         Logger
-	metrics        *observability.Metrics
-	circuitBreaker *resilience.CircuitBreaker
+	notifier Notifier
+	policy   RetryPolicy
+	logger   Logger
+	metrics  *observability.Metrics
 }
 
 // NewRetryableNotifier creates a new RetryableNotifier with the given policy.
 func NewRetryableNotifier(notifier Notifier, policy RetryPolicy, logger Logger, metrics *observability.Metrics) *RetryableNotifier {
-	// Create circuit breaker for this notifier
-	cb := resilience.NewCircuitBreaker(
-		notifier.Name(),
-		5,              // max 5 failures
-		30*time.Second, // 30s timeout
-	)
-
 	return &RetryableNotifier{
-		notifier:       notifier,
-		policy:         policy,
-		logger:         logger,
-		metrics:        metrics,
-		circuitBreaker: cb,
+		notifier: notifier,
+		policy:   policy,
+		logger:   logger,
+		metrics:  metrics,
 	}
 }
 
@@ -86,27 +78,20 @@ func (r *RetryableNotifier) Notify(ctx context.Context, alert *entity.Alert) (st
 			retriesUsed++
 		}
 
-		// Attempt notification through circuit breaker
-		cbErr := r.circuitBreaker.Execute(ctx, func() error {
-			messageID, lastErr = r.notifier.Notify(ctx, alert)
-			return lastErr
-		})
-
-		// Check if circuit breaker blocked the request
-		if cbErr == resilience.ErrCircuitOpen {
-			r.logger.Warn("circuit breaker open, skipping notification",
-				"notifier", r.notifier.Name(),
-				"alert_id", alert.ID,
-				"cb_state", r.circuitBreaker.State(),
-			)
-			return "", cbErr
-		}
+		attemptCtx, span := observability.StartSpan(ctx, "Notifier.Notify",
+			observability.AttrNotifierName.String(r.notifier.Name()),
+			observability.AttrNotifierAttempt.Int(attempt),
+			observability.AttrAlertID.String(alert.ID),
+			observability.AttrAlertFingerprint.String(alert.Fingerprint),
+		)
+		messageID, lastErr = r.notifier.Notify(attemptCtx, alert)
+		observability.EndSpan(span, lastErr)
 
 		// Success - return immediately
 		if lastErr == nil {
 			success = true
 			if attempt > 1 {
-				r.logger.Info("notification succeeded after retry",
+				logger.WithContext(ctx, r.logger).Info("notification succeeded after retry",
 					"notifier", r.notifier.Name(),
 					"alert_id", alert.ID,
 					"attempt", attempt,
@@ -118,7 +103,7 @@ func (r *RetryableNotifier) Notify(ctx context.Context, alert *entity.Alert) (st
 		// Check if error is retryable
 		if !domainerrors.IsTransientError(lastErr) {
 			// Permanent error - don't retry
-			r.logger.Warn("notification failed with permanent error",
+			logger.WithContext(ctx, r.logger).Warn("notification failed with permanent error",
 				"notifier", r.notifier.Name(),
 				"alert_id", alert.ID,
 				"error", lastErr,
@@ -128,7 +113,7 @@ func (r *RetryableNotifier) Notify(ctx context.Context, alert *entity.Alert) (st
 
 		// Last attempt failed - don't sleep
 		if attempt == r.policy.MaxAttempts {
-			r.logger.Error("notification failed after max retries",
+			logger.WithContext(ctx, r.logger).Error("notification failed after max retries",
 				"notifier", r.notifier.Name(),
 				"alert_id", alert.ID,
 				"attempts", attempt,
@@ -137,9 +122,9 @@ func (r *RetryableNotifier) Notify(ctx context.Context, alert *entity.Alert) (st
 			break
 		}
 
-		// Calculate backoff with jitter
-		backoff := r.calculateBackoff(attempt)
-		r.logger.Warn("notification failed, retrying",
+		// Calculate backoff with jitter, waiting at least as asked
+		backoff := r.backoffFor(attempt, lastErr)
+		logger.WithContext(ctx, r.logger).Warn("notification failed, retrying",
 			"notifier", r.notifier.Name(),
 			"alert_id", alert.ID,
 			"attempt", attempt,
@@ -164,12 +149,19 @@ func (r *RetryableNotifier) UpdateMessage(ctx context.Context, messageID string,
 	var lastErr error
 
 	for attempt := 1; attempt <= r.policy.MaxAttempts; attempt++ {
-		lastErr = r.notifier.UpdateMessage(ctx, messageID, alert)
+		attemptCtx, span := observability.StartSpan(ctx, "Notifier.UpdateMessage",
+			observability.AttrNotifierName.String(r.notifier.Name()),
+			observability.AttrNotifierAttempt.Int(attempt),
+			observability.AttrAlertID.String(alert.ID),
+			observability.AttrAlertFingerprint.String(alert.Fingerprint),
+		)
+		lastErr = r.notifier.UpdateMessage(attemptCtx, messageID, alert)
+		observability.EndSpan(span, lastErr)
 
 		// Success
 		if lastErr == nil {
 			if attempt > 1 {
-				r.logger.Info("update message succeeded after retry",
+				logger.WithContext(ctx, r.logger).Info("update message succeeded after retry",
 					"notifier", r.notifier.Name(),
 					"message_id", messageID,
 					"attempt", attempt,
@@ -180,7 +172,7 @@ func (r *RetryableNotifier) UpdateMessage(ctx context.Context, messageID string,
 
 		// Check if error is retryable
 		if !domainerrors.IsTransientError(lastErr) {
-			r.logger.Warn("update message failed with permanent error",
+			logger.WithContext(ctx, r.logger).Warn("update message failed with permanent error",
 				"notifier", r.notifier.Name(),
 				"message_id", messageID,
 				"error", lastErr,
@@ -190,7 +182,7 @@ func (r *RetryableNotifier) UpdateMessage(ctx context.Context, messageID string,
 
 		// Last attempt failed
 		if attempt == r.policy.MaxAttempts {
-			r.logger.Error("update message failed after max retries",
+			logger.WithContext(ctx, r.logger).Error("update message failed after max retries",
 				"notifier", r.notifier.Name(),
 				"message_id", messageID,
 				"attempts", attempt,
@@ -199,9 +191,9 @@ func (r *RetryableNotifier) UpdateMessage(ctx context.Context, messageID string,
 			break
 		}
 
-		// Calculate backoff with jitter
-		backoff := r.calculateBackoff(attempt)
-		r.logger.Warn("update message failed, retrying",
+		// Calculate backoff with jitter, waiting at least as asked
+		backoff := r.backoffFor(attempt, lastErr)
+		logger.WithContext(ctx, r.logger).Warn("update message failed, retrying",
 			"notifier", r.notifier.Name(),
 			"message_id", messageID,
 			"attempt", attempt,
@@ -226,6 +218,31 @@ func (r *RetryableNotifier) Name() string {
 	return r.notifier.Name()
 }
 
+// SelfTest forwards to the underlying notifier's self-test, if it has one.
+func (r *RetryableNotifier) SelfTest(ctx context.Context) error {
+	return SelfTest(ctx, r.notifier)
+}
+
+// Preview delegates to the wrapped notifier's preview, if supported.
+func (r *RetryableNotifier) Preview(alert *entity.Alert) (any, error) {
+	return Preview(r.notifier, alert)
+}
+
+// Close forwards to the underlying notifier's Close, if it has one.
+func (r *RetryableNotifier) Close(ctx context.Context) error {
+	return Close(ctx, r.notifier)
+}
+
+// backoffFor returns the backoff after the given failed attempt, or the wait
+// the error asks for, e.g. the retry-after of a rate limit, if that is longer.
+func (r *RetryableNotifier) backoffFor(attempt int, err error) time.Duration {
+	backoff := r.calculateBackoff(attempt)
+	if retryAfter, ok := domainerrors.RetryAfter(err); ok && retryAfter > backoff {
+		return retryAfter
+	}
+	return backoff
+}
+
 // calculateBackoff calculates the backoff duration with exponential growth and jitter.
 // Formula: min(InitialInterval * Multiplier^(attempt-1) * (1 ± jitter), MaxInterval)
 func (r *RetryableNotifier) calculateBackoff(attempt int) time.Duration {
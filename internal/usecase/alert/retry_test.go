@@ -0,0 +1,46 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	domainerrors "github.com/qj0r9j0vc2/alert-bridge/internal/domain/errors"
+)
+
+func TestRetryableNotifier_BackoffHonorsRetryAfter(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, InitialInterval: 100 * time.Millisecond, MaxInterval: time.Second, Multiplier: 2}
+	n := NewRetryableNotifier(&recordingNotifier{name: "telegram"}, policy, nopLogger{}, nil)
+
+	rateLimited := domainerrors.NewTransientError("rate limited", errors.New("429")).WithRetryAfter(3 * time.Second)
+	assert.Equal(t, 3*time.Second, n.backoffFor(1, rateLimited))
+
+	// A shorter hint does not shorten the backoff
+	soon := domainerrors.NewTransientError("rate limited", errors.New("429")).WithRetryAfter(time.Millisecond)
+	assert.Equal(t, 200*time.Millisecond, n.backoffFor(2, soon))
+
+	plain := domainerrors.NewTransientError("server error", errors.New("502"))
+	assert.Equal(t, 100*time.Millisecond, n.backoffFor(1, plain))
+}
+
+func TestRetryableNotifier_WaitsForRetryAfter(t *testing.T) {
+	ctx := context.Background()
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+
+	rateLimited := domainerrors.NewTransientError("rate limited", errors.New("429")).WithRetryAfter(50 * time.Millisecond)
+	inner := &flakyNotifier{recordingNotifier: recordingNotifier{name: "discord"}, err: rateLimited}
+	policy := RetryPolicy{MaxAttempts: 2, InitialInterval: time.Millisecond, MaxInterval: time.Millisecond, Multiplier: 2}
+	n := NewRetryableNotifier(inner, policy, nopLogger{}, nil)
+
+	start := time.Now()
+	_, err := n.Notify(ctx, alert)
+	require.Error(t, err)
+
+	assert.Equal(t, 2, inner.notified)
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+}
@@ -0,0 +1,88 @@
+package alert
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+)
+
+// Route sends alerts whose labels match all of its matchers to a subset of notifiers.
+type Route struct {
+	// Match requires each label to equal the given value.
+	Match map[string]string
+
+	// MatchRE requires each label to fully match the given regular expression.
+	MatchRE map[string]string
+
+	// Notifiers are the names of the notifiers that receive matching alerts.
+	Notifiers []string
+}
+
+// compiledRoute is a Route with its regular expressions compiled.
+type compiledRoute struct {
+	match     map[string]string
+	matchRE   map[string]*regexp.Regexp
+	notifiers []string
+}
+
+// Router selects notifiers for an alert based on its labels.
+type Router struct {
+	routes []compiledRoute
+}
+
+// NewRouter compiles the given routes. Regular expressions are anchored, so
+// `match_re: {env: prod}` does not match "preprod".
+func NewRouter(routes []Route) (*Router, error) {
+	router := &Router{routes: make([]compiledRoute, 0, len(routes))}
+	for i, route := range routes {
+		compiled := compiledRoute{
+			match:     route.Match,
+			matchRE:   make(map[string]*regexp.Regexp, len(route.MatchRE)),
+			notifiers: route.Notifiers,
+		}
+		for label, expr := range route.MatchRE {
+			re, err := regexp.Compile("^(?:" + expr + ")$")
+			if err != nil {
+				return nil, fmt.Errorf("route %d: invalid regex for label %q: %w", i, label, err)
+			}
+			compiled.matchRE[label] = re
+		}
+		router.routes = append(router.routes, compiled)
+	}
+	return router, nil
+}
+
+// Match returns the de-duplicated notifier names of every route matching the
+// alert, in route order. Overlapping routes add up. Returns nil when no route
+// matches, meaning the alert goes to all notifiers.
+func (r *Router) Match(alert *entity.Alert) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, route := range r.routes {
+		if !route.matches(alert) {
+			continue
+		}
+		for _, name := range route.notifiers {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+func (r compiledRoute) matches(alert *entity.Alert) bool {
+	for label, value := range r.match {
+		if alert.GetLabel(label) != value {
+			return false
+		}
+	}
+	for label, re := range r.matchRE {
+		if !re.MatchString(alert.GetLabel(label)) {
+			return false
+		}
+	}
+	return true
+}
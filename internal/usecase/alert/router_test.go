@@ -0,0 +1,72 @@
+package alert
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+func TestRouter_Match(t *testing.T) {
+	router, err := NewRouter([]Route{
+		{Match: map[string]string{"severity": "critical"}, Notifiers: []string{"slack", "pagerduty"}},
+		{MatchRE: map[string]string{"team": "db|storage"}, Notifiers: []string{"email", "slack"}},
+		{Match: map[string]string{"env": "prod"}, MatchRE: map[string]string{"team": "db"}, Notifiers: []string{"telegram"}},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   []string
+	}{
+		{name: "single route", labels: map[string]string{"severity": "critical"}, want: []string{"slack", "pagerduty"}},
+		{name: "regex route", labels: map[string]string{"team": "storage"}, want: []string{"email", "slack"}},
+		{name: "overlapping routes are merged", labels: map[string]string{"severity": "critical", "team": "db", "env": "prod"}, want: []string{"slack", "pagerduty", "email", "telegram"}},
+		{name: "all matchers of a route must match", labels: map[string]string{"env": "prod", "team": "web"}, want: nil},
+		{name: "regex is anchored", labels: map[string]string{"team": "dbadmin"}, want: nil},
+		{name: "no match", labels: map[string]string{"severity": "info"}, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := entity.NewAlert("fp", "HighCPU", "host-1", "", "", entity.SeverityWarning)
+			a.Labels = tt.labels
+			assert.Equal(t, tt.want, router.Match(a))
+		})
+	}
+}
+
+func TestNewRouter_InvalidRegex(t *testing.T) {
+	_, err := NewRouter([]Route{{MatchRE: map[string]string{"team": "("}, Notifiers: []string{"slack"}}})
+	assert.Error(t, err)
+}
+
+func TestProcessAlert_RoutesNotifications(t *testing.T) {
+	router, err := NewRouter([]Route{
+		{Match: map[string]string{"severity": "critical"}, Notifiers: []string{"pagerduty"}},
+	})
+	require.NoError(t, err)
+
+	slack := &recordingNotifier{name: "slack"}
+	pagerduty := &recordingNotifier{name: "pagerduty"}
+	uc := NewProcessAlertUseCase(memory.NewAlertRepository(), memory.NewSilenceRepository(), []Notifier{slack, pagerduty}, nopLogger{}, nil)
+	uc.SetRouter(router)
+
+	ctx := context.Background()
+	_, err = uc.Execute(ctx, dto.ProcessAlertInput{Fingerprint: "fp-critical", Name: "DiskFull", Status: "firing", Labels: map[string]string{"severity": "critical"}})
+	require.NoError(t, err)
+	assert.Equal(t, 0, slack.notified)
+	assert.Equal(t, 1, pagerduty.notified)
+
+	// Unrouted alerts go to every notifier
+	_, err = uc.Execute(ctx, dto.ProcessAlertInput{Fingerprint: "fp-info", Name: "Heartbeat", Status: "firing", Labels: map[string]string{"severity": "info"}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, slack.notified)
+	assert.Equal(t, 2, pagerduty.notified)
+}
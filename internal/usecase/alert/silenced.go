@@ -0,0 +1,65 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+)
+
+// FindSilencedAlertsUseCase lists the firing alerts that active silences
+// currently suppress, and who silenced them.
+type FindSilencedAlertsUseCase struct {
+	alertRepo   repository.AlertRepository
+	silenceRepo repository.SilenceRepository
+}
+
+// NewFindSilencedAlertsUseCase creates a new FindSilencedAlertsUseCase.
+func NewFindSilencedAlertsUseCase(alertRepo repository.AlertRepository, silenceRepo repository.SilenceRepository) *FindSilencedAlertsUseCase {
+	return &FindSilencedAlertsUseCase{
+		alertRepo:   alertRepo,
+		silenceRepo: silenceRepo,
+	}
+}
+
+// Execute returns the silenced firing alerts, most recently fired first,
+// each with its matching silences. Active silences are loaded once and
+// matched in process, the same way FindMatchingAlert matches them, instead
+// of querying the silences of every alert.
+func (uc *FindSilencedAlertsUseCase) Execute(ctx context.Context) (*dto.SilencedAlertsOutput, error) {
+	alerts, err := uc.alertRepo.FindFiring(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("finding firing alerts: %w", err)
+	}
+
+	output := &dto.SilencedAlertsOutput{Alerts: make([]dto.SilencedAlert, 0)}
+	if len(alerts) == 0 {
+		return output, nil
+	}
+
+	silences, err := uc.silenceRepo.FindActive(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("finding active silences: %w", err)
+	}
+	if len(silences) == 0 {
+		return output, nil
+	}
+
+	for _, alert := range alerts {
+		var matching []dto.SilenceSummary
+		for _, silence := range silences {
+			if silence.MatchesAlert(alert) {
+				matching = append(matching, dto.NewSilenceSummary(silence))
+			}
+		}
+		if len(matching) > 0 {
+			output.Alerts = append(output.Alerts, dto.SilencedAlert{
+				AlertSummary: dto.NewAlertSummary(alert),
+				Silences:     matching,
+			})
+		}
+	}
+	output.Count = len(output.Alerts)
+	return output, nil
+}
@@ -0,0 +1,48 @@
+package alert
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+func TestFindSilencedAlertsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	silenceRepo := memory.NewSilenceRepository()
+	uc := NewFindSilencedAlertsUseCase(alertRepo, silenceRepo)
+
+	output, err := uc.Execute(ctx)
+	require.NoError(t, err)
+	assert.NotNil(t, output.Alerts)
+	assert.Zero(t, output.Count)
+
+	silencedAlert := entity.NewAlert("fp-1", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	other := entity.NewAlert("fp-2", "Disk", "host-2", "", "", entity.SeverityWarning)
+	resolved := entity.NewAlert("fp-3", "Memory", "host-1", "", "", entity.SeverityCritical)
+	resolved.Resolve("alertmanager", time.Now())
+	for _, alert := range []*entity.Alert{silencedAlert, other, resolved} {
+		require.NoError(t, alertRepo.Save(ctx, alert))
+	}
+
+	silence, err := entity.NewSilenceMark(time.Hour, "oncall", "oncall@example.com", entity.AckSourceAPI)
+	require.NoError(t, err)
+	silence.ForInstance("host-1").WithReason("maintenance")
+	require.NoError(t, silenceRepo.Save(ctx, silence))
+
+	// Resolved alerts are not reported even if a silence matches them
+	output, err = uc.Execute(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, output.Count)
+	assert.Equal(t, silencedAlert.ID, output.Alerts[0].ID)
+	require.Len(t, output.Alerts[0].Silences, 1)
+	assert.Equal(t, silence.ID, output.Alerts[0].Silences[0].ID)
+	assert.Equal(t, "oncall", output.Alerts[0].Silences[0].CreatedBy)
+	assert.Equal(t, "maintenance", output.Alerts[0].Silences[0].Reason)
+}
@@ -0,0 +1,93 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+)
+
+// statsStates and statsSeverities are always reported, with zero counts if empty,
+// so dashboards see a stable set of keys.
+var (
+	statsStates     = []entity.AlertState{entity.StateActive, entity.StateAcked, entity.StateResolved}
+	statsSeverities = []entity.AlertSeverity{entity.SeverityCritical, entity.SeverityWarning, entity.SeverityInfo}
+)
+
+// GetStatsUseCase aggregates alert and silence counts for dashboards.
+// Counting is pushed down to the repositories so no alerts are loaded.
+type GetStatsUseCase struct {
+	alertRepo   repository.AlertRepository
+	silenceRepo repository.SilenceRepository
+	now         func() time.Time
+}
+
+// NewGetStatsUseCase creates a new GetStatsUseCase.
+func NewGetStatsUseCase(alertRepo repository.AlertRepository, silenceRepo repository.SilenceRepository) *GetStatsUseCase {
+	return &GetStatsUseCase{
+		alertRepo:   alertRepo,
+		silenceRepo: silenceRepo,
+		now:         time.Now,
+	}
+}
+
+// Execute returns counts by state and severity, the number of active
+// silences and the age of the oldest unacknowledged alert.
+func (uc *GetStatsUseCase) Execute(ctx context.Context) (*dto.AlertStatsOutput, error) {
+	counts, err := uc.alertRepo.CountByStateSeverity(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("counting alerts: %w", err)
+	}
+
+	activeSilences, err := uc.silenceRepo.CountActive(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("counting active silences: %w", err)
+	}
+
+	now := uc.now().UTC()
+	output := &dto.AlertStatsOutput{
+		ByState:         make(map[string]int),
+		BySeverity:      make(map[string]int),
+		ByStateSeverity: make(map[string]map[string]int),
+		ActiveSilences:  activeSilences,
+		GeneratedAt:     now,
+	}
+	for _, state := range statsStates {
+		output.ByState[string(state)] = 0
+		output.ByStateSeverity[string(state)] = make(map[string]int)
+		for _, severity := range statsSeverities {
+			output.ByStateSeverity[string(state)][string(severity)] = 0
+		}
+	}
+	for _, severity := range statsSeverities {
+		output.BySeverity[string(severity)] = 0
+	}
+
+	var oldestUnacked time.Time
+	for _, count := range counts {
+		state, severity := string(count.State), string(count.Severity)
+		output.TotalAlerts += count.Count
+		output.ByState[state] += count.Count
+		output.BySeverity[severity] += count.Count
+		if output.ByStateSeverity[state] == nil {
+			output.ByStateSeverity[state] = make(map[string]int)
+		}
+		output.ByStateSeverity[state][severity] += count.Count
+
+		if count.State == entity.StateActive && (oldestUnacked.IsZero() || count.OldestFiredAt.Before(oldestUnacked)) {
+			oldestUnacked = count.OldestFiredAt
+		}
+	}
+
+	if !oldestUnacked.IsZero() {
+		output.OldestUnacked = &dto.OldestUnackedAlert{
+			FiredAt:    oldestUnacked,
+			AgeSeconds: int64(now.Sub(oldestUnacked).Seconds()),
+		}
+	}
+
+	return output, nil
+}
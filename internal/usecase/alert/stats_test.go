@@ -0,0 +1,60 @@
+package alert
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+func TestGetStatsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	silenceRepo := memory.NewSilenceRepository()
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	uc := NewGetStatsUseCase(alertRepo, silenceRepo)
+	uc.now = func() time.Time { return now }
+
+	// Empty stores still report every known state and severity
+	stats, err := uc.Execute(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, stats.TotalAlerts)
+	assert.Equal(t, map[string]int{"active": 0, "acknowledged": 0, "resolved": 0}, stats.ByState)
+	assert.Equal(t, map[string]int{"critical": 0, "warning": 0, "info": 0}, stats.BySeverity)
+	assert.Nil(t, stats.OldestUnacked)
+
+	firing := entity.NewAlert("fp-1", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	firing.FiredAt = now.Add(-90 * time.Minute)
+	warning := entity.NewAlert("fp-2", "Disk", "host-2", "", "", entity.SeverityWarning)
+	warning.FiredAt = now.Add(-10 * time.Minute)
+	acked := entity.NewAlert("fp-3", "Memory", "host-3", "", "", entity.SeverityCritical)
+	acked.FiredAt = now.Add(-3 * time.Hour)
+	require.NoError(t, acked.Acknowledge("oncall@example.com", now))
+	for _, alert := range []*entity.Alert{firing, warning, acked} {
+		require.NoError(t, alertRepo.Save(ctx, alert))
+	}
+
+	silence, err := entity.NewSilenceMark(time.Hour, "oncall", "oncall@example.com", entity.AckSourceAPI)
+	require.NoError(t, err)
+	silence.ForInstance("host-1")
+	require.NoError(t, silenceRepo.Save(ctx, silence))
+
+	stats, err = uc.Execute(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, stats.TotalAlerts)
+	assert.Equal(t, map[string]int{"active": 2, "acknowledged": 1, "resolved": 0}, stats.ByState)
+	assert.Equal(t, map[string]int{"critical": 2, "warning": 1, "info": 0}, stats.BySeverity)
+	assert.Equal(t, 1, stats.ByStateSeverity["active"]["warning"])
+	assert.Equal(t, 1, stats.ActiveSilences)
+
+	// The acknowledged alert is older but no longer counts as unacknowledged
+	require.NotNil(t, stats.OldestUnacked)
+	assert.Equal(t, firing.FiredAt, stats.OldestUnacked.FiredAt)
+	assert.Equal(t, int64(90*60), stats.OldestUnacked.AgeSeconds)
+}
@@ -0,0 +1,108 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+)
+
+// TimelineUseCase builds the chronological history of an alert for
+// post-incident review.
+type TimelineUseCase struct {
+	alertRepo    repository.AlertRepository
+	ackEventRepo repository.AckEventRepository
+}
+
+// NewTimelineUseCase creates a new TimelineUseCase.
+func NewTimelineUseCase(alertRepo repository.AlertRepository, ackEventRepo repository.AckEventRepository) *TimelineUseCase {
+	return &TimelineUseCase{
+		alertRepo:    alertRepo,
+		ackEventRepo: ackEventRepo,
+	}
+}
+
+// Execute merges the alert's lifecycle timestamps with its ack events and
+// assignment changes, oldest first. Ack events with a duration are silences. The alert's own
+// acknowledgment is listed only if no ack event records it, since the
+// events carry the source and note.
+// Returns repository.ErrAlertNotFound for an unknown alert ID.
+func (uc *TimelineUseCase) Execute(ctx context.Context, alertID string) ([]dto.TimelineEvent, error) {
+	alert, err := uc.alertRepo.FindByID(ctx, alertID)
+	if err != nil {
+		return nil, fmt.Errorf("finding alert: %w", err)
+	}
+	if alert == nil {
+		return nil, repository.ErrAlertNotFound
+	}
+
+	ackEvents, err := uc.ackEventRepo.FindByAlertID(ctx, alert.ID)
+	if err != nil {
+		return nil, fmt.Errorf("finding ack events: %w", err)
+	}
+
+	events := []dto.TimelineEvent{{
+		Type:   dto.TimelineFired,
+		At:     alert.FiredAt,
+		Source: "alertmanager",
+	}}
+
+	acked := false
+	for _, ackEvent := range ackEvents {
+		event := dto.TimelineEvent{
+			Type:   dto.TimelineAcknowledged,
+			At:     ackEvent.CreatedAt,
+			Actor:  ackEvent.Actor(),
+			Source: string(ackEvent.Source),
+			Note:   ackEvent.Note,
+		}
+		if ackEvent.HasDuration() {
+			event.Type = dto.TimelineSilenced
+			event.DurationSeconds = int64(ackEvent.Duration.Seconds())
+		} else {
+			acked = true
+		}
+		events = append(events, event)
+	}
+
+	if alert.AckedAt != nil && !acked {
+		events = append(events, dto.TimelineEvent{
+			Type:  dto.TimelineAcknowledged,
+			At:    *alert.AckedAt,
+			Actor: alert.AckedBy,
+		})
+	}
+
+	for _, assignment := range alert.Assignments {
+		event := dto.TimelineEvent{
+			Type:     dto.TimelineAssigned,
+			At:       assignment.At,
+			Actor:    assignment.By,
+			Assignee: assignment.To,
+		}
+		if assignment.To == "" {
+			event.Type = dto.TimelineUnassigned
+		}
+		events = append(events, event)
+	}
+
+	if alert.ResolvedAt != nil {
+		event := dto.TimelineEvent{
+			Type: dto.TimelineResolved,
+			At:   *alert.ResolvedAt,
+		}
+		if alert.LastTransition != nil && alert.LastTransition.State == entity.StateResolved {
+			event.Actor = alert.LastTransition.By
+		}
+		events = append(events, event)
+	}
+
+	// Stable, so the firing stays first when timestamps tie
+	slices.SortStableFunc(events, func(a, b dto.TimelineEvent) int {
+		return a.At.Compare(b.At)
+	})
+	return events, nil
+}
@@ -0,0 +1,95 @@
+package alert
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+func TestTimeline_MergesLifecycleAndAckEvents(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	ackEventRepo := memory.NewAckEventRepository()
+	uc := NewTimelineUseCase(alertRepo, ackEventRepo)
+
+	firedAt := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	alert.FiredAt = firedAt
+	require.NoError(t, alert.Acknowledge("jane@example.com", firedAt.Add(5*time.Minute)))
+	alert.Resolve("alertmanager", firedAt.Add(30*time.Minute))
+	require.NoError(t, alertRepo.Save(ctx, alert))
+
+	ack := entity.NewAckEvent(alert.ID, entity.AckSourcePagerDuty, "P1", "jane@example.com", "Jane").WithNote("looking into it")
+	ack.CreatedAt = firedAt.Add(5 * time.Minute)
+	silence := entity.NewAckEvent(alert.ID, entity.AckSourceSlack, "U1", "", "bob").WithDuration(time.Hour)
+	silence.CreatedAt = firedAt.Add(3 * time.Minute)
+	for _, event := range []*entity.AckEvent{ack, silence} {
+		require.NoError(t, ackEventRepo.Save(ctx, event))
+	}
+
+	events, err := uc.Execute(ctx, alert.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []dto.TimelineEvent{
+		{Type: dto.TimelineFired, At: firedAt, Source: "alertmanager"},
+		{Type: dto.TimelineSilenced, At: firedAt.Add(3 * time.Minute), Actor: "bob", Source: "slack", DurationSeconds: 3600},
+		{Type: dto.TimelineAcknowledged, At: firedAt.Add(5 * time.Minute), Actor: "jane@example.com", Source: "pagerduty", Note: "looking into it"},
+		{Type: dto.TimelineResolved, At: firedAt.Add(30 * time.Minute), Actor: "alertmanager"},
+	}, events)
+}
+
+func TestTimeline_AcknowledgmentWithoutAckEvent(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	uc := NewTimelineUseCase(alertRepo, memory.NewAckEventRepository())
+
+	firedAt := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	alert.FiredAt = firedAt
+	require.NoError(t, alert.Acknowledge("jane@example.com", firedAt.Add(time.Minute)))
+	require.NoError(t, alertRepo.Save(ctx, alert))
+
+	events, err := uc.Execute(ctx, alert.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []dto.TimelineEvent{
+		{Type: dto.TimelineFired, At: firedAt, Source: "alertmanager"},
+		{Type: dto.TimelineAcknowledged, At: firedAt.Add(time.Minute), Actor: "jane@example.com"},
+	}, events)
+}
+
+func TestTimeline_UnknownAlert(t *testing.T) {
+	uc := NewTimelineUseCase(memory.NewAlertRepository(), memory.NewAckEventRepository())
+
+	_, err := uc.Execute(context.Background(), "missing")
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+}
+
+func TestTimeline_AssignmentChanges(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	uc := NewTimelineUseCase(alertRepo, memory.NewAckEventRepository())
+
+	firedAt := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	alert := entity.NewAlert("fp", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	alert.FiredAt = firedAt
+	_, err := alert.Assign("jane@example.com", "lead@example.com", firedAt.Add(time.Minute))
+	require.NoError(t, err)
+	_, err = alert.Assign("", "jane@example.com", firedAt.Add(2*time.Minute))
+	require.NoError(t, err)
+	require.NoError(t, alertRepo.Save(ctx, alert))
+
+	events, err := uc.Execute(ctx, alert.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []dto.TimelineEvent{
+		{Type: dto.TimelineFired, At: firedAt, Source: "alertmanager"},
+		{Type: dto.TimelineAssigned, At: firedAt.Add(time.Minute), Actor: "lead@example.com", Assignee: "jane@example.com"},
+		{Type: dto.TimelineUnassigned, At: firedAt.Add(2 * time.Minute), Actor: "jane@example.com"},
+	}, events)
+}
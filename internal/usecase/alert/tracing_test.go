@@ -0,0 +1,100 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	domainerrors "github.com/qj0r9j0vc2/alert-bridge/internal/domain/errors"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/observability"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+// recordSpans installs a tracer provider that records every ended span for
+// the duration of the test.
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+// spanAttr returns the value of the span attribute key, if set.
+func spanAttr(span sdktrace.ReadOnlySpan, key attribute.Key) (attribute.Value, bool) {
+	for _, attr := range span.Attributes() {
+		if attr.Key == key {
+			return attr.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+func TestProcessAlert_RecordsSpan(t *testing.T) {
+	recorder := recordSpans(t)
+	notifier := &recordingNotifier{name: "slack"}
+	uc := NewProcessAlertUseCase(memory.NewAlertRepository(), memory.NewSilenceRepository(), []Notifier{notifier}, nopLogger{}, nil)
+
+	output, err := uc.Execute(context.Background(), dto.ProcessAlertInput{
+		Fingerprint: "fp-cpu",
+		Name:        "High CPU",
+		Severity:    entity.SeverityCritical,
+		Status:      "firing",
+		FiredAt:     time.Now().UTC(),
+	})
+	require.NoError(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "ProcessAlertUseCase.Execute", span.Name())
+	assert.Equal(t, codes.Unset, span.Status().Code)
+	alertID, ok := spanAttr(span, observability.AttrAlertID)
+	require.True(t, ok, "expected the alert ID attribute")
+	assert.Equal(t, output.AlertID, alertID.AsString())
+	fingerprint, _ := spanAttr(span, observability.AttrAlertFingerprint)
+	assert.Equal(t, "fp-cpu", fingerprint.AsString())
+}
+
+func TestRetryableNotifier_RecordsSpanPerAttempt(t *testing.T) {
+	recorder := recordSpans(t)
+	inner := &flakyNotifier{
+		recordingNotifier: recordingNotifier{name: "pagerduty"},
+		err:               domainerrors.NewTransientError("server error", errors.New("502")),
+	}
+	policy := RetryPolicy{MaxAttempts: 2, InitialInterval: time.Millisecond, MaxInterval: time.Millisecond, Multiplier: 1}
+	n := NewRetryableNotifier(inner, policy, nopLogger{}, nil)
+	alert := entity.NewAlert("fp-cpu", "High CPU", "host-1", "", "", entity.SeverityCritical)
+
+	_, err := n.Notify(context.Background(), alert)
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	for i, span := range spans {
+		assert.Equal(t, "Notifier.Notify", span.Name())
+		assert.Equal(t, codes.Error, span.Status().Code)
+		assert.Contains(t, span.Status().Description, "502")
+
+		alertID, ok := spanAttr(span, observability.AttrAlertID)
+		require.True(t, ok, "expected the alert ID attribute")
+		assert.Equal(t, alert.ID, alertID.AsString())
+		notifier, _ := spanAttr(span, observability.AttrNotifierName)
+		assert.Equal(t, "pagerduty", notifier.AsString())
+		attempt, _ := spanAttr(span, observability.AttrNotifierAttempt)
+		assert.Equal(t, int64(i+1), attempt.AsInt64())
+	}
+}
@@ -0,0 +1,208 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/logger"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+)
+
+const (
+	// DefaultPollInterval is how often the dispatcher checks for pending entries.
+	DefaultPollInterval = time.Second
+
+	// DefaultBatchSize is the maximum number of entries delivered per poll.
+	DefaultBatchSize = 50
+
+	// DefaultMaxAttempts is how often delivery is tried before an entry is abandoned.
+	DefaultMaxAttempts = 10
+
+	// DefaultClaimLease is how long a claimed entry is kept from other
+	// instances. An instance that crashes mid-batch leaves its entries to be
+	// claimed again once the lease ends.
+	DefaultClaimLease = 5 * time.Minute
+
+	// DefaultRetryBackoff is how long a failed entry waits before its first
+	// retry. The wait doubles with every further failure.
+	DefaultRetryBackoff = 5 * time.Second
+
+	// MaxRetryBackoff caps the wait between two attempts of an entry.
+	MaxRetryBackoff = 5 * time.Minute
+)
+
+// Logger is the unified logging interface from domain layer.
+type Logger = logger.Logger
+
+// Deliverer sends the notifications for an outbox entry.
+type Deliverer interface {
+	Deliver(ctx context.Context, alert *entity.Alert, action entity.OutboxAction) error
+}
+
+// Dispatcher delivers pending outbox entries in the background.
+type Dispatcher struct {
+	outboxRepo repository.OutboxRepository
+	alertRepo  repository.AlertRepository
+	deliverer  Deliverer
+	logger     Logger
+
+	pollInterval time.Duration
+	batchSize    int
+	maxAttempts  int
+	retryBackoff time.Duration
+}
+
+// NewDispatcher creates a dispatcher with the default poll interval, batch size and attempt limit.
+func NewDispatcher(
+	outboxRepo repository.OutboxRepository,
+	alertRepo repository.AlertRepository,
+	deliverer Deliverer,
+	logger Logger,
+) *Dispatcher {
+	return &Dispatcher{
+		outboxRepo:   outboxRepo,
+		alertRepo:    alertRepo,
+		deliverer:    deliverer,
+		logger:       logger,
+		pollInterval: DefaultPollInterval,
+		batchSize:    DefaultBatchSize,
+		maxAttempts:  DefaultMaxAttempts,
+		retryBackoff: DefaultRetryBackoff,
+	}
+}
+
+// SetPollInterval sets how often the dispatcher checks for pending entries.
+// Non-positive values are ignored.
+func (d *Dispatcher) SetPollInterval(interval time.Duration) {
+	if interval > 0 {
+		d.pollInterval = interval
+	}
+}
+
+// SetMaxAttempts sets how often delivery is tried before an entry is abandoned.
+// Non-positive values are ignored.
+func (d *Dispatcher) SetMaxAttempts(attempts int) {
+	if attempts > 0 {
+		d.maxAttempts = attempts
+	}
+}
+
+// SetRetryBackoff sets how long a failed entry waits before its first retry.
+// Non-positive values are ignored.
+func (d *Dispatcher) SetRetryBackoff(backoff time.Duration) {
+	if backoff > 0 {
+		d.retryBackoff = backoff
+	}
+}
+
+// Run delivers pending entries every poll interval until ctx is cancelled.
+// Entries left behind by a crash are picked up on the first poll.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := d.DispatchPending(ctx); err != nil && ctx.Err() == nil {
+			d.logger.Error("outbox dispatch failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchPending claims and delivers one batch of pending entries and
+// returns how many were sent. Entries claimed by another instance are left
+// to it. A failed entry stays pending with its attempt count incremented and
+// is retried once its backoff has passed.
+func (d *Dispatcher) DispatchPending(ctx context.Context) (int, error) {
+	entries, err := d.outboxRepo.ClaimPending(ctx, d.maxAttempts, d.batchSize, DefaultClaimLease)
+	if err != nil {
+		return 0, fmt.Errorf("claiming pending outbox entries: %w", err)
+	}
+
+	sent := 0
+	for _, entry := range entries {
+		if ctx.Err() != nil {
+			return sent, ctx.Err()
+		}
+
+		if err := d.dispatch(ctx, entry); err != nil {
+			d.markFailed(ctx, entry, err)
+			continue
+		}
+
+		if err := d.outboxRepo.MarkSent(ctx, entry.ID, time.Now().UTC()); err != nil {
+			d.logger.Error("failed to mark outbox entry sent",
+				"entryID", entry.ID,
+				"alertID", entry.AlertID,
+				"error", err,
+			)
+			continue
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
+// dispatch loads the entry's alert and delivers its notifications.
+func (d *Dispatcher) dispatch(ctx context.Context, entry *entity.OutboxEntry) error {
+	alert, err := d.alertRepo.FindByID(ctx, entry.AlertID)
+	if err != nil {
+		return fmt.Errorf("finding alert: %w", err)
+	}
+	if alert == nil {
+		return fmt.Errorf("alert %s not found", entry.AlertID)
+	}
+
+	return d.deliverer.Deliver(ctx, alert, entry.Action)
+}
+
+// markFailed records a failed attempt and logs when the entry is abandoned.
+func (d *Dispatcher) markFailed(ctx context.Context, entry *entity.OutboxEntry, deliverErr error) {
+	retryAt := time.Now().UTC().Add(d.backoff(entry.Attempts + 1))
+	if err := d.outboxRepo.MarkFailed(ctx, entry.ID, deliverErr.Error(), retryAt); err != nil {
+		d.logger.Error("failed to mark outbox entry failed",
+			"entryID", entry.ID,
+			"alertID", entry.AlertID,
+			"error", err,
+		)
+		return
+	}
+
+	if entry.Attempts+1 >= d.maxAttempts {
+		d.logger.Error("giving up on outbox entry",
+			"entryID", entry.ID,
+			"alertID", entry.AlertID,
+			"action", entry.Action,
+			"attempts", entry.Attempts+1,
+			"error", deliverErr,
+		)
+		return
+	}
+
+	d.logger.Warn("outbox delivery failed, will retry",
+		"entryID", entry.ID,
+		"alertID", entry.AlertID,
+		"action", entry.Action,
+		"attempt", entry.Attempts+1,
+		"retryAt", retryAt,
+		"error", deliverErr,
+	)
+}
+
+// backoff returns how long an entry waits after its given failed attempt:
+// the retry backoff, doubled for every earlier failure, at most MaxRetryBackoff.
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	backoff := d.retryBackoff
+	for i := 1; i < attempts && backoff < MaxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, MaxRetryBackoff)
+}
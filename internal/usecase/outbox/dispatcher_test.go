@@ -0,0 +1,202 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/sqlite"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
+)
+
+// flakyNotifier fails the first failures calls to Notify.
+type flakyNotifier struct {
+	name     string
+	failures int
+	notified int
+	updated  int
+}
+
+func (n *flakyNotifier) Notify(ctx context.Context, alert *entity.Alert) (string, error) {
+	if n.failures > 0 {
+		n.failures--
+		return "", errors.New("unavailable")
+	}
+	n.notified++
+	return n.name + "-msg", nil
+}
+
+func (n *flakyNotifier) UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error {
+	n.updated++
+	return nil
+}
+
+func (n *flakyNotifier) Name() string { return n.name }
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...any) {}
+func (nopLogger) Info(string, ...any)  {}
+func (nopLogger) Warn(string, ...any)  {}
+func (nopLogger) Error(string, ...any) {}
+
+func TestDispatcher_DeliversQueuedNotifications(t *testing.T) {
+	ctx := context.Background()
+	db, err := sqlite.NewDB(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, db.Migrate(ctx))
+	repos := sqlite.NewRepositories(db)
+
+	slack := &flakyNotifier{name: "slack"}
+	pagerduty := &flakyNotifier{name: "pagerduty", failures: 1}
+	uc := alert.NewProcessAlertUseCase(repos.Alert, repos.Silence, []alert.Notifier{slack, pagerduty}, nopLogger{}, nil)
+	uc.SetOutbox(repos.Outbox, db)
+	dispatcher := NewDispatcher(repos.Outbox, repos.Alert, uc, nopLogger{})
+	dispatcher.SetRetryBackoff(time.Millisecond)
+
+	firing := dto.ProcessAlertInput{Fingerprint: "fp", Name: "High CPU", Severity: entity.SeverityCritical, Status: "firing"}
+	output, err := uc.Execute(ctx, firing)
+	require.NoError(t, err)
+	assert.True(t, output.IsNew)
+
+	// Nothing is sent until the dispatcher runs, e.g. after a restart
+	assert.Equal(t, 0, slack.notified+pagerduty.notified)
+
+	sent, err := dispatcher.DispatchPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, sent)
+	assert.Equal(t, 1, slack.notified)
+	assert.Equal(t, 0, pagerduty.notified)
+
+	// The retry only posts to the notifier that failed, once its backoff passed
+	time.Sleep(time.Second)
+	sent, err = dispatcher.DispatchPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Equal(t, 1, slack.notified)
+	assert.Equal(t, 1, pagerduty.notified)
+
+	stored, err := repos.Alert.FindByID(ctx, output.AlertID)
+	require.NoError(t, err)
+	assert.Equal(t, "slack-msg", stored.GetExternalReference("slack"))
+	assert.Equal(t, "pagerduty-msg", stored.GetExternalReference("pagerduty"))
+
+	resolved := firing
+	resolved.Status = "resolved"
+	_, err = uc.Execute(ctx, resolved)
+	require.NoError(t, err)
+
+	sent, err = dispatcher.DispatchPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Equal(t, 1, slack.updated)
+	assert.Equal(t, 1, pagerduty.updated)
+
+	pending, err := repos.Outbox.ClaimPending(ctx, DefaultMaxAttempts, DefaultBatchSize, DefaultClaimLease)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+func TestOutbox_ClaimedEntriesAreNotClaimedTwice(t *testing.T) {
+	ctx := context.Background()
+	db, err := sqlite.NewDB(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, db.Migrate(ctx))
+	repos := sqlite.NewRepositories(db)
+
+	entry := entity.NewOutboxEntry("alert-1", entity.OutboxActionNotify)
+	require.NoError(t, repos.Outbox.Save(ctx, entry))
+
+	claimed, err := repos.Outbox.ClaimPending(ctx, DefaultMaxAttempts, DefaultBatchSize, DefaultClaimLease)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, entry.ID, claimed[0].ID)
+
+	// Another instance polling meanwhile gets nothing
+	claimed, err = repos.Outbox.ClaimPending(ctx, DefaultMaxAttempts, DefaultBatchSize, DefaultClaimLease)
+	require.NoError(t, err)
+	assert.Empty(t, claimed)
+
+	// A failed attempt keeps the claim until its retry time
+	require.NoError(t, repos.Outbox.MarkFailed(ctx, entry.ID, "unavailable", time.Now().Add(time.Hour)))
+	claimed, err = repos.Outbox.ClaimPending(ctx, DefaultMaxAttempts, DefaultBatchSize, DefaultClaimLease)
+	require.NoError(t, err)
+	assert.Empty(t, claimed)
+
+	require.NoError(t, repos.Outbox.MarkFailed(ctx, entry.ID, "unavailable", time.Now().Add(-time.Second)))
+	claimed, err = repos.Outbox.ClaimPending(ctx, DefaultMaxAttempts, DefaultBatchSize, -time.Second)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, 2, claimed[0].Attempts)
+
+	// An expired lease is claimed again, e.g. after a crash mid-batch
+	claimed, err = repos.Outbox.ClaimPending(ctx, DefaultMaxAttempts, DefaultBatchSize, DefaultClaimLease)
+	require.NoError(t, err)
+	assert.Len(t, claimed, 1)
+}
+
+func TestDispatcher_BacksOffFailedEntries(t *testing.T) {
+	ctx := context.Background()
+	// The in-memory outbox keeps sub-second retry times, so the test can use
+	// a short backoff
+	alertRepo := memory.NewAlertRepository()
+	outboxRepo := memory.NewOutboxRepository()
+
+	slack := &flakyNotifier{name: "slack", failures: 2}
+	uc := alert.NewProcessAlertUseCase(alertRepo, memory.NewSilenceRepository(), []alert.Notifier{slack}, nopLogger{}, nil)
+	uc.SetOutbox(outboxRepo, memory.NewTransactionManager())
+	dispatcher := NewDispatcher(outboxRepo, alertRepo, uc, nopLogger{})
+	dispatcher.SetRetryBackoff(200 * time.Millisecond)
+
+	_, err := uc.Execute(ctx, dto.ProcessAlertInput{Fingerprint: "fp", Name: "High CPU", Severity: entity.SeverityCritical, Status: "firing"})
+	require.NoError(t, err)
+
+	sent, err := dispatcher.DispatchPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, sent)
+	assert.Equal(t, 1, slack.failures)
+
+	// Polls before the backoff ends leave the entry alone
+	sent, err = dispatcher.DispatchPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, sent)
+	assert.Equal(t, 1, slack.failures)
+
+	time.Sleep(250 * time.Millisecond)
+	sent, err = dispatcher.DispatchPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, sent)
+	assert.Equal(t, 0, slack.failures)
+
+	// The second failure waits twice as long
+	time.Sleep(250 * time.Millisecond)
+	sent, err = dispatcher.DispatchPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, sent)
+	assert.Equal(t, 0, slack.notified)
+
+	time.Sleep(200 * time.Millisecond)
+	sent, err = dispatcher.DispatchPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Equal(t, 1, slack.notified)
+}
+
+func TestDispatcher_Backoff(t *testing.T) {
+	dispatcher := NewDispatcher(nil, nil, nil, nopLogger{})
+
+	assert.Equal(t, 5*time.Second, dispatcher.backoff(1))
+	assert.Equal(t, 10*time.Second, dispatcher.backoff(2))
+	assert.Equal(t, 160*time.Second, dispatcher.backoff(6))
+	assert.Equal(t, MaxRetryBackoff, dispatcher.backoff(7))
+	assert.Equal(t, MaxRetryBackoff, dispatcher.backoff(100))
+}
@@ -2,11 +2,14 @@ package pagerduty
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/logger"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/ack"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
@@ -17,7 +20,10 @@ type HandleWebhookUseCase struct {
 	alertRepo    repository.AlertRepository
 	syncAckUC    *ack.SyncAckUseCase
 	slackUpdater MessageUpdater
+	threadReply  alert.ThreadReplier
+	dependents   DependentReleaser
 	logger       alert.Logger
+	auditLogger  alert.AuditLogger
 }
 
 // MessageUpdater defines the interface for updating messages.
@@ -25,6 +31,12 @@ type MessageUpdater interface {
 	UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error
 }
 
+// DependentReleaser lifts the suppression of the alerts a resolved parent
+// alert suppressed.
+type DependentReleaser interface {
+	ReleaseDependents(ctx context.Context, parent *entity.Alert)
+}
+
 // NewHandleWebhookUseCase creates a new HandleWebhookUseCase.
 func NewHandleWebhookUseCase(
 	alertRepo repository.AlertRepository,
@@ -40,6 +52,24 @@ func NewHandleWebhookUseCase(
 	}
 }
 
+// SetAuditLogger records alerts resolved from PagerDuty in the audit log.
+// Acknowledgments are recorded by the SyncAckUseCase.
+func (uc *HandleWebhookUseCase) SetAuditLogger(auditLogger alert.AuditLogger) {
+	uc.auditLogger = auditLogger
+}
+
+// SetThreadReplier posts notes added to PagerDuty incidents as replies in
+// the thread of the alert's Slack message.
+func (uc *HandleWebhookUseCase) SetThreadReplier(replier alert.ThreadReplier) {
+	uc.threadReply = replier
+}
+
+// SetDependentReleaser releases the alerts suppressed by alerts resolved
+// from PagerDuty, as when their source resolves them.
+func (uc *HandleWebhookUseCase) SetDependentReleaser(releaser DependentReleaser) {
+	uc.dependents = releaser
+}
+
 // Execute processes a PagerDuty webhook event.
 func (uc *HandleWebhookUseCase) Execute(ctx context.Context, input dto.HandlePagerDutyWebhookInput) (*dto.HandlePagerDutyWebhookOutput, error) {
 	output := &dto.HandlePagerDutyWebhookOutput{}
@@ -50,7 +80,7 @@ func (uc *HandleWebhookUseCase) Execute(ctx context.Context, input dto.HandlePag
 		return nil, fmt.Errorf("finding alert: %w", err)
 	}
 	if alertEntity == nil {
-		uc.logger.Debug("no alert found for PagerDuty incident",
+		logger.WithContext(ctx, uc.logger).Debug("no alert found for PagerDuty incident",
 			"incidentID", input.IncidentID,
 			"incidentKey", input.IncidentKey,
 		)
@@ -60,6 +90,18 @@ func (uc *HandleWebhookUseCase) Execute(ctx context.Context, input dto.HandlePag
 
 	output.AlertID = alertEntity.ID
 
+	// The incident was replaced by a new one when the alert's ack expired
+	if entity.IsSupersededDedupKey(alertEntity.GetExternalReference("pagerduty"), input.IncidentKey) {
+		logger.WithContext(ctx, uc.logger).Debug("ignoring event of superseded PagerDuty incident",
+			"alertID", alertEntity.ID,
+			"incidentKey", input.IncidentKey,
+		)
+		output.Message = "superseded incident ignored"
+		return output, nil
+	}
+
+	linked := uc.recordIncident(ctx, alertEntity, input.IncidentID, input.IncidentURL)
+
 	// Handle based on event type
 	switch input.EventType {
 	case "incident.acknowledged":
@@ -68,10 +110,23 @@ func (uc *HandleWebhookUseCase) Execute(ctx context.Context, input dto.HandlePag
 	case "incident.resolved":
 		return uc.handleResolved(ctx, alertEntity, input, output)
 
+	case "incident.annotated":
+		return uc.handleAnnotated(ctx, alertEntity, input, output)
+
+	case "incident.triggered":
+		// Recording the incident ID lets later events that reference only
+		// the incident, such as incident.annotated, find the alert
+		if linked {
+			uc.showIncidentLink(ctx, alertEntity)
+		}
+		output.Processed = true
+		output.Message = "incident ID recorded"
+		return output, nil
+
 	case "incident.unacknowledged":
 		// PagerDuty unacknowledged - we don't sync this back to Slack
 		// as it's typically a timeout, not a user action
-		uc.logger.Info("incident unacknowledged (not syncing)",
+		logger.WithContext(ctx, uc.logger).Info("incident unacknowledged (not syncing)",
 			"alertID", alertEntity.ID,
 			"incidentID", input.IncidentID,
 		)
@@ -80,7 +135,7 @@ func (uc *HandleWebhookUseCase) Execute(ctx context.Context, input dto.HandlePag
 		return output, nil
 
 	default:
-		uc.logger.Debug("ignoring PagerDuty event type",
+		logger.WithContext(ctx, uc.logger).Debug("ignoring PagerDuty event type",
 			"eventType", input.EventType,
 		)
 		output.Message = "event type not handled"
@@ -88,6 +143,50 @@ func (uc *HandleWebhookUseCase) Execute(ctx context.Context, input dto.HandlePag
 	}
 }
 
+// recordIncident stores the PagerDuty incident ID on the alert so that acks
+// from other sources can target the incident through the REST API, even when
+// the incident was not created through our routing key. The incident's web
+// URL is stored too, for Slack to link to; it reports whether that changed.
+func (uc *HandleWebhookUseCase) recordIncident(ctx context.Context, alertEntity *entity.Alert, incidentID, incidentURL string) bool {
+	idChanged := incidentID != "" && alertEntity.GetExternalReference(entity.PagerDutyIncidentReference) != incidentID
+	urlChanged := incidentURL != "" && alertEntity.GetExternalReference(entity.PagerDutyURLReference) != incidentURL
+	if !idChanged && !urlChanged {
+		return false
+	}
+
+	if idChanged {
+		alertEntity.SetExternalReference(entity.PagerDutyIncidentReference, incidentID)
+	}
+	if urlChanged {
+		alertEntity.SetExternalReference(entity.PagerDutyURLReference, incidentURL)
+	}
+	if err := uc.alertRepo.Update(ctx, alertEntity); err != nil {
+		logger.WithContext(ctx, uc.logger).Warn("failed to record PagerDuty incident",
+			"alertID", alertEntity.ID,
+			"incidentID", incidentID,
+			"error", err,
+		)
+	}
+	return urlChanged
+}
+
+// showIncidentLink updates the alert's Slack message to link to its newly
+// recorded PagerDuty incident.
+func (uc *HandleWebhookUseCase) showIncidentLink(ctx context.Context, alertEntity *entity.Alert) {
+	slackMessageID := alertEntity.GetExternalReference("slack")
+	if slackMessageID == "" || uc.slackUpdater == nil {
+		return
+	}
+	if err := uc.slackUpdater.UpdateMessage(ctx, slackMessageID, alertEntity); err != nil {
+		logger.WithContext(ctx, uc.logger).Error("failed to link Slack message to PagerDuty incident",
+			"alertID", alertEntity.ID,
+			"slackMessageID", slackMessageID,
+			"error", err,
+		)
+	}
+	uc.persistSlackReference(ctx, alertEntity, slackMessageID)
+}
+
 // handleAcknowledged processes an incident.acknowledged event.
 func (uc *HandleWebhookUseCase) handleAcknowledged(
 	ctx context.Context,
@@ -95,9 +194,9 @@ func (uc *HandleWebhookUseCase) handleAcknowledged(
 	input dto.HandlePagerDutyWebhookInput,
 	output *dto.HandlePagerDutyWebhookOutput,
 ) (*dto.HandlePagerDutyWebhookOutput, error) {
-	// Skip if already acked
-	if alertEntity.IsAcked() || alertEntity.IsResolved() {
-		uc.logger.Debug("alert already acked/resolved, skipping PagerDuty ack sync",
+	// Skip if already acked or resolved, e.g. a replayed or late webhook
+	if !alertEntity.CanTransitionTo(entity.StateAcked) {
+		logger.WithContext(ctx, uc.logger).Debug("alert already acked/resolved, skipping PagerDuty ack sync",
 			"alertID", alertEntity.ID,
 			"state", alertEntity.State,
 		)
@@ -124,17 +223,18 @@ func (uc *HandleWebhookUseCase) handleAcknowledged(
 	slackMessageID := alertEntity.GetExternalReference("slack")
 	if slackMessageID != "" && uc.slackUpdater != nil {
 		if err := uc.slackUpdater.UpdateMessage(ctx, slackMessageID, ackOutput.Alert); err != nil {
-			uc.logger.Error("failed to update Slack message",
+			logger.WithContext(ctx, uc.logger).Error("failed to update Slack message",
 				"alertID", alertEntity.ID,
 				"slackMessageID", slackMessageID,
 				"error", err,
 			)
 		} else {
-			uc.logger.Info("updated Slack message for PagerDuty ack",
+			logger.WithContext(ctx, uc.logger).Info("updated Slack message for PagerDuty ack",
 				"alertID", alertEntity.ID,
 				"slackMessageID", slackMessageID,
 			)
 		}
+		uc.persistSlackReference(ctx, ackOutput.Alert, slackMessageID)
 	}
 
 	output.Processed = true
@@ -149,34 +249,46 @@ func (uc *HandleWebhookUseCase) handleResolved(
 	input dto.HandlePagerDutyWebhookInput,
 	output *dto.HandlePagerDutyWebhookOutput,
 ) (*dto.HandlePagerDutyWebhookOutput, error) {
-	// Skip if already resolved
-	if alertEntity.IsResolved() {
-		output.Processed = true
-		output.Message = "already resolved"
-		return output, nil
+	// Resolve the alert, attributing it to the PagerDuty user when known
+	resolvedBy := input.UserEmail
+	if resolvedBy == "" {
+		resolvedBy = "pagerduty"
+	}
+	if err := alertEntity.Resolve(resolvedBy, time.Now().UTC()); err != nil {
+		// A replayed or late webhook keeps the original resolution
+		if errors.Is(err, entity.ErrAlertAlreadyResolved) {
+			output.Processed = true
+			output.Message = "already resolved"
+			return output, nil
+		}
+		return nil, fmt.Errorf("resolving alert: %w", err)
 	}
-
-	// Resolve the alert
-	alertEntity.Resolve(time.Now().UTC())
 	if err := uc.alertRepo.Update(ctx, alertEntity); err != nil {
 		return nil, fmt.Errorf("updating alert: %w", err)
 	}
+	if uc.auditLogger != nil {
+		uc.auditLogger.Audit(ctx, entity.NewAuditEvent(entity.AuditActionResolve, resolvedBy, string(entity.AckSourcePagerDuty)).ForAlert(alertEntity.ID))
+	}
+	if uc.dependents != nil {
+		uc.dependents.ReleaseDependents(ctx, alertEntity)
+	}
 
 	// Update Slack message if we have a message ID
 	slackMessageID := alertEntity.GetExternalReference("slack")
 	if slackMessageID != "" && uc.slackUpdater != nil {
 		if err := uc.slackUpdater.UpdateMessage(ctx, slackMessageID, alertEntity); err != nil {
-			uc.logger.Error("failed to update Slack message for resolution",
+			logger.WithContext(ctx, uc.logger).Error("failed to update Slack message for resolution",
 				"alertID", alertEntity.ID,
 				"slackMessageID", slackMessageID,
 				"error", err,
 			)
 		} else {
-			uc.logger.Info("updated Slack message for PagerDuty resolution",
+			logger.WithContext(ctx, uc.logger).Info("updated Slack message for PagerDuty resolution",
 				"alertID", alertEntity.ID,
 				"slackMessageID", slackMessageID,
 			)
 		}
+		uc.persistSlackReference(ctx, alertEntity, slackMessageID)
 	}
 
 	output.Processed = true
@@ -184,27 +296,114 @@ func (uc *HandleWebhookUseCase) handleResolved(
 	return output, nil
 }
 
+// handleAnnotated processes an incident.annotated event. The note is
+// recorded as a PagerDuty ack event, acknowledging the alert if it is still
+// unacknowledged, and posted in the thread of the alert's Slack message.
+func (uc *HandleWebhookUseCase) handleAnnotated(
+	ctx context.Context,
+	alertEntity *entity.Alert,
+	input dto.HandlePagerDutyWebhookInput,
+	output *dto.HandlePagerDutyWebhookOutput,
+) (*dto.HandlePagerDutyWebhookOutput, error) {
+	note := strings.TrimSpace(input.Note)
+	if note == "" {
+		output.Processed = true
+		output.Message = "empty note ignored"
+		return output, nil
+	}
+
+	wasActive := alertEntity.IsActive()
+	ackOutput, err := uc.syncAckUC.Execute(ctx, ack.SyncAckInput{
+		AlertID:   alertEntity.ID,
+		Source:    entity.AckSourcePagerDuty,
+		UserID:    input.UserID,
+		UserEmail: input.UserEmail,
+		UserName:  input.UserName,
+		Note:      note,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("recording note: %w", err)
+	}
+
+	slackMessageID := ackOutput.Alert.GetExternalReference("slack")
+	if slackMessageID != "" && wasActive && uc.slackUpdater != nil {
+		if err := uc.slackUpdater.UpdateMessage(ctx, slackMessageID, ackOutput.Alert); err != nil {
+			logger.WithContext(ctx, uc.logger).Error("failed to update Slack message",
+				"alertID", alertEntity.ID,
+				"slackMessageID", slackMessageID,
+				"error", err,
+			)
+		}
+		uc.persistSlackReference(ctx, ackOutput.Alert, slackMessageID)
+		slackMessageID = ackOutput.Alert.GetExternalReference("slack")
+	}
+	if slackMessageID != "" && uc.threadReply != nil {
+		if err := uc.threadReply.PostThreadReply(ctx, slackMessageID, noteReply(ackOutput.AckEvent.Actor(), note)); err != nil {
+			logger.WithContext(ctx, uc.logger).Error("failed to post PagerDuty note to Slack",
+				"alertID", alertEntity.ID,
+				"slackMessageID", slackMessageID,
+				"error", err,
+			)
+		}
+	}
+
+	output.Processed = true
+	output.Message = fmt.Sprintf("note added by %s", ackOutput.AckEvent.Actor())
+	return output, nil
+}
+
+// mrkdwnEscaper escapes the characters Slack treats as control sequences.
+var mrkdwnEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// noteReply formats a PagerDuty note as a quoted Slack thread reply.
+func noteReply(actor, note string) string {
+	if actor == "" {
+		actor = "a PagerDuty responder"
+	}
+	lines := strings.Split(mrkdwnEscaper.Replace(note), "\n")
+	return fmt.Sprintf("📝 Note from %s in PagerDuty:\n>%s", mrkdwnEscaper.Replace(actor), strings.Join(lines, "\n>"))
+}
+
+// persistSlackReference stores the alert if the Slack updater re-posted a
+// deleted message and replaced its reference.
+func (uc *HandleWebhookUseCase) persistSlackReference(ctx context.Context, alertEntity *entity.Alert, previous string) {
+	if alertEntity.GetExternalReference("slack") == previous {
+		return
+	}
+	if err := uc.alertRepo.Update(ctx, alertEntity); err != nil {
+		logger.WithContext(ctx, uc.logger).Error("failed to store re-posted Slack message ID",
+			"alertID", alertEntity.ID,
+			"error", err,
+		)
+	}
+}
+
 // findAlertByIncidentKey finds an alert by PagerDuty incident key.
 // The incident key typically maps to our fingerprint.
-// Uses two-tier lookup strategy: primary by incident ID, fallback to fingerprint.
+// Looks up by incident ID first, as the dedup key or the recorded incident ID,
+// then falls back to the fingerprint.
 func (uc *HandleWebhookUseCase) findAlertByIncidentKey(ctx context.Context, incidentKey, incidentID string) (*entity.Alert, error) {
-	// First, try to find by PagerDuty incident ID (primary lookup)
-	if incidentID != "" {
+	// First, try to find by PagerDuty incident ID (primary lookup), then by
+	// the incident ID recorded from an earlier webhook
+	for _, system := range []string{"pagerduty", entity.PagerDutyIncidentReference} {
+		if incidentID == "" {
+			break
+		}
 		start := time.Now()
-		alertEntity, err := uc.alertRepo.FindByExternalReference(ctx, "pagerduty", incidentID)
+		alertEntity, err := uc.alertRepo.FindByExternalReference(ctx, system, incidentID)
 		duration := time.Since(start)
 
 		// Log query performance
 		if duration > 10*time.Millisecond {
-			uc.logger.Warn("slow database query",
+			logger.WithContext(ctx, uc.logger).Warn("slow database query",
 				"query", "FindByExternalReference",
-				"system", "pagerduty",
+				"system", system,
 				"duration_ms", duration.Milliseconds(),
 			)
 		}
 
 		if err != nil {
-			uc.logger.Error("error finding alert by incident ID",
+			logger.WithContext(ctx, uc.logger).Error("error finding alert by incident ID",
 				"incidentID", incidentID,
 				"duration_ms", duration.Milliseconds(),
 				"error", err,
@@ -213,17 +412,18 @@ func (uc *HandleWebhookUseCase) findAlertByIncidentKey(ctx context.Context, inci
 		}
 
 		if alertEntity != nil {
-			uc.logger.Debug("alert found by incident ID",
+			logger.WithContext(ctx, uc.logger).Debug("alert found by incident ID",
 				"alertID", alertEntity.ID,
 				"incidentID", incidentID,
-				"lookup_method", "incident_id",
+				"lookup_method", system,
 				"duration_ms", duration.Milliseconds(),
 			)
 			return alertEntity, nil
 		}
 
-		uc.logger.Debug("no alert found by incident ID, trying fingerprint fallback",
+		logger.WithContext(ctx, uc.logger).Debug("no alert found by incident ID",
 			"incidentID", incidentID,
+			"system", system,
 			"duration_ms", duration.Milliseconds(),
 		)
 	}
@@ -236,7 +436,7 @@ func (uc *HandleWebhookUseCase) findAlertByIncidentKey(ctx context.Context, inci
 
 		// Log query performance
 		if duration > 10*time.Millisecond {
-			uc.logger.Warn("slow database query",
+			logger.WithContext(ctx, uc.logger).Warn("slow database query",
 				"query", "FindByFingerprint",
 				"fingerprint", incidentKey,
 				"duration_ms", duration.Milliseconds(),
@@ -244,7 +444,7 @@ func (uc *HandleWebhookUseCase) findAlertByIncidentKey(ctx context.Context, inci
 		}
 
 		if err != nil {
-			uc.logger.Error("error finding alert by fingerprint",
+			logger.WithContext(ctx, uc.logger).Error("error finding alert by fingerprint",
 				"fingerprint", incidentKey,
 				"duration_ms", duration.Milliseconds(),
 				"error", err,
@@ -255,7 +455,7 @@ func (uc *HandleWebhookUseCase) findAlertByIncidentKey(ctx context.Context, inci
 		// Return the most recent firing alert (preferred)
 		for _, a := range alerts {
 			if a.IsFiring() {
-				uc.logger.Debug("alert found by fingerprint",
+				logger.WithContext(ctx, uc.logger).Debug("alert found by fingerprint",
 					"alertID", a.ID,
 					"fingerprint", incidentKey,
 					"lookup_method", "fingerprint",
@@ -269,7 +469,7 @@ func (uc *HandleWebhookUseCase) findAlertByIncidentKey(ctx context.Context, inci
 
 		// Return any alert if no firing one found
 		if len(alerts) > 0 {
-			uc.logger.Debug("alert found by fingerprint (no firing alert)",
+			logger.WithContext(ctx, uc.logger).Debug("alert found by fingerprint (no firing alert)",
 				"alertID", alerts[0].ID,
 				"fingerprint", incidentKey,
 				"lookup_method", "fingerprint",
@@ -279,7 +479,7 @@ func (uc *HandleWebhookUseCase) findAlertByIncidentKey(ctx context.Context, inci
 			return alerts[0], nil
 		}
 
-		uc.logger.Debug("no alert found by fingerprint",
+		logger.WithContext(ctx, uc.logger).Debug("no alert found by fingerprint",
 			"fingerprint", incidentKey,
 			"duration_ms", duration.Milliseconds(),
 		)
@@ -0,0 +1,71 @@
+package silence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
+)
+
+// maxExpireAttempts bounds the retries when another writer updates the
+// silence between reading and expiring it.
+const maxExpireAttempts = 3
+
+// ExpireSilenceUseCase ends a silence early. Unlike deleting it, the silence
+// is kept with its end moved to now, so its history stays available.
+type ExpireSilenceUseCase struct {
+	silenceRepo repository.SilenceRepository
+	auditLogger alert.AuditLogger
+}
+
+// NewExpireSilenceUseCase creates a new ExpireSilenceUseCase.
+func NewExpireSilenceUseCase(silenceRepo repository.SilenceRepository) *ExpireSilenceUseCase {
+	return &ExpireSilenceUseCase{silenceRepo: silenceRepo}
+}
+
+// SetAuditLogger records expired silences in the audit log.
+func (uc *ExpireSilenceUseCase) SetAuditLogger(auditLogger alert.AuditLogger) {
+	uc.auditLogger = auditLogger
+}
+
+// Execute expires the silence with the given ID in the tenant of ctx and
+// returns it. It returns repository.ErrSilenceNotFound if the silence does
+// not exist, entity.ErrSilenceExpired if it has already ended and
+// entity.ErrSilenceImported if it was imported from Alertmanager, whose next
+// sync would bring it back.
+func (uc *ExpireSilenceUseCase) Execute(ctx context.Context, id, actor string, source entity.AckSource) (*entity.SilenceMark, error) {
+	for attempt := 1; ; attempt++ {
+		silence, err := uc.silenceRepo.FindByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("finding silence: %w", err)
+		}
+		if silence == nil {
+			return nil, repository.ErrSilenceNotFound
+		}
+		if silence.IsExpired() {
+			return nil, entity.ErrSilenceExpired
+		}
+		if silence.Source == entity.AckSourceAlertmanager {
+			return nil, entity.ErrSilenceImported
+		}
+
+		silence.Cancel()
+		err = uc.silenceRepo.Update(ctx, silence)
+		if errors.Is(err, repository.ErrConcurrentUpdate) && attempt < maxExpireAttempts {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("expiring silence: %w", err)
+		}
+
+		if uc.auditLogger != nil {
+			uc.auditLogger.Audit(ctx, entity.NewAuditEvent(entity.AuditActionSilenceExpire, actor, string(source)).
+				ForSilence(silence.ID).
+				WithNote(silence.Reason))
+		}
+		return silence, nil
+	}
+}
@@ -0,0 +1,43 @@
+package silence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+func TestExpireSilence(t *testing.T) {
+	ctx := context.Background()
+	silenceRepo := memory.NewSilenceRepository()
+
+	silence, err := entity.NewSilenceMark(time.Hour, "jane", "jane@example.com", entity.AckSourceSlack)
+	require.NoError(t, err)
+	silence.ForInstance("db-1")
+	require.NoError(t, silenceRepo.Save(ctx, silence))
+
+	uc := NewExpireSilenceUseCase(silenceRepo)
+
+	expired, err := uc.Execute(ctx, silence.ID, "api", entity.AckSourceAPI)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), expired.EndAt, time.Second)
+
+	// The silence is kept, no longer active
+	stored, err := silenceRepo.FindByID(ctx, silence.ID)
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.False(t, stored.IsActive())
+	assert.Equal(t, "jane", stored.CreatedBy)
+
+	_, err = uc.Execute(ctx, silence.ID, "api", entity.AckSourceAPI)
+	assert.ErrorIs(t, err, entity.ErrSilenceExpired)
+
+	_, err = uc.Execute(ctx, "missing", "api", entity.AckSourceAPI)
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+}
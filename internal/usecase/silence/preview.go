@@ -0,0 +1,77 @@
+package silence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+)
+
+// DefaultPreviewSampleSize is how many matched alerts a preview lists.
+const DefaultPreviewSampleSize = 20
+
+// PreviewSilenceUseCase reports which firing alerts a proposed silence would
+// match, so over-broad silences can be caught before they are created.
+// Nothing is persisted.
+type PreviewSilenceUseCase struct {
+	alertRepo  repository.AlertRepository
+	sampleSize int
+}
+
+// NewPreviewSilenceUseCase creates a new PreviewSilenceUseCase.
+func NewPreviewSilenceUseCase(alertRepo repository.AlertRepository) *PreviewSilenceUseCase {
+	return &PreviewSilenceUseCase{
+		alertRepo:  alertRepo,
+		sampleSize: DefaultPreviewSampleSize,
+	}
+}
+
+// SetSampleSize sets how many matched alerts a preview lists.
+func (uc *PreviewSilenceUseCase) SetSampleSize(size int) {
+	uc.sampleSize = size
+}
+
+// Execute matches the proposed silence against the firing alerts with
+// SilenceMark.MatchesAlert, as if it were created now in the tenant of ctx.
+// The sample lists the most recently fired matches first.
+func (uc *PreviewSilenceUseCase) Execute(ctx context.Context, input dto.SilencePreviewRequest) (*dto.SilencePreviewResponse, error) {
+	alerts, err := uc.alertRepo.FindFiring(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("finding firing alerts: %w", err)
+	}
+
+	proposed := proposedSilence(ctx, input)
+	output := &dto.SilencePreviewResponse{Sample: make([]dto.AlertSummary, 0)}
+	for _, alert := range alerts {
+		if !proposed.MatchesAlert(alert) {
+			continue
+		}
+		output.Count++
+		if len(output.Sample) < uc.sampleSize {
+			output.Sample = append(output.Sample, dto.NewAlertSummary(alert))
+		}
+	}
+	output.Truncated = output.Count > len(output.Sample)
+	return output, nil
+}
+
+// proposedSilence builds an active, unsaved silence from the preview request.
+func proposedSilence(ctx context.Context, input dto.SilencePreviewRequest) *entity.SilenceMark {
+	now := time.Now().UTC()
+	silence := &entity.SilenceMark{
+		AlertID:     input.AlertID,
+		Instance:    input.Instance,
+		Fingerprint: input.Fingerprint,
+		Labels:      input.Labels,
+		StartAt:     now,
+		EndAt:       now.Add(time.Minute),
+		Source:      entity.AckSourceAPI,
+	}
+	if tenantID, ok := repository.TenantFromContext(ctx); ok {
+		silence.TenantID = tenantID
+	}
+	return silence
+}
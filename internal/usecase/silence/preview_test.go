@@ -0,0 +1,56 @@
+package silence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+func TestPreviewSilence_MatchesFiringAlerts(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+
+	for _, instance := range []string{"db-1", "db-2", "db-3"} {
+		a := entity.NewAlert("fp-"+instance, "DiskFull", instance, "", "", entity.SeverityCritical)
+		a.AddLabel("team", "storage")
+		require.NoError(t, alertRepo.Save(ctx, a))
+	}
+	other := entity.NewAlert("fp-web", "HighCPU", "web-1", "", "", entity.SeverityWarning)
+	other.AddLabel("team", "web")
+	require.NoError(t, alertRepo.Save(ctx, other))
+	resolved := entity.NewAlert("fp-old", "DiskFull", "db-4", "", "", entity.SeverityCritical)
+	resolved.AddLabel("team", "storage")
+	resolved.Resolve("alertmanager", time.Now())
+	require.NoError(t, alertRepo.Save(ctx, resolved))
+
+	uc := NewPreviewSilenceUseCase(alertRepo)
+	uc.SetSampleSize(2)
+
+	out, err := uc.Execute(ctx, dto.SilencePreviewRequest{Labels: map[string]string{"team": "storage"}})
+	require.NoError(t, err)
+	assert.Equal(t, 3, out.Count)
+	assert.Len(t, out.Sample, 2)
+	assert.True(t, out.Truncated)
+	for _, summary := range out.Sample {
+		assert.Equal(t, "DiskFull", summary.Name)
+	}
+
+	out, err = uc.Execute(ctx, dto.SilencePreviewRequest{Instance: "web-1"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, out.Count)
+	assert.False(t, out.Truncated)
+	assert.Equal(t, other.ID, out.Sample[0].ID)
+
+	// A silence matching nothing reports an empty sample, not null
+	out, err = uc.Execute(ctx, dto.SilencePreviewRequest{Instance: "none"})
+	require.NoError(t, err)
+	assert.Zero(t, out.Count)
+	assert.NotNil(t, out.Sample)
+}
@@ -0,0 +1,296 @@
+package silence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp/syntax"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/logger"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/alertmanager"
+)
+
+const (
+	// DefaultSyncInterval is how often silences are imported from Alertmanager.
+	DefaultSyncInterval = time.Minute
+
+	// maxExpandedSilences caps how many silences one Alertmanager silence
+	// with regex alternatives may expand into.
+	maxExpandedSilences = 64
+)
+
+// ErrUnsupportedMatcher is returned for Alertmanager matchers that cannot be
+// expressed as label equality, such as negative matchers or regexes other
+// than a list of literal alternatives.
+var ErrUnsupportedMatcher = errors.New("unsupported matcher")
+
+// importNamespace is the UUIDv5 namespace for IDs of imported silences.
+var importNamespace = uuid.MustParse("37674ea5-e4cb-443b-bf39-478b1a7db198")
+
+// Logger is the unified logging interface from domain layer.
+type Logger = logger.Logger
+
+// SilenceLister lists the current silences of an Alertmanager.
+type SilenceLister interface {
+	ListSilences(ctx context.Context) ([]alertmanager.Silence, error)
+}
+
+// SyncResult counts the changes made by one sync.
+type SyncResult struct {
+	Imported int // silences created
+	Updated  int // imported silences changed upstream
+	Removed  int // imported silences no longer present upstream
+	Skipped  int // Alertmanager silences with unsupported matchers
+}
+
+// SyncAlertmanagerSilencesUseCase mirrors Alertmanager silences into the
+// silence repository, so alerts silenced there are not notified here.
+// Imported silences carry entity.AckSourceAlertmanager; silences from any
+// other source are never modified or removed.
+type SyncAlertmanagerSilencesUseCase struct {
+	lister      SilenceLister
+	silenceRepo repository.SilenceRepository
+	logger      Logger
+	interval    time.Duration
+
+	// tenantLabel names the matcher label holding an imported silence's tenant.
+	tenantLabel string
+}
+
+// NewSyncAlertmanagerSilencesUseCase creates a sync with the default interval.
+func NewSyncAlertmanagerSilencesUseCase(
+	lister SilenceLister,
+	silenceRepo repository.SilenceRepository,
+	logger Logger,
+) *SyncAlertmanagerSilencesUseCase {
+	return &SyncAlertmanagerSilencesUseCase{
+		lister:      lister,
+		silenceRepo: silenceRepo,
+		logger:      logger,
+		interval:    DefaultSyncInterval,
+	}
+}
+
+// SetInterval sets how often Run syncs. Non-positive values are ignored.
+func (uc *SyncAlertmanagerSilencesUseCase) SetInterval(interval time.Duration) {
+	if interval > 0 {
+		uc.interval = interval
+	}
+}
+
+// SetTenantLabel assigns imported silences to the tenant their matcher on
+// the given label selects. Silences without such a matcher belong to the
+// default tenant.
+func (uc *SyncAlertmanagerSilencesUseCase) SetTenantLabel(label string) {
+	uc.tenantLabel = label
+}
+
+// Run syncs immediately and then every interval until ctx is cancelled.
+func (uc *SyncAlertmanagerSilencesUseCase) Run(ctx context.Context) {
+	ticker := time.NewTicker(uc.interval)
+	defer ticker.Stop()
+
+	for {
+		result, err := uc.Sync(ctx)
+		if err != nil && ctx.Err() == nil {
+			uc.logger.Error("alertmanager silence sync failed", "error", err)
+		} else if result != (SyncResult{}) {
+			uc.logger.Info("synced alertmanager silences",
+				"imported", result.Imported,
+				"updated", result.Updated,
+				"removed", result.Removed,
+				"skipped", result.Skipped,
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync imports new Alertmanager silences, updates changed ones and removes
+// imported silences that are no longer active upstream.
+// Imported silences that have not started yet are removed once they start.
+func (uc *SyncAlertmanagerSilencesUseCase) Sync(ctx context.Context) (SyncResult, error) {
+	var result SyncResult
+
+	upstream, err := uc.lister.ListSilences(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	current := make(map[string]bool)
+	for _, amSilence := range upstream {
+		silences, err := importSilence(amSilence)
+		if err != nil {
+			uc.logger.Warn("skipping alertmanager silence",
+				"silenceID", amSilence.ID,
+				"error", err,
+			)
+			result.Skipped++
+			continue
+		}
+
+		for _, silence := range silences {
+			if uc.tenantLabel != "" {
+				silence.TenantID = silence.Labels[uc.tenantLabel]
+			}
+			current[silence.ID] = true
+			if err := uc.upsert(ctx, silence, &result); err != nil {
+				return result, err
+			}
+		}
+	}
+
+	active, err := uc.silenceRepo.FindActive(ctx)
+	if err != nil {
+		return result, fmt.Errorf("finding active silences: %w", err)
+	}
+	for _, silence := range active {
+		if silence.Source != entity.AckSourceAlertmanager || current[silence.ID] {
+			continue
+		}
+		if err := uc.silenceRepo.Delete(ctx, silence.ID); err != nil && !errors.Is(err, entity.ErrSilenceNotFound) {
+			return result, fmt.Errorf("removing imported silence %s: %w", silence.ID, err)
+		}
+		result.Removed++
+	}
+
+	return result, nil
+}
+
+// upsert saves an imported silence, or updates the stored copy if it changed.
+func (uc *SyncAlertmanagerSilencesUseCase) upsert(ctx context.Context, silence *entity.SilenceMark, result *SyncResult) error {
+	existing, err := uc.silenceRepo.FindByID(ctx, silence.ID)
+	if err != nil {
+		return fmt.Errorf("finding imported silence %s: %w", silence.ID, err)
+	}
+
+	if existing == nil {
+		if err := uc.silenceRepo.Save(ctx, silence); err != nil {
+			return fmt.Errorf("saving imported silence %s: %w", silence.ID, err)
+		}
+		result.Imported++
+		return nil
+	}
+
+	if existing.Source != entity.AckSourceAlertmanager || sameSilence(existing, silence) {
+		return nil
+	}
+
+	existing.Instance = silence.Instance
+	existing.Labels = silence.Labels
+	existing.StartAt = silence.StartAt
+	existing.EndAt = silence.EndAt
+	existing.CreatedBy = silence.CreatedBy
+	existing.Reason = silence.Reason
+	existing.TenantID = silence.TenantID
+	if err := uc.silenceRepo.Update(ctx, existing); err != nil {
+		return fmt.Errorf("updating imported silence %s: %w", silence.ID, err)
+	}
+	result.Updated++
+	return nil
+}
+
+// sameSilence reports whether two imported silences match the same alerts
+// over the same window with the same details.
+func sameSilence(a, b *entity.SilenceMark) bool {
+	return a.Instance == b.Instance &&
+		maps.Equal(a.Labels, b.Labels) &&
+		a.StartAt.Equal(b.StartAt) &&
+		a.EndAt.Equal(b.EndAt) &&
+		a.CreatedBy == b.CreatedBy &&
+		a.Reason == b.Reason &&
+		a.TenantID == b.TenantID
+}
+
+// importSilence maps an Alertmanager silence to silences of our model.
+// Equality matchers become label matches, with the instance label moved to
+// SilenceMark.Instance. A regex matcher listing literal alternatives, such
+// as env=~"prod|staging", yields one silence per alternative.
+func importSilence(amSilence alertmanager.Silence) ([]*entity.SilenceMark, error) {
+	combinations := []map[string]string{{}}
+	for _, matcher := range amSilence.Matchers {
+		values, err := matcherValues(matcher)
+		if err != nil {
+			return nil, err
+		}
+		if len(combinations)*len(values) > maxExpandedSilences {
+			return nil, fmt.Errorf("%w: regex alternatives expand to more than %d silences", ErrUnsupportedMatcher, maxExpandedSilences)
+		}
+
+		expanded := make([]map[string]string, 0, len(combinations)*len(values))
+		for _, labels := range combinations {
+			for _, value := range values {
+				next := maps.Clone(labels)
+				next[matcher.Name] = value
+				expanded = append(expanded, next)
+			}
+		}
+		combinations = expanded
+	}
+
+	// Whole seconds keep the window identical across storage backends, so
+	// an unchanged silence is not rewritten on every sync.
+	startAt := amSilence.StartsAt.UTC().Truncate(time.Second)
+	endAt := amSilence.EndsAt.UTC().Truncate(time.Second)
+
+	silences := make([]*entity.SilenceMark, 0, len(combinations))
+	for i, labels := range combinations {
+		silence := &entity.SilenceMark{
+			ID:        uuid.NewSHA1(importNamespace, []byte(fmt.Sprintf("%s/%d", amSilence.ID, i))).String(),
+			Labels:    labels,
+			StartAt:   startAt,
+			EndAt:     endAt,
+			CreatedBy: amSilence.CreatedBy,
+			Reason:    amSilence.Comment,
+			Source:    entity.AckSourceAlertmanager,
+			CreatedAt: time.Now().UTC(),
+		}
+		// An empty instance matcher selects alerts without the label, which
+		// only the label match expresses.
+		if instance := labels["instance"]; instance != "" {
+			delete(labels, "instance")
+			silence.ForInstance(instance)
+		}
+		silences = append(silences, silence)
+	}
+	return silences, nil
+}
+
+// matcherValues returns the label values an Alertmanager matcher accepts.
+func matcherValues(matcher alertmanager.Matcher) ([]string, error) {
+	if !matcher.Equal() {
+		return nil, fmt.Errorf("%w: negative matcher on %q", ErrUnsupportedMatcher, matcher.Name)
+	}
+	if !matcher.IsRegex {
+		return []string{matcher.Value}, nil
+	}
+
+	var values []string
+	for _, alternative := range strings.Split(matcher.Value, "|") {
+		re, err := syntax.Parse(alternative, syntax.Perl)
+		if err != nil {
+			return nil, fmt.Errorf("%w: regex %q on %q", ErrUnsupportedMatcher, matcher.Value, matcher.Name)
+		}
+		switch {
+		case re.Op == syntax.OpEmptyMatch:
+			values = append(values, "")
+		case re.Op == syntax.OpLiteral && re.Flags&syntax.FoldCase == 0:
+			values = append(values, string(re.Rune))
+		default:
+			return nil, fmt.Errorf("%w: regex %q on %q", ErrUnsupportedMatcher, matcher.Value, matcher.Name)
+		}
+	}
+	return values, nil
+}
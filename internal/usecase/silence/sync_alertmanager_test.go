@@ -0,0 +1,157 @@
+package silence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/alertmanager"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+type fakeLister struct {
+	silences []alertmanager.Silence
+}
+
+func (f *fakeLister) ListSilences(ctx context.Context) ([]alertmanager.Silence, error) {
+	return f.silences, nil
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...any) {}
+func (nopLogger) Info(string, ...any)  {}
+func (nopLogger) Warn(string, ...any)  {}
+func (nopLogger) Error(string, ...any) {}
+
+func amSilence(id string, matchers ...alertmanager.Matcher) alertmanager.Silence {
+	now := time.Now().UTC()
+	return alertmanager.Silence{
+		ID:        id,
+		Matchers:  matchers,
+		StartsAt:  now.Add(-time.Minute),
+		EndsAt:    now.Add(time.Hour),
+		CreatedBy: "jane",
+		Comment:   "maintenance",
+		Status:    alertmanager.SilenceStatus{State: "active"},
+	}
+}
+
+func TestImportSilence_Matchers(t *testing.T) {
+	notEqual := false
+	tests := []struct {
+		name     string
+		matchers []alertmanager.Matcher
+		want     []map[string]string // labels per silence
+		instance []string
+		wantErr  bool
+	}{
+		{
+			name:     "equality",
+			matchers: []alertmanager.Matcher{{Name: "env", Value: "prod"}, {Name: "team", Value: "platform"}},
+			want:     []map[string]string{{"env": "prod", "team": "platform"}},
+			instance: []string{""},
+		},
+		{
+			name:     "instance",
+			matchers: []alertmanager.Matcher{{Name: "instance", Value: "host-1"}, {Name: "env", Value: "prod"}},
+			want:     []map[string]string{{"env": "prod"}},
+			instance: []string{"host-1"},
+		},
+		{
+			name:     "regex alternatives",
+			matchers: []alertmanager.Matcher{{Name: "env", Value: "prod|staging", IsRegex: true}},
+			want:     []map[string]string{{"env": "prod"}, {"env": "staging"}},
+			instance: []string{"", ""},
+		},
+		{
+			name:     "escaped regex literal",
+			matchers: []alertmanager.Matcher{{Name: "instance", Value: `host-1\.example\.com`, IsRegex: true}},
+			want:     []map[string]string{{}},
+			instance: []string{"host-1.example.com"},
+		},
+		{
+			name:     "regex wildcard",
+			matchers: []alertmanager.Matcher{{Name: "env", Value: "prod-.*", IsRegex: true}},
+			wantErr:  true,
+		},
+		{
+			name:     "negative matcher",
+			matchers: []alertmanager.Matcher{{Name: "env", Value: "prod", IsEqual: &notEqual}},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			silences, err := importSilence(amSilence("am-1", tt.matchers...))
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrUnsupportedMatcher)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, silences, len(tt.want))
+			for i, silence := range silences {
+				assert.Equal(t, tt.want[i], silence.Labels)
+				assert.Equal(t, tt.instance[i], silence.Instance)
+				assert.Equal(t, entity.AckSourceAlertmanager, silence.Source)
+			}
+		})
+	}
+}
+
+func TestSyncAlertmanagerSilences(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewSilenceRepository()
+	lister := &fakeLister{}
+	uc := NewSyncAlertmanagerSilencesUseCase(lister, repo, nopLogger{})
+
+	userSilence, err := entity.NewSilenceMark(time.Hour, "U1", "", entity.AckSourceSlack)
+	require.NoError(t, err)
+	userSilence.ForInstance("host-2")
+	require.NoError(t, repo.Save(ctx, userSilence))
+
+	maintenance := amSilence("am-1", alertmanager.Matcher{Name: "env", Value: "prod|staging", IsRegex: true})
+	lister.silences = []alertmanager.Silence{
+		maintenance,
+		amSilence("am-2", alertmanager.Matcher{Name: "job", Value: ".+", IsRegex: true}),
+	}
+
+	result, err := uc.Sync(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, SyncResult{Imported: 2, Skipped: 1}, result)
+
+	alert := entity.NewAlert("fp-1", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	alert.AddLabel("env", "staging")
+	matches, err := repo.FindMatchingAlert(ctx, alert)
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	assert.Equal(t, "maintenance", matches[0].Reason)
+
+	// Unchanged silences are left alone.
+	result, err = uc.Sync(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, SyncResult{Skipped: 1}, result)
+
+	// Extended upstream.
+	maintenance.EndsAt = maintenance.EndsAt.Add(time.Hour)
+	lister.silences = []alertmanager.Silence{maintenance}
+	result, err = uc.Sync(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, SyncResult{Updated: 2}, result)
+
+	// Expired or deleted upstream; the user's silence stays.
+	lister.silences = nil
+	result, err = uc.Sync(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, SyncResult{Removed: 2}, result)
+
+	active, err := repo.FindActive(ctx)
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+	assert.Equal(t, userSilence.ID, active[0].ID)
+}
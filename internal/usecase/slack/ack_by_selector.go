@@ -0,0 +1,91 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/logger"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/ack"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
+)
+
+// AckBySelectorUseCase acknowledges every firing alert matching a label
+// selector, for the /ack slash command.
+type AckBySelectorUseCase struct {
+	alertRepo   repository.AlertRepository
+	syncAckUC   *ack.SyncAckUseCase
+	slackClient SlackClient
+	logger      alert.Logger
+}
+
+// NewAckBySelectorUseCase creates a new AckBySelectorUseCase.
+func NewAckBySelectorUseCase(
+	alertRepo repository.AlertRepository,
+	syncAckUC *ack.SyncAckUseCase,
+	slackClient SlackClient,
+	logger alert.Logger,
+) *AckBySelectorUseCase {
+	return &AckBySelectorUseCase{
+		alertRepo:   alertRepo,
+		syncAckUC:   syncAckUC,
+		slackClient: slackClient,
+		logger:      logger,
+	}
+}
+
+// Execute acknowledges the alerts matching selector on behalf of the Slack
+// user. Alerts that fail are counted in the message; the rest are still
+// acknowledged and their Slack messages updated.
+func (uc *AckBySelectorUseCase) Execute(ctx context.Context, userID, userName string, selector map[string]string) (*dto.SlackInteractionOutput, error) {
+	userEmail, err := uc.slackClient.GetUserEmail(ctx, userID)
+	if err != nil {
+		logger.WithContext(ctx, uc.logger).Warn("failed to get user email",
+			"userID", userID,
+			"error", err,
+		)
+		userEmail = userID // Fallback to user ID
+	}
+
+	results, err := uc.syncAckUC.ExecuteBulk(ctx, ack.BulkAckInput{
+		Selector:  selector,
+		Source:    entity.AckSourceSlack,
+		UserID:    userID,
+		UserEmail: userEmail,
+		UserName:  userName,
+		Note:      "Acknowledged via /ack",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("acknowledging alerts: %w", err)
+	}
+	if len(results) == 0 {
+		return &dto.SlackInteractionOutput{Success: true, Message: "No unacknowledged alerts match the selector."}, nil
+	}
+
+	var failed int
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+			continue
+		}
+		// The Slack syncer skips Slack-sourced acks, so update the messages here
+		if result.Output.Alert.HasExternalReference("slack") {
+			messageID := result.Output.Alert.GetExternalReference("slack")
+			updateAlertMessage(ctx, uc.slackClient, uc.alertRepo, logger.WithContext(ctx, uc.logger), result.Output.Alert, messageID)
+		}
+	}
+
+	acked := len(results) - failed
+	if failed > 0 {
+		return &dto.SlackInteractionOutput{
+			Success: acked > 0,
+			Message: fmt.Sprintf("Acknowledged %d alert(s); %d could not be acknowledged.", acked, failed),
+		}, nil
+	}
+	return &dto.SlackInteractionOutput{
+		Success: true,
+		Message: fmt.Sprintf("Acknowledged %d alert(s).", acked),
+	}, nil
+}
@@ -0,0 +1,256 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/logger"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
+)
+
+// DefaultAppHomeRefreshInterval is how often Run checks alerts for changes unless configured.
+const DefaultAppHomeRefreshInterval = 30 * time.Second
+
+const (
+	// appHomeViewerTTL is how long a viewer's Home tab keeps being refreshed
+	// after they last opened or acted on it.
+	appHomeViewerTTL = 24 * time.Hour
+
+	// maxAppHomeViewers caps the Home tabs kept up to date; the viewer seen
+	// least recently is dropped first.
+	maxAppHomeViewers = 1000
+)
+
+// HomeViewPublisher publishes the App Home tab of a Slack user.
+type HomeViewPublisher interface {
+	PublishHomeView(ctx context.Context, userID string, alerts []*entity.Alert) error
+}
+
+// ViewerTenants finds the tenants whose alerts a Slack user may see.
+type ViewerTenants interface {
+	TenantsOfUser(ctx context.Context, userID string) ([]string, error)
+}
+
+// AppHomeUseCase keeps the Slack App Home tab of every user who opened it
+// listing the active alerts.
+type AppHomeUseCase struct {
+	alertRepo repository.AlertRepository
+	publisher HomeViewPublisher
+	tenants   ViewerTenants
+	logger    alert.Logger
+	interval  time.Duration
+
+	mu sync.Mutex
+	// viewers are the users whose Home tab is republished on changes
+	viewers map[string]*homeViewer
+	// published identifies the alerts the Home tabs of each set of tenants
+	// last showed, keyed by tenantsKey
+	published map[string]string
+}
+
+// homeViewer is a user whose Home tab is kept up to date.
+type homeViewer struct {
+	// tenants are the tenants whose alerts the user sees, nil for all
+	tenants []string
+	// seenAt is when the user last opened or acted on the Home tab
+	seenAt time.Time
+}
+
+// NewAppHomeUseCase creates a new AppHomeUseCase.
+func NewAppHomeUseCase(alertRepo repository.AlertRepository, publisher HomeViewPublisher, logger alert.Logger) *AppHomeUseCase {
+	return &AppHomeUseCase{
+		alertRepo: alertRepo,
+		publisher: publisher,
+		logger:    logger,
+		interval:  DefaultAppHomeRefreshInterval,
+		viewers:   make(map[string]*homeViewer),
+		published: make(map[string]string),
+	}
+}
+
+// SetViewerTenants scopes each user's Home tab to the tenants tenants finds
+// for them; a user of no tenant sees the default tenant's alerts. Without it
+// every user sees all alerts.
+func (uc *AppHomeUseCase) SetViewerTenants(tenants ViewerTenants) {
+	uc.tenants = tenants
+}
+
+// SetInterval sets how often Run checks alerts for changes. Non-positive values are ignored.
+func (uc *AppHomeUseCase) SetInterval(interval time.Duration) {
+	if interval > 0 {
+		uc.interval = interval
+	}
+}
+
+// Open publishes the Home tab of a user who opened it or acted on it, and
+// keeps it up to date from then on.
+func (uc *AppHomeUseCase) Open(ctx context.Context, userID string) error {
+	var tenants []string
+	if uc.tenants != nil {
+		var err error
+		if tenants, err = uc.tenants.TenantsOfUser(ctx, userID); err != nil {
+			return fmt.Errorf("finding tenants of user: %w", err)
+		}
+		if len(tenants) == 0 {
+			tenants = []string{""}
+		}
+	}
+
+	alerts, err := uc.activeAlerts(ctx, tenants)
+	if err != nil {
+		return err
+	}
+
+	uc.mu.Lock()
+	uc.viewers[userID] = &homeViewer{tenants: tenants, seenAt: time.Now()}
+	uc.pruneViewers(time.Now())
+	uc.mu.Unlock()
+
+	if err := uc.publisher.PublishHomeView(ctx, userID, alerts); err != nil {
+		return fmt.Errorf("publishing home view: %w", err)
+	}
+	return nil
+}
+
+// Refresh republishes the Home tab of every viewer whose active alerts
+// changed since the last refresh, and returns how many were republished.
+// A viewer whose Home tab fails to publish is retried on the next change.
+// Viewers not seen for a day are no longer refreshed.
+func (uc *AppHomeUseCase) Refresh(ctx context.Context) (int, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	uc.pruneViewers(time.Now())
+
+	// Viewers of the same tenants see the same alerts
+	type viewerGroup struct {
+		tenants []string
+		userIDs []string
+	}
+	groups := make(map[string]*viewerGroup)
+	for userID, viewer := range uc.viewers {
+		key := tenantsKey(viewer.tenants)
+		if groups[key] == nil {
+			groups[key] = &viewerGroup{tenants: viewer.tenants}
+		}
+		groups[key].userIDs = append(groups[key].userIDs, userID)
+	}
+	for key := range uc.published {
+		if groups[key] == nil {
+			delete(uc.published, key)
+		}
+	}
+
+	published := 0
+	for key, group := range groups {
+		alerts, err := uc.activeAlerts(ctx, group.tenants)
+		if err != nil {
+			return published, err
+		}
+		signature := alertsSignature(alerts)
+		if signature == uc.published[key] {
+			continue
+		}
+		uc.published[key] = signature
+
+		for _, userID := range group.userIDs {
+			if err := uc.publisher.PublishHomeView(ctx, userID, alerts); err != nil {
+				logger.WithContext(ctx, uc.logger).Warn("failed to refresh home view", "userID", userID, "error", err)
+				continue
+			}
+			published++
+		}
+	}
+	return published, nil
+}
+
+// pruneViewers drops the viewers not seen within appHomeViewerTTL, then the
+// least recently seen ones beyond maxAppHomeViewers. Callers hold uc.mu.
+func (uc *AppHomeUseCase) pruneViewers(now time.Time) {
+	for userID, viewer := range uc.viewers {
+		if now.Sub(viewer.seenAt) > appHomeViewerTTL {
+			delete(uc.viewers, userID)
+		}
+	}
+	for len(uc.viewers) > maxAppHomeViewers {
+		var oldest string
+		for userID, viewer := range uc.viewers {
+			if oldest == "" || viewer.seenAt.Before(uc.viewers[oldest].seenAt) {
+				oldest = userID
+			}
+		}
+		delete(uc.viewers, oldest)
+	}
+}
+
+// Run refreshes the Home tabs every interval until ctx is cancelled.
+func (uc *AppHomeUseCase) Run(ctx context.Context) {
+	ticker := time.NewTicker(uc.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		count, err := uc.Refresh(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				uc.logger.Error("refreshing home views failed", "error", err)
+			}
+			continue
+		}
+		if count > 0 {
+			uc.logger.Debug("refreshed home views", "users", count)
+		}
+	}
+}
+
+// activeAlerts returns the unresolved alerts of tenants, or of every tenant
+// if tenants is nil, most urgent first.
+func (uc *AppHomeUseCase) activeAlerts(ctx context.Context, tenants []string) ([]*entity.Alert, error) {
+	if tenants == nil {
+		alerts, err := uc.alertRepo.GetActiveAlerts(ctx, "")
+		if err != nil {
+			return nil, fmt.Errorf("getting active alerts: %w", err)
+		}
+		entity.DefaultSeverityWeights().SortByPriority(alerts)
+		return alerts, nil
+	}
+
+	var alerts []*entity.Alert
+	for _, tenantID := range tenants {
+		tenantAlerts, err := uc.alertRepo.GetActiveAlerts(repository.NewContextWithTenant(ctx, tenantID), "")
+		if err != nil {
+			return nil, fmt.Errorf("getting active alerts of tenant %q: %w", tenantID, err)
+		}
+		alerts = append(alerts, tenantAlerts...)
+	}
+	entity.DefaultSeverityWeights().SortByPriority(alerts)
+	return alerts, nil
+}
+
+// tenantsKey identifies a set of tenants, distinguishing nil (all tenants)
+// from the default tenant alone.
+func tenantsKey(tenants []string) string {
+	if tenants == nil {
+		return "*"
+	}
+	return strings.Join(tenants, ",")
+}
+
+// alertsSignature identifies the alerts and the state they are shown in.
+func alertsSignature(alerts []*entity.Alert) string {
+	var b strings.Builder
+	for _, alert := range alerts {
+		fmt.Fprintf(&b, "%s/%s/%s/%d;", alert.ID, alert.State, alert.Severity, alert.UpdatedAt.UnixNano())
+	}
+	return b.String()
+}
@@ -2,6 +2,7 @@ package slack
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/logger"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
 	slackInfra "github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/slack"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/ack"
@@ -20,9 +22,15 @@ import (
 type HandleInteractionUseCase struct {
 	alertRepo   repository.AlertRepository
 	silenceRepo repository.SilenceRepository
+	silenceUC   *ManageSilenceUseCase
 	syncAckUC   *ack.SyncAckUseCase
+	assignUC    *alert.AssignUseCase
 	slackClient SlackClient
 	logger      alert.Logger
+	auditLogger alert.AuditLogger
+
+	// instanceSilenceDuration is how long the "silence instance" button silences.
+	instanceSilenceDuration time.Duration
 }
 
 // SlackClient defines the required Slack client operations.
@@ -37,15 +45,35 @@ func NewHandleInteractionUseCase(
 	alertRepo repository.AlertRepository,
 	silenceRepo repository.SilenceRepository,
 	syncAckUC *ack.SyncAckUseCase,
+	assignUC *alert.AssignUseCase,
 	slackClient SlackClient,
 	logger alert.Logger,
 ) *HandleInteractionUseCase {
 	return &HandleInteractionUseCase{
 		alertRepo:   alertRepo,
 		silenceRepo: silenceRepo,
+		silenceUC:   NewManageSilenceUseCase(silenceRepo, alertRepo, nil),
 		syncAckUC:   syncAckUC,
+		assignUC:    assignUC,
 		slackClient: slackClient,
 		logger:      logger,
+
+		instanceSilenceDuration: time.Hour,
+	}
+}
+
+// SetAuditLogger records silences created from Slack buttons and modals in
+// the audit log. Acknowledgments are recorded by the SyncAckUseCase.
+func (uc *HandleInteractionUseCase) SetAuditLogger(auditLogger alert.AuditLogger) {
+	uc.auditLogger = auditLogger
+	uc.silenceUC.SetAuditLogger(auditLogger)
+}
+
+// SetInstanceSilenceDuration sets how long the "silence instance" button
+// silences alerts from the clicked alert's instance.
+func (uc *HandleInteractionUseCase) SetInstanceSilenceDuration(d time.Duration) {
+	if d > 0 {
+		uc.instanceSilenceDuration = d
 	}
 }
 
@@ -54,13 +82,21 @@ func (uc *HandleInteractionUseCase) Execute(ctx context.Context, input dto.Slack
 	// Parse action type from action ID
 	actionType, alertID := parseActionID(input.ActionID)
 
+	// Link buttons (e.g., runbook) open a URL client-side; nothing to do here.
+	if actionType == "runbook" || actionType == "alertmanager" {
+		return &dto.SlackInteractionOutput{Success: true, Message: "link opened"}, nil
+	}
+	if actionType == "more" {
+		return uc.handleMore(ctx, alertID, input)
+	}
+
 	// Get user email
 	userEmail := input.UserEmail
 	if userEmail == "" {
 		var err error
 		userEmail, err = uc.slackClient.GetUserEmail(ctx, input.UserID)
 		if err != nil {
-			uc.logger.Warn("failed to get user email",
+			logger.WithContext(ctx, uc.logger).Warn("failed to get user email",
 				"userID", input.UserID,
 				"error", err,
 			)
@@ -73,6 +109,14 @@ func (uc *HandleInteractionUseCase) Execute(ctx context.Context, input dto.Slack
 		return uc.handleAck(ctx, alertID, input, userEmail)
 	case "silence":
 		return uc.handleSilence(ctx, alertID, input, userEmail)
+	case "silenceinstance":
+		return uc.handleSilenceInstance(ctx, alertID, input, userEmail)
+	case "unsilence":
+		return uc.handleUnsilence(ctx, alertID, input, userEmail)
+	case "assign":
+		return uc.handleAssign(ctx, alertID, input, userEmail)
+	case "unassign":
+		return uc.handleUnassign(ctx, alertID, input, userEmail)
 	default:
 		return nil, fmt.Errorf("unknown action type: %s", actionType)
 	}
@@ -93,22 +137,48 @@ func (uc *HandleInteractionUseCase) handleAck(ctx context.Context, alertID strin
 	if err != nil {
 		return nil, fmt.Errorf("syncing ack: %w", err)
 	}
-
-	// Update Slack message to show acknowledged state
-	messageID := fmt.Sprintf("%s:%s", input.ChannelID, input.MessageTS)
-	if err := uc.slackClient.UpdateMessage(ctx, messageID, output.Alert); err != nil {
-		uc.logger.Error("failed to update Slack message",
-			"messageID", messageID,
-			"error", err,
-		)
+	if output.AlreadyAcked {
+		return &dto.SlackInteractionOutput{
+			Success: true,
+			Message: fmt.Sprintf("Alert already acknowledged by %s", output.Alert.AckedBy),
+		}, nil
 	}
 
+	// Update every copy of the Slack message to show acknowledged state
+	messageID := alertMessageID(output.Alert, input.MessageRef())
+	updateAlertMessage(ctx, uc.slackClient, uc.alertRepo, logger.WithContext(ctx, uc.logger), output.Alert, messageID)
+
 	return &dto.SlackInteractionOutput{
 		Success: true,
 		Message: fmt.Sprintf("Alert acknowledged by %s", input.UserName),
 	}, nil
 }
 
+// handleMore handles an option picked from the "more actions" menu, named
+// by the action value. Link options open a URL client-side; the fingerprint
+// option replies with the alert's fingerprint, ready to copy.
+func (uc *HandleInteractionUseCase) handleMore(ctx context.Context, alertID string, input dto.SlackInteractionInput) (*dto.SlackInteractionOutput, error) {
+	switch input.Value {
+	case slackInfra.OverflowRunbook, slackInfra.OverflowAlertmanager, slackInfra.OverflowPagerDuty:
+		return &dto.SlackInteractionOutput{Success: true, Message: "link opened"}, nil
+	case slackInfra.OverflowFingerprint:
+		alertEntity, err := uc.alertRepo.FindByID(ctx, alertID)
+		if err != nil {
+			return nil, fmt.Errorf("finding alert: %w", err)
+		}
+		if alertEntity == nil {
+			return nil, entity.ErrAlertNotFound
+		}
+		return &dto.SlackInteractionOutput{
+			Success: true,
+			Message: "fingerprint shown",
+			Reply:   fmt.Sprintf("🔑 Fingerprint of *%s*: `%s`", alertEntity.Name, alertEntity.Fingerprint),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown menu option: %s", input.Value)
+	}
+}
+
 // handleSilence handles the silence action.
 func (uc *HandleInteractionUseCase) handleSilence(ctx context.Context, alertID string, input dto.SlackInteractionInput, userEmail string) (*dto.SlackInteractionOutput, error) {
 	// Parse duration from value
@@ -133,13 +203,15 @@ func (uc *HandleInteractionUseCase) handleSilence(ctx context.Context, alertID s
 	}
 
 	// Set silence target (fingerprint-based for similar alerts)
-	silence.ForFingerprint(alertEntity.Fingerprint)
+	silence.ForFingerprint(alertEntity.Fingerprint).ForTenant(alertEntity.TenantID)
 	silence.WithReason(fmt.Sprintf("Silenced from Slack by %s", input.UserName))
 
 	// Save silence
 	if err := uc.silenceRepo.Save(ctx, silence); err != nil {
 		return nil, fmt.Errorf("saving silence: %w", err)
 	}
+	auditSilence(ctx, uc.auditLogger, entity.AuditActionSilenceCreate, silence, silenceCreator(silence))
+	uc.markSilenced(ctx, alertEntity, silence.ID)
 
 	// Also acknowledge the alert
 	syncInput := ack.SyncAckInput{
@@ -153,22 +225,15 @@ func (uc *HandleInteractionUseCase) handleSilence(ctx context.Context, alertID s
 
 	ackOutput, err := uc.syncAckUC.Execute(ctx, syncInput)
 	if err != nil {
-		uc.logger.Warn("failed to sync ack with silence",
+		logger.WithContext(ctx, uc.logger).Warn("failed to sync ack with silence",
 			"alertID", alertID,
 			"error", err,
 		)
 	}
 
-	// Update Slack message
-	messageID := fmt.Sprintf("%s:%s", input.ChannelID, input.MessageTS)
-	if ackOutput != nil && ackOutput.Alert != nil {
-		if err := uc.slackClient.UpdateMessage(ctx, messageID, ackOutput.Alert); err != nil {
-			uc.logger.Error("failed to update Slack message",
-				"messageID", messageID,
-				"error", err,
-			)
-		}
-	}
+	// Update every copy of the Slack message
+	messageID := alertMessageID(alertEntity, input.MessageRef())
+	updateAlertMessage(ctx, uc.slackClient, uc.alertRepo, logger.WithContext(ctx, uc.logger), silencedAlert(alertEntity, ackOutput), messageID)
 
 	// Post thread reply about silence
 	silenceMsg := fmt.Sprintf("🔕 Silenced for %s by %s (until %s)",
@@ -177,7 +242,7 @@ func (uc *HandleInteractionUseCase) handleSilence(ctx context.Context, alertID s
 		silence.EndAt.Format("Jan 2, 15:04 MST"),
 	)
 	if err := uc.slackClient.PostThreadReply(ctx, messageID, silenceMsg); err != nil {
-		uc.logger.Error("failed to post silence notification",
+		logger.WithContext(ctx, uc.logger).Error("failed to post silence notification",
 			"messageID", messageID,
 			"error", err,
 		)
@@ -191,6 +256,294 @@ func (uc *HandleInteractionUseCase) handleSilence(ctx context.Context, alertID s
 	}, nil
 }
 
+// handleSilenceInstance silences every alert from the clicked alert's instance.
+func (uc *HandleInteractionUseCase) handleSilenceInstance(ctx context.Context, alertID string, input dto.SlackInteractionInput, userEmail string) (*dto.SlackInteractionOutput, error) {
+	duration := uc.instanceSilenceDuration
+
+	alertEntity, err := uc.alertRepo.FindByID(ctx, alertID)
+	if err != nil {
+		return nil, fmt.Errorf("finding alert: %w", err)
+	}
+	if alertEntity == nil {
+		return nil, entity.ErrAlertNotFound
+	}
+	if alertEntity.Instance == "" {
+		return nil, fmt.Errorf("alert %s has no instance to silence", alertID)
+	}
+
+	// The instance silence belongs to the clicked alert's tenant
+	tenantCtx := repository.NewContextWithTenant(ctx, alertEntity.TenantID)
+	result, err := uc.silenceUC.Execute(tenantCtx, &dto.SilenceRequest{
+		Action:   dto.SilenceActionCreate,
+		Duration: duration,
+		Reason:   fmt.Sprintf("Instance silenced from Slack by %s", input.UserName),
+		Instance: alertEntity.Instance,
+		UserID:   input.UserID,
+		UserName: input.UserName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating instance silence: %w", err)
+	}
+	silence := result.Created
+	uc.markSilenced(ctx, alertEntity, silence.ID)
+
+	// Acknowledge the clicked alert so its message renders as silenced
+	syncInput := ack.SyncAckInput{
+		AlertID:   alertID,
+		Source:    entity.AckSourceSlack,
+		UserID:    input.UserID,
+		UserEmail: userEmail,
+		UserName:  input.UserName,
+		Duration:  &duration,
+	}
+
+	ackOutput, err := uc.syncAckUC.Execute(ctx, syncInput)
+	if err != nil {
+		logger.WithContext(ctx, uc.logger).Warn("failed to sync ack with instance silence",
+			"alertID", alertID,
+			"error", err,
+		)
+	}
+
+	messageID := alertMessageID(alertEntity, input.MessageRef())
+	updateAlertMessage(ctx, uc.slackClient, uc.alertRepo, logger.WithContext(ctx, uc.logger), silencedAlert(alertEntity, ackOutput), messageID)
+
+	silenceMsg := fmt.Sprintf("🔕 All alerts from `%s` silenced for %s by %s (until %s, silence ID `%s`)",
+		alertEntity.Instance,
+		formatDuration(duration),
+		input.UserName,
+		silence.EndAt.Format("Jan 2, 15:04 MST"),
+		silence.ID,
+	)
+	if err := uc.slackClient.PostThreadReply(ctx, messageID, silenceMsg); err != nil {
+		logger.WithContext(ctx, uc.logger).Error("failed to post instance silence notification",
+			"messageID", messageID,
+			"error", err,
+		)
+	}
+
+	return &dto.SlackInteractionOutput{
+		Success:      true,
+		Message:      fmt.Sprintf("Silenced %s for %s", alertEntity.Instance, formatDuration(duration)),
+		SilenceID:    silence.ID,
+		SilenceEndAt: &silence.EndAt,
+	}, nil
+}
+
+// handleUnsilence deletes the silence created from the alert's message and
+// re-renders the message with the silence options. The button carries the
+// silence ID, which picks out that silence when several match the alert.
+func (uc *HandleInteractionUseCase) handleUnsilence(ctx context.Context, alertID string, input dto.SlackInteractionInput, userEmail string) (*dto.SlackInteractionOutput, error) {
+	alertEntity, err := uc.alertRepo.FindByID(ctx, alertID)
+	if err != nil {
+		return nil, fmt.Errorf("finding alert: %w", err)
+	}
+	if alertEntity == nil {
+		return nil, entity.ErrAlertNotFound
+	}
+
+	silences, err := uc.silenceRepo.FindMatchingAlert(ctx, alertEntity)
+	if err != nil {
+		return nil, fmt.Errorf("finding silences: %w", err)
+	}
+	silence, err := pickSilence(silences, input.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	message := "Silence already expired or deleted"
+	if silence != nil {
+		if err := uc.silenceRepo.Delete(ctx, silence.ID); err != nil && !errors.Is(err, repository.ErrSilenceNotFound) {
+			return nil, fmt.Errorf("deleting silence: %w", err)
+		}
+		auditSilence(ctx, uc.auditLogger, entity.AuditActionSilenceDelete, silence, userEmail)
+		message = fmt.Sprintf("Deleted silence %s", silence.ID)
+	}
+
+	// The message offers the silence options again even if the silence was already gone
+	alertEntity.RemoveExternalReference(entity.SilenceReference)
+	if err := uc.alertRepo.Update(ctx, alertEntity); err != nil {
+		logger.WithContext(ctx, uc.logger).Warn("failed to clear silence reference",
+			"alertID", alertID,
+			"error", err,
+		)
+	}
+
+	messageID := alertMessageID(alertEntity, input.MessageRef())
+	updateAlertMessage(ctx, uc.slackClient, uc.alertRepo, logger.WithContext(ctx, uc.logger), alertEntity, messageID)
+
+	if silence != nil {
+		reply := fmt.Sprintf("🔔 Unsilenced by %s (silence ID `%s` deleted)", input.UserName, silence.ID)
+		if err := uc.slackClient.PostThreadReply(ctx, messageID, reply); err != nil {
+			logger.WithContext(ctx, uc.logger).Error("failed to post unsilence notification",
+				"messageID", messageID,
+				"error", err,
+			)
+		}
+	}
+
+	return &dto.SlackInteractionOutput{
+		Success: true,
+		Message: message,
+	}, nil
+}
+
+// handleAssign handles a user picked from the assign select, named by its
+// Slack user ID in the action value. The assignee is recorded by email, like
+// acknowledgments, falling back to the user ID.
+func (uc *HandleInteractionUseCase) handleAssign(ctx context.Context, alertID string, input dto.SlackInteractionInput, userEmail string) (*dto.SlackInteractionOutput, error) {
+	if input.Value == "" {
+		return nil, errors.New("no user selected")
+	}
+	assignee, err := uc.slackClient.GetUserEmail(ctx, input.Value)
+	if err != nil {
+		logger.WithContext(ctx, uc.logger).Warn("failed to get assignee email",
+			"userID", input.Value,
+			"error", err,
+		)
+		assignee = input.Value
+	}
+
+	output, err := uc.assign(ctx, alertID, assignee, userEmail, input)
+	if err != nil {
+		return nil, err
+	}
+	if !output.Changed {
+		return &dto.SlackInteractionOutput{
+			Success: true,
+			Message: fmt.Sprintf("Alert already assigned to %s", output.Alert.AssignedTo),
+		}, nil
+	}
+
+	// Mention the assignee in the thread so they are notified
+	if messageID := alertMessageID(output.Alert, input.MessageRef()); messageID != "" {
+		reply := fmt.Sprintf("👤 Assigned to <@%s> by %s", input.Value, input.UserName)
+		if err := uc.slackClient.PostThreadReply(ctx, messageID, reply); err != nil {
+			logger.WithContext(ctx, uc.logger).Error("failed to post assignment notification",
+				"messageID", messageID,
+				"error", err,
+			)
+		}
+	}
+
+	return &dto.SlackInteractionOutput{
+		Success: true,
+		Message: fmt.Sprintf("Alert assigned to %s", assignee),
+	}, nil
+}
+
+// handleUnassign handles the unassign action.
+func (uc *HandleInteractionUseCase) handleUnassign(ctx context.Context, alertID string, input dto.SlackInteractionInput, userEmail string) (*dto.SlackInteractionOutput, error) {
+	output, err := uc.assign(ctx, alertID, "", userEmail, input)
+	if err != nil {
+		return nil, err
+	}
+	if !output.Changed {
+		return &dto.SlackInteractionOutput{Success: true, Message: "Alert already unassigned"}, nil
+	}
+	return &dto.SlackInteractionOutput{
+		Success: true,
+		Message: fmt.Sprintf("Alert unassigned by %s", input.UserName),
+	}, nil
+}
+
+// assign records the alert's new assignee through the AssignUseCase the
+// admin API uses, which updates its Slack messages.
+func (uc *HandleInteractionUseCase) assign(ctx context.Context, alertID, assignee, by string, input dto.SlackInteractionInput) (*alert.AssignOutput, error) {
+	if uc.assignUC == nil {
+		return nil, errors.New("assignment is not configured")
+	}
+	return uc.assignUC.Execute(ctx, alert.AssignInput{
+		AlertID:    alertID,
+		Assignee:   assignee,
+		By:         by,
+		MessageRef: input.MessageRef(),
+	})
+}
+
+// pickSilence returns the silence to delete among those matching an alert:
+// the one with silenceID, or the only match if no ID is given. It returns
+// nil if that silence no longer matches, e.g. because it expired.
+func pickSilence(silences []*entity.SilenceMark, silenceID string) (*entity.SilenceMark, error) {
+	if silenceID == "" {
+		switch len(silences) {
+		case 0:
+			return nil, nil
+		case 1:
+			return silences[0], nil
+		default:
+			return nil, fmt.Errorf("%d silences match the alert; delete one with /silence delete <id>", len(silences))
+		}
+	}
+
+	for _, silence := range silences {
+		if silence.ID == silenceID {
+			return silence, nil
+		}
+	}
+	return nil, nil
+}
+
+// markSilenced records the silence on the alert so its message offers to
+// delete the silence again.
+func (uc *HandleInteractionUseCase) markSilenced(ctx context.Context, alertEntity *entity.Alert, silenceID string) {
+	alertEntity.SetExternalReference(entity.SilenceReference, silenceID)
+	if err := uc.alertRepo.Update(ctx, alertEntity); err != nil {
+		logger.WithContext(ctx, uc.logger).Warn("failed to record silence on alert",
+			"alertID", alertEntity.ID,
+			"silenceID", silenceID,
+			"error", err,
+		)
+	}
+}
+
+// silencedAlert returns the alert to render after a silence: the
+// acknowledged alert if the ack succeeded, else the silenced one.
+func silencedAlert(alertEntity *entity.Alert, ackOutput *ack.SyncAckOutput) *entity.Alert {
+	if ackOutput != nil && ackOutput.Alert != nil {
+		return ackOutput.Alert
+	}
+	return alertEntity
+}
+
+// alertMessageID returns the message ID covering every Slack copy of the alert,
+// falling back to the message the user interacted with. It is empty for an
+// alert without a message acted on outside one, e.g. from the App Home tab.
+func alertMessageID(alert *entity.Alert, fallback entity.MessageRef) string {
+	if alert != nil && alert.HasExternalReference("slack") {
+		return alert.GetExternalReference("slack")
+	}
+	if fallback.Timestamp == "" {
+		return ""
+	}
+	return fallback.String()
+}
+
+// updateAlertMessage updates the alert's Slack message and persists the alert
+// if the client re-posted a deleted message under a new reference.
+func updateAlertMessage(ctx context.Context, client SlackClient, alertRepo repository.AlertRepository, logger alert.Logger, alertEntity *entity.Alert, messageID string) {
+	if messageID == "" {
+		return
+	}
+
+	before := alertEntity.GetExternalReference("slack")
+	if err := client.UpdateMessage(ctx, messageID, alertEntity); err != nil {
+		logger.Error("failed to update Slack message",
+			"messageID", messageID,
+			"error", err,
+		)
+	}
+
+	if alertEntity.GetExternalReference("slack") != before {
+		if err := alertRepo.Update(ctx, alertEntity); err != nil {
+			logger.Error("failed to store re-posted Slack message ID",
+				"alertID", alertEntity.ID,
+				"error", err,
+			)
+		}
+	}
+}
+
 // parseActionID parses an action ID like "ack_<alertID>" into action type and alert ID.
 func parseActionID(actionID string) (actionType, alertID string) {
 	parts := strings.SplitN(actionID, "_", 2)
@@ -233,6 +586,10 @@ func (uc *HandleInteractionUseCase) HandleModalSubmission(ctx context.Context, p
 
 // handleSilenceModalSubmission processes the silence creation modal submission.
 func (uc *HandleInteractionUseCase) handleSilenceModalSubmission(ctx context.Context, payload *slackLib.InteractionCallback) (*dto.SlackInteractionOutput, error) {
+	// The submission carries no channel; the modal remembers the command's tenant
+	if tenantID, ok := slackInfra.SilenceModalTenant(payload.View.PrivateMetadata); ok {
+		ctx = repository.NewContextWithTenant(ctx, tenantID)
+	}
 	values := payload.View.State.Values
 
 	// Parse duration
@@ -286,18 +643,20 @@ func (uc *HandleInteractionUseCase) handleSilenceModalSubmission(ctx context.Con
 	if len(matchers) > 0 {
 		silence.WithMatchers(matchers)
 	}
+	silence.TenantID, _ = repository.TenantFromContext(ctx)
 
 	// Save silence
 	if err := uc.silenceRepo.Save(ctx, silence); err != nil {
 		return nil, fmt.Errorf("failed to save silence: %w", err)
 	}
+	auditSilence(ctx, uc.auditLogger, entity.AuditActionSilenceCreate, silence, silenceCreator(silence))
 
 	msg := fmt.Sprintf("Created silence for %s", formatDuration(duration))
 	if len(matchers) > 0 {
 		msg += fmt.Sprintf(" with %d matcher(s)", len(matchers))
 	}
 
-	uc.logger.Info("silence created from modal",
+	logger.WithContext(ctx, uc.logger).Info("silence created from modal",
 		"silenceID", silence.ID,
 		"duration", duration.String(),
 		"matcherCount", len(matchers),
@@ -0,0 +1,128 @@
+package slack
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/ack"
+)
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...any) {}
+func (nopLogger) Info(string, ...any)  {}
+func (nopLogger) Warn(string, ...any)  {}
+func (nopLogger) Error(string, ...any) {}
+
+// recordingSlackClient keeps the message updates and thread replies it is asked to post.
+type recordingSlackClient struct {
+	updates map[string]*entity.Alert // message ID -> rendered alert
+	replies map[string][]string      // message ID -> thread replies
+}
+
+func newRecordingSlackClient() *recordingSlackClient {
+	return &recordingSlackClient{
+		updates: make(map[string]*entity.Alert),
+		replies: make(map[string][]string),
+	}
+}
+
+func (c *recordingSlackClient) GetUserEmail(ctx context.Context, userID string) (string, error) {
+	return userID + "@example.com", nil
+}
+
+func (c *recordingSlackClient) UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error {
+	c.updates[messageID] = alert
+	return nil
+}
+
+func (c *recordingSlackClient) PostThreadReply(ctx context.Context, messageID, text string) error {
+	c.replies[messageID] = append(c.replies[messageID], text)
+	return nil
+}
+
+func TestHandleInteraction_SilenceInstance(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	silenceRepo := memory.NewSilenceRepository()
+	syncAck := ack.NewSyncAckUseCase(alertRepo, memory.NewAckEventRepository(), memory.NewTransactionManager(), nil, nopLogger{}, nil)
+	slackClient := newRecordingSlackClient()
+
+	uc := NewHandleInteractionUseCase(alertRepo, silenceRepo, syncAck, nil, slackClient, nopLogger{})
+	uc.SetInstanceSilenceDuration(2 * time.Hour)
+
+	clicked := entity.NewAlert("fp-1", "High CPU", "host-1", "", "", entity.SeverityCritical)
+	clicked.SetExternalReference("slack", "C123:1700000000.000100")
+	require.NoError(t, alertRepo.Save(ctx, clicked))
+
+	output, err := uc.Execute(ctx, dto.SlackInteractionInput{
+		ActionID:  "silenceinstance_" + clicked.ID,
+		UserID:    "U123",
+		UserName:  "alice",
+		ChannelID: "C123",
+		MessageTS: "1700000000.000100",
+	})
+	require.NoError(t, err)
+	assert.True(t, output.Success)
+	require.NotEmpty(t, output.SilenceID)
+
+	// The silence covers the whole instance for the configured duration
+	silences, err := silenceRepo.FindByInstance(ctx, "host-1")
+	require.NoError(t, err)
+	require.Len(t, silences, 1)
+	silence := silences[0]
+	assert.Equal(t, output.SilenceID, silence.ID)
+	assert.Empty(t, silence.AlertID)
+	assert.Empty(t, silence.Fingerprint)
+	assert.WithinDuration(t, time.Now().Add(2*time.Hour), silence.EndAt, time.Minute)
+	assert.True(t, silence.MatchesAlert(entity.NewAlert("fp-2", "Disk full", "host-1", "", "", entity.SeverityWarning)))
+
+	// The clicked alert is acknowledged and records the silence
+	stored, err := alertRepo.FindByID(ctx, clicked.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.StateAcked, stored.State)
+	assert.Equal(t, silence.ID, stored.GetExternalReference(entity.SilenceReference))
+
+	// The message is re-rendered as silenced and the silence is announced in its thread
+	updated := slackClient.updates["C123:1700000000.000100"]
+	require.NotNil(t, updated)
+	assert.Equal(t, entity.StateAcked, updated.State)
+	assert.Equal(t, silence.ID, updated.GetExternalReference(entity.SilenceReference))
+
+	replies := slackClient.replies["C123:1700000000.000100"]
+	require.Len(t, replies, 1)
+	assert.Contains(t, replies[0], "`host-1`")
+	assert.Contains(t, replies[0], silence.ID)
+}
+
+func TestHandleInteraction_SilenceInstanceWithoutInstance(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	silenceRepo := memory.NewSilenceRepository()
+	syncAck := ack.NewSyncAckUseCase(alertRepo, memory.NewAckEventRepository(), memory.NewTransactionManager(), nil, nopLogger{}, nil)
+	slackClient := newRecordingSlackClient()
+
+	uc := NewHandleInteractionUseCase(alertRepo, silenceRepo, syncAck, nil, slackClient, nopLogger{})
+
+	clicked := entity.NewAlert("fp-1", "High CPU", "", "", "", entity.SeverityCritical)
+	require.NoError(t, alertRepo.Save(ctx, clicked))
+
+	_, err := uc.Execute(ctx, dto.SlackInteractionInput{
+		ActionID: "silenceinstance_" + clicked.ID,
+		UserID:   "U123",
+		UserName: "alice",
+	})
+	require.Error(t, err)
+
+	silences, err := silenceRepo.FindActive(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, silences)
+	assert.Empty(t, slackClient.updates)
+}
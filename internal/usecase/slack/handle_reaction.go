@@ -0,0 +1,98 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/logger"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/ack"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
+)
+
+// HandleReactionUseCase acknowledges alerts when a configured emoji is added to their Slack message.
+type HandleReactionUseCase struct {
+	alertRepo   repository.AlertRepository
+	syncAckUC   *ack.SyncAckUseCase
+	slackClient SlackClient
+	ackReaction string
+	logger      alert.Logger
+}
+
+// NewHandleReactionUseCase creates a new HandleReactionUseCase.
+// ackReaction is the emoji name that triggers an ack; surrounding colons are ignored.
+func NewHandleReactionUseCase(
+	alertRepo repository.AlertRepository,
+	syncAckUC *ack.SyncAckUseCase,
+	slackClient SlackClient,
+	ackReaction string,
+	logger alert.Logger,
+) *HandleReactionUseCase {
+	return &HandleReactionUseCase{
+		alertRepo:   alertRepo,
+		syncAckUC:   syncAckUC,
+		slackClient: slackClient,
+		ackReaction: strings.Trim(ackReaction, ":"),
+		logger:      logger,
+	}
+}
+
+// Execute processes a reaction_added event.
+// Reactions other than the ack emoji, reactions on non-alert messages and
+// reactions on alerts that are no longer active are ignored.
+func (uc *HandleReactionUseCase) Execute(ctx context.Context, input dto.SlackReactionInput) (*dto.SlackInteractionOutput, error) {
+	if uc.ackReaction == "" || input.Reaction != uc.ackReaction {
+		return &dto.SlackInteractionOutput{Message: "reaction ignored"}, nil
+	}
+
+	messageID := input.MessageRef().String()
+	alertEntity, err := uc.alertRepo.FindByExternalReference(ctx, "slack", messageID)
+	if err != nil {
+		return nil, fmt.Errorf("finding alert by slack message: %w", err)
+	}
+	if alertEntity == nil {
+		return &dto.SlackInteractionOutput{Message: "not an alert message"}, nil
+	}
+	if !alertEntity.IsActive() {
+		logger.WithContext(ctx, uc.logger).Debug("ignoring ack reaction on acked or resolved alert",
+			"alertID", alertEntity.ID,
+			"state", alertEntity.State,
+		)
+		return &dto.SlackInteractionOutput{Message: "alert not active"}, nil
+	}
+
+	userEmail, err := uc.slackClient.GetUserEmail(ctx, input.UserID)
+	if err != nil {
+		logger.WithContext(ctx, uc.logger).Warn("failed to get user email",
+			"userID", input.UserID,
+			"error", err,
+		)
+		userEmail = input.UserID // Fallback to user ID
+	}
+
+	// Reaction events carry only the user ID, so the email doubles as display
+	// name. No note is given, so a reaction racing another ack of the alert
+	// is recognized as a repeated ack.
+	output, err := uc.syncAckUC.Execute(ctx, ack.SyncAckInput{
+		AlertID:   alertEntity.ID,
+		Source:    entity.AckSourceSlack,
+		UserID:    input.UserID,
+		UserEmail: userEmail,
+		UserName:  userEmail,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("syncing ack: %w", err)
+	}
+
+	// Update every copy of the message, not just the one that was reacted to
+	messageID = alertMessageID(output.Alert, input.MessageRef())
+	updateAlertMessage(ctx, uc.slackClient, uc.alertRepo, logger.WithContext(ctx, uc.logger), output.Alert, messageID)
+
+	return &dto.SlackInteractionOutput{
+		Success: true,
+		Message: fmt.Sprintf("Alert acknowledged by %s", userEmail),
+	}, nil
+}
@@ -0,0 +1,115 @@
+package slack
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qj0r9j0vc2/alert-bridge/internal/adapter/dto"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/persistence/memory"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/ack"
+)
+
+func TestHandleReaction(t *testing.T) {
+	const messageID = "C123:1700000000.000100"
+
+	reaction := func(emoji string) dto.SlackReactionInput {
+		return dto.SlackReactionInput{
+			Reaction:  emoji,
+			UserID:    "U123",
+			ChannelID: "C123",
+			MessageTS: "1700000000.000100",
+		}
+	}
+
+	tests := []struct {
+		name        string
+		input       dto.SlackReactionInput
+		acked       bool // the alert was acknowledged before the reaction
+		wantMessage string
+		wantAck     bool
+	}{
+		{
+			name:        "ack emoji acknowledges the alert",
+			input:       reaction("x"),
+			wantMessage: "Alert acknowledged by U123@example.com",
+			wantAck:     true,
+		},
+		{
+			name:        "other emoji is ignored",
+			input:       reaction("eyes"),
+			wantMessage: "reaction ignored",
+		},
+		{
+			name: "message that is not an alert is ignored",
+			input: dto.SlackReactionInput{
+				Reaction:  "x",
+				UserID:    "U123",
+				ChannelID: "C123",
+				MessageTS: "1700000000.999999",
+			},
+			wantMessage: "not an alert message",
+		},
+		{
+			name:        "already acknowledged alert is ignored",
+			input:       reaction("x"),
+			acked:       true,
+			wantMessage: "alert not active",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			alertRepo := memory.NewAlertRepository()
+			ackEventRepo := memory.NewAckEventRepository()
+			syncAck := ack.NewSyncAckUseCase(alertRepo, ackEventRepo, memory.NewTransactionManager(), nil, nopLogger{}, nil)
+			slackClient := newRecordingSlackClient()
+
+			// Colons around the configured emoji are ignored
+			uc := NewHandleReactionUseCase(alertRepo, syncAck, slackClient, ":x:", nopLogger{})
+
+			alert := entity.NewAlert("fp-1", "High CPU", "host-1", "", "", entity.SeverityCritical)
+			alert.SetExternalReference("slack", messageID)
+			if tt.acked {
+				require.NoError(t, alert.Acknowledge("earlier@example.com", time.Now().UTC()))
+			}
+			require.NoError(t, alertRepo.Save(ctx, alert))
+
+			output, err := uc.Execute(ctx, tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAck, output.Success)
+			assert.Equal(t, tt.wantMessage, output.Message)
+
+			stored, err := alertRepo.FindByID(ctx, alert.ID)
+			require.NoError(t, err)
+			events, err := ackEventRepo.FindByAlertID(ctx, alert.ID)
+			require.NoError(t, err)
+
+			if !tt.wantAck {
+				assert.Empty(t, events)
+				assert.Empty(t, slackClient.updates)
+				if !tt.acked {
+					assert.Equal(t, entity.StateActive, stored.State)
+				}
+				return
+			}
+
+			assert.Equal(t, entity.StateAcked, stored.State)
+			assert.Equal(t, "U123@example.com", stored.AckedBy)
+
+			require.Len(t, events, 1)
+			assert.Equal(t, entity.AckSourceSlack, events[0].Source)
+			assert.Equal(t, "U123", events[0].UserID)
+			assert.Empty(t, events[0].Note)
+
+			updated := slackClient.updates[messageID]
+			require.NotNil(t, updated)
+			assert.Equal(t, entity.StateAcked, updated.State)
+		})
+	}
+}
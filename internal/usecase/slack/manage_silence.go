@@ -11,6 +11,8 @@ import (
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/entity"
 	"github.com/qj0r9j0vc2/alert-bridge/internal/domain/repository"
 	slackInfra "github.com/qj0r9j0vc2/alert-bridge/internal/infrastructure/slack"
+	"github.com/qj0r9j0vc2/alert-bridge/internal/usecase/alert"
+	silenceUseCase "github.com/qj0r9j0vc2/alert-bridge/internal/usecase/silence"
 )
 
 // SilenceResult represents the result of a silence operation.
@@ -19,6 +21,7 @@ type SilenceResult struct {
 	Silences    []*entity.SilenceMark
 	Created     *entity.SilenceMark
 	Deleted     *entity.SilenceMark
+	Expired     *entity.SilenceMark
 	Message     string
 	OpenedModal bool // True if a modal was opened (no message response needed)
 }
@@ -36,6 +39,8 @@ type ManageSilenceUseCase struct {
 	silenceRepo repository.SilenceRepository
 	alertRepo   repository.AlertRepository
 	slackClient SilenceModalClient
+	auditLogger alert.AuditLogger
+	expire      *silenceUseCase.ExpireSilenceUseCase
 }
 
 // NewManageSilenceUseCase creates a new manage silence use case.
@@ -48,9 +53,16 @@ func NewManageSilenceUseCase(
 		silenceRepo: silenceRepo,
 		alertRepo:   alertRepo,
 		slackClient: slackClient,
+		expire:      silenceUseCase.NewExpireSilenceUseCase(silenceRepo),
 	}
 }
 
+// SetAuditLogger records created, deleted and expired silences in the audit log.
+func (uc *ManageSilenceUseCase) SetAuditLogger(auditLogger alert.AuditLogger) {
+	uc.auditLogger = auditLogger
+	uc.expire.SetAuditLogger(auditLogger)
+}
+
 // Execute performs the requested silence action.
 func (uc *ManageSilenceUseCase) Execute(ctx context.Context, req *dto.SilenceRequest) (*SilenceResult, error) {
 	switch req.Action {
@@ -62,6 +74,8 @@ func (uc *ManageSilenceUseCase) Execute(ctx context.Context, req *dto.SilenceReq
 		return uc.listSilences(ctx)
 	case dto.SilenceActionDelete:
 		return uc.deleteSilence(ctx, req)
+	case dto.SilenceActionExpire:
+		return uc.expireSilence(ctx, req)
 	default:
 		return nil, fmt.Errorf("unknown action: %s", req.Action)
 	}
@@ -86,6 +100,9 @@ func (uc *ManageSilenceUseCase) openModal(ctx context.Context, req *dto.SilenceR
 
 	// Build and open the modal
 	modal := slackInfra.BuildSilenceModal(labelOptions)
+	if tenantID, ok := repository.TenantFromContext(ctx); ok {
+		modal.PrivateMetadata = slackInfra.SilenceModalMetadata(tenantID)
+	}
 	if err := uc.slackClient.OpenModal(ctx, req.TriggerID, modal); err != nil {
 		return nil, fmt.Errorf("failed to open modal: %w", err)
 	}
@@ -121,13 +138,21 @@ func (uc *ManageSilenceUseCase) createSilence(ctx context.Context, req *dto.Sile
 	if len(req.Matchers) > 0 {
 		silence.WithMatchers(req.Matchers)
 	}
+	if req.Instance != "" {
+		silence.ForInstance(req.Instance)
+	}
+	silence.TenantID, _ = repository.TenantFromContext(ctx)
 
 	if err := uc.silenceRepo.Save(ctx, silence); err != nil {
 		return nil, fmt.Errorf("failed to save silence: %w", err)
 	}
+	auditSilence(ctx, uc.auditLogger, entity.AuditActionSilenceCreate, silence, silenceCreator(silence))
 
 	// Build message with matcher info
 	msg := fmt.Sprintf("Created silence for %s", formatDuration(req.Duration))
+	if req.Instance != "" {
+		msg += fmt.Sprintf(" on instance %s", req.Instance)
+	}
 	if len(req.Matchers) > 0 {
 		msg += fmt.Sprintf(" with %d matcher(s)", len(req.Matchers))
 	}
@@ -171,6 +196,7 @@ func (uc *ManageSilenceUseCase) deleteSilence(ctx context.Context, req *dto.Sile
 	if err := uc.silenceRepo.Delete(ctx, req.SilenceID); err != nil {
 		return nil, fmt.Errorf("failed to delete silence: %w", err)
 	}
+	auditSilence(ctx, uc.auditLogger, entity.AuditActionSilenceDelete, silence, req.UserName)
 
 	return &SilenceResult{
 		Action:  dto.SilenceActionDelete,
@@ -178,3 +204,40 @@ func (uc *ManageSilenceUseCase) deleteSilence(ctx context.Context, req *dto.Sile
 		Message: fmt.Sprintf("Deleted silence %s", req.SilenceID),
 	}, nil
 }
+
+// expireSilence ends a silence now, keeping it for its history.
+func (uc *ManageSilenceUseCase) expireSilence(ctx context.Context, req *dto.SilenceRequest) (*SilenceResult, error) {
+	if req.SilenceID == "" {
+		return nil, fmt.Errorf("silence ID is required for expire action")
+	}
+
+	silence, err := uc.expire.Execute(ctx, req.SilenceID, req.UserName, entity.AckSourceSlack)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SilenceResult{
+		Action:  dto.SilenceActionExpire,
+		Expired: silence,
+		Message: fmt.Sprintf("Expired silence %s", req.SilenceID),
+	}, nil
+}
+
+// auditSilence records a silence change if an audit logger is set. The
+// event is attributed to the Slack user who made the change.
+func auditSilence(ctx context.Context, auditLogger alert.AuditLogger, action entity.AuditAction, silence *entity.SilenceMark, actor string) {
+	if auditLogger == nil {
+		return
+	}
+	auditLogger.Audit(ctx, entity.NewAuditEvent(action, actor, string(entity.AckSourceSlack)).
+		ForSilence(silence.ID).
+		WithNote(silence.Reason))
+}
+
+// silenceCreator names who created a silence, preferring the email.
+func silenceCreator(silence *entity.SilenceMark) string {
+	if silence.CreatedByEmail != "" {
+		return silence.CreatedByEmail
+	}
+	return silence.CreatedBy
+}